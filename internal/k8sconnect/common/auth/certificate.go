@@ -0,0 +1,131 @@
+package auth
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// certificateIssue is a problem found in a configured CA certificate bundle
+type certificateIssue struct {
+	summary string
+	detail  string
+	warning bool // the connection may still work, e.g. other certificates in the bundle are fine
+}
+
+// caCertificateIssues checks a cluster_ca_certificate value (PEM or base64-encoded PEM)
+// as of now. Expired or not-yet-valid certificates are errors when no certificate in the
+// bundle is usable, since TLS verification would then fail on every request. A
+// certificate without the CA flag is only a warning: a self-signed server certificate
+// pinned as its own CA still verifies.
+func caCertificateIssues(value string, now time.Time) []certificateIssue {
+	data, err := AutoDecodePEM(value, "cluster_ca_certificate")
+	if err != nil {
+		return []certificateIssue{{
+			summary: "Invalid CA Certificate",
+			detail: fmt.Sprintf("%s.\n\n"+
+				"Provide the cluster CA certificate, starting with '-----BEGIN CERTIFICATE-----', "+
+				"or its base64 encoding (e.g. certificate-authority-data from a kubeconfig).", err),
+		}}
+	}
+
+	certs, issue := parseCertificateBundle(data)
+	if issue != nil {
+		return []certificateIssue{*issue}
+	}
+
+	var expired, notYetValid, notCA []*x509.Certificate
+	for _, cert := range certs {
+		switch {
+		case now.After(cert.NotAfter):
+			expired = append(expired, cert)
+		case now.Before(cert.NotBefore):
+			notYetValid = append(notYetValid, cert)
+		case !cert.IsCA:
+			notCA = append(notCA, cert)
+		}
+	}
+	allUnusable := len(expired)+len(notYetValid) == len(certs)
+
+	var issues []certificateIssue
+	for _, cert := range expired {
+		issues = append(issues, certificateIssue{
+			summary: "Expired CA Certificate",
+			detail: fmt.Sprintf("The CA certificate %s expired on %s.\n\n"+
+				"TLS connections verified against it fail. Use the cluster's current CA certificate, "+
+				"e.g. certificate-authority-data from a freshly downloaded kubeconfig.",
+				describeCertificate(cert), cert.NotAfter.UTC().Format(time.RFC3339)),
+			warning: !allUnusable,
+		})
+	}
+	for _, cert := range notYetValid {
+		issues = append(issues, certificateIssue{
+			summary: "CA Certificate Not Yet Valid",
+			detail: fmt.Sprintf("The CA certificate %s is not valid before %s.\n\n"+
+				"Check that the clock of the machine running Terraform is correct, or use the cluster's current CA certificate.",
+				describeCertificate(cert), cert.NotBefore.UTC().Format(time.RFC3339)),
+			warning: !allUnusable,
+		})
+	}
+	for _, cert := range notCA {
+		issues = append(issues, certificateIssue{
+			summary: "Not a CA Certificate",
+			detail: fmt.Sprintf("The certificate %s in cluster_ca_certificate is not a CA certificate.\n\n"+
+				"This is usually a client or server certificate pasted by mistake, e.g. client-certificate-data "+
+				"instead of certificate-authority-data. TLS verification only succeeds if it is the API server's own "+
+				"self-signed certificate.", describeCertificate(cert)),
+			warning: true,
+		})
+	}
+	return issues
+}
+
+// parseCertificateBundle parses every PEM block of data as an X.509 certificate
+func parseCertificateBundle(data []byte) ([]*x509.Certificate, *certificateIssue) {
+	var certs []*x509.Certificate
+	for block, rest := pem.Decode(data); block != nil; block, rest = pem.Decode(rest) {
+		if block.Type != "CERTIFICATE" {
+			hint := ""
+			if strings.Contains(block.Type, "PRIVATE KEY") {
+				hint = " A private key must never be used as cluster_ca_certificate; it belongs in client_key."
+			}
+			return nil, &certificateIssue{
+				summary: "Invalid CA Certificate",
+				detail:  fmt.Sprintf("cluster_ca_certificate contains a %s block, but only CERTIFICATE blocks are allowed.%s", block.Type, hint),
+			}
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, &certificateIssue{
+				summary: "Invalid CA Certificate",
+				detail: fmt.Sprintf("Certificate %d in cluster_ca_certificate could not be parsed: %s\n\n"+
+					"The PEM data is likely truncated or was changed while copying it. Copy the whole certificate again, "+
+					"including the BEGIN and END lines.", len(certs)+1, err),
+			}
+		}
+		certs = append(certs, cert)
+	}
+
+	if len(certs) == 0 {
+		return nil, &certificateIssue{
+			summary: "Invalid CA Certificate",
+			detail: "cluster_ca_certificate contains no valid PEM certificate. Check that the BEGIN and END lines " +
+				"are intact and that each is on its own line.",
+		}
+	}
+	return certs, nil
+}
+
+// describeCertificate names a certificate for diagnostics by subject and issuer
+func describeCertificate(cert *x509.Certificate) string {
+	subject := cert.Subject.String()
+	if subject == "" {
+		subject = "(no subject)"
+	}
+	if issuer := cert.Issuer.String(); issuer != "" && issuer != subject {
+		return fmt.Sprintf("%q (issued by %q)", subject, issuer)
+	}
+	return fmt.Sprintf("%q", subject)
+}
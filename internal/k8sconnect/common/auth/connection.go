@@ -5,7 +5,9 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
+	"sort"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/jmorris0x0/terraform-provider-k8sconnect/internal/k8sconnect/common/k8sclient"
@@ -27,7 +29,13 @@ type ClusterModel struct {
 	ClientKey            types.String   `tfsdk:"client_key"`
 	Insecure             types.Bool     `tfsdk:"insecure"`
 	ProxyURL             types.String   `tfsdk:"proxy_url"`
+	UseEnv               types.Bool     `tfsdk:"use_env"`
+	RequestTimeout       types.String   `tfsdk:"request_timeout"`
+	Namespace            types.String   `tfsdk:"namespace"`
 	Exec                 *ExecAuthModel `tfsdk:"exec"`
+	EKS                  *EKSAuthModel  `tfsdk:"eks"`
+	GKE                  *GKEAuthModel  `tfsdk:"gke"`
+	AKS                  *AKSAuthModel  `tfsdk:"aks"`
 }
 
 // ExecAuthModel represents exec-based authentication configuration
@@ -39,19 +47,55 @@ type ExecAuthModel struct {
 }
 
 // CreateRESTConfig creates a Kubernetes REST config from the connection model.
-// It determines the appropriate method (inline or kubeconfig) and returns
+// It determines the appropriate method (inline, kubeconfig, or environment) and returns
 // a configured rest.Config ready for creating a Kubernetes client.
 func CreateRESTConfig(ctx context.Context, conn ClusterModel) (*rest.Config, error) {
+	var config *rest.Config
+	var err error
+
 	// Determine which connection method to use
 	if !conn.Host.IsNull() {
 		// Inline configuration
-		return createInlineConfig(conn)
+		config, err = createInlineConfig(conn)
 	} else if !conn.Kubeconfig.IsNull() {
 		// Kubeconfig (raw content, use file() function to load from file)
-		return createKubeconfigConfig(conn)
+		config, err = createKubeconfigConfig(conn)
+	} else if hasEnvMode(conn) {
+		// Kubeconfig resolved from KUBECONFIG or ~/.kube/config
+		config, err = createEnvConfig(conn)
+	} else {
+		return nil, fmt.Errorf("no connection configuration provided")
+	}
+	if err != nil {
+		return nil, err
 	}
 
-	return nil, fmt.Errorf("no connection configuration provided")
+	// Per-request timeout applies to every connection mode
+	if err := configureRequestTimeout(config, conn); err != nil {
+		return nil, err
+	}
+
+	return config, nil
+}
+
+// configureRequestTimeout sets rest.Config.Timeout from request_timeout so a single
+// Get/Apply/Patch/List call can't hang on a degraded control plane. Watches are exempt:
+// the client builds a separate watch client without this timeout (see k8sclient).
+func configureRequestTimeout(config *rest.Config, conn ClusterModel) error {
+	if conn.RequestTimeout.IsNull() {
+		return nil
+	}
+
+	timeout, err := time.ParseDuration(conn.RequestTimeout.ValueString())
+	if err != nil {
+		return fmt.Errorf("failed to parse request_timeout: %w", err)
+	}
+	if timeout <= 0 {
+		return fmt.Errorf("request_timeout must be greater than zero, got %q", conn.RequestTimeout.ValueString())
+	}
+
+	config.Timeout = timeout
+	return nil
 }
 
 // createInlineConfig creates a REST config from inline connection settings
@@ -165,34 +209,36 @@ func configureClientCerts(config *rest.Config, conn ClusterModel) error {
 	return nil
 }
 
-// configureExecAuth handles exec authentication
+// configureExecAuth handles exec authentication, including the eks, gke, and aks shortcuts
 func configureExecAuth(config *rest.Config, conn ClusterModel) error {
-	if conn.Exec == nil || conn.Exec.APIVersion.IsNull() {
+	exec := EffectiveExecAuth(conn)
+	if exec == nil {
 		return nil // No exec auth
 	}
 
 	// Build args array
-	args := make([]string, len(conn.Exec.Args))
-	for i, arg := range conn.Exec.Args {
+	args := make([]string, len(exec.Args))
+	for i, arg := range exec.Args {
 		args[i] = arg.ValueString()
 	}
 
-	// Build env vars array
+	// Build env vars array in sorted order. client-go caches exec authenticators (and the
+	// credentials they return, until expiry) keyed by the full ExecConfig, so a stable order
+	// lets every client for this connection reuse one token instead of re-running the plugin.
 	var envVars []clientcmdapi.ExecEnvVar
-	if conn.Exec.Env != nil {
-		for name, value := range conn.Exec.Env {
-			if !value.IsNull() {
-				envVars = append(envVars, clientcmdapi.ExecEnvVar{
-					Name:  name,
-					Value: value.ValueString(),
-				})
-			}
+	for _, name := range sortedExecEnvNames(exec.Env) {
+		value := exec.Env[name]
+		if !value.IsNull() {
+			envVars = append(envVars, clientcmdapi.ExecEnvVar{
+				Name:  name,
+				Value: value.ValueString(),
+			})
 		}
 	}
 
 	config.ExecProvider = &clientcmdapi.ExecConfig{
-		APIVersion:      conn.Exec.APIVersion.ValueString(),
-		Command:         conn.Exec.Command.ValueString(),
+		APIVersion:      exec.APIVersion.ValueString(),
+		Command:         exec.Command.ValueString(),
 		Args:            args,
 		Env:             envVars,
 		InteractiveMode: clientcmdapi.NeverExecInteractiveMode,
@@ -201,6 +247,16 @@ func configureExecAuth(config *rest.Config, conn ClusterModel) error {
 	return nil
 }
 
+// sortedExecEnvNames returns the exec env var names in sorted order
+func sortedExecEnvNames(env map[string]types.String) []string {
+	names := make([]string, 0, len(env))
+	for name := range env {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
 // configureProxy sets up proxy configuration
 func configureProxy(config *rest.Config, conn ClusterModel) error {
 	if !conn.ProxyURL.IsNull() {
@@ -230,6 +286,44 @@ func createKubeconfigConfig(conn ClusterModel) (*rest.Config, error) {
 		return nil, fmt.Errorf("failed to parse kubeconfig YAML: %w\n\nHint: Ensure kubeconfig contains valid YAML content. If loading from a file, use: kubeconfig = file(\"~/.kube/config\")", err)
 	}
 
+	return restConfigFromKubeconfig(clientConfig, conn)
+}
+
+// createEnvConfig creates a REST config from the kubeconfig found in the environment.
+// Files are resolved with the standard kubectl loading rules: the KUBECONFIG
+// environment variable (a path list, merged in order) or ~/.kube/config when unset.
+func createEnvConfig(conn ClusterModel) (*rest.Config, error) {
+	loadingRules := clientcmd.NewDefaultClientConfigLoadingRules()
+
+	clientConfig, err := loadingRules.Load()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load kubeconfig from environment: %w\n\n"+
+			"Searched: %s\n\n"+
+			"Hint: Set the KUBECONFIG environment variable or create ~/.kube/config", err, describeEnvKubeconfigPaths(loadingRules))
+	}
+
+	if len(clientConfig.Contexts) == 0 {
+		return nil, fmt.Errorf("no kubeconfig found in environment (use_env = true)\n\n"+
+			"Searched: %s\n\n"+
+			"Hint: Set the KUBECONFIG environment variable or create ~/.kube/config", describeEnvKubeconfigPaths(loadingRules))
+	}
+
+	return restConfigFromKubeconfig(clientConfig, conn)
+}
+
+// describeEnvKubeconfigPaths lists the kubeconfig paths consulted by use_env for error messages
+func describeEnvKubeconfigPaths(loadingRules *clientcmd.ClientConfigLoadingRules) string {
+	paths := loadingRules.GetLoadingPrecedence()
+	if len(paths) == 0 {
+		return "(none)"
+	}
+	return strings.Join(paths, ", ")
+}
+
+// restConfigFromKubeconfig builds a REST config from a parsed kubeconfig, selecting the
+// context from the connection. Without an explicit context, the kubeconfig must contain
+// exactly one context to prevent accidental connection to the wrong cluster.
+func restConfigFromKubeconfig(clientConfig *clientcmdapi.Config, conn ClusterModel) (*rest.Config, error) {
 	if !conn.Context.IsNull() {
 		// Context explicitly provided - use it
 		context := conn.Context.ValueString()
@@ -280,6 +374,47 @@ func createKubeconfigConfig(conn ClusterModel) (*rest.Config, error) {
 		contextCount, strings.Join(contextNames, "\n  - "))
 }
 
+// DefaultNamespace returns the namespace used for namespaced objects that omit
+// metadata.namespace, matching kubectl: the connection's namespace if set, otherwise the
+// namespace of the kubeconfig context the connection selects, otherwise "default".
+// Kubeconfig errors are ignored here; CreateRESTConfig reports them.
+func DefaultNamespace(conn ClusterModel) string {
+	if !conn.Namespace.IsNull() && !conn.Namespace.IsUnknown() && conn.Namespace.ValueString() != "" {
+		return conn.Namespace.ValueString()
+	}
+
+	var kubeconfig *clientcmdapi.Config
+	switch {
+	case !conn.Host.IsNull():
+		// Inline connections have no context
+	case !conn.Kubeconfig.IsNull() && !conn.Kubeconfig.IsUnknown():
+		kubeconfig, _ = clientcmd.Load([]byte(conn.Kubeconfig.ValueString()))
+	case hasEnvMode(conn):
+		kubeconfig, _ = clientcmd.NewDefaultClientConfigLoadingRules().Load()
+	}
+
+	if kubeconfig != nil {
+		if kubeCtx := selectedContext(kubeconfig, conn); kubeCtx != nil && kubeCtx.Namespace != "" {
+			return kubeCtx.Namespace
+		}
+	}
+	return "default"
+}
+
+// selectedContext returns the kubeconfig context restConfigFromKubeconfig connects with:
+// the explicit context, or the only one. Nil if there is none.
+func selectedContext(kubeconfig *clientcmdapi.Config, conn ClusterModel) *clientcmdapi.Context {
+	if !conn.Context.IsNull() {
+		return kubeconfig.Contexts[conn.Context.ValueString()]
+	}
+	if len(kubeconfig.Contexts) == 1 {
+		for _, kubeCtx := range kubeconfig.Contexts {
+			return kubeCtx
+		}
+	}
+	return nil
+}
+
 // validateKubeconfigContent performs early validation of kubeconfig content to catch common mistakes
 func validateKubeconfigContent(content string) error {
 	// Check for empty content
@@ -349,12 +484,14 @@ func IsConnectionReady(obj types.Object) bool {
 		conn.Token.IsUnknown() ||
 		conn.ClientCertificate.IsUnknown() ||
 		conn.ClientKey.IsUnknown() ||
-		conn.ProxyURL.IsUnknown() {
+		conn.ProxyURL.IsUnknown() ||
+		conn.RequestTimeout.IsUnknown() ||
+		conn.Namespace.IsUnknown() {
 		return false
 	}
 
-	// Check bool field
-	if conn.Insecure.IsUnknown() {
+	// Check bool fields
+	if conn.Insecure.IsUnknown() || conn.UseEnv.IsUnknown() {
 		return false
 	}
 
@@ -382,6 +519,19 @@ func IsConnectionReady(obj types.Object) bool {
 		}
 	}
 
+	// Check exec shortcuts
+	if conn.EKS != nil && (conn.EKS.ClusterName.IsUnknown() || conn.EKS.Region.IsUnknown() ||
+		conn.EKS.Profile.IsUnknown() || conn.EKS.RoleARN.IsUnknown()) {
+		return false
+	}
+	if conn.GKE != nil && conn.GKE.UseApplicationDefaultCredentials.IsUnknown() {
+		return false
+	}
+	if conn.AKS != nil && (conn.AKS.Login.IsUnknown() || conn.AKS.ServerID.IsUnknown() ||
+		conn.AKS.TenantID.IsUnknown() || conn.AKS.ClientID.IsUnknown()) {
+		return false
+	}
+
 	// All fields are known (or null) - connection is ready
 	return true
 }
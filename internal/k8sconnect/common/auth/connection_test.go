@@ -3,7 +3,10 @@ package auth
 import (
 	"context"
 	"encoding/base64"
+	"os"
+	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/stretchr/testify/assert"
@@ -40,6 +43,36 @@ func TestCreateRESTConfig_InlineToken(t *testing.T) {
 	assert.Equal(t, []byte(testCACert), config.TLSClientConfig.CAData)
 }
 
+func TestCreateRESTConfig_RequestTimeout(t *testing.T) {
+	conn := ClusterModel{
+		Host:                 types.StringValue("https://test.example.com"),
+		ClusterCACertificate: types.StringValue(base64.StdEncoding.EncodeToString([]byte(testCACert))),
+		Token:                types.StringValue("test-bearer-token"),
+		RequestTimeout:       types.StringValue("30s"),
+	}
+
+	config, err := CreateRESTConfig(context.Background(), conn)
+
+	require.NoError(t, err)
+	assert.Equal(t, 30*time.Second, config.Timeout)
+}
+
+func TestCreateRESTConfig_InvalidRequestTimeout(t *testing.T) {
+	for _, value := range []string{"soon", "0s", "-5s"} {
+		conn := ClusterModel{
+			Host:                 types.StringValue("https://test.example.com"),
+			ClusterCACertificate: types.StringValue(base64.StdEncoding.EncodeToString([]byte(testCACert))),
+			Token:                types.StringValue("test-bearer-token"),
+			RequestTimeout:       types.StringValue(value),
+		}
+
+		_, err := CreateRESTConfig(context.Background(), conn)
+
+		require.Error(t, err, "request_timeout %q should be rejected", value)
+		assert.Contains(t, err.Error(), "request_timeout")
+	}
+}
+
 func TestCreateRESTConfig_InlineClientCert(t *testing.T) {
 	conn := ClusterModel{
 		Host:                 types.StringValue("https://test.example.com"),
@@ -112,6 +145,33 @@ func TestCreateRESTConfig_InlineExecAuth(t *testing.T) {
 	assert.Equal(t, "test-profile", config.ExecProvider.Env[0].Value)
 }
 
+func TestCreateRESTConfig_ExecEnvOrderIsStable(t *testing.T) {
+	// client-go caches exec credentials keyed by the ExecConfig, so the env order
+	// must not depend on map iteration
+	conn := ClusterModel{
+		Host:                 types.StringValue("https://test.example.com"),
+		ClusterCACertificate: types.StringValue(base64.StdEncoding.EncodeToString([]byte(testCACert))),
+		Exec: &ExecAuthModel{
+			APIVersion: types.StringValue("client.authentication.k8s.io/v1"),
+			Command:    types.StringValue("aws"),
+			Env: map[string]types.String{
+				"AWS_REGION":          types.StringValue("us-east-1"),
+				"AWS_PROFILE":         types.StringValue("test-profile"),
+				"AWS_SDK_LOAD_CONFIG": types.StringValue("1"),
+			},
+		},
+	}
+
+	for i := 0; i < 10; i++ {
+		config, err := CreateRESTConfig(context.Background(), conn)
+		require.NoError(t, err)
+		require.Len(t, config.ExecProvider.Env, 3)
+		assert.Equal(t, "AWS_PROFILE", config.ExecProvider.Env[0].Name)
+		assert.Equal(t, "AWS_REGION", config.ExecProvider.Env[1].Name)
+		assert.Equal(t, "AWS_SDK_LOAD_CONFIG", config.ExecProvider.Env[2].Name)
+	}
+}
+
 func TestCreateRESTConfig_KubeconfigRaw(t *testing.T) {
 	// Minimal valid kubeconfig
 	kubeconfig := `apiVersion: v1
@@ -300,6 +360,26 @@ func TestValidateConnectionWithUnknowns_SkipsWhenUnknown(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestValidateConnectionWithUnknowns_DefersComputedKubeconfig(t *testing.T) {
+	conns := map[string]ClusterModel{
+		// Kubeconfig computed by another resource, e.g. from an EKS data source
+		"unknown kubeconfig": {Kubeconfig: types.StringUnknown(), Context: types.StringValue("prod")},
+		// Context computed alongside a known kubeconfig
+		"unknown context": {Kubeconfig: types.StringValue("apiVersion: v1\nkind: Config\n"), Context: types.StringUnknown()},
+	}
+
+	for name, conn := range conns {
+		t.Run(name, func(t *testing.T) {
+			assert.NoError(t, ValidateConnectionWithUnknowns(context.Background(), conn))
+
+			// Connecting is deferred to apply
+			obj, err := ConnectionToObject(context.Background(), conn)
+			require.NoError(t, err)
+			assert.False(t, IsConnectionReady(obj))
+		})
+	}
+}
+
 func TestCreateRESTConfig_AutoDecodePEM(t *testing.T) {
 	// Sample PEM data
 	caCert := `-----BEGIN CERTIFICATE-----
@@ -730,3 +810,136 @@ func TestCreateRESTConfig_KubeconfigInvalidYAML(t *testing.T) {
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "is not valid YAML")
 }
+
+func TestCreateRESTConfig_UseEnv(t *testing.T) {
+	kubeconfig := `apiVersion: v1
+kind: Config
+clusters:
+- cluster:
+    server: https://env.example.com
+    insecure-skip-tls-verify: true
+  name: env-cluster
+contexts:
+- context:
+    cluster: env-cluster
+    user: env-user
+  name: env-context
+current-context: env-context
+users:
+- name: env-user
+  user:
+    token: env-token`
+
+	path := filepath.Join(t.TempDir(), "config")
+	require.NoError(t, os.WriteFile(path, []byte(kubeconfig), 0600))
+	t.Setenv("KUBECONFIG", path)
+
+	conn := ClusterModel{
+		UseEnv: types.BoolValue(true),
+	}
+
+	config, err := CreateRESTConfig(context.Background(), conn)
+
+	require.NoError(t, err)
+	assert.Equal(t, "https://env.example.com", config.Host)
+	assert.Equal(t, "env-token", config.BearerToken)
+}
+
+func TestCreateRESTConfig_UseEnvNoKubeconfig(t *testing.T) {
+	t.Setenv("KUBECONFIG", filepath.Join(t.TempDir(), "missing"))
+
+	conn := ClusterModel{
+		UseEnv: types.BoolValue(true),
+	}
+
+	_, err := CreateRESTConfig(context.Background(), conn)
+
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "no kubeconfig found in environment")
+}
+
+func TestValidateConnection_UseEnvWithKubeconfig(t *testing.T) {
+	conn := ClusterModel{
+		Kubeconfig: types.StringValue("apiVersion: v1"),
+		UseEnv:     types.BoolValue(true),
+	}
+
+	err := ValidateConnection(context.Background(), conn)
+
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "multiple connection modes specified")
+	assert.Contains(t, err.Error(), "use_env")
+}
+
+func TestValidateConnection_UseEnvFalseIsNotAMode(t *testing.T) {
+	conn := ClusterModel{
+		UseEnv: types.BoolValue(false),
+	}
+
+	err := ValidateConnection(context.Background(), conn)
+
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "no connection mode specified")
+}
+
+func TestDefaultNamespace(t *testing.T) {
+	kubeconfig := `apiVersion: v1
+kind: Config
+clusters:
+- name: test
+  cluster:
+    server: https://test.example.com
+contexts:
+- name: team-a
+  context:
+    cluster: test
+    user: admin
+    namespace: foo
+- name: admin
+  context:
+    cluster: test
+    user: admin
+users:
+- name: admin
+  user:
+    token: test
+`
+
+	tests := []struct {
+		name string
+		conn ClusterModel
+		want string
+	}{
+		{
+			name: "context namespace",
+			conn: ClusterModel{Kubeconfig: types.StringValue(kubeconfig), Context: types.StringValue("team-a")},
+			want: "foo",
+		},
+		{
+			name: "context without namespace",
+			conn: ClusterModel{Kubeconfig: types.StringValue(kubeconfig), Context: types.StringValue("admin")},
+			want: "default",
+		},
+		{
+			name: "cluster namespace overrides the context",
+			conn: ClusterModel{Kubeconfig: types.StringValue(kubeconfig), Context: types.StringValue("team-a"), Namespace: types.StringValue("bar")},
+			want: "bar",
+		},
+		{
+			name: "ambiguous context",
+			conn: ClusterModel{Kubeconfig: types.StringValue(kubeconfig)},
+			want: "default",
+		},
+		{
+			name: "inline connection",
+			conn: ClusterModel{Host: types.StringValue("https://test.example.com"), Namespace: types.StringNull()},
+			want: "default",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, DefaultNamespace(tt.conn))
+		})
+	}
+}
@@ -29,6 +29,9 @@ func ObjectToConnectionModel(ctx context.Context, obj basetypes.ObjectValue) (Cl
 	conn.ClientKey = attrs["client_key"].(types.String)
 	conn.Insecure = attrs["insecure"].(types.Bool)
 	conn.ProxyURL = attrs["proxy_url"].(types.String)
+	conn.UseEnv = attrs["use_env"].(types.Bool)
+	conn.RequestTimeout = attrs["request_timeout"].(types.String)
+	conn.Namespace = attrs["namespace"].(types.String)
 
 	// Handle exec if present
 	if execObj, ok := attrs["exec"].(types.Object); ok && !execObj.IsNull() {
@@ -57,6 +60,32 @@ func ObjectToConnectionModel(ctx context.Context, obj basetypes.ObjectValue) (Cl
 		}
 	}
 
+	// Handle exec shortcuts if present
+	if eksObj, ok := attrs["eks"].(types.Object); ok && !eksObj.IsNull() {
+		eksAttrs := eksObj.Attributes()
+		conn.EKS = &EKSAuthModel{
+			ClusterName: eksAttrs["cluster_name"].(types.String),
+			Region:      eksAttrs["region"].(types.String),
+			Profile:     eksAttrs["profile"].(types.String),
+			RoleARN:     eksAttrs["role_arn"].(types.String),
+		}
+	}
+	if gkeObj, ok := attrs["gke"].(types.Object); ok && !gkeObj.IsNull() {
+		gkeAttrs := gkeObj.Attributes()
+		conn.GKE = &GKEAuthModel{
+			UseApplicationDefaultCredentials: gkeAttrs["use_application_default_credentials"].(types.Bool),
+		}
+	}
+	if aksObj, ok := attrs["aks"].(types.Object); ok && !aksObj.IsNull() {
+		aksAttrs := aksObj.Attributes()
+		conn.AKS = &AKSAuthModel{
+			Login:    aksAttrs["login"].(types.String),
+			ServerID: aksAttrs["server_id"].(types.String),
+			TenantID: aksAttrs["tenant_id"].(types.String),
+			ClientID: aksAttrs["client_id"].(types.String),
+		}
+	}
+
 	return conn, nil
 }
 
@@ -76,23 +105,32 @@ func ConnectionToObject(ctx context.Context, conn ClusterModel) (types.Object, e
 		"client_key":             conn.ClientKey,
 		"insecure":               conn.Insecure,
 		"proxy_url":              conn.ProxyURL,
+		"use_env":                conn.UseEnv,
+		"request_timeout":        conn.RequestTimeout,
+		"namespace":              conn.Namespace,
 	}
 
 	// Handle exec
 	if conn.Exec != nil {
-		// Convert args to list
-		var argsList []attr.Value
-		for _, arg := range conn.Exec.Args {
-			argsList = append(argsList, arg)
+		// Convert args to list; nil stays null so an unset args round-trips without a diff
+		argsValue := types.ListNull(types.StringType)
+		if conn.Exec.Args != nil {
+			argsList := make([]attr.Value, 0, len(conn.Exec.Args))
+			for _, arg := range conn.Exec.Args {
+				argsList = append(argsList, arg)
+			}
+			argsValue, _ = types.ListValue(types.StringType, argsList)
 		}
-		argsValue, _ := types.ListValue(types.StringType, argsList)
 
-		// Convert env to map
-		envMap := make(map[string]attr.Value)
-		for k, v := range conn.Exec.Env {
-			envMap[k] = v
+		// Convert env to map; nil stays null like args
+		envValue := types.MapNull(types.StringType)
+		if conn.Exec.Env != nil {
+			envMap := make(map[string]attr.Value, len(conn.Exec.Env))
+			for k, v := range conn.Exec.Env {
+				envMap[k] = v
+			}
+			envValue, _ = types.MapValue(types.StringType, envMap)
 		}
-		envValue, _ := types.MapValue(types.StringType, envMap)
 
 		execValue, _ := types.ObjectValue(
 			GetExecAttributeTypes(),
@@ -108,6 +146,32 @@ func ConnectionToObject(ctx context.Context, conn ClusterModel) (types.Object, e
 		attrs["exec"] = types.ObjectNull(GetExecAttributeTypes())
 	}
 
+	// Handle exec shortcuts
+	attrs["eks"] = types.ObjectNull(GetEKSAttributeTypes())
+	if conn.EKS != nil {
+		attrs["eks"], _ = types.ObjectValue(GetEKSAttributeTypes(), map[string]attr.Value{
+			"cluster_name": conn.EKS.ClusterName,
+			"region":       conn.EKS.Region,
+			"profile":      conn.EKS.Profile,
+			"role_arn":     conn.EKS.RoleARN,
+		})
+	}
+	attrs["gke"] = types.ObjectNull(GetGKEAttributeTypes())
+	if conn.GKE != nil {
+		attrs["gke"], _ = types.ObjectValue(GetGKEAttributeTypes(), map[string]attr.Value{
+			"use_application_default_credentials": conn.GKE.UseApplicationDefaultCredentials,
+		})
+	}
+	attrs["aks"] = types.ObjectNull(GetAKSAttributeTypes())
+	if conn.AKS != nil {
+		attrs["aks"], _ = types.ObjectValue(GetAKSAttributeTypes(), map[string]attr.Value{
+			"login":     conn.AKS.Login,
+			"server_id": conn.AKS.ServerID,
+			"tenant_id": conn.AKS.TenantID,
+			"client_id": conn.AKS.ClientID,
+		})
+	}
+
 	objValue, diags := types.ObjectValue(attrTypes, attrs)
 	if diags.HasError() {
 		return types.ObjectNull(attrTypes), fmt.Errorf("failed to create connection object: %v", diags)
@@ -127,7 +191,13 @@ func GetConnectionAttributeTypes() map[string]attr.Type {
 		"client_key":             types.StringType,
 		"insecure":               types.BoolType,
 		"proxy_url":              types.StringType,
+		"use_env":                types.BoolType,
+		"request_timeout":        types.StringType,
+		"namespace":              types.StringType,
 		"exec":                   types.ObjectType{AttrTypes: GetExecAttributeTypes()},
+		"eks":                    types.ObjectType{AttrTypes: GetEKSAttributeTypes()},
+		"gke":                    types.ObjectType{AttrTypes: GetGKEAttributeTypes()},
+		"aks":                    types.ObjectType{AttrTypes: GetAKSAttributeTypes()},
 	}
 }
 
@@ -0,0 +1,203 @@
+package auth
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Exec shortcuts expand to the exec configuration the managed Kubernetes services document,
+// so the common cases don't need hand-written exec blocks.
+const (
+	shortcutExecAPIVersion = "client.authentication.k8s.io/v1beta1"
+
+	// aksDefaultServerID is the Azure Kubernetes Service AAD server application ID,
+	// the same for every AKS cluster
+	aksDefaultServerID = "6dae42f8-4368-4678-94ff-3960e28e3630"
+	aksDefaultLogin    = "azurecli"
+)
+
+// aksLoginModes are the kubelogin login modes that work without a terminal
+var aksLoginModes = []string{"azurecli", "azd", "msi", "spn", "workloadidentity"}
+
+// EKSAuthModel authenticates to Amazon EKS with `aws eks get-token`
+type EKSAuthModel struct {
+	ClusterName types.String `tfsdk:"cluster_name"`
+	Region      types.String `tfsdk:"region"`
+	Profile     types.String `tfsdk:"profile"`
+	RoleARN     types.String `tfsdk:"role_arn"`
+}
+
+// GKEAuthModel authenticates to Google Kubernetes Engine with gke-gcloud-auth-plugin
+type GKEAuthModel struct {
+	UseApplicationDefaultCredentials types.Bool `tfsdk:"use_application_default_credentials"`
+}
+
+// AKSAuthModel authenticates to Azure Kubernetes Service with kubelogin
+type AKSAuthModel struct {
+	Login    types.String `tfsdk:"login"`
+	ServerID types.String `tfsdk:"server_id"`
+	TenantID types.String `tfsdk:"tenant_id"`
+	ClientID types.String `tfsdk:"client_id"`
+}
+
+// EffectiveExecAuth returns the exec configuration the connection authenticates with:
+// the exec block itself, or the expansion of an eks, gke, or aks shortcut. Nil if none is set.
+func EffectiveExecAuth(conn ClusterModel) *ExecAuthModel {
+	switch {
+	case conn.Exec != nil && !conn.Exec.APIVersion.IsNull():
+		return conn.Exec
+	case conn.EKS != nil:
+		return expandEKSExec(conn.EKS)
+	case conn.GKE != nil:
+		return expandGKEExec(conn.GKE)
+	case conn.AKS != nil:
+		return expandAKSExec(conn.AKS)
+	default:
+		return nil
+	}
+}
+
+func expandEKSExec(eks *EKSAuthModel) *ExecAuthModel {
+	var args []types.String
+	if !eks.Region.IsNull() {
+		args = append(args, types.StringValue("--region"), eks.Region)
+	}
+	args = append(args,
+		types.StringValue("eks"), types.StringValue("get-token"),
+		types.StringValue("--cluster-name"), eks.ClusterName,
+		types.StringValue("--output"), types.StringValue("json"),
+	)
+	if !eks.RoleARN.IsNull() {
+		args = append(args, types.StringValue("--role-arn"), eks.RoleARN)
+	}
+
+	exec := &ExecAuthModel{
+		APIVersion: types.StringValue(shortcutExecAPIVersion),
+		Command:    types.StringValue("aws"),
+		Args:       args,
+	}
+	if !eks.Profile.IsNull() {
+		exec.Env = map[string]types.String{"AWS_PROFILE": eks.Profile}
+	}
+	return exec
+}
+
+func expandGKEExec(gke *GKEAuthModel) *ExecAuthModel {
+	var args []types.String
+	if gke.UseApplicationDefaultCredentials.ValueBool() {
+		args = append(args, types.StringValue("--use_application_default_credentials"))
+	}
+	return &ExecAuthModel{
+		APIVersion: types.StringValue(shortcutExecAPIVersion),
+		Command:    types.StringValue("gke-gcloud-auth-plugin"),
+		Args:       args,
+	}
+}
+
+func expandAKSExec(aks *AKSAuthModel) *ExecAuthModel {
+	login := aks.Login
+	if login.IsNull() {
+		login = types.StringValue(aksDefaultLogin)
+	}
+	serverID := aks.ServerID
+	if serverID.IsNull() {
+		serverID = types.StringValue(aksDefaultServerID)
+	}
+
+	args := []types.String{
+		types.StringValue("get-token"),
+		types.StringValue("--login"), login,
+		types.StringValue("--server-id"), serverID,
+	}
+	if !aks.TenantID.IsNull() {
+		args = append(args, types.StringValue("--tenant-id"), aks.TenantID)
+	}
+	if !aks.ClientID.IsNull() {
+		args = append(args, types.StringValue("--client-id"), aks.ClientID)
+	}
+
+	return &ExecAuthModel{
+		APIVersion: types.StringValue(shortcutExecAPIVersion),
+		Command:    types.StringValue("kubelogin"),
+		Args:       args,
+	}
+}
+
+// configuredExecMethods lists the exec-based settings present on the connection
+func configuredExecMethods(conn ClusterModel) []string {
+	var methods []string
+	if conn.Exec != nil && !conn.Exec.APIVersion.IsNull() {
+		methods = append(methods, "exec")
+	}
+	if conn.EKS != nil {
+		methods = append(methods, "eks")
+	}
+	if conn.GKE != nil {
+		methods = append(methods, "gke")
+	}
+	if conn.AKS != nil {
+		methods = append(methods, "aks")
+	}
+	return methods
+}
+
+// validateExecShortcuts ensures at most one exec-based setting is used, that shortcuts
+// are only used with inline connections (kubeconfig and use_env bring their own auth), and
+// that aks.login is a supported mode
+func validateExecShortcuts(conn ClusterModel) error {
+	methods := configuredExecMethods(conn)
+	if len(methods) > 1 {
+		return fmt.Errorf("multiple exec authentication settings specified\n\n"+
+			"Only one of 'exec', 'eks', 'gke', or 'aks' can be set. Found: %v", methods)
+	}
+
+	hasShortcut := conn.EKS != nil || conn.GKE != nil || conn.AKS != nil
+	if hasShortcut && !hasInlineMode(conn) {
+		return fmt.Errorf("'%s' requires an inline connection\n\n"+
+			"Set 'host' and 'cluster_ca_certificate' for the cluster. With 'kubeconfig' or 'use_env', "+
+			"authentication comes from the kubeconfig's user entry instead.", methods[0])
+	}
+
+	if conn.AKS != nil && !conn.AKS.Login.IsNull() && !conn.AKS.Login.IsUnknown() {
+		login := conn.AKS.Login.ValueString()
+		for _, mode := range aksLoginModes {
+			if login == mode {
+				return nil
+			}
+		}
+		return fmt.Errorf("unsupported aks.login mode %q\n\n"+
+			"Use one of: %s", login, strings.Join(aksLoginModes, ", "))
+	}
+
+	return nil
+}
+
+// GetEKSAttributeTypes returns the attribute types for the eks shortcut
+func GetEKSAttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"cluster_name": types.StringType,
+		"region":       types.StringType,
+		"profile":      types.StringType,
+		"role_arn":     types.StringType,
+	}
+}
+
+// GetGKEAttributeTypes returns the attribute types for the gke shortcut
+func GetGKEAttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"use_application_default_credentials": types.BoolType,
+	}
+}
+
+// GetAKSAttributeTypes returns the attribute types for the aks shortcut
+func GetAKSAttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"login":     types.StringType,
+		"server_id": types.StringType,
+		"tenant_id": types.StringType,
+		"client_id": types.StringType,
+	}
+}
@@ -0,0 +1,116 @@
+package auth
+
+import (
+	"context"
+	"encoding/base64"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+)
+
+func shortcutTestConn() ClusterModel {
+	return ClusterModel{
+		Host:                 types.StringValue("https://test.example.com"),
+		ClusterCACertificate: types.StringValue(base64.StdEncoding.EncodeToString([]byte(testCACert))),
+	}
+}
+
+func TestCreateRESTConfig_EKSShortcut(t *testing.T) {
+	conn := shortcutTestConn()
+	conn.EKS = &EKSAuthModel{
+		ClusterName: types.StringValue("prod"),
+		Region:      types.StringValue("us-west-2"),
+		Profile:     types.StringValue("admin"),
+		RoleARN:     types.StringNull(),
+	}
+
+	config, err := CreateRESTConfig(context.Background(), conn)
+
+	require.NoError(t, err)
+	require.NotNil(t, config.ExecProvider)
+	assert.Equal(t, "client.authentication.k8s.io/v1beta1", config.ExecProvider.APIVersion)
+	assert.Equal(t, "aws", config.ExecProvider.Command)
+	assert.Equal(t, []string{"--region", "us-west-2", "eks", "get-token", "--cluster-name", "prod", "--output", "json"}, config.ExecProvider.Args)
+	assert.Equal(t, []clientcmdapi.ExecEnvVar{{Name: "AWS_PROFILE", Value: "admin"}}, config.ExecProvider.Env)
+}
+
+func TestCreateRESTConfig_GKEShortcut(t *testing.T) {
+	conn := shortcutTestConn()
+	conn.GKE = &GKEAuthModel{UseApplicationDefaultCredentials: types.BoolValue(true)}
+
+	config, err := CreateRESTConfig(context.Background(), conn)
+
+	require.NoError(t, err)
+	require.NotNil(t, config.ExecProvider)
+	assert.Equal(t, "gke-gcloud-auth-plugin", config.ExecProvider.Command)
+	assert.Equal(t, []string{"--use_application_default_credentials"}, config.ExecProvider.Args)
+}
+
+func TestCreateRESTConfig_AKSShortcutDefaults(t *testing.T) {
+	conn := shortcutTestConn()
+	conn.AKS = &AKSAuthModel{
+		Login:    types.StringNull(),
+		ServerID: types.StringNull(),
+		TenantID: types.StringValue("tenant"),
+		ClientID: types.StringNull(),
+	}
+
+	config, err := CreateRESTConfig(context.Background(), conn)
+
+	require.NoError(t, err)
+	require.NotNil(t, config.ExecProvider)
+	assert.Equal(t, "kubelogin", config.ExecProvider.Command)
+	assert.Equal(t, []string{"get-token", "--login", "azurecli", "--server-id", aksDefaultServerID, "--tenant-id", "tenant"}, config.ExecProvider.Args)
+}
+
+func TestValidateConnection_ExecShortcuts(t *testing.T) {
+	eks := &EKSAuthModel{ClusterName: types.StringValue("prod")}
+
+	t.Run("shortcut satisfies inline authentication", func(t *testing.T) {
+		conn := shortcutTestConn()
+		conn.EKS = eks
+		assert.NoError(t, ValidateConnection(context.Background(), conn))
+	})
+
+	t.Run("shortcut conflicts with exec", func(t *testing.T) {
+		conn := shortcutTestConn()
+		conn.EKS = eks
+		conn.Exec = &ExecAuthModel{
+			APIVersion: types.StringValue("client.authentication.k8s.io/v1"),
+			Command:    types.StringValue("aws"),
+		}
+		err := ValidateConnection(context.Background(), conn)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "multiple exec authentication settings")
+	})
+
+	t.Run("shortcuts conflict with each other", func(t *testing.T) {
+		conn := shortcutTestConn()
+		conn.EKS = eks
+		conn.GKE = &GKEAuthModel{}
+		err := ValidateConnection(context.Background(), conn)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "[eks gke]")
+	})
+
+	t.Run("shortcut requires inline connection", func(t *testing.T) {
+		conn := ClusterModel{UseEnv: types.BoolValue(true), GKE: &GKEAuthModel{}}
+		err := ValidateConnection(context.Background(), conn)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "'gke' requires an inline connection")
+	})
+
+	t.Run("aks login mode must be supported", func(t *testing.T) {
+		conn := shortcutTestConn()
+		conn.AKS = &AKSAuthModel{Login: types.StringValue("devicecode")}
+		err := ValidateConnection(context.Background(), conn)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `unsupported aks.login mode "devicecode"`)
+
+		conn.AKS.Login = types.StringValue("msi")
+		assert.NoError(t, ValidateConnection(context.Background(), conn))
+	})
+}
@@ -1,7 +1,9 @@
 package auth
 
 import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	datasourceschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	providerschema "github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	resourceschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -22,6 +24,9 @@ func GetConnectionSchemaForResource() map[string]resourceschema.Attribute {
 			Optional:    true,
 			Sensitive:   true,
 			Description: "Root certificate bundle for TLS authentication. Accepts PEM format or base64-encoded PEM - automatically detected.",
+			Validators: []validator.String{
+				caCertificateValidator{},
+			},
 		},
 		"kubeconfig": resourceschema.StringAttribute{
 			Optional:    true,
@@ -61,6 +66,25 @@ func GetConnectionSchemaForResource() map[string]resourceschema.Attribute {
 				urlValidator{},
 			},
 		},
+		"use_env": resourceschema.BoolAttribute{
+			Optional: true,
+			Description: "Load the connection from the environment using standard kubectl loading rules: the files listed in the KUBECONFIG environment variable, or ~/.kube/config when it is unset. " +
+				"Makes reliance on ambient configuration an explicit choice. Use 'context' to select a context when the kubeconfig contains more than one. Cannot be combined with inline settings or 'kubeconfig'.",
+		},
+		"request_timeout": resourceschema.StringAttribute{
+			Optional: true,
+			Description: "Maximum duration of a single Kubernetes API request (e.g., '30s'). Bounds individual Get/Apply/Patch/List calls so they fail fast on a degraded control plane. " +
+				"Watches used by waits are not affected; they are bounded by the wait timeout instead. Defaults to no per-request timeout.",
+			Validators: []validator.String{
+				durationValidator{},
+			},
+		},
+		"namespace": resourceschema.StringAttribute{
+			Optional: true,
+			Description: "Namespace for namespaced k8sconnect_object resources whose yaml_body omits metadata.namespace, and for the k8sconnect_object data source without namespace. " +
+				"Defaults to the namespace of the kubeconfig context, like kubectl, or 'default' when the context sets none. " +
+				"An explicit metadata.namespace always wins.",
+		},
 		"exec": resourceschema.SingleNestedAttribute{
 			Optional:    true,
 			Sensitive:   true,
@@ -86,6 +110,67 @@ func GetConnectionSchemaForResource() map[string]resourceschema.Attribute {
 				},
 			},
 		},
+		"eks": resourceschema.SingleNestedAttribute{
+			Optional: true,
+			Description: "Authenticate to Amazon EKS with 'aws eks get-token'. Shortcut for the equivalent exec block; requires the AWS CLI. " +
+				"Use with 'host' and 'cluster_ca_certificate'. Cannot be combined with 'exec', 'gke', or 'aks'.",
+			Attributes: map[string]resourceschema.Attribute{
+				"cluster_name": resourceschema.StringAttribute{
+					Required:    true,
+					Description: "Name of the EKS cluster.",
+				},
+				"region": resourceschema.StringAttribute{
+					Optional:    true,
+					Description: "AWS region of the cluster. Defaults to the AWS CLI's configured region.",
+				},
+				"profile": resourceschema.StringAttribute{
+					Optional:    true,
+					Description: "AWS CLI profile to use, passed as AWS_PROFILE.",
+				},
+				"role_arn": resourceschema.StringAttribute{
+					Optional:    true,
+					Description: "IAM role to assume when generating the token.",
+				},
+			},
+		},
+		"gke": resourceschema.SingleNestedAttribute{
+			Optional: true,
+			Description: "Authenticate to Google Kubernetes Engine with gke-gcloud-auth-plugin. Shortcut for the equivalent exec block; requires the plugin on PATH. " +
+				"Use with 'host' and 'cluster_ca_certificate'; set to {} for defaults. Cannot be combined with 'exec', 'eks', or 'aks'.",
+			Attributes: map[string]resourceschema.Attribute{
+				"use_application_default_credentials": resourceschema.BoolAttribute{
+					Optional:    true,
+					Description: "Use Application Default Credentials instead of the active gcloud account, e.g. with workload identity or GOOGLE_APPLICATION_CREDENTIALS.",
+				},
+			},
+		},
+		"aks": resourceschema.SingleNestedAttribute{
+			Optional: true,
+			Description: "Authenticate to Azure Kubernetes Service (Entra ID clusters) with kubelogin. Shortcut for the equivalent exec block; requires kubelogin on PATH. " +
+				"Use with 'host' and 'cluster_ca_certificate'; set to {} for defaults. Cannot be combined with 'exec', 'eks', or 'gke'.",
+			Attributes: map[string]resourceschema.Attribute{
+				"login": resourceschema.StringAttribute{
+					Optional: true,
+					Description: "kubelogin login mode: 'azurecli' (default), 'azd', 'msi', 'spn', or 'workloadidentity'. " +
+						"'spn' reads the client secret from AAD_SERVICE_PRINCIPAL_CLIENT_SECRET in the environment.",
+					Validators: []validator.String{
+						stringvalidator.OneOf(aksLoginModes...),
+					},
+				},
+				"server_id": resourceschema.StringAttribute{
+					Optional:    true,
+					Description: "Application ID of the AKS AAD server. Defaults to the ID shared by all AKS clusters.",
+				},
+				"tenant_id": resourceschema.StringAttribute{
+					Optional:    true,
+					Description: "Azure tenant ID.",
+				},
+				"client_id": resourceschema.StringAttribute{
+					Optional:    true,
+					Description: "Client ID of the service principal or managed identity, for the 'spn', 'msi', and 'workloadidentity' modes.",
+				},
+			},
+		},
 	}
 }
 
@@ -94,3 +179,9 @@ func GetConnectionSchemaForResource() map[string]resourceschema.Attribute {
 func GetConnectionSchemaForDataSource() map[string]datasourceschema.Attribute {
 	return ConvertResourceAttributesToDatasource(GetConnectionSchemaForResource())
 }
+
+// GetConnectionSchemaForProvider returns the cluster connection schema attributes for the provider block.
+// This converts the resource schema to provider schema types.
+func GetConnectionSchemaForProvider() map[string]providerschema.Attribute {
+	return ConvertResourceAttributesToProvider(GetConnectionSchemaForResource())
+}
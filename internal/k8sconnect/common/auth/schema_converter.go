@@ -2,6 +2,7 @@ package auth
 
 import (
 	datasourceschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	providerschema "github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	resourceschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
 )
 
@@ -29,7 +30,7 @@ func convertSingleAttribute(resourceAttr resourceschema.Attribute) datasourcesch
 			Description:         attr.Description,
 			MarkdownDescription: attr.MarkdownDescription,
 			DeprecationMessage:  attr.DeprecationMessage,
-			// Validators are not converted as they're type-specific
+			Validators:          attr.Validators,
 		}
 
 	case resourceschema.BoolAttribute:
@@ -191,3 +192,83 @@ func convertSingleAttribute(resourceAttr resourceschema.Attribute) datasourcesch
 		}
 	}
 }
+
+// ConvertResourceAttributesToProvider converts resource schema attributes to provider schema attributes.
+// Provider schemas have no computed attributes, so only the attribute types used by the
+// connection schema are supported.
+func ConvertResourceAttributesToProvider(resourceAttrs map[string]resourceschema.Attribute) map[string]providerschema.Attribute {
+	providerAttrs := make(map[string]providerschema.Attribute)
+
+	for key, attr := range resourceAttrs {
+		providerAttrs[key] = convertSingleAttributeToProvider(attr)
+	}
+
+	return providerAttrs
+}
+
+// convertSingleAttributeToProvider converts a single resource attribute to a provider attribute
+func convertSingleAttributeToProvider(resourceAttr resourceschema.Attribute) providerschema.Attribute {
+	switch attr := resourceAttr.(type) {
+	case resourceschema.StringAttribute:
+		return providerschema.StringAttribute{
+			Required:            attr.Required,
+			Optional:            attr.Optional,
+			Sensitive:           attr.Sensitive,
+			Description:         attr.Description,
+			MarkdownDescription: attr.MarkdownDescription,
+			DeprecationMessage:  attr.DeprecationMessage,
+			Validators:          attr.Validators,
+		}
+
+	case resourceschema.BoolAttribute:
+		return providerschema.BoolAttribute{
+			Required:            attr.Required,
+			Optional:            attr.Optional,
+			Sensitive:           attr.Sensitive,
+			Description:         attr.Description,
+			MarkdownDescription: attr.MarkdownDescription,
+			DeprecationMessage:  attr.DeprecationMessage,
+		}
+
+	case resourceschema.ListAttribute:
+		return providerschema.ListAttribute{
+			Required:            attr.Required,
+			Optional:            attr.Optional,
+			Sensitive:           attr.Sensitive,
+			Description:         attr.Description,
+			MarkdownDescription: attr.MarkdownDescription,
+			DeprecationMessage:  attr.DeprecationMessage,
+			ElementType:         attr.ElementType,
+		}
+
+	case resourceschema.MapAttribute:
+		return providerschema.MapAttribute{
+			Required:            attr.Required,
+			Optional:            attr.Optional,
+			Sensitive:           attr.Sensitive,
+			Description:         attr.Description,
+			MarkdownDescription: attr.MarkdownDescription,
+			DeprecationMessage:  attr.DeprecationMessage,
+			ElementType:         attr.ElementType,
+		}
+
+	case resourceschema.SingleNestedAttribute:
+		// Recursively convert nested attributes
+		return providerschema.SingleNestedAttribute{
+			Required:            attr.Required,
+			Optional:            attr.Optional,
+			Sensitive:           attr.Sensitive,
+			Description:         attr.Description,
+			MarkdownDescription: attr.MarkdownDescription,
+			DeprecationMessage:  attr.DeprecationMessage,
+			Attributes:          ConvertResourceAttributesToProvider(attr.Attributes),
+		}
+
+	default:
+		// Not used by the connection schema; fall back to an optional string
+		return providerschema.StringAttribute{
+			Optional:    true,
+			Description: "Unknown attribute type - defaulted to optional string",
+		}
+	}
+}
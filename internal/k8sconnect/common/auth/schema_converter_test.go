@@ -0,0 +1,28 @@
+package auth
+
+import (
+	"testing"
+
+	datasourceschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	providerschema "github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	resourceschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConvertedSchemasKeepStringValidators(t *testing.T) {
+	resourceAttrs := GetConnectionSchemaForResource()
+
+	provider := GetConnectionSchemaForProvider()
+	ca, ok := provider["cluster_ca_certificate"].(providerschema.StringAttribute)
+	require.True(t, ok)
+	assert.NotEmpty(t, ca.Validators)
+	assert.Equal(t, resourceAttrs["cluster_ca_certificate"].(resourceschema.StringAttribute).Validators, ca.Validators)
+
+	datasource := GetConnectionSchemaForDataSource()
+	aks, ok := datasource["aks"].(datasourceschema.SingleNestedAttribute)
+	require.True(t, ok)
+	login, ok := aks.Attributes["login"].(datasourceschema.StringAttribute)
+	require.True(t, ok)
+	assert.Len(t, login.Validators, 1)
+}
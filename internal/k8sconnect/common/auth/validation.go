@@ -12,6 +12,11 @@ func ValidateConnection(ctx context.Context, conn ClusterModel) error {
 		return err
 	}
 
+	// Validate exec shortcuts before inline auth, which counts them as exec auth
+	if err := validateExecShortcuts(conn); err != nil {
+		return err
+	}
+
 	// Validate client certificate configuration BEFORE checking inline auth
 	// This ensures we catch mismatched cert/key errors first
 	if err := validateClientCertificates(conn); err != nil {
@@ -43,7 +48,8 @@ func validateConnectionModes(conn ClusterModel) error {
 		return fmt.Errorf("no connection mode specified\n\n" +
 			"Must specify exactly one connection mode:\n" +
 			"• Inline: Provide 'host' and either 'cluster_ca_certificate' or 'insecure = true'\n" +
-			"• Kubeconfig raw: Provide 'kubeconfig' content")
+			"• Kubeconfig raw: Provide 'kubeconfig' content\n" +
+			"• Environment: Set 'use_env = true' to load KUBECONFIG or ~/.kube/config")
 	}
 
 	if modes > 1 {
@@ -63,6 +69,9 @@ func countActiveModes(conn ClusterModel) int {
 	if !conn.Kubeconfig.IsNull() {
 		modes++
 	}
+	if hasEnvMode(conn) {
+		modes++
+	}
 	return modes
 }
 
@@ -71,6 +80,11 @@ func hasInlineMode(conn ClusterModel) bool {
 	return !conn.Host.IsNull() || !conn.ClusterCACertificate.IsNull()
 }
 
+// hasEnvMode checks if the connection should be loaded from the environment
+func hasEnvMode(conn ClusterModel) bool {
+	return !conn.UseEnv.IsNull() && !conn.UseEnv.IsUnknown() && conn.UseEnv.ValueBool()
+}
+
 // buildMultipleModeError creates error message for multiple modes
 func buildMultipleModeError(conn ClusterModel) string {
 	conflictingModes := []string{}
@@ -80,11 +94,15 @@ func buildMultipleModeError(conn ClusterModel) string {
 	if !conn.Kubeconfig.IsNull() {
 		conflictingModes = append(conflictingModes, "kubeconfig")
 	}
+	if hasEnvMode(conn) {
+		conflictingModes = append(conflictingModes, "use_env")
+	}
 
 	return fmt.Sprintf("Only one connection mode can be specified. Found: %v\n\n"+
 		"Choose ONE of:\n"+
-		"• Remove 'kubeconfig' to use inline mode\n"+
-		"• Remove inline fields ('host', 'cluster_ca_certificate') to use kubeconfig",
+		"• Remove 'kubeconfig' and 'use_env' to use inline mode\n"+
+		"• Remove inline fields ('host', 'cluster_ca_certificate') and 'use_env' to use kubeconfig\n"+
+		"• Remove inline fields and 'kubeconfig' to use use_env",
 		conflictingModes)
 }
 
@@ -112,7 +130,7 @@ func validateInlineConnection(conn ClusterModel) error {
 			"Inline connections require at least one authentication method:\n" +
 			"• Bearer token: Set 'token'\n" +
 			"• Client certificates: Set both 'client_certificate' and 'client_key'\n" +
-			"• Exec auth: Configure the 'exec' block")
+			"• Exec auth: Configure the 'exec' block, or the 'eks', 'gke', or 'aks' shortcut")
 	}
 
 	return nil
@@ -138,9 +156,9 @@ func hasClientCertAuth(conn ClusterModel) bool {
 	return !conn.ClientCertificate.IsNull() && !conn.ClientKey.IsNull()
 }
 
-// hasExecAuth checks if exec authentication is configured
+// hasExecAuth checks if exec authentication is configured, directly or through a shortcut
 func hasExecAuth(conn ClusterModel) bool {
-	return conn.Exec != nil && !conn.Exec.APIVersion.IsNull()
+	return EffectiveExecAuth(conn) != nil
 }
 
 // validateExecAuth validates exec authentication configuration
@@ -189,10 +207,13 @@ func validateClientCertificates(conn ClusterModel) error {
 // ValidateConnectionWithUnknowns performs validation that's safe when values might be unknown.
 // This is used during Terraform plan phase when some values might not be computed yet.
 func ValidateConnectionWithUnknowns(ctx context.Context, conn ClusterModel) error {
-	// Skip validation if key fields are unknown
+	// Skip validation if key fields are unknown. A kubeconfig computed from another
+	// resource, and the context within it, are only resolved during apply.
 	hasUnknownFields := conn.Host.IsUnknown() ||
 		conn.ClusterCACertificate.IsUnknown() ||
-		conn.Kubeconfig.IsUnknown()
+		conn.Kubeconfig.IsUnknown() ||
+		conn.Context.IsUnknown() ||
+		conn.UseEnv.IsUnknown()
 
 	if hasUnknownFields {
 		// Can't validate mode count with unknown values
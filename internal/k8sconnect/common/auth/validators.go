@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net/url"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"gopkg.in/yaml.v3"
@@ -46,6 +47,42 @@ func (v urlValidator) ValidateString(ctx context.Context, req validator.StringRe
 	}
 }
 
+// durationValidator validates that a string is a positive Go duration
+type durationValidator struct{}
+
+func (v durationValidator) Description(ctx context.Context) string {
+	return "validates that the value is a positive Go duration (e.g., '30s', '1m')"
+}
+
+func (v durationValidator) MarkdownDescription(ctx context.Context) string {
+	return "validates that the value is a positive Go duration (e.g., '30s', '1m')"
+}
+
+func (v durationValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return // Skip validation for unknown/null values
+	}
+
+	value := req.ConfigValue.ValueString()
+	duration, err := time.ParseDuration(value)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Duration",
+			fmt.Sprintf("The value '%s' is not a valid duration: %s. Use format like '30s', '1m'", value, err),
+		)
+		return
+	}
+
+	if duration <= 0 {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Duration",
+			fmt.Sprintf("The value '%s' must be greater than zero", value),
+		)
+	}
+}
+
 // kubeconfigValidator validates that a string is valid YAML
 type kubeconfigValidator struct{}
 
@@ -72,3 +109,29 @@ func (v kubeconfigValidator) ValidateString(ctx context.Context, req validator.S
 		)
 	}
 }
+
+// caCertificateValidator validates that cluster_ca_certificate holds usable CA certificates,
+// so a mis-pasted or expired certificate fails the plan instead of the TLS handshake
+type caCertificateValidator struct{}
+
+func (v caCertificateValidator) Description(ctx context.Context) string {
+	return "validates that the value is a PEM or base64-encoded PEM CA certificate that is currently valid"
+}
+
+func (v caCertificateValidator) MarkdownDescription(ctx context.Context) string {
+	return "validates that the value is a PEM or base64-encoded PEM CA certificate that is currently valid"
+}
+
+func (v caCertificateValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return // Skip validation for unknown/null values
+	}
+
+	for _, issue := range caCertificateIssues(req.ConfigValue.ValueString(), time.Now()) {
+		if issue.warning {
+			resp.Diagnostics.AddAttributeWarning(req.Path, issue.summary, issue.detail)
+		} else {
+			resp.Diagnostics.AddAttributeError(req.Path, issue.summary, issue.detail)
+		}
+	}
+}
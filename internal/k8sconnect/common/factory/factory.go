@@ -6,6 +6,7 @@ import (
 	"encoding/hex"
 	"fmt"
 	"hash"
+	"sort"
 	"sync"
 
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -23,6 +24,12 @@ type ClientFactory interface {
 type CachedClientFactory struct {
 	cache map[string]k8sclient.K8sClient
 	mu    sync.RWMutex
+
+	// dryRun wraps new clients so every write is simulated with server-side dry-run
+	dryRun bool
+
+	// limiter caps the API requests in flight across all clients; nil means no limit
+	limiter *k8sclient.OperationLimiter
 }
 
 // NewCachedClientFactory creates a new factory with caching
@@ -32,6 +39,24 @@ func NewCachedClientFactory() *CachedClientFactory {
 	}
 }
 
+// SetDryRun makes clients created from now on simulate every write with server-side
+// dry-run instead of persisting it. Set by the provider's dry_run setting, before any
+// client is created.
+func (f *CachedClientFactory) SetDryRun(enabled bool) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.dryRun = enabled
+}
+
+// SetMaxConcurrentOperations limits clients created from now on to max API requests in
+// flight at a time, shared by all of them whatever cluster they connect to. Set by the
+// provider's max_concurrent_operations setting, before any client is created.
+func (f *CachedClientFactory) SetMaxConcurrentOperations(max int) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.limiter = k8sclient.NewOperationLimiter(max)
+}
+
 // GetClient returns a cached client or creates a new one
 func (f *CachedClientFactory) GetClient(conn auth.ClusterModel) (k8sclient.K8sClient, error) {
 	cacheKey := f.generateCacheKey(conn)
@@ -58,12 +83,20 @@ func (f *CachedClientFactory) GetClient(conn auth.ClusterModel) (k8sclient.K8sCl
 	if err != nil {
 		return nil, fmt.Errorf("failed to create REST config: %w", err)
 	}
+	if f.limiter != nil {
+		config.Wrap(f.limiter.Wrap)
+	}
 
-	client, err := k8sclient.NewDynamicK8sClient(config)
+	dynamicClient, err := k8sclient.NewDynamicK8sClient(config)
 	if err != nil {
 		return nil, err
 	}
 
+	var client k8sclient.K8sClient = dynamicClient
+	if f.dryRun {
+		client = k8sclient.NewDryRunClient(dynamicClient)
+	}
+
 	f.cache[cacheKey] = client
 	return client, nil
 }
@@ -82,35 +115,51 @@ func (f *CachedClientFactory) generateCacheKey(conn auth.ClusterModel) string {
 	f.hashStringField(h, conn.ClientKey)
 	f.hashBoolField(h, conn.Insecure)
 	f.hashStringField(h, conn.ProxyURL)
-
-	// Hash exec config if present
-	if conn.Exec != nil {
-		f.hashStringField(h, conn.Exec.APIVersion)
-		f.hashStringField(h, conn.Exec.Command)
-		for _, arg := range conn.Exec.Args {
+	f.hashBoolField(h, conn.UseEnv)
+	f.hashStringField(h, conn.RequestTimeout)
+
+	// Hash exec config if present. eks/gke/aks shortcuts hash as the exec they expand to,
+	// so a shortcut and the equivalent exec block share a client.
+	if exec := auth.EffectiveExecAuth(conn); exec != nil {
+		f.hashStringField(h, exec.APIVersion)
+		f.hashStringField(h, exec.Command)
+		for _, arg := range exec.Args {
 			f.hashStringField(h, arg)
 		}
-		for k, v := range conn.Exec.Env {
+		// Sort env names so the key is stable - map iteration order would otherwise
+		// give the same connection a different key (and exec token) on each lookup
+		envNames := make([]string, 0, len(exec.Env))
+		for k := range exec.Env {
+			envNames = append(envNames, k)
+		}
+		sort.Strings(envNames)
+		for _, k := range envNames {
 			h.Write([]byte(k))
-			f.hashStringField(h, v)
+			h.Write([]byte{0})
+			f.hashStringField(h, exec.Env[k])
 		}
 	}
 
 	return hex.EncodeToString(h.Sum(nil))
 }
 
-// hashStringField safely hashes a types.String field
+// hashStringField safely hashes a types.String field. Every field is terminated
+// with a NUL byte, even when null, so values can't run into their neighbours:
+// without it {host: "https://a", token: "bc"} and {host: "https://ab", token: "c"}
+// would share a key and one cluster's client would be handed to the other.
 func (f *CachedClientFactory) hashStringField(h hash.Hash, field types.String) {
 	if !field.IsNull() && !field.IsUnknown() {
 		h.Write([]byte(field.ValueString()))
 	}
+	h.Write([]byte{0})
 }
 
-// hashBoolField safely hashes a types.Bool field
+// hashBoolField safely hashes a types.Bool field, NUL-terminated like hashStringField
 func (f *CachedClientFactory) hashBoolField(h hash.Hash, field types.Bool) {
 	if !field.IsNull() && !field.IsUnknown() {
 		h.Write([]byte(fmt.Sprintf("%v", field.ValueBool())))
 	}
+	h.Write([]byte{0})
 }
 
 // ClearCache removes all cached clients
@@ -39,6 +39,34 @@ func TestCachedClientFactory_CacheKey(t *testing.T) {
 	assert.NotEqual(t, key1, key3, "Different connections should generate different cache keys")
 }
 
+func TestCachedClientFactory_CacheKeyFieldBoundaries(t *testing.T) {
+	factory := NewCachedClientFactory()
+
+	// Concatenated field values are identical; the connections are not
+	conn1 := auth.ClusterModel{
+		Host:  types.StringValue("https://k8s.example.com"),
+		Token: types.StringValue("abc"),
+	}
+	conn2 := auth.ClusterModel{
+		Host:  types.StringValue("https://k8s.example.coma"),
+		Token: types.StringValue("bc"),
+	}
+	assert.NotEqual(t, factory.generateCacheKey(conn1), factory.generateCacheKey(conn2),
+		"Values shifted between fields must not produce the same cache key")
+
+	// Same kubeconfig, different contexts - the for_each-over-contexts fleet pattern
+	prod := auth.ClusterModel{
+		Kubeconfig: types.StringValue("kubeconfig-data"),
+		Context:    types.StringValue("prod"),
+	}
+	staging := auth.ClusterModel{
+		Kubeconfig: types.StringValue("kubeconfig-data"),
+		Context:    types.StringValue("staging"),
+	}
+	assert.NotEqual(t, factory.generateCacheKey(prod), factory.generateCacheKey(staging),
+		"Different contexts must get different clients")
+}
+
 func TestCachedClientFactory_CacheKeyWithExec(t *testing.T) {
 	factory := NewCachedClientFactory()
 
@@ -74,6 +102,28 @@ func TestCachedClientFactory_CacheKeyWithExec(t *testing.T) {
 	assert.NotEqual(t, key1, key2, "Different exec args should generate different cache keys")
 }
 
+func TestCachedClientFactory_CacheKeyWithExecEnvIsStable(t *testing.T) {
+	factory := NewCachedClientFactory()
+
+	conn := auth.ClusterModel{
+		Host: types.StringValue("https://k8s.example.com"),
+		Exec: &auth.ExecAuthModel{
+			APIVersion: types.StringValue("client.authentication.k8s.io/v1beta1"),
+			Command:    types.StringValue("aws"),
+			Env: map[string]types.String{
+				"AWS_PROFILE": types.StringValue("prod"),
+				"AWS_REGION":  types.StringValue("us-east-1"),
+				"AWS_ROLE":    types.StringValue("deployer"),
+			},
+		},
+	}
+
+	key := factory.generateCacheKey(conn)
+	for i := 0; i < 10; i++ {
+		assert.Equal(t, key, factory.generateCacheKey(conn), "Exec env should not make the cache key depend on map order")
+	}
+}
+
 func TestCachedClientFactory_ClearCache(t *testing.T) {
 	factory := NewCachedClientFactory()
 
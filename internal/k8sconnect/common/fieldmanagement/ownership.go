@@ -317,6 +317,12 @@ func ExtractFieldPathsFromManagedFieldsJSON(managedFieldsJSON string) ([]string,
 //
 // This keeps diffs simple while tracking comprehensive ownership internally.
 func FlattenManagedFields(ownership map[string][]string) map[string]string {
+	return FlattenManagedFieldsFor(ownership, "k8sconnect")
+}
+
+// FlattenManagedFieldsFor is FlattenManagedFields for a resource that applies with
+// fieldManager instead of "k8sconnect": fieldManager is shown wherever it is a co-owner.
+func FlattenManagedFieldsFor(ownership map[string][]string, fieldManager string) map[string]string {
 	result := make(map[string]string, len(ownership))
 
 	for path, managers := range ownership {
@@ -325,17 +331,17 @@ func FlattenManagedFields(ownership map[string][]string) map[string]string {
 		}
 
 		// Check if we're a co-owner
-		hasK8sconnect := false
+		isOwner := false
 		for _, m := range managers {
-			if m == "k8sconnect" {
-				hasK8sconnect = true
+			if m == fieldManager {
+				isOwner = true
 				break
 			}
 		}
 
-		if hasK8sconnect {
-			// We're an owner (exclusive or shared) → show "k8sconnect"
-			result[path] = "k8sconnect"
+		if isOwner {
+			// We're an owner (exclusive or shared) → show our manager
+			result[path] = fieldManager
 		} else if len(managers) == 1 {
 			// External exclusive owner → show that manager
 			result[path] = managers[0]
@@ -0,0 +1,195 @@
+package k8sclient
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+
+	"github.com/jmorris0x0/terraform-provider-k8sconnect/internal/k8sconnect/common/k8serrors"
+)
+
+var apiServiceGVR = schema.GroupVersionResource{Group: "apiregistration.k8s.io", Version: "v1", Resource: "apiservices"}
+
+// aggregatedAPIs tells whether a group/version is served by an aggregated (extension)
+// API server, i.e. its APIService points at a Service instead of the kube-apiserver.
+// Results are cached per group/version; lookups that fail are not cached, so a missing
+// RBAC permission for apiservices only means the group/version is treated as local.
+type aggregatedAPIs struct {
+	client dynamic.Interface
+
+	mu       sync.Mutex
+	services map[string]string
+}
+
+func newAggregatedAPIs(client dynamic.Interface) *aggregatedAPIs {
+	return &aggregatedAPIs{client: client, services: make(map[string]string)}
+}
+
+// Service returns "namespace/name" of the Service backing groupVersion, or "" when the
+// group/version is served by the kube-apiserver itself or the APIService can't be read
+func (a *aggregatedAPIs) Service(ctx context.Context, groupVersion string) string {
+	gv, err := schema.ParseGroupVersion(groupVersion)
+	if err != nil || gv.Group == "" {
+		return ""
+	}
+
+	a.mu.Lock()
+	service, ok := a.services[groupVersion]
+	a.mu.Unlock()
+	if ok {
+		return service
+	}
+
+	apiService, err := a.client.Resource(apiServiceGVR).Get(ctx, apiServiceName(gv), metav1.GetOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			a.remember(groupVersion, "")
+		} else {
+			tflog.Debug(ctx, "Could not read APIService, treating group/version as served locally", map[string]interface{}{
+				"group_version": groupVersion,
+				"error":         err.Error(),
+			})
+		}
+		return ""
+	}
+
+	service = aggregatedServiceName(apiService)
+	a.remember(groupVersion, service)
+	return service
+}
+
+func (a *aggregatedAPIs) remember(groupVersion, service string) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.services[groupVersion] = service
+}
+
+// apiServiceName returns the name of the APIService registering gv, e.g. "v1beta1.metrics.k8s.io"
+func apiServiceName(gv schema.GroupVersion) string {
+	return gv.Version + "." + gv.Group
+}
+
+// aggregatedServiceName returns "namespace/name" of spec.service of an APIService,
+// or "" for a local APIService without one
+func aggregatedServiceName(apiService *unstructured.Unstructured) string {
+	service, found, _ := unstructured.NestedMap(apiService.Object, "spec", "service")
+	if !found || service == nil {
+		return ""
+	}
+	namespace, _ := service["namespace"].(string)
+	name, _ := service["name"].(string)
+	if name == "" {
+		return ""
+	}
+	return namespace + "/" + name
+}
+
+// aggregatedAPIUnavailableError is returned when discovery of an aggregated group/version
+// fails and there is no last-known-good result to fall back to
+type aggregatedAPIUnavailableError struct {
+	groupVersion string
+	service      string
+	err          error
+}
+
+func (e *aggregatedAPIUnavailableError) Error() string {
+	gv, _ := schema.ParseGroupVersion(e.groupVersion)
+	return fmt.Sprintf("API group/version %s is served by the aggregated API server behind Service %s, "+
+		"which is not responding (last error: %v).\n\n"+
+		"Resources of other API groups are not affected. Check the extension API server with:\n"+
+		"  kubectl get apiservice %s\n"+
+		"  kubectl get pods -n %s",
+		e.groupVersion, e.service, e.err, apiServiceName(gv), strings.SplitN(e.service, "/", 2)[0])
+}
+
+func (e *aggregatedAPIUnavailableError) Unwrap() error {
+	return e.err
+}
+
+// supportsVerb reports whether verbs (from discovery) contains verb. An empty verb
+// list means discovery didn't say, and every verb is assumed supported.
+func supportsVerb(verbs metav1.Verbs, verb string) bool {
+	if len(verbs) == 0 {
+		return true
+	}
+	for _, v := range verbs {
+		if v == verb {
+			return true
+		}
+	}
+	return false
+}
+
+// applyUnsupported reports whether err means the server can't handle a server-side apply
+// patch, as some aggregated API servers can't
+func applyUnsupported(err error) bool {
+	return apierrors.IsUnsupportedMediaType(err) || apierrors.IsMethodNotSupported(err)
+}
+
+// applyOrFallback server-side applies obj, falling back to create/update when the API
+// server doesn't support apply patches, as some aggregated API servers don't
+func applyOrFallback(ctx context.Context, resource dynamic.ResourceInterface, verbs metav1.Verbs, obj *unstructured.Unstructured, opts metav1.ApplyOptions) (*unstructured.Unstructured, error) {
+	fields := map[string]interface{}{
+		"kind": obj.GetKind(),
+		"name": obj.GetName(),
+	}
+	if supportsVerb(verbs, "patch") {
+		result, err := resource.Apply(ctx, obj.GetName(), obj, opts)
+		if err == nil || !applyUnsupported(err) {
+			return result, err
+		}
+		fields["error"] = err.Error()
+		tflog.Warn(ctx, "Server-side apply not supported, falling back to create/update", fields)
+	} else {
+		fields["verbs"] = strings.Join(verbs, ", ")
+		tflog.Warn(ctx, "Resource does not support patch, using create/update instead of server-side apply", fields)
+	}
+
+	return createOrUpdate(ctx, resource, verbs, obj, opts.FieldManager, opts.DryRun)
+}
+
+// createOrUpdate writes obj with plain create/update semantics, for resources whose API
+// server doesn't support server-side apply. Unlike apply, the update replaces the whole
+// object. A resourceVersion already set on obj is sent as a precondition; otherwise the
+// current one is used.
+func createOrUpdate(ctx context.Context, resource dynamic.ResourceInterface, verbs metav1.Verbs, obj *unstructured.Unstructured, fieldManager string, dryRun []string) (*unstructured.Unstructured, error) {
+	existing, err := resource.Get(ctx, obj.GetName(), metav1.GetOptions{})
+	if err != nil && !apierrors.IsNotFound(err) {
+		return nil, err
+	}
+
+	if apierrors.IsNotFound(err) {
+		if !supportsVerb(verbs, "create") {
+			return nil, unsupportedWriteError(obj, "create", verbs)
+		}
+		return resource.Create(ctx, obj, metav1.CreateOptions{FieldManager: fieldManager, DryRun: dryRun})
+	}
+
+	if !supportsVerb(verbs, "update") {
+		return nil, unsupportedWriteError(obj, "update", verbs)
+	}
+	toUpdate := obj.DeepCopy()
+	if toUpdate.GetResourceVersion() == "" {
+		toUpdate.SetResourceVersion(existing.GetResourceVersion())
+	}
+	return resource.Update(ctx, toUpdate, metav1.UpdateOptions{FieldManager: fieldManager, DryRun: dryRun})
+}
+
+// unsupportedWriteError explains that the API server doesn't allow writing obj at all
+func unsupportedWriteError(obj *unstructured.Unstructured, verb string, verbs metav1.Verbs) error {
+	return &k8serrors.UnsupportedOperationError{
+		Message: fmt.Sprintf("the API server for %s does not support server-side apply or %s for %s %q "+
+			"(supported verbs: %s).\n\n"+
+			"Resources of aggregated APIs such as metrics.k8s.io are often read-only and cannot be managed with "+
+			"k8sconnect_object. Use the k8sconnect_object data source to read them instead.",
+			obj.GetAPIVersion(), verb, obj.GetKind(), obj.GetName(), strings.Join(verbs, ", ")),
+	}
+}
@@ -0,0 +1,177 @@
+package k8sclient
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+
+	"github.com/jmorris0x0/terraform-provider-k8sconnect/internal/k8sconnect/common/k8serrors"
+)
+
+// recordingResource is a dynamic.ResourceInterface that serves one object and records writes
+type recordingResource struct {
+	dynamic.ResourceInterface
+
+	existing *unstructured.Unstructured
+	applyErr error
+	calls    []string
+	written  *unstructured.Unstructured
+}
+
+func (r *recordingResource) Get(_ context.Context, name string, _ metav1.GetOptions, _ ...string) (*unstructured.Unstructured, error) {
+	r.calls = append(r.calls, "get")
+	if r.existing == nil {
+		return nil, apierrors.NewNotFound(schema.GroupResource{Group: "example.com", Resource: "widgets"}, name)
+	}
+	return r.existing, nil
+}
+
+func (r *recordingResource) Apply(_ context.Context, _ string, obj *unstructured.Unstructured, _ metav1.ApplyOptions, _ ...string) (*unstructured.Unstructured, error) {
+	r.calls = append(r.calls, "apply")
+	return obj, r.applyErr
+}
+
+func (r *recordingResource) Create(_ context.Context, obj *unstructured.Unstructured, _ metav1.CreateOptions, _ ...string) (*unstructured.Unstructured, error) {
+	r.calls = append(r.calls, "create")
+	r.written = obj
+	return obj, nil
+}
+
+func (r *recordingResource) Update(_ context.Context, obj *unstructured.Unstructured, _ metav1.UpdateOptions, _ ...string) (*unstructured.Unstructured, error) {
+	r.calls = append(r.calls, "update")
+	r.written = obj
+	return obj, nil
+}
+
+func widget(resourceVersion string) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "example.com/v1",
+		"kind":       "Widget",
+		"metadata":   map[string]interface{}{"name": "w"},
+	}}
+	obj.SetResourceVersion(resourceVersion)
+	return obj
+}
+
+func TestApplyOrFallback(t *testing.T) {
+	ctx := context.Background()
+	allVerbs := metav1.Verbs{"create", "get", "patch", "update"}
+
+	t.Run("applies when supported", func(t *testing.T) {
+		r := &recordingResource{}
+		if _, err := applyOrFallback(ctx, r, allVerbs, widget(""), metav1.ApplyOptions{}); err != nil {
+			t.Fatal(err)
+		}
+		if strings.Join(r.calls, ",") != "apply" {
+			t.Errorf("calls = %v, want only apply", r.calls)
+		}
+	})
+
+	t.Run("creates when apply patches are rejected", func(t *testing.T) {
+		r := &recordingResource{applyErr: apierrors.NewGenericServerResponse(415, "patch", schema.GroupResource{}, "w", "", 0, false)}
+		if _, err := applyOrFallback(ctx, r, allVerbs, widget(""), metav1.ApplyOptions{}); err != nil {
+			t.Fatal(err)
+		}
+		if strings.Join(r.calls, ",") != "apply,get,create" {
+			t.Errorf("calls = %v, want apply,get,create", r.calls)
+		}
+	})
+
+	t.Run("updates with the current resourceVersion without patch", func(t *testing.T) {
+		r := &recordingResource{existing: widget("42")}
+		if _, err := applyOrFallback(ctx, r, metav1.Verbs{"get", "update"}, widget(""), metav1.ApplyOptions{}); err != nil {
+			t.Fatal(err)
+		}
+		if strings.Join(r.calls, ",") != "get,update" {
+			t.Errorf("calls = %v, want get,update", r.calls)
+		}
+		if rv := r.written.GetResourceVersion(); rv != "42" {
+			t.Errorf("update resourceVersion = %q, want 42", rv)
+		}
+	})
+
+	t.Run("keeps a resourceVersion precondition", func(t *testing.T) {
+		r := &recordingResource{existing: widget("42")}
+		if _, err := applyOrFallback(ctx, r, metav1.Verbs{"get", "update"}, widget("7"), metav1.ApplyOptions{}); err != nil {
+			t.Fatal(err)
+		}
+		if rv := r.written.GetResourceVersion(); rv != "7" {
+			t.Errorf("update resourceVersion = %q, want the precondition 7", rv)
+		}
+	})
+
+	t.Run("read-only resource", func(t *testing.T) {
+		r := &recordingResource{}
+		_, err := applyOrFallback(ctx, r, metav1.Verbs{"get", "list"}, widget(""), metav1.ApplyOptions{})
+		if !k8serrors.IsUnsupportedOperationError(err) {
+			t.Fatalf("expected an unsupported operation error, got: %v", err)
+		}
+		if !strings.Contains(err.Error(), "supported verbs: get, list") {
+			t.Errorf("error should list the supported verbs: %s", err)
+		}
+	})
+}
+
+func TestResourceDiscoveryIsolatesAggregatedAPIs(t *testing.T) {
+	outage := apierrors.NewServiceUnavailable("the server is currently unable to handle the request")
+	client := &scriptedDiscovery{results: []error{outage}}
+	rd := newResourceDiscovery(client, noRetry)
+	rd.aggregatedService = func(_ context.Context, groupVersion string) string {
+		if groupVersion == "metrics.k8s.io/v1beta1" {
+			return "kube-system/metrics-server"
+		}
+		return ""
+	}
+	ctx := context.Background()
+
+	for i := 0; i < discoveryFailureThreshold+1; i++ {
+		_, err := rd.ServerResourcesForGroupVersion(ctx, "metrics.k8s.io/v1beta1")
+		var unavailable *aggregatedAPIUnavailableError
+		if !errors.As(err, &unavailable) {
+			t.Fatalf("lookup %d: expected aggregatedAPIUnavailableError, got: %v", i+1, err)
+		}
+	}
+	if rd.consecutiveFailures != 0 {
+		t.Errorf("aggregated API failures should not count towards discovery unavailability, got %d", rd.consecutiveFailures)
+	}
+
+	_, err := rd.ServerResourcesForGroupVersion(ctx, "metrics.k8s.io/v1beta1")
+	for _, want := range []string{"kube-system/metrics-server", "kubectl get apiservice v1beta1.metrics.k8s.io", "kubectl get pods -n kube-system"} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("error missing %q:\n%s", want, err)
+		}
+	}
+
+	// Other groups are still looked up
+	client.results = []error{nil}
+	if _, err := rd.ServerResourcesForGroupVersion(ctx, "apps/v1"); err != nil {
+		t.Errorf("expected other group/versions to be unaffected, got: %v", err)
+	}
+}
+
+func TestAggregatedServiceName(t *testing.T) {
+	tests := []struct {
+		spec map[string]interface{}
+		want string
+	}{
+		{spec: map[string]interface{}{"service": map[string]interface{}{"namespace": "kube-system", "name": "metrics-server"}}, want: "kube-system/metrics-server"},
+		{spec: map[string]interface{}{"service": nil}, want: ""},
+		{spec: map[string]interface{}{}, want: ""},
+	}
+	for i, tt := range tests {
+		apiService := &unstructured.Unstructured{Object: map[string]interface{}{"spec": tt.spec}}
+		if got := aggregatedServiceName(apiService); got != tt.want {
+			t.Errorf("case %d: aggregatedServiceName() = %q, want %q", i, got, tt.want)
+		}
+	}
+	if got := apiServiceName(schema.GroupVersion{Group: "metrics.k8s.io", Version: "v1beta1"}); got != "v1beta1.metrics.k8s.io" {
+		t.Errorf("apiServiceName() = %q", got)
+	}
+}
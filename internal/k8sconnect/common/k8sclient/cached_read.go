@@ -0,0 +1,26 @@
+package k8sclient
+
+import (
+	"context"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// cachedReadKey marks a context whose Gets may be served from the API server's cache
+type cachedReadKey struct{}
+
+// WithCachedReads returns a context whose Gets are sent with resourceVersion "0". The API
+// server answers them from its watch cache instead of a quorum read from etcd, so the
+// object returned can be older than the latest write. Only use it where a slightly stale
+// read is harmless, such as refresh.
+func WithCachedReads(ctx context.Context) context.Context {
+	return context.WithValue(ctx, cachedReadKey{}, true)
+}
+
+// getOptions returns the options for a Get made with ctx
+func getOptions(ctx context.Context) metav1.GetOptions {
+	if cached, _ := ctx.Value(cachedReadKey{}).(bool); cached {
+		return metav1.GetOptions{ResourceVersion: "0"}
+	}
+	return metav1.GetOptions{}
+}
@@ -0,0 +1,16 @@
+package k8sclient
+
+import (
+	"context"
+	"testing"
+)
+
+func TestGetOptions(t *testing.T) {
+	ctx := context.Background()
+	if rv := getOptions(ctx).ResourceVersion; rv != "" {
+		t.Errorf("default Get resourceVersion = %q, want a quorum read", rv)
+	}
+	if rv := getOptions(WithCachedReads(ctx)).ResourceVersion; rv != "0" {
+		t.Errorf("cached Get resourceVersion = %q, want \"0\"", rv)
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -27,6 +28,9 @@ type K8sClient interface {
 	// Get retrieves an object by GVR, namespace, and name.
 	Get(ctx context.Context, gvr schema.GroupVersionResource, namespace, name string) (*unstructured.Unstructured, error)
 
+	// GetSubresource retrieves a subresource (e.g. "scale" or "status") of an object by GVR, namespace, and name.
+	GetSubresource(ctx context.Context, gvr schema.GroupVersionResource, namespace, name, subresource string) (*unstructured.Unstructured, error)
+
 	// Delete deletes an object by GVR, namespace, and name.
 	Delete(ctx context.Context, gvr schema.GroupVersionResource, namespace, name string, options DeleteOptions) error
 
@@ -48,7 +52,11 @@ type K8sClient interface {
 	// Returns true for namespace-scoped resources (like Pods, Services), false for cluster-scoped (like Namespaces, ClusterRoles).
 	IsResourceNamespaced(ctx context.Context, apiVersion, kind string) (bool, error)
 
-	Patch(ctx context.Context, gvr schema.GroupVersionResource, namespace, name string, patchType types.PatchType, data []byte, options metav1.PatchOptions) (*unstructured.Unstructured, error)
+	Patch(ctx context.Context, gvr schema.GroupVersionResource, namespace, name string, patchType types.PatchType, data []byte, options metav1.PatchOptions, subresources ...string) (*unstructured.Unstructured, error)
+
+	// UpdateSubresource replaces a subresource of obj with a PUT (e.g. a Namespace's "finalize",
+	// which doesn't support patches).
+	UpdateSubresource(ctx context.Context, gvr schema.GroupVersionResource, obj *unstructured.Unstructured, options metav1.UpdateOptions, subresource string) (*unstructured.Unstructured, error)
 
 	// Watch returns a watcher that handles reconnection automatically
 	Watch(ctx context.Context, gvr schema.GroupVersionResource, namespace string, opts metav1.ListOptions) (watch.Interface, error)
@@ -59,6 +67,9 @@ type K8sClient interface {
 
 	// List retrieves all resources of a given GVR in a namespace (or cluster-wide if namespace is empty)
 	List(ctx context.Context, gvr schema.GroupVersionResource, namespace string, opts metav1.ListOptions) (*unstructured.UnstructuredList, error)
+
+	// ServerPreferredVersion returns the version discovery prefers for an API group, or "" if the group isn't served
+	ServerPreferredVersion(group string) (string, error)
 }
 
 // ApplyOptions holds options for server-side apply operations.
@@ -67,12 +78,14 @@ type ApplyOptions struct {
 	Force           bool
 	DryRun          []string
 	FieldValidation string // "Strict", "Warn", or "Ignore" - validates fields against OpenAPI schema
+	UpdateOperation bool   // Record the write as an Update operation (create or merge patch) instead of Apply
 }
 
 // DeleteOptions holds options for delete operations.
 type DeleteOptions struct {
 	GracePeriodSeconds *int64
 	PropagationPolicy  *metav1.DeletionPropagation
+	DryRun             []string
 }
 
 // resilientWatcher wraps a watch.Interface and handles reconnection
@@ -91,7 +104,9 @@ type resilientWatcher struct {
 // DynamicK8sClient uses client-go's Dynamic Client for operations.
 type DynamicK8sClient struct {
 	client           dynamic.Interface
+	watchClient      dynamic.Interface
 	discovery        discovery.DiscoveryInterface
+	resources        *resourceDiscovery
 	fieldManager     string
 	warningCollector *WarningCollector
 }
@@ -99,6 +114,9 @@ type DynamicK8sClient struct {
 // NewDynamicK8sClient creates a new DynamicK8sClient from a REST config.
 // The config should have a WarningHandler set if you want to collect API warnings.
 func NewDynamicK8sClient(config *rest.Config) (*DynamicK8sClient, error) {
+	config = rest.CopyConfig(config)
+	config.Wrap(countAPICalls)
+
 	dynamicClient, err := dynamic.NewForConfig(config)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create dynamic client: %w", err)
@@ -109,15 +127,33 @@ func NewDynamicK8sClient(config *rest.Config) (*DynamicK8sClient, error) {
 		return nil, fmt.Errorf("failed to create discovery client: %w", err)
 	}
 
+	// rest.Config.Timeout is an http.Client timeout that would also cut off long-lived
+	// watches, so watches get their own client without it. They are bounded by the
+	// caller's context (the wait timeout) instead.
+	watchClient := dynamicClient
+	if config.Timeout > 0 {
+		watchConfig := rest.CopyConfig(config)
+		watchConfig.Timeout = 0
+		watchClient, err = dynamic.NewForConfig(watchConfig)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create dynamic watch client: %w", err)
+		}
+	}
+
 	// Extract warning collector from config if present
 	var warningCollector *WarningCollector
 	if wc, ok := config.WarningHandler.(*WarningCollector); ok {
 		warningCollector = wc
 	}
 
+	resources := newResourceDiscovery(discoveryClient, DefaultRetryConfig)
+	resources.aggregatedService = newAggregatedAPIs(dynamicClient).Service
+
 	return &DynamicK8sClient{
 		client:           dynamicClient,
+		watchClient:      watchClient,
 		discovery:        discoveryClient,
+		resources:        resources,
 		fieldManager:     "k8sconnect",
 		warningCollector: warningCollector,
 	}, nil
@@ -130,23 +166,24 @@ func (d *DynamicK8sClient) SetFieldManager(name string) K8sClient {
 
 // getResourceInterface returns the appropriate ResourceInterface, handling default namespace inference
 func (d *DynamicK8sClient) getResourceInterface(ctx context.Context, gvr schema.GroupVersionResource, obj *unstructured.Unstructured) (dynamic.ResourceInterface, error) {
-	var resourceList *metav1.APIResourceList
-
-	// Wrap the discovery call in retry
-	err := withRetry(ctx, DefaultRetryConfig, func() error {
-		var err error
-		resourceList, err = d.discovery.ServerResourcesForGroupVersion(gvr.GroupVersion().String())
-		return err
-	})
+	resource, _, err := d.getResourceInterfaceWithVerbs(ctx, gvr, obj)
+	return resource, err
+}
 
+// getResourceInterfaceWithVerbs is getResourceInterface that also returns the verbs
+// discovery lists for the resource
+func (d *DynamicK8sClient) getResourceInterfaceWithVerbs(ctx context.Context, gvr schema.GroupVersionResource, obj *unstructured.Unstructured) (dynamic.ResourceInterface, metav1.Verbs, error) {
+	resourceList, err := d.resources.ServerResourcesForGroupVersion(ctx, gvr.GroupVersion().String())
 	if err != nil {
-		return nil, fmt.Errorf("failed to get resource info: %w", err)
+		return nil, nil, fmt.Errorf("failed to get resource info: %w", err)
 	}
 
 	var isNamespaced bool
+	var verbs metav1.Verbs
 	for _, apiResource := range resourceList.APIResources {
 		if apiResource.Name == gvr.Resource {
 			isNamespaced = apiResource.Namespaced
+			verbs = apiResource.Verbs
 			break
 		}
 	}
@@ -156,10 +193,10 @@ func (d *DynamicK8sClient) getResourceInterface(ctx context.Context, gvr schema.
 		if namespace == "" {
 			namespace = "default" // Default namespace inference
 		}
-		return d.client.Resource(gvr).Namespace(namespace), nil
+		return d.client.Resource(gvr).Namespace(namespace), verbs, nil
 	} else {
 		// Truly cluster-scoped (like Namespaces)
-		return d.client.Resource(gvr), nil
+		return d.client.Resource(gvr), verbs, nil
 	}
 }
 
@@ -167,15 +204,7 @@ func (d *DynamicK8sClient) getResourceInterface(ctx context.Context, gvr schema.
 func (d *DynamicK8sClient) getResourceInterfaceByNamespace(ctx context.Context, gvr schema.GroupVersionResource, namespace string) (dynamic.ResourceInterface, error) {
 	// Always check discovery to determine if resource is actually namespaced
 	// This prevents errors when user provides namespace for cluster-scoped resources
-	var resourceList *metav1.APIResourceList
-
-	// Wrap the discovery call in retry
-	err := withRetry(ctx, DefaultRetryConfig, func() error {
-		var err error
-		resourceList, err = d.discovery.ServerResourcesForGroupVersion(gvr.GroupVersion().String())
-		return err
-	})
-
+	resourceList, err := d.resources.ServerResourcesForGroupVersion(ctx, gvr.GroupVersion().String())
 	if err != nil {
 		return nil, fmt.Errorf("failed to get resource info: %w", err)
 	}
@@ -223,12 +252,16 @@ func (d *DynamicK8sClient) Apply(ctx context.Context, obj *unstructured.Unstruct
 			applyOpts.DryRun = options.DryRun
 		}
 
-		resource, err := d.getResourceInterface(ctx, gvr, obj)
+		resource, verbs, err := d.getResourceInterfaceWithVerbs(ctx, gvr, obj)
 		if err != nil {
 			return err
 		}
 
-		_, err = resource.Apply(ctx, obj.GetName(), obj, applyOpts)
+		if options.UpdateOperation {
+			_, err = createOrMergePatch(ctx, resource, verbs, obj, fieldManager, applyOpts.DryRun)
+			return err
+		}
+		_, err = applyOrFallback(ctx, resource, verbs, obj, applyOpts)
 		return err
 	})
 }
@@ -254,19 +287,24 @@ func (d *DynamicK8sClient) DryRunApply(ctx context.Context, obj *unstructured.Un
 			DryRun:       []string{metav1.DryRunAll},
 		}
 
-		resource, err := d.getResourceInterface(ctx, gvr, obj)
+		resource, verbs, err := d.getResourceInterfaceWithVerbs(ctx, gvr, obj)
 		if err != nil {
 			return err
 		}
 
-		result, err = resource.Apply(ctx, obj.GetName(), obj, applyOpts)
+		if options.UpdateOperation {
+			result, err = createOrMergePatch(ctx, resource, verbs, obj, fieldManager, applyOpts.DryRun)
+			return err
+		}
+		result, err = applyOrFallback(ctx, resource, verbs, obj, applyOpts)
 		return err
 	})
 
 	return result, err
 }
 
-// Get retrieves an object from the cluster.
+// Get retrieves an object from the cluster, from the API server's cache when ctx comes
+// from WithCachedReads.
 func (d *DynamicK8sClient) Get(ctx context.Context, gvr schema.GroupVersionResource, namespace, name string) (*unstructured.Unstructured, error) {
 	var result *unstructured.Unstructured
 
@@ -276,7 +314,25 @@ func (d *DynamicK8sClient) Get(ctx context.Context, gvr schema.GroupVersionResou
 			return err
 		}
 
-		result, err = resource.Get(ctx, name, metav1.GetOptions{})
+		result, err = resource.Get(ctx, name, getOptions(ctx))
+		return err
+	})
+
+	return result, err
+}
+
+// GetSubresource retrieves a subresource of an object from the cluster. The scale
+// subresource is returned as an autoscaling/v1 Scale, not the object itself.
+func (d *DynamicK8sClient) GetSubresource(ctx context.Context, gvr schema.GroupVersionResource, namespace, name, subresource string) (*unstructured.Unstructured, error) {
+	var result *unstructured.Unstructured
+
+	err := withRetry(ctx, DefaultRetryConfig, func() error {
+		resource, err := d.getResourceInterfaceByNamespace(ctx, gvr, namespace)
+		if err != nil {
+			return err
+		}
+
+		result, err = resource.Get(ctx, name, metav1.GetOptions{}, subresource)
 		return err
 	})
 
@@ -293,6 +349,9 @@ func (d *DynamicK8sClient) Delete(ctx context.Context, gvr schema.GroupVersionRe
 		if options.PropagationPolicy != nil {
 			deleteOpts.PropagationPolicy = options.PropagationPolicy
 		}
+		if len(options.DryRun) > 0 {
+			deleteOpts.DryRun = options.DryRun
+		}
 
 		resource, err := d.getResourceInterfaceByNamespace(ctx, gvr, namespace)
 		if err != nil {
@@ -318,16 +377,9 @@ func (d *DynamicK8sClient) getGVR(ctx context.Context, obj *unstructured.Unstruc
 	tflog.Debug(ctx, "Discovering GVR", map[string]interface{}{
 		"gvk": gvk.String(),
 	})
+	defer TrackPhase(ctx, PhaseGVRResolution, gvk.GroupVersion().String()+"/"+gvk.Kind)()
 
-	var resources *metav1.APIResourceList
-
-	// Wrap the discovery call in retry
-	err := withRetry(ctx, DefaultRetryConfig, func() error {
-		var err error
-		resources, err = d.discovery.ServerResourcesForGroupVersion(gvk.GroupVersion().String())
-		return err
-	})
-
+	resources, err := d.resources.ServerResourcesForGroupVersion(ctx, gvk.GroupVersion().String())
 	if err != nil {
 		// Provide helpful error message for common scenarios
 		if d.isDiscoveryError(err) {
@@ -396,6 +448,8 @@ func (d *DynamicK8sClient) DiscoverGVR(ctx context.Context, apiVersion, kind str
 		"kind":       kind,
 	})
 
+	defer TrackPhase(ctx, PhaseGVRResolution, apiVersion+"/"+kind)()
+
 	// Parse the apiVersion to get group and version
 	gv, err := schema.ParseGroupVersion(apiVersion)
 	if err != nil {
@@ -403,13 +457,7 @@ func (d *DynamicK8sClient) DiscoverGVR(ctx context.Context, apiVersion, kind str
 	}
 
 	// Get API resources for this group/version
-	var resourceList *metav1.APIResourceList
-	err = withRetry(ctx, DefaultRetryConfig, func() error {
-		var err error
-		resourceList, err = d.discovery.ServerResourcesForGroupVersion(apiVersion)
-		return err
-	})
-
+	resourceList, err := d.resources.ServerResourcesForGroupVersion(ctx, apiVersion)
 	if err != nil {
 		return schema.GroupVersionResource{}, fmt.Errorf(
 			"failed to discover resources for %s: %w\n\n"+
@@ -466,13 +514,7 @@ func (d *DynamicK8sClient) IsResourceNamespaced(ctx context.Context, apiVersion,
 	})
 
 	// Get API resources for this group/version
-	var resourceList *metav1.APIResourceList
-	err := withRetry(ctx, DefaultRetryConfig, func() error {
-		var err error
-		resourceList, err = d.discovery.ServerResourcesForGroupVersion(apiVersion)
-		return err
-	})
-
+	resourceList, err := d.resources.ServerResourcesForGroupVersion(ctx, apiVersion)
 	if err != nil {
 		return false, fmt.Errorf("failed to get resource info for %s/%s: %w", apiVersion, kind, err)
 	}
@@ -501,16 +543,40 @@ func (d *DynamicK8sClient) IsResourceNamespaced(ctx context.Context, apiVersion,
 		kind, apiVersion, gv.Group)
 }
 
-func (d *DynamicK8sClient) Patch(ctx context.Context, gvr schema.GroupVersionResource, namespace, name string, patchType types.PatchType, data []byte, options metav1.PatchOptions) (*unstructured.Unstructured, error) {
+// Patch applies a raw patch to a resource. Optional subresources (e.g. "ephemeralcontainers")
+// route the request to that subresource instead of the main resource endpoint.
+func (d *DynamicK8sClient) Patch(ctx context.Context, gvr schema.GroupVersionResource, namespace, name string, patchType types.PatchType, data []byte, options metav1.PatchOptions, subresources ...string) (*unstructured.Unstructured, error) {
 	var result *unstructured.Unstructured
 
 	err := withRetry(ctx, DefaultRetryConfig, func() error {
 		var err error
 		if namespace == "" {
-			result, err = d.client.Resource(gvr).Patch(ctx, name, patchType, data, options)
+			result, err = d.client.Resource(gvr).Patch(ctx, name, patchType, data, options, subresources...)
 		} else {
-			result, err = d.client.Resource(gvr).Namespace(namespace).Patch(ctx, name, patchType, data, options)
+			result, err = d.client.Resource(gvr).Namespace(namespace).Patch(ctx, name, patchType, data, options, subresources...)
+		}
+		return err
+	})
+
+	return result, err
+}
+
+// UpdateSubresource replaces a subresource of obj, using the client's field manager
+// unless options sets one.
+func (d *DynamicK8sClient) UpdateSubresource(ctx context.Context, gvr schema.GroupVersionResource, obj *unstructured.Unstructured, options metav1.UpdateOptions, subresource string) (*unstructured.Unstructured, error) {
+	var result *unstructured.Unstructured
+
+	if options.FieldManager == "" {
+		options.FieldManager = d.fieldManager
+	}
+
+	err := withRetry(ctx, DefaultRetryConfig, func() error {
+		resource, err := d.getResourceInterfaceByNamespace(ctx, gvr, obj.GetNamespace())
+		if err != nil {
+			return err
 		}
+
+		result, err = resource.Update(ctx, obj, options, subresource)
 		return err
 	})
 
@@ -543,16 +609,14 @@ func (rw *resilientWatcher) run() {
 			return
 		default:
 			// Create the actual watch
-			var watcher watch.Interface
-			var err error
-
-			if rw.namespace != "" {
-				watcher, err = rw.client.client.Resource(rw.gvr).Namespace(rw.namespace).Watch(rw.ctx, rw.opts)
-			} else {
-				watcher, err = rw.client.client.Resource(rw.gvr).Watch(rw.ctx, rw.opts)
-			}
-
+			watcher, err := rw.resource().Watch(rw.ctx, rw.opts)
 			if err != nil {
+				if isResourceExpired(err) {
+					if !rw.relist() {
+						return
+					}
+					continue
+				}
 				// Send error event and retry
 				rw.resultChan <- watch.Event{Type: watch.Error, Object: &metav1.Status{Message: err.Error()}}
 				time.Sleep(2 * time.Second)
@@ -560,7 +624,12 @@ func (rw *resilientWatcher) run() {
 			}
 
 			// Forward events until watch closes
+			expired := false
 			for event := range watcher.ResultChan() {
+				if event.Type == watch.Error && isResourceExpired(apierrors.FromObject(event.Object)) {
+					expired = true
+					break
+				}
 				select {
 				case rw.resultChan <- event:
 					// Update resource version for reconnection
@@ -580,11 +649,76 @@ func (rw *resilientWatcher) run() {
 
 			// Watch closed, will reconnect
 			watcher.Stop()
+			if expired {
+				if !rw.relist() {
+					return
+				}
+				continue
+			}
 			time.Sleep(time.Second) // Brief pause before reconnect
 		}
 	}
 }
 
+// resource returns the dynamic resource interface the watch runs against
+func (rw *resilientWatcher) resource() dynamic.ResourceInterface {
+	if rw.namespace != "" {
+		return rw.client.watchClient.Resource(rw.gvr).Namespace(rw.namespace)
+	}
+	return rw.client.watchClient.Resource(rw.gvr)
+}
+
+// relist recovers from a "too old resource version" error, which the API server returns
+// once it has compacted the history the watch would resume from. Reconnecting with the
+// same resourceVersion would fail the same way, so the watch resumes from a fresh list
+// instead, and the listed objects are sent as Modified events since changes made in the
+// gap were never delivered. A failed list is sent as an error event like a failed watch.
+// Returns false when the watcher was stopped.
+func (rw *resilientWatcher) relist() bool {
+	list, err := rw.resource().List(rw.ctx, metav1.ListOptions{
+		FieldSelector: rw.opts.FieldSelector,
+		LabelSelector: rw.opts.LabelSelector,
+	})
+	if err != nil {
+		event := watch.Event{Type: watch.Error, Object: &metav1.Status{Message: err.Error()}}
+		if !rw.send(event) {
+			return false
+		}
+		time.Sleep(2 * time.Second)
+		return true
+	}
+
+	tflog.Debug(rw.ctx, "Watch resourceVersion expired, resuming from a fresh list", map[string]interface{}{
+		"resource":         rw.gvr.String(),
+		"expired_version":  rw.opts.ResourceVersion,
+		"resource_version": list.GetResourceVersion(),
+	})
+	rw.opts.ResourceVersion = list.GetResourceVersion()
+	for i := range list.Items {
+		if !rw.send(watch.Event{Type: watch.Modified, Object: &list.Items[i]}) {
+			return false
+		}
+	}
+	return true
+}
+
+// send delivers event unless the watcher is stopped first
+func (rw *resilientWatcher) send(event watch.Event) bool {
+	select {
+	case rw.resultChan <- event:
+		return true
+	case <-rw.stopCh:
+		return false
+	case <-rw.ctx.Done():
+		return false
+	}
+}
+
+// isResourceExpired reports a watch rejected because its resourceVersion was compacted
+func isResourceExpired(err error) bool {
+	return apierrors.IsResourceExpired(err) || apierrors.IsGone(err)
+}
+
 func (rw *resilientWatcher) Stop() {
 	close(rw.stopCh)
 }
@@ -624,5 +758,20 @@ func (d *DynamicK8sClient) ServerGroupsAndResources() ([]*metav1.APIGroup, []*me
 	return d.discovery.ServerGroupsAndResources()
 }
 
+// ServerPreferredVersion returns the version discovery prefers for group. Returns "" if
+// group isn't served.
+func (d *DynamicK8sClient) ServerPreferredVersion(group string) (string, error) {
+	groups, err := d.discovery.ServerGroups()
+	if err != nil {
+		return "", err
+	}
+	for _, g := range groups.Groups {
+		if g.Name == group {
+			return g.PreferredVersion.Version, nil
+		}
+	}
+	return "", nil
+}
+
 // Interface assertion to ensure DynamicK8sClient satisfies K8sClient
 var _ K8sClient = (*DynamicK8sClient)(nil)
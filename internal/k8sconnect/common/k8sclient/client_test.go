@@ -4,9 +4,11 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/rest"
 )
 
 func TestDiscoveryErrorDetection(t *testing.T) {
@@ -459,3 +461,21 @@ func TestStubK8sClient_CallRecording(t *testing.T) {
 		t.Errorf("expected second get namespace kube-system, got %s", stubClient.GetCalls[1].Namespace)
 	}
 }
+
+func TestNewDynamicK8sClient_WatchClientIgnoresRequestTimeout(t *testing.T) {
+	client, err := NewDynamicK8sClient(&rest.Config{Host: "https://k8s.example.com"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if client.watchClient != client.client {
+		t.Error("expected watches to share the request client when no timeout is set")
+	}
+
+	client, err = NewDynamicK8sClient(&rest.Config{Host: "https://k8s.example.com", Timeout: 30 * time.Second})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if client.watchClient == client.client {
+		t.Error("expected a separate watch client when request timeout is set")
+	}
+}
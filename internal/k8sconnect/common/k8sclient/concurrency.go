@@ -0,0 +1,86 @@
+package k8sclient
+
+import (
+	"io"
+	"net/http"
+	"strconv"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// OperationLimiter caps the requests in flight to the API server across every client
+// that shares it, for the provider's max_concurrent_operations. Terraform's -parallelism
+// bounds resources, not requests: a single resource can send several (discovery, dry-run,
+// apply, read-back), so a large configuration can still overwhelm a small control plane.
+//
+// Watches are long-lived and would hold a slot until the wait ends, so they are not
+// counted; the list or get before each watch is.
+type OperationLimiter struct {
+	slots chan struct{}
+}
+
+// NewOperationLimiter returns a limiter that allows at most max requests at a time
+func NewOperationLimiter(max int) *OperationLimiter {
+	return &OperationLimiter{slots: make(chan struct{}, max)}
+}
+
+// Wrap returns rt limited by l, for rest.Config.Wrap
+func (l *OperationLimiter) Wrap(rt http.RoundTripper) http.RoundTripper {
+	return &limitedTransport{limiter: l, next: rt}
+}
+
+// limitedTransport holds a slot of its limiter from sending a request until its
+// response body is closed, so reading a large list counts as in flight too
+type limitedTransport struct {
+	limiter *OperationLimiter
+	next    http.RoundTripper
+}
+
+func (t *limitedTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if isWatchRequest(req) {
+		return t.next.RoundTrip(req)
+	}
+
+	select {
+	case t.limiter.slots <- struct{}{}:
+	default:
+		tflog.Debug(req.Context(), "Waiting for a free API operation slot", map[string]interface{}{
+			"max_concurrent_operations": cap(t.limiter.slots),
+			"method":                    req.Method,
+			"path":                      req.URL.Path,
+		})
+		select {
+		case t.limiter.slots <- struct{}{}:
+		case <-req.Context().Done():
+			return nil, req.Context().Err()
+		}
+	}
+
+	resp, err := t.next.RoundTrip(req)
+	if err != nil || resp.Body == nil {
+		<-t.limiter.slots
+		return resp, err
+	}
+	resp.Body = &releasingBody{ReadCloser: resp.Body, release: func() { <-t.limiter.slots }}
+	return resp, nil
+}
+
+// releasingBody releases its request's slot when it is closed, once
+type releasingBody struct {
+	io.ReadCloser
+	release func()
+	once    sync.Once
+}
+
+func (b *releasingBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.once.Do(b.release)
+	return err
+}
+
+// isWatchRequest reports whether req opens a watch (?watch=true)
+func isWatchRequest(req *http.Request) bool {
+	watch, err := strconv.ParseBool(req.URL.Query().Get("watch"))
+	return err == nil && watch
+}
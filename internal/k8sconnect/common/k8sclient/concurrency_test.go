@@ -0,0 +1,109 @@
+package k8sclient
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// blockingRoundTripper holds every request until release is closed and records the
+// most requests it saw at once
+type blockingRoundTripper struct {
+	release  chan struct{}
+	inFlight atomic.Int64
+	peak     atomic.Int64
+}
+
+func (b *blockingRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	n := b.inFlight.Add(1)
+	defer b.inFlight.Add(-1)
+	for {
+		peak := b.peak.Load()
+		if n <= peak || b.peak.CompareAndSwap(peak, n) {
+			break
+		}
+	}
+	<-b.release
+	return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader("{}"))}, nil
+}
+
+func TestOperationLimiter(t *testing.T) {
+	t.Run("caps requests in flight", func(t *testing.T) {
+		next := &blockingRoundTripper{release: make(chan struct{})}
+		rt := NewOperationLimiter(2).Wrap(next)
+
+		var wg sync.WaitGroup
+		for i := 0; i < 6; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				req, _ := http.NewRequest(http.MethodGet, "https://cluster.example.com/api/v1/namespaces", nil)
+				resp, err := rt.RoundTrip(req)
+				if err != nil {
+					t.Error(err)
+					return
+				}
+				resp.Body.Close()
+			}()
+		}
+		time.Sleep(50 * time.Millisecond)
+		close(next.release)
+		wg.Wait()
+
+		if peak := next.peak.Load(); peak != 2 {
+			t.Errorf("peak in-flight requests = %d, want 2", peak)
+		}
+	})
+
+	t.Run("holds the slot until the body is closed", func(t *testing.T) {
+		next := &blockingRoundTripper{release: make(chan struct{})}
+		close(next.release)
+		rt := NewOperationLimiter(1).Wrap(next)
+
+		req, _ := http.NewRequest(http.MethodGet, "https://cluster.example.com/api/v1/pods", nil)
+		resp, err := rt.RoundTrip(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+		defer cancel()
+		if _, err := rt.RoundTrip(req.WithContext(ctx)); err != context.DeadlineExceeded {
+			t.Fatalf("expected the second request to wait for the open body, got %v", err)
+		}
+
+		resp.Body.Close()
+		resp.Body.Close() // closing twice releases only once
+		resp, err = rt.RoundTrip(req)
+		if err != nil {
+			t.Fatalf("expected the slot to be free after Close, got %v", err)
+		}
+		resp.Body.Close()
+	})
+
+	t.Run("watches are not counted", func(t *testing.T) {
+		next := &blockingRoundTripper{release: make(chan struct{})}
+		close(next.release)
+		rt := NewOperationLimiter(1).Wrap(next)
+
+		watchReq, _ := http.NewRequest(http.MethodGet, "https://cluster.example.com/api/v1/pods?watch=true", nil)
+		if _, err := rt.RoundTrip(watchReq); err != nil {
+			t.Fatal(err)
+		}
+
+		// The watch's body is still open, but the slot is free
+		req, _ := http.NewRequest(http.MethodGet, "https://cluster.example.com/api/v1/pods", nil)
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+		resp, err := rt.RoundTrip(req.WithContext(ctx))
+		if err != nil {
+			t.Fatalf("expected the request to be sent beside the watch, got %v", err)
+		}
+		resp.Body.Close()
+	})
+}
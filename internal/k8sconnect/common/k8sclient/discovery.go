@@ -0,0 +1,164 @@
+package k8sclient
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// discoveryFailureThreshold is the number of consecutive failed discovery lookups
+	// (each already retried with backoff) after which discovery is considered unavailable
+	discoveryFailureThreshold = 3
+
+	// discoveryCooldown is how long lookups fail fast once discovery is unavailable,
+	// before the API server is asked again
+	discoveryCooldown = 30 * time.Second
+)
+
+// groupVersionResourcesGetter is the part of discovery.DiscoveryInterface used for GVR lookups
+type groupVersionResourcesGetter interface {
+	ServerResourcesForGroupVersion(groupVersion string) (*metav1.APIResourceList, error)
+}
+
+// resourceDiscovery looks up the resources of a group/version with bounded retries.
+// On a cluster mid-upgrade discovery can fail intermittently, so each successful lookup
+// is kept as last-known-good and served when a later lookup fails with a transient error.
+// After discoveryFailureThreshold consecutive failures, lookups without a cached result
+// fail fast for discoveryCooldown with a single discovery-unavailable error, instead of
+// every resource spending the full retry budget.
+//
+// Failures of a group/version served by an aggregated API server (e.g. metrics.k8s.io
+// while metrics-server is down) don't count towards that: one broken extension API
+// server must not make discovery fail fast for every other group.
+type resourceDiscovery struct {
+	client groupVersionResourcesGetter
+	retry  RetryConfig
+	now    func() time.Time
+
+	// aggregatedService returns the Service behind an aggregated group/version, or ""
+	aggregatedService func(ctx context.Context, groupVersion string) string
+
+	mu                  sync.Mutex
+	lastKnownGood       map[string]*metav1.APIResourceList
+	consecutiveFailures int
+	lastErr             error
+	unavailableUntil    time.Time
+}
+
+func newResourceDiscovery(client groupVersionResourcesGetter, retry RetryConfig) *resourceDiscovery {
+	return &resourceDiscovery{
+		client:        client,
+		retry:         retry,
+		now:           time.Now,
+		lastKnownGood: make(map[string]*metav1.APIResourceList),
+	}
+}
+
+// discoveryUnavailableError is returned while discovery is considered unavailable
+type discoveryUnavailableError struct {
+	failures int
+	retryIn  time.Duration
+	lastErr  error
+}
+
+func (e *discoveryUnavailableError) Error() string {
+	return fmt.Sprintf("API discovery is unavailable: the last %d discovery requests failed after retries "+
+		"(last error: %v). Lookups fail fast for another %s before discovery is tried again.\n\n"+
+		"This usually means the API server is restarting or the control plane is being upgraded. "+
+		"Run terraform again once the cluster is healthy.",
+		e.failures, e.lastErr, e.retryIn.Round(time.Second))
+}
+
+func (e *discoveryUnavailableError) Unwrap() error {
+	return e.lastErr
+}
+
+// ServerResourcesForGroupVersion returns the resources of groupVersion, falling back to
+// the last-known-good result when discovery fails transiently
+func (rd *resourceDiscovery) ServerResourcesForGroupVersion(ctx context.Context, groupVersion string) (*metav1.APIResourceList, error) {
+	rd.mu.Lock()
+	cached := rd.lastKnownGood[groupVersion]
+	if now := rd.now(); now.Before(rd.unavailableUntil) {
+		failures, lastErr, retryIn := rd.consecutiveFailures, rd.lastErr, rd.unavailableUntil.Sub(now)
+		rd.mu.Unlock()
+		if cached != nil {
+			tflog.Debug(ctx, "Discovery unavailable, using last-known-good result", map[string]interface{}{
+				"group_version": groupVersion,
+			})
+			return cached, nil
+		}
+		return nil, &discoveryUnavailableError{failures: failures, retryIn: retryIn, lastErr: lastErr}
+	}
+	rd.mu.Unlock()
+
+	var resourceList *metav1.APIResourceList
+	var lastAttemptErr error
+	done := TrackPhase(ctx, PhaseDiscovery, groupVersion)
+	err := withRetry(ctx, rd.retry, func() error {
+		var err error
+		resourceList, err = rd.client.ServerResourcesForGroupVersion(groupVersion)
+		lastAttemptErr = err
+		return err
+	})
+	done()
+
+	// Looked up before taking the lock, as it is an API request itself
+	var aggregatedService string
+	if err != nil && ctx.Err() == nil && isRetryableError(lastAttemptErr) && rd.aggregatedService != nil {
+		aggregatedService = rd.aggregatedService(ctx, groupVersion)
+	}
+
+	rd.mu.Lock()
+	defer rd.mu.Unlock()
+
+	if err == nil {
+		rd.lastKnownGood[groupVersion] = resourceList
+		rd.consecutiveFailures = 0
+		rd.lastErr = nil
+		rd.unavailableUntil = time.Time{}
+		return resourceList, nil
+	}
+
+	// Definitive answers (e.g. 404 for a group/version that isn't served) are returned as-is:
+	// a cached result would hide a deleted CRD
+	if ctx.Err() != nil || !isRetryableError(lastAttemptErr) {
+		return nil, err
+	}
+
+	if aggregatedService != "" {
+		if cached != nil {
+			tflog.Warn(ctx, "Aggregated API discovery failed, using last-known-good result", map[string]interface{}{
+				"group_version": groupVersion,
+				"service":       aggregatedService,
+				"error":         err.Error(),
+			})
+			return cached, nil
+		}
+		return nil, &aggregatedAPIUnavailableError{groupVersion: groupVersion, service: aggregatedService, err: lastAttemptErr}
+	}
+
+	rd.consecutiveFailures++
+	rd.lastErr = lastAttemptErr
+	if rd.consecutiveFailures >= discoveryFailureThreshold {
+		rd.unavailableUntil = rd.now().Add(discoveryCooldown)
+		tflog.Warn(ctx, "Discovery unavailable, failing fast during cooldown", map[string]interface{}{
+			"consecutive_failures": rd.consecutiveFailures,
+			"cooldown":             discoveryCooldown.String(),
+			"last_error":           lastAttemptErr.Error(),
+		})
+	}
+
+	if cached != nil {
+		tflog.Warn(ctx, "Discovery failed, using last-known-good result", map[string]interface{}{
+			"group_version": groupVersion,
+			"error":         err.Error(),
+		})
+		return cached, nil
+	}
+	return nil, err
+}
@@ -0,0 +1,118 @@
+package k8sclient
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// scriptedDiscovery returns the queued results in order, repeating the last one
+type scriptedDiscovery struct {
+	results []error
+	calls   int
+}
+
+func (s *scriptedDiscovery) ServerResourcesForGroupVersion(groupVersion string) (*metav1.APIResourceList, error) {
+	i := s.calls
+	if i >= len(s.results) {
+		i = len(s.results) - 1
+	}
+	s.calls++
+	if s.results[i] != nil {
+		return nil, s.results[i]
+	}
+	return &metav1.APIResourceList{
+		GroupVersion: groupVersion,
+		APIResources: []metav1.APIResource{{Name: "deployments", Kind: "Deployment", Namespaced: true}},
+	}, nil
+}
+
+var noRetry = RetryConfig{MaxRetries: 0, InitialDelay: time.Millisecond, MaxDelay: time.Millisecond, Multiplier: 1, TotalTimeout: time.Second}
+
+func TestResourceDiscoveryServesLastKnownGood(t *testing.T) {
+	blip := fmt.Errorf("connection refused")
+	client := &scriptedDiscovery{results: []error{nil, blip}}
+	rd := newResourceDiscovery(client, noRetry)
+	ctx := context.Background()
+
+	if _, err := rd.ServerResourcesForGroupVersion(ctx, "apps/v1"); err != nil {
+		t.Fatalf("first lookup failed: %v", err)
+	}
+
+	list, err := rd.ServerResourcesForGroupVersion(ctx, "apps/v1")
+	if err != nil {
+		t.Fatalf("expected the cached result during a transient failure, got: %v", err)
+	}
+	if list.APIResources[0].Kind != "Deployment" {
+		t.Errorf("unexpected cached result: %+v", list)
+	}
+
+	// Nothing cached for another group/version: the error is returned
+	if _, err := rd.ServerResourcesForGroupVersion(ctx, "batch/v1"); err == nil {
+		t.Error("expected an error for a group/version without a cached result")
+	}
+}
+
+func TestResourceDiscoveryDoesNotCacheDefinitiveErrors(t *testing.T) {
+	notFound := apierrors.NewNotFound(schema.GroupResource{Group: "example.com"}, "v1")
+	client := &scriptedDiscovery{results: []error{nil, notFound}}
+	rd := newResourceDiscovery(client, noRetry)
+	ctx := context.Background()
+
+	if _, err := rd.ServerResourcesForGroupVersion(ctx, "example.com/v1"); err != nil {
+		t.Fatalf("first lookup failed: %v", err)
+	}
+	_, err := rd.ServerResourcesForGroupVersion(ctx, "example.com/v1")
+	if !apierrors.IsNotFound(err) {
+		t.Errorf("expected NotFound (e.g. CRD deleted) not to be masked by the cache, got: %v", err)
+	}
+	if rd.consecutiveFailures != 0 {
+		t.Errorf("definitive errors should not count towards unavailability, got %d failures", rd.consecutiveFailures)
+	}
+}
+
+func TestResourceDiscoveryFailsFastWhenUnavailable(t *testing.T) {
+	outage := fmt.Errorf("connection refused")
+	client := &scriptedDiscovery{results: []error{outage}}
+	rd := newResourceDiscovery(client, noRetry)
+	now := time.Now()
+	rd.now = func() time.Time { return now }
+	ctx := context.Background()
+
+	for i := 0; i < discoveryFailureThreshold; i++ {
+		if _, err := rd.ServerResourcesForGroupVersion(ctx, "apps/v1"); err == nil {
+			t.Fatalf("lookup %d: expected an error during the outage", i+1)
+		}
+	}
+	calls := client.calls
+
+	_, err := rd.ServerResourcesForGroupVersion(ctx, "apps/v1")
+	var unavailable *discoveryUnavailableError
+	if !errors.As(err, &unavailable) {
+		t.Fatalf("expected discoveryUnavailableError, got: %v", err)
+	}
+	if client.calls != calls {
+		t.Errorf("expected no discovery request while unavailable, got %d", client.calls-calls)
+	}
+	if !strings.Contains(err.Error(), "API discovery is unavailable") || !strings.Contains(err.Error(), "connection refused") {
+		t.Errorf("unexpected error message: %s", err)
+	}
+
+	// After the cooldown discovery is tried again, and a success resets the failure count
+	client.results = []error{nil}
+	client.calls = 0
+	now = now.Add(discoveryCooldown + time.Second)
+	if _, err := rd.ServerResourcesForGroupVersion(ctx, "apps/v1"); err != nil {
+		t.Fatalf("expected discovery to recover after the cooldown, got: %v", err)
+	}
+	if rd.consecutiveFailures != 0 {
+		t.Errorf("expected failures reset after success, got %d", rd.consecutiveFailures)
+	}
+}
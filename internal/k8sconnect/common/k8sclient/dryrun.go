@@ -0,0 +1,162 @@
+package k8sclient
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// dryRunClient wraps a K8sClient for the provider's dry_run mode. Every write is sent
+// with DryRun: ["All"], so the API server validates it (admission, schema, conflicts)
+// without persisting anything. Writes that were already dry-run (plan-time diffs) pass
+// through unchanged.
+//
+// So that the read-back after a simulated write sees its result, Get returns the
+// simulated object for applied and patched objects and NotFound for deleted ones.
+type dryRunClient struct {
+	K8sClient
+	sim *simulatedObjects
+}
+
+// simulatedObjects holds the results of simulated writes, shared by every resource
+// using the same connection for the lifetime of the provider process
+type simulatedObjects struct {
+	mu      sync.Mutex
+	objects map[string]*unstructured.Unstructured // nil value: simulated delete
+}
+
+// NewDryRunClient returns a client that simulates every write to inner with
+// server-side dry-run
+func NewDryRunClient(inner K8sClient) K8sClient {
+	return &dryRunClient{
+		K8sClient: inner,
+		sim:       &simulatedObjects{objects: make(map[string]*unstructured.Unstructured)},
+	}
+}
+
+// IsDryRun reports whether client simulates writes instead of persisting them
+func IsDryRun(client K8sClient) bool {
+	_, ok := client.(*dryRunClient)
+	return ok
+}
+
+// SurfaceDryRunWarning adds a warning that operation on resourceDesc was simulated when
+// client is in dry-run mode, and does nothing otherwise
+func SurfaceDryRunWarning(ctx context.Context, client K8sClient, operation, resourceDesc string, diagnostics *diag.Diagnostics) {
+	if !IsDryRun(client) {
+		return
+	}
+	diagnostics.AddWarning(
+		"Dry Run: Changes Simulated",
+		fmt.Sprintf("%s of %s was validated by the API server with server-side dry-run and not persisted, "+
+			"because the provider is configured with dry_run = true.\n\n"+
+			"Terraform state records the simulated result, so the next plan shows the change again.", operation, resourceDesc),
+	)
+	tflog.Warn(ctx, "Dry run: change simulated, not persisted", map[string]interface{}{
+		"operation": operation,
+		"resource":  resourceDesc,
+	})
+}
+
+// SurfaceDryRunDelete adds an error that the destroy of resourceDesc was simulated when
+// client is in dry-run mode, and does nothing otherwise. The object still exists, so
+// failing the destroy keeps it in Terraform state instead of orphaning it. This covers the
+// destroy half of a replacement too.
+func SurfaceDryRunDelete(ctx context.Context, client K8sClient, operation, resourceDesc string, diagnostics *diag.Diagnostics) {
+	if !IsDryRun(client) {
+		return
+	}
+	diagnostics.AddError(
+		"Dry Run: Destroy Not Persisted",
+		fmt.Sprintf("%s of %s was validated by the API server with server-side dry-run and not persisted, "+
+			"because the provider is configured with dry_run = true.\n\n"+
+			"The object still exists, so Terraform keeps it in state rather than forgetting it. "+
+			"Run the destroy or replacement again without dry_run to apply it.", operation, resourceDesc),
+	)
+	tflog.Warn(ctx, "Dry run: destroy simulated, resource kept in state", map[string]interface{}{
+		"operation": operation,
+		"resource":  resourceDesc,
+	})
+}
+
+func simulatedKey(gvr schema.GroupVersionResource, namespace, name string) string {
+	return fmt.Sprintf("%s/%s/%s/%s", gvr.Group, gvr.Resource, namespace, name)
+}
+
+func (c *dryRunClient) record(gvr schema.GroupVersionResource, namespace, name string, obj *unstructured.Unstructured) {
+	c.sim.mu.Lock()
+	defer c.sim.mu.Unlock()
+	c.sim.objects[simulatedKey(gvr, namespace, name)] = obj
+}
+
+func (c *dryRunClient) Apply(ctx context.Context, obj *unstructured.Unstructured, options ApplyOptions) error {
+	if len(options.DryRun) > 0 {
+		return c.K8sClient.Apply(ctx, obj, options)
+	}
+
+	result, err := c.K8sClient.DryRunApply(ctx, obj, options)
+	if err != nil {
+		return err
+	}
+	if gvr, gvrErr := c.K8sClient.GetGVR(ctx, obj); gvrErr == nil && result != nil {
+		c.record(gvr, result.GetNamespace(), result.GetName(), result.DeepCopy())
+	}
+	return nil
+}
+
+func (c *dryRunClient) Patch(ctx context.Context, gvr schema.GroupVersionResource, namespace, name string, patchType types.PatchType, data []byte, options metav1.PatchOptions, subresources ...string) (*unstructured.Unstructured, error) {
+	if len(options.DryRun) > 0 {
+		return c.K8sClient.Patch(ctx, gvr, namespace, name, patchType, data, options, subresources...)
+	}
+
+	options.DryRun = []string{metav1.DryRunAll}
+	result, err := c.K8sClient.Patch(ctx, gvr, namespace, name, patchType, data, options, subresources...)
+	if err == nil && result != nil && result.GetName() == name {
+		c.record(gvr, namespace, name, result.DeepCopy())
+	}
+	return result, err
+}
+
+func (c *dryRunClient) UpdateSubresource(ctx context.Context, gvr schema.GroupVersionResource, obj *unstructured.Unstructured, options metav1.UpdateOptions, subresource string) (*unstructured.Unstructured, error) {
+	options.DryRun = []string{metav1.DryRunAll}
+	return c.K8sClient.UpdateSubresource(ctx, gvr, obj, options, subresource)
+}
+
+func (c *dryRunClient) Delete(ctx context.Context, gvr schema.GroupVersionResource, namespace, name string, options DeleteOptions) error {
+	if len(options.DryRun) > 0 {
+		return c.K8sClient.Delete(ctx, gvr, namespace, name, options)
+	}
+
+	options.DryRun = []string{metav1.DryRunAll}
+	if err := c.K8sClient.Delete(ctx, gvr, namespace, name, options); err != nil {
+		return err
+	}
+	c.record(gvr, namespace, name, nil)
+	return nil
+}
+
+func (c *dryRunClient) Get(ctx context.Context, gvr schema.GroupVersionResource, namespace, name string) (*unstructured.Unstructured, error) {
+	c.sim.mu.Lock()
+	obj, simulated := c.sim.objects[simulatedKey(gvr, namespace, name)]
+	c.sim.mu.Unlock()
+
+	if !simulated {
+		return c.K8sClient.Get(ctx, gvr, namespace, name)
+	}
+	if obj == nil {
+		return nil, errors.NewNotFound(gvr.GroupResource(), name)
+	}
+	return obj.DeepCopy(), nil
+}
+
+func (c *dryRunClient) SetFieldManager(name string) K8sClient {
+	return &dryRunClient{K8sClient: c.K8sClient.SetFieldManager(name), sim: c.sim}
+}
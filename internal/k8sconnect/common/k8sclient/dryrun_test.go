@@ -0,0 +1,100 @@
+package k8sclient
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func TestDryRunClientSimulatesWrites(t *testing.T) {
+	ctx := context.Background()
+	gvr := schema.GroupVersionResource{Version: "v1", Resource: "configmaps"}
+	configMap := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "ConfigMap",
+		"metadata":   map[string]interface{}{"name": "settings", "namespace": "default"},
+		"data":       map[string]interface{}{"mode": "dry"},
+	}}
+
+	stub := NewStubK8sClient()
+	stub.DryRunResponse = configMap
+	stub.GetError = errors.NewNotFound(gvr.GroupResource(), "settings")
+	client := NewDryRunClient(stub)
+
+	if !IsDryRun(client) || IsDryRun(stub) {
+		t.Fatal("IsDryRun should only report the dry-run wrapper")
+	}
+
+	// Apply is sent as a dry-run and never persisted
+	if err := client.Apply(ctx, configMap, ApplyOptions{FieldManager: "k8sconnect", Force: true}); err != nil {
+		t.Fatalf("Apply: %v", err)
+	}
+	if len(stub.ApplyCalls) != 0 || len(stub.DryRunCalls) != 1 {
+		t.Fatalf("expected the apply to be sent as a dry-run, got %d applies and %d dry-runs", len(stub.ApplyCalls), len(stub.DryRunCalls))
+	}
+	if options := stub.DryRunCalls[0].Options; options.FieldManager != "k8sconnect" || !options.Force {
+		t.Errorf("apply options not passed through: %+v", options)
+	}
+
+	// The read-back sees the simulated object, not the cluster
+	got, err := client.Get(ctx, gvr, "default", "settings")
+	if err != nil || got.Object["data"].(map[string]interface{})["mode"] != "dry" {
+		t.Fatalf("expected the simulated object, got %v, %v", got, err)
+	}
+
+	// Deletes are sent with DryRun: All and the object then reads as gone
+	if err := client.Delete(ctx, gvr, "default", "settings", DeleteOptions{}); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+	if dryRun := stub.DeleteCalls[0].Options.DryRun; len(dryRun) != 1 || dryRun[0] != metav1.DryRunAll {
+		t.Errorf("expected DryRun: [All] on delete, got %v", dryRun)
+	}
+	if _, err := client.Get(ctx, gvr, "default", "settings"); !errors.IsNotFound(err) {
+		t.Errorf("expected NotFound after a simulated delete, got %v", err)
+	}
+
+	// The simulated objects are shared with clients for other field managers
+	if !IsDryRun(client.SetFieldManager("k8sconnect-patch")) {
+		t.Error("SetFieldManager should keep the dry-run wrapper")
+	}
+}
+
+func TestDryRunClientPassesThroughDryRuns(t *testing.T) {
+	ctx := context.Background()
+	stub := NewStubK8sClient()
+	client := NewDryRunClient(stub)
+	obj := &unstructured.Unstructured{Object: map[string]interface{}{"apiVersion": "v1", "kind": "ConfigMap"}}
+
+	// Plan-time dry-runs are not recorded as simulated writes
+	if err := client.Apply(ctx, obj, ApplyOptions{DryRun: []string{metav1.DryRunAll}}); err != nil {
+		t.Fatalf("Apply: %v", err)
+	}
+	if len(stub.ApplyCalls) != 1 || len(stub.DryRunCalls) != 0 {
+		t.Errorf("expected an explicit dry-run apply to pass through, got %d applies and %d dry-runs", len(stub.ApplyCalls), len(stub.DryRunCalls))
+	}
+}
+
+func TestSurfaceDryRunDelete(t *testing.T) {
+	ctx := context.Background()
+	stub := NewStubK8sClient()
+
+	// A simulated destroy must fail so Terraform keeps the resource in state
+	var diags diag.Diagnostics
+	SurfaceDryRunDelete(ctx, NewDryRunClient(stub), "Delete", "v1/ConfigMap default/settings", &diags)
+	if !diags.HasError() || !strings.Contains(diags.Errors()[0].Detail(), "v1/ConfigMap default/settings") {
+		t.Errorf("expected an error naming the resource, got %v", diags)
+	}
+
+	diags = nil
+	SurfaceDryRunDelete(ctx, stub, "Delete", "v1/ConfigMap default/settings", &diags)
+	if diags.HasError() {
+		t.Errorf("expected no diagnostics outside dry-run mode, got %v", diags)
+	}
+}
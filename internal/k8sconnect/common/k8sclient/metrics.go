@@ -0,0 +1,156 @@
+package k8sclient
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Phases timed by TrackPhase
+const (
+	PhaseDiscovery     = "discovery"
+	PhaseGVRResolution = "gvr_resolution"
+	PhaseApply         = "apply"
+	PhaseReadBack      = "read_back"
+	PhaseWait          = "wait"
+)
+
+// slowestResourcesInSummary is how many resources RunSummary lists
+const slowestResourcesInSummary = 5
+
+// runMetrics aggregates timings and API call counts for the lifetime of the provider
+// process. Terraform starts the provider for each plan or apply, so that is one run.
+type runMetrics struct {
+	apiCalls atomic.Int64
+
+	mu        sync.Mutex
+	phases    map[string]*phaseStats
+	resources map[string]time.Duration
+}
+
+type phaseStats struct {
+	count int
+	total time.Duration
+}
+
+var metrics = newRunMetrics()
+
+func newRunMetrics() *runMetrics {
+	return &runMetrics{
+		phases:    make(map[string]*phaseStats),
+		resources: make(map[string]time.Duration),
+	}
+}
+
+// TrackPhase starts timing phase for subject (a resource, or a group/version for
+// discovery) and returns the function that stops it. Each completed phase is logged
+// at debug level and added to the run summary.
+//
+//	defer k8sclient.TrackPhase(ctx, k8sclient.PhaseApply, "Deployment default/web")()
+func TrackPhase(ctx context.Context, phase, subject string) func() {
+	start := time.Now()
+	return func() {
+		duration := time.Since(start)
+		metrics.record(phase, subject, duration)
+		tflog.Debug(ctx, "Phase timing", map[string]interface{}{
+			"phase":       phase,
+			"subject":     subject,
+			"duration_ms": duration.Milliseconds(),
+		})
+	}
+}
+
+func (m *runMetrics) record(phase, subject string, duration time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	stats := m.phases[phase]
+	if stats == nil {
+		stats = &phaseStats{}
+		m.phases[phase] = stats
+	}
+	stats.count++
+	stats.total += duration
+
+	// Discovery and GVR resolution are keyed by group/version and kind, shared by every
+	// resource of that kind, so they are not attributed to any one resource
+	if phase != PhaseDiscovery && phase != PhaseGVRResolution {
+		m.resources[subject] += duration
+	}
+}
+
+// RunSummary describes where time went in this run: operations and time per phase,
+// total API calls, and the slowest resources. Empty if nothing was timed.
+func RunSummary() string {
+	return metrics.summary()
+}
+
+func (m *runMetrics) summary() string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if len(m.phases) == 0 {
+		return ""
+	}
+
+	var b strings.Builder
+	operations := 0
+	for _, stats := range m.phases {
+		operations += stats.count
+	}
+	fmt.Fprintf(&b, "k8sconnect run summary: %d timed operations, %d API calls\n", operations, m.apiCalls.Load())
+
+	phases := make([]string, 0, len(m.phases))
+	for phase := range m.phases {
+		phases = append(phases, phase)
+	}
+	sort.Strings(phases)
+	for _, phase := range phases {
+		stats := m.phases[phase]
+		fmt.Fprintf(&b, "  %s: %d in %s (avg %s)\n", phase, stats.count,
+			stats.total.Round(time.Millisecond), (stats.total / time.Duration(stats.count)).Round(time.Millisecond))
+	}
+
+	subjects := make([]string, 0, len(m.resources))
+	for subject := range m.resources {
+		subjects = append(subjects, subject)
+	}
+	sort.Slice(subjects, func(i, j int) bool {
+		if m.resources[subjects[i]] != m.resources[subjects[j]] {
+			return m.resources[subjects[i]] > m.resources[subjects[j]]
+		}
+		return subjects[i] < subjects[j]
+	})
+	if len(subjects) > slowestResourcesInSummary {
+		subjects = subjects[:slowestResourcesInSummary]
+	}
+	if len(subjects) > 0 {
+		b.WriteString("Slowest resources:\n")
+		for i, subject := range subjects {
+			fmt.Fprintf(&b, "  %d. %s: %s\n", i+1, subject, m.resources[subject].Round(time.Millisecond))
+		}
+	}
+
+	return strings.TrimSuffix(b.String(), "\n")
+}
+
+// apiCallCounter counts the requests sent to the API server for the run summary
+type apiCallCounter struct {
+	next http.RoundTripper
+}
+
+func (c *apiCallCounter) RoundTrip(req *http.Request) (*http.Response, error) {
+	metrics.apiCalls.Add(1)
+	return c.next.RoundTrip(req)
+}
+
+func countAPICalls(rt http.RoundTripper) http.RoundTripper {
+	return &apiCallCounter{next: rt}
+}
@@ -0,0 +1,76 @@
+package k8sclient
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestRunSummary(t *testing.T) {
+	m := newRunMetrics()
+	if got := m.summary(); got != "" {
+		t.Errorf("expected an empty summary before anything is timed, got %q", got)
+	}
+
+	m.record(PhaseDiscovery, "apps/v1", 300*time.Millisecond)
+	m.record(PhaseGVRResolution, "apps/v1/Deployment", 310*time.Millisecond)
+	m.record(PhaseApply, "Deployment web (namespace: default)", 2*time.Second)
+	m.record(PhaseWait, "Deployment web (namespace: default)", 40*time.Second)
+	m.record(PhaseApply, "ConfigMap settings (namespace: default)", time.Second)
+	m.record(PhaseApply, "Namespace default", 500*time.Millisecond)
+	m.apiCalls.Add(12)
+
+	summary := m.summary()
+	for _, want := range []string{
+		"6 timed operations, 12 API calls",
+		"apply: 3 in 3.5s (avg 1.167s)",
+		"discovery: 1 in 300ms",
+		"wait: 1 in 40s",
+		"1. Deployment web (namespace: default): 42s",
+		"2. ConfigMap settings (namespace: default): 1s",
+		"3. Namespace default: 500ms",
+	} {
+		if !strings.Contains(summary, want) {
+			t.Errorf("summary missing %q:\n%s", want, summary)
+		}
+	}
+	if strings.Contains(summary, "apps/v1/Deployment:") {
+		t.Errorf("GVR resolution should not be listed as a resource:\n%s", summary)
+	}
+}
+
+func TestTrackPhaseRecords(t *testing.T) {
+	saved := metrics
+	metrics = newRunMetrics()
+	defer func() { metrics = saved }()
+
+	TrackPhase(context.Background(), PhaseReadBack, "Secret token (namespace: default)")()
+	if stats := metrics.phases[PhaseReadBack]; stats == nil || stats.count != 1 {
+		t.Fatalf("expected one read_back recorded, got %+v", stats)
+	}
+	if _, ok := metrics.resources["Secret token (namespace: default)"]; !ok {
+		t.Error("expected the read-back to count towards the resource")
+	}
+}
+
+type noopRoundTripper struct{}
+
+func (noopRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	return &http.Response{StatusCode: http.StatusOK}, nil
+}
+
+func TestCountAPICalls(t *testing.T) {
+	before := metrics.apiCalls.Load()
+	rt := countAPICalls(noopRoundTripper{})
+	req, _ := http.NewRequest(http.MethodGet, "https://cluster.example.com/api", nil)
+	for i := 0; i < 3; i++ {
+		if _, err := rt.RoundTrip(req); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if got := metrics.apiCalls.Load() - before; got != 3 {
+		t.Errorf("counted %d API calls, want 3", got)
+	}
+}
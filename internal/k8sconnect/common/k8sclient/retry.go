@@ -122,12 +122,35 @@ func withRetry(ctx context.Context, config RetryConfig, operation func() error)
 		// Calculate backoff delay with jitter
 		delay := calculateBackoff(attempt, config)
 
-		tflog.Debug(ctx, "Retrying operation after backoff", map[string]interface{}{
-			"attempt":    attempt + 1,
-			"delay":      delay,
-			"error":      err.Error(),
-			"error_type": classifyError(err),
-		})
+		if apierrors.IsTooManyRequests(err) {
+			// Priority and fairness throttling: wait at least as long as the server asked
+			retryAfter := throttleDelay(err, config)
+			if retryAfter > delay {
+				delay = retryAfter
+			}
+			if remaining := config.TotalTimeout - time.Since(startTime); delay > remaining {
+				tflog.Warn(ctx, "API server is throttling requests, retry budget exhausted", map[string]interface{}{
+					"attempts":    attempt + 1,
+					"retry_after": retryAfter,
+					"remaining":   remaining,
+				})
+				return fmt.Errorf("API server is still throttling requests after %d attempts (%v): %w",
+					attempt+1, time.Since(startTime).Round(time.Millisecond), err)
+			}
+			tflog.Warn(ctx, "API server is throttling requests, retrying", map[string]interface{}{
+				"attempt":     attempt + 1,
+				"delay":       delay,
+				"retry_after": retryAfter,
+				"error":       err.Error(),
+			})
+		} else {
+			tflog.Debug(ctx, "Retrying operation after backoff", map[string]interface{}{
+				"attempt":    attempt + 1,
+				"delay":      delay,
+				"error":      err.Error(),
+				"error_type": classifyError(err),
+			})
+		}
 
 		// Sleep with context awareness
 		select {
@@ -165,6 +188,20 @@ func calculateBackoff(attempt int, config RetryConfig) time.Duration {
 	return delay
 }
 
+// throttleDelay returns how long a 429 response asked the client to wait (its
+// Retry-After), capped at config.MaxDelay. It is zero when the server gave no hint.
+func throttleDelay(err error, config RetryConfig) time.Duration {
+	seconds, ok := apierrors.SuggestsClientDelay(err)
+	if !ok || seconds <= 0 {
+		return 0
+	}
+	delay := time.Duration(seconds) * time.Second
+	if delay > config.MaxDelay {
+		delay = config.MaxDelay
+	}
+	return delay
+}
+
 // retryableErrorPatterns contains error message patterns that indicate a retryable error
 var retryableErrorPatterns = []string{
 	// etcd errors
@@ -209,6 +209,96 @@ func TestWithRetry_TotalTimeout(t *testing.T) {
 	}
 }
 
+func TestWithRetry_HonorsRetryAfter(t *testing.T) {
+	ctx := context.Background()
+	callCount := 0
+
+	config := RetryConfig{
+		MaxRetries:   3,
+		InitialDelay: 10 * time.Millisecond,
+		MaxDelay:     5 * time.Second,
+		Multiplier:   2.0,
+		Jitter:       0.1,
+		TotalTimeout: 10 * time.Second,
+	}
+
+	start := time.Now()
+	err := withRetry(ctx, config, func() error {
+		callCount++
+		if callCount == 1 {
+			return apierrors.NewTooManyRequests("too many requests", 1)
+		}
+		return nil
+	})
+
+	if err != nil {
+		t.Errorf("expected success after throttling, got: %v", err)
+	}
+	if callCount != 2 {
+		t.Errorf("expected 2 calls, got %d", callCount)
+	}
+	if elapsed := time.Since(start); elapsed < time.Second {
+		t.Errorf("expected the retry to wait for Retry-After (1s), waited %v", elapsed)
+	}
+}
+
+func TestWithRetry_RetryAfterExceedsBudget(t *testing.T) {
+	ctx := context.Background()
+	callCount := 0
+
+	config := RetryConfig{
+		MaxRetries:   5,
+		InitialDelay: 10 * time.Millisecond,
+		MaxDelay:     30 * time.Second,
+		Multiplier:   2.0,
+		Jitter:       0.1,
+		TotalTimeout: 200 * time.Millisecond,
+	}
+
+	start := time.Now()
+	err := withRetry(ctx, config, func() error {
+		callCount++
+		return apierrors.NewTooManyRequests("too many requests", 10)
+	})
+
+	if err == nil {
+		t.Fatal("expected an error, got nil")
+	}
+	if !apierrors.IsTooManyRequests(err) {
+		t.Errorf("expected the 429 to be wrapped, got: %v", err)
+	}
+	if callCount != 1 {
+		t.Errorf("expected no retry when Retry-After exceeds the budget, got %d calls", callCount)
+	}
+	if elapsed := time.Since(start); elapsed > config.TotalTimeout {
+		t.Errorf("should not sleep past the retry budget, took %v", elapsed)
+	}
+}
+
+func TestThrottleDelay(t *testing.T) {
+	config := RetryConfig{MaxDelay: 5 * time.Second}
+
+	tests := []struct {
+		name string
+		err  error
+		want time.Duration
+	}{
+		{name: "retry after", err: apierrors.NewTooManyRequests("too many requests", 2), want: 2 * time.Second},
+		{name: "capped at max delay", err: apierrors.NewTooManyRequests("too many requests", 60), want: 5 * time.Second},
+		{name: "no hint", err: apierrors.NewTooManyRequests("too many requests", 0), want: 0},
+		{name: "wrapped", err: fmt.Errorf("apply: %w", apierrors.NewTooManyRequests("too many requests", 3)), want: 3 * time.Second},
+		{name: "other error", err: errors.New("boom"), want: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := throttleDelay(tt.err, config); got != tt.want {
+				t.Errorf("throttleDelay() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestCalculateBackoff(t *testing.T) {
 	config := RetryConfig{
 		InitialDelay: 100 * time.Millisecond,
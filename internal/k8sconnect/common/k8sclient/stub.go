@@ -23,6 +23,8 @@ type stubK8sClient struct {
 	DeleteCalls []DeleteCall
 	DryRunCalls []DryRunCall
 
+	UpdateSubresourceCalls []UpdateSubresourceCall
+
 	// Response configuration
 	ApplyError     error
 	GetResponse    *unstructured.Unstructured
@@ -31,6 +33,13 @@ type stubK8sClient struct {
 	DryRunResponse *unstructured.Unstructured
 	DryRunError    error
 
+	// SubresourceResponses maps a subresource name to its GetSubresource response;
+	// subresources without an entry return NotFound
+	SubresourceResponses map[string]*unstructured.Unstructured
+
+	// PreferredVersions maps an API group to its ServerPreferredVersion; other groups return ""
+	PreferredVersions map[string]string
+
 	// State simulation - when true, Get returns NotFound after Delete/Apply
 	SimulateDeletedAfterMutation bool
 	mutationOccurred             bool
@@ -59,6 +68,12 @@ type DryRunCall struct {
 	Options ApplyOptions
 }
 
+type UpdateSubresourceCall struct {
+	Object      *unstructured.Unstructured
+	Options     metav1.UpdateOptions
+	Subresource string
+}
+
 // NewStubK8sClient creates a new stub client for testing
 func NewStubK8sClient() *stubK8sClient {
 	return &stubK8sClient{
@@ -95,6 +110,13 @@ func (s *stubK8sClient) Get(ctx context.Context, gvr schema.GroupVersionResource
 	return s.GetResponse, nil
 }
 
+func (s *stubK8sClient) GetSubresource(ctx context.Context, gvr schema.GroupVersionResource, namespace, name, subresource string) (*unstructured.Unstructured, error) {
+	if response, ok := s.SubresourceResponses[subresource]; ok {
+		return response, nil
+	}
+	return nil, errors.NewNotFound(gvr.GroupResource(), name)
+}
+
 func (s *stubK8sClient) Delete(ctx context.Context, gvr schema.GroupVersionResource, namespace, name string, options DeleteOptions) error {
 	s.DeleteCalls = append(s.DeleteCalls, DeleteCall{
 		GVR:       gvr,
@@ -201,7 +223,17 @@ func (s *stubK8sClient) IsResourceNamespaced(ctx context.Context, apiVersion, ki
 	return !IsClusterScopedResource(apiVersion, kind), nil
 }
 
-func (s *stubK8sClient) Patch(ctx context.Context, gvr schema.GroupVersionResource, namespace, name string, patchType types.PatchType, data []byte, options metav1.PatchOptions) (*unstructured.Unstructured, error) {
+func (s *stubK8sClient) UpdateSubresource(ctx context.Context, gvr schema.GroupVersionResource, obj *unstructured.Unstructured, options metav1.UpdateOptions, subresource string) (*unstructured.Unstructured, error) {
+	s.UpdateSubresourceCalls = append(s.UpdateSubresourceCalls, UpdateSubresourceCall{
+		Object:      obj,
+		Options:     options,
+		Subresource: subresource,
+	})
+	s.mutationOccurred = true
+	return obj, nil
+}
+
+func (s *stubK8sClient) Patch(ctx context.Context, gvr schema.GroupVersionResource, namespace, name string, patchType types.PatchType, data []byte, options metav1.PatchOptions, subresources ...string) (*unstructured.Unstructured, error) {
 	// For stub, just return success or configured response
 	return s.GetResponse, nil
 }
@@ -222,5 +254,9 @@ func (s *stubK8sClient) List(ctx context.Context, gvr schema.GroupVersionResourc
 	}, nil
 }
 
+func (s *stubK8sClient) ServerPreferredVersion(group string) (string, error) {
+	return s.PreferredVersions[group], nil
+}
+
 // Interface assertion to ensure stubK8sClient satisfies K8sClient
 var _ K8sClient = (*stubK8sClient)(nil)
@@ -0,0 +1,42 @@
+package k8sclient
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/dynamic"
+)
+
+// createOrMergePatch writes obj so that managedFields records the field manager with
+// operation Update instead of Apply: a create when the object doesn't exist, otherwise a
+// JSON merge patch of obj onto it. Unlike createOrUpdate it leaves fields obj doesn't
+// set alone. Update operations never conflict, so fields owned by other managers are
+// taken over silently, and fields later removed from obj stay owned and set. A
+// resourceVersion set on obj is sent as a precondition.
+func createOrMergePatch(ctx context.Context, resource dynamic.ResourceInterface, verbs metav1.Verbs, obj *unstructured.Unstructured, fieldManager string, dryRun []string) (*unstructured.Unstructured, error) {
+	_, err := resource.Get(ctx, obj.GetName(), metav1.GetOptions{})
+	if err != nil && !apierrors.IsNotFound(err) {
+		return nil, err
+	}
+
+	if apierrors.IsNotFound(err) {
+		if !supportsVerb(verbs, "create") {
+			return nil, unsupportedWriteError(obj, "create", verbs)
+		}
+		return resource.Create(ctx, obj, metav1.CreateOptions{FieldManager: fieldManager, DryRun: dryRun})
+	}
+
+	if !supportsVerb(verbs, "patch") {
+		return nil, unsupportedWriteError(obj, "patch", verbs)
+	}
+	data, err := json.Marshal(obj.Object)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode merge patch: %w", err)
+	}
+	return resource.Patch(ctx, obj.GetName(), types.MergePatchType, data, metav1.PatchOptions{FieldManager: fieldManager, DryRun: dryRun})
+}
@@ -0,0 +1,52 @@
+package k8sclient
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/jmorris0x0/terraform-provider-k8sconnect/internal/k8sconnect/common/k8serrors"
+)
+
+func (r *recordingResource) Patch(_ context.Context, _ string, patchType types.PatchType, data []byte, _ metav1.PatchOptions, _ ...string) (*unstructured.Unstructured, error) {
+	r.calls = append(r.calls, "patch "+string(patchType)+" "+string(data))
+	return r.existing, nil
+}
+
+func TestCreateOrMergePatch(t *testing.T) {
+	ctx := context.Background()
+	allVerbs := metav1.Verbs{"create", "get", "patch", "update"}
+
+	t.Run("creates a missing object", func(t *testing.T) {
+		r := &recordingResource{}
+		if _, err := createOrMergePatch(ctx, r, allVerbs, widget(""), "k8sconnect", nil); err != nil {
+			t.Fatal(err)
+		}
+		if strings.Join(r.calls, ",") != "get,create" {
+			t.Errorf("calls = %v, want get,create", r.calls)
+		}
+	})
+
+	t.Run("merge patches an existing object", func(t *testing.T) {
+		r := &recordingResource{existing: widget("42")}
+		if _, err := createOrMergePatch(ctx, r, allVerbs, widget("7"), "k8sconnect", nil); err != nil {
+			t.Fatal(err)
+		}
+		want := `get,patch application/merge-patch+json {"apiVersion":"example.com/v1","kind":"Widget","metadata":{"name":"w","resourceVersion":"7"}}`
+		if strings.Join(r.calls, ",") != want {
+			t.Errorf("calls = %v, want %s", r.calls, want)
+		}
+	})
+
+	t.Run("resource without patch", func(t *testing.T) {
+		r := &recordingResource{existing: widget("42")}
+		_, err := createOrMergePatch(ctx, r, metav1.Verbs{"get", "update"}, widget(""), "k8sconnect", nil)
+		if !k8serrors.IsUnsupportedOperationError(err) {
+			t.Fatalf("expected an unsupported operation error, got: %v", err)
+		}
+	})
+}
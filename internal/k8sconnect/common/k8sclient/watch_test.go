@@ -0,0 +1,91 @@
+package k8sclient
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/watch"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+func TestResilientWatcher_ResumesFromFreshListWhenExpired(t *testing.T) {
+	gvr := schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "deployments"}
+	deployment := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"metadata":   map[string]interface{}{"name": "web", "namespace": "default", "resourceVersion": "200"},
+	}}
+
+	fake := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+		map[schema.GroupVersionResource]string{gvr: "DeploymentList"})
+
+	var mu sync.Mutex
+	var watchVersions []string
+	fake.PrependWatchReactor("deployments", func(action k8stesting.Action) (bool, watch.Interface, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		watchVersions = append(watchVersions, action.(k8stesting.WatchActionImpl).WatchRestrictions.ResourceVersion)
+
+		w := watch.NewFake()
+		if len(watchVersions) == 1 {
+			expired := apierrors.NewResourceExpired("too old resource version: 100 (150)")
+			go w.Error(&expired.ErrStatus)
+		}
+		return true, w, nil
+	})
+	fake.PrependReactor("list", "deployments", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		list := &unstructured.UnstructuredList{Items: []unstructured.Unstructured{*deployment}}
+		list.SetResourceVersion("300")
+		return true, list, nil
+	})
+
+	client := &DynamicK8sClient{client: fake, watchClient: fake}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	watcher, err := client.Watch(ctx, gvr, "default", metav1.ListOptions{
+		FieldSelector:   "metadata.name=web",
+		ResourceVersion: "100",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer watcher.Stop()
+
+	select {
+	case event := <-watcher.ResultChan():
+		if event.Type != watch.Modified {
+			t.Fatalf("expected the relisted object as a Modified event, got %s: %v", event.Type, event.Object)
+		}
+		if name := event.Object.(*unstructured.Unstructured).GetName(); name != "web" {
+			t.Errorf("expected the relisted deployment, got %q", name)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for the relisted object")
+	}
+
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		mu.Lock()
+		versions := append([]string(nil), watchVersions...)
+		mu.Unlock()
+		if len(versions) >= 2 {
+			if versions[0] != "100" || versions[1] != "300" {
+				t.Errorf("expected the watch to resume from the list's resourceVersion, got %v", versions)
+			}
+			return
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("expected the watch to be restarted, got %v", versions)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
@@ -1,12 +1,15 @@
 package k8serrors
 
 import (
+	stderrors "errors"
 	"fmt"
 	"regexp"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 // isBuiltInAPIGroup checks if the apiVersion belongs to a built-in Kubernetes API group
@@ -77,6 +80,15 @@ func IsConnectionError(err error) bool {
 	return false
 }
 
+// IsRequestTimeoutError checks if an error is a client-side per-request timeout,
+// i.e. an http.Client timeout set via rest.Config.Timeout (cluster.request_timeout)
+func IsRequestTimeoutError(err error) bool {
+	if err == nil {
+		return false
+	}
+	return strings.Contains(err.Error(), "Client.Timeout exceeded")
+}
+
 // extractKindFromResourceDesc extracts the kind from a resourceDesc like "ConfigMap test-config"
 func extractKindFromResourceDesc(resourceDesc string) string {
 	// resourceDesc format is typically "Kind name" or "Kind namespace/name"
@@ -89,7 +101,8 @@ func extractKindFromResourceDesc(resourceDesc string) string {
 }
 
 // ClassifyError categorizes Kubernetes API errors for better user experience
-// Returns (severity, title, detail) suitable for Terraform diagnostics
+// Returns (severity, title, detail) suitable for Terraform diagnostics.
+// Titles are prefixed with a stable ErrorType, e.g. "[AuthFailed] Create: Authentication Failed".
 func ClassifyError(err error, operation, resourceDesc, apiVersion string) (severity, title, detail string) {
 	switch {
 	// Check auth errors FIRST — they use type-based unwrapping (errors.As) which
@@ -97,7 +110,7 @@ func ClassifyError(err error, operation, resourceDesc, apiVersion string) (sever
 	// "failed to get resource info for v1/ConfigMap: Unauthorized".
 	// IsConnectionError uses string matching and would incorrectly catch these.
 	case errors.IsUnauthorized(err):
-		return "error", fmt.Sprintf("%s: Authentication Failed", operation),
+		return "error", classifiedTitle(ErrorTypeAuthFailed, operation, "Authentication Failed"),
 			fmt.Sprintf("Authentication failed for %s %s.\n\n"+
 				"Error: %v\n\n"+
 				"This usually means:\n"+
@@ -107,15 +120,58 @@ func ClassifyError(err error, operation, resourceDesc, apiVersion string) (sever
 				"Check your cluster authentication configuration.",
 				operation, resourceDesc, err)
 
+	// A terminating namespace is also a 403, but not an RBAC problem
+	case IsNamespaceTerminatingError(err):
+		return "error", classifiedTitle(ErrorTypeNamespaceTerminating, operation, "Namespace Terminating"),
+			fmt.Sprintf("Cannot %s %s: its namespace is being deleted, and the API server rejects new objects in a terminating namespace.\n\n"+
+				"Error: %v\n\n"+
+				"This is common when a configuration is destroyed and applied again in quick succession. "+
+				"Run terraform apply again once the namespace is gone, or set wait_for_namespace_termination = true "+
+				"on the k8sconnect_object to wait for it.",
+				strings.ToLower(operation), resourceDesc, err)
+
 	case errors.IsForbidden(err):
-		return "error", fmt.Sprintf("%s: Insufficient Permissions", operation),
+		return "error", classifiedTitle(ErrorTypeForbidden, operation, "Insufficient Permissions"),
 			fmt.Sprintf("RBAC permissions insufficient to %s %s. Check that your credentials have the required permissions for this operation. Details: %v",
 				operation, resourceDesc, err)
 
+	// Only reached once the client's retries (which honor Retry-After) are used up
+	case errors.IsTooManyRequests(err):
+		return "error", classifiedTitle(ErrorTypeThrottled, operation, "API Server Throttling"),
+			fmt.Sprintf("The API server kept rejecting requests to %s %s with 429 Too Many Requests, "+
+				"even after retrying with backoff.\n\n"+
+				"Error: %v\n\n"+
+				"The cluster's API Priority and Fairness limits are throttling this client. Run terraform again "+
+				"once the load has dropped, or lower concurrency with terraform apply -parallelism=N.",
+				strings.ToLower(operation), resourceDesc, err)
+
+	case IsUnsupportedOperationError(err):
+		return "error", classifiedTitle(ErrorTypeNotSupported, operation, "Operation Not Supported"),
+			fmt.Sprintf("Cannot %s %s: %v", strings.ToLower(operation), resourceDesc, err)
+
+	// Client-side request timeout (cluster.request_timeout) - check before connection
+	// errors since both are network-level failures but need different guidance
+	case IsRequestTimeoutError(err):
+		return "error", classifiedTitle(ErrorTypeAPITimeout, operation, "Kubernetes API Request Timeout"),
+			fmt.Sprintf("A Kubernetes API request for %s on %s did not complete within the configured request_timeout.\n\n"+
+				"Error: %v\n\n"+
+				"This usually means:\n"+
+				"1. The control plane is degraded or overloaded\n"+
+				"2. The request_timeout is too short for this cluster\n\n"+
+				"Check cluster health, or increase cluster.request_timeout.",
+				operation, resourceDesc, err)
+
+	// Before IsConnectionError: the cluster is reachable, only one extension API server isn't
+	case IsAggregatedAPIUnavailableError(err):
+		return "error", classifiedTitle(ErrorTypeConnectionFailed, operation, "Aggregated API Server Unavailable"),
+			fmt.Sprintf("Could not %s %s because its API server is unavailable.\n\n"+
+				"Error: %v",
+				strings.ToLower(operation), resourceDesc, err)
+
 	// Check connection errors AFTER auth errors (Bug #4 fix)
 	// Connection errors were being misdiagnosed as "Resource Type Not Found"
 	case IsConnectionError(err):
-		return "error", fmt.Sprintf("%s: Cluster Connection Failed", operation),
+		return "error", classifiedTitle(ErrorTypeConnectionFailed, operation, "Cluster Connection Failed"),
 			fmt.Sprintf("Could not connect to Kubernetes cluster.\n\n"+
 				"Error: %v\n\n"+
 				"This usually means:\n"+
@@ -127,7 +183,7 @@ func ClassifyError(err error, operation, resourceDesc, apiVersion string) (sever
 				err)
 
 	case errors.IsNotFound(err):
-		return "warning", fmt.Sprintf("%s: Resource Not Found", operation),
+		return "warning", classifiedTitle(ErrorTypeNotFound, operation, "Resource Not Found"),
 			fmt.Sprintf("The %s was not found in the cluster. It may have been deleted outside of Terraform.", resourceDesc)
 
 	// Note: SSA conflicts are intentionally prevented by using Force=true (ADR-005)
@@ -149,19 +205,22 @@ func ClassifyError(err error, operation, resourceDesc, apiVersion string) (sever
 				"2. Remove the conflicting fields from your Terraform configuration\n\n"
 		}
 
+		if conflictsJSON := FormatFieldConflictsJSON(ExtractFieldConflicts(err)); conflictsJSON != "" {
+			message += conflictsJSON + "\n\n"
+		}
 		message += fmt.Sprintf("Details: %v", err)
 
-		return "error", fmt.Sprintf("%s: Field Manager Conflict", operation), message
+		return "error", classifiedTitle(ErrorTypeConflict, operation, "Field Manager Conflict"), message
 
 	case errors.IsTimeout(err) || errors.IsServerTimeout(err):
-		return "error", fmt.Sprintf("%s: Kubernetes API Timeout", operation),
+		return "error", classifiedTitle(ErrorTypeAPITimeout, operation, "Kubernetes API Timeout"),
 			fmt.Sprintf("Timeout while performing %s on %s. The cluster may be under heavy load or experiencing connectivity issues. Details: %v",
 				operation, resourceDesc, err)
 
 	// ADR-017: Field validation errors (status 400) - check BEFORE IsInvalid (status 422)
 	case IsFieldValidationError(err):
 		fieldDetails := ExtractFieldValidationDetails(err)
-		return "error", fmt.Sprintf("%s: Field Validation Failed", operation),
+		return "error", classifiedTitle(ErrorTypeValidationFailed, operation, "Field Validation Failed"),
 			fmt.Sprintf("Field validation failed for %s.\n\n%s",
 				resourceDesc, fieldDetails)
 
@@ -172,7 +231,7 @@ func ClassifyError(err error, operation, resourceDesc, apiVersion string) (sever
 		// Immutable is more specific, so it should take precedence.
 		if IsImmutableFieldError(err) {
 			immutableFields := ExtractImmutableFields(err)
-			return "error", fmt.Sprintf("%s: Immutable Field Changed", operation),
+			return "error", classifiedTitle(ErrorTypeImmutable, operation, "Immutable Field Changed"),
 				fmt.Sprintf("Cannot update immutable field(s) %v on %s.\n\n"+
 					"Immutable fields cannot be changed after resource creation.\n\n"+
 					"To resolve this:\n\n"+
@@ -186,12 +245,23 @@ func ClassifyError(err error, operation, resourceDesc, apiVersion string) (sever
 					immutableFields, resourceDesc)
 		}
 
+		// Check if a custom resource violates its CRD's OpenAPI schema (required fields,
+		// types, enums). Checked before CEL, whose message heuristics also match type errors.
+		if !isBuiltInAPIGroup(apiVersion) && IsSchemaValidationError(err) {
+			return "error", classifiedTitle(ErrorTypeValidationFailed, operation, "Schema Validation Failed"),
+				fmt.Sprintf("%s does not match the OpenAPI schema of its CRD.\n\n"+
+					"%s\n\n"+
+					"Fix these fields in yaml_body. To see the schema:\n"+
+					"  kubectl explain %s",
+					resourceDesc, strings.Join(ExtractSchemaValidationCauses(err), "\n"), schemaExplainTarget(err, apiVersion))
+		}
+
 		// Check if this is specifically a CEL validation error
 		// IMPORTANT: Only show CEL error for CRDs, not built-in K8s resources
 		// Built-in resources (v1, apps/v1, etc.) use OpenAPI schema validation, not CEL
 		if IsCELValidationError(err) && !isBuiltInAPIGroup(apiVersion) {
 			celDetails := ExtractCELValidationDetails(err)
-			return "error", fmt.Sprintf("%s: CEL Validation Failed", operation),
+			return "error", classifiedTitle(ErrorTypeValidationFailed, operation, "CEL Validation Failed"),
 				fmt.Sprintf("CEL validation rule failed for %s.\n\n"+
 					"%s\n\n"+
 					"CEL (Common Expression Language) validation is defined in the CRD schema.\n"+
@@ -205,13 +275,13 @@ func ClassifyError(err error, operation, resourceDesc, apiVersion string) (sever
 		// This is more generic than CEL, so check it AFTER CEL validation
 		if IsInvalidWithFieldDetails(err) {
 			fieldDetails := ExtractInvalidFieldDetails(err)
-			return "error", fmt.Sprintf("%s: Field Validation Failed", operation),
+			return "error", classifiedTitle(ErrorTypeValidationFailed, operation, "Field Validation Failed"),
 				fmt.Sprintf("Field validation failed for %s.\n\n%s",
 					resourceDesc, fieldDetails)
 		}
 
 		// Generic invalid resource error (for non-field-validation, non-CEL, and non-immutable errors)
-		return "error", fmt.Sprintf("%s: Invalid Resource", operation),
+		return "error", classifiedTitle(ErrorTypeInvalidResource, operation, "Invalid Resource"),
 			fmt.Sprintf("The %s contains invalid fields or values. Review the YAML specification and ensure all required fields are present and correctly formatted. Details: %v",
 				resourceDesc, err)
 
@@ -219,7 +289,7 @@ func ClassifyError(err error, operation, resourceDesc, apiVersion string) (sever
 	// SSA is idempotent - it updates existing resources instead of failing
 	// This code path exists for defensive programming in case non-SSA operations are added
 	case errors.IsAlreadyExists(err):
-		return "error", fmt.Sprintf("%s: Resource Already Exists", operation),
+		return "error", classifiedTitle(ErrorTypeAlreadyExists, operation, "Resource Already Exists"),
 			fmt.Sprintf("The %s already exists in the cluster and cannot be created. Use import to manage existing resources with Terraform. Details: %v",
 				resourceDesc, err)
 
@@ -249,12 +319,12 @@ func ClassifyError(err error, operation, resourceDesc, apiVersion string) (sever
 		default:
 			correctVersion = "(check Kubernetes documentation)"
 		}
-		return "error", fmt.Sprintf("%s: Invalid API Group", operation),
+		return "error", classifiedTitle(ErrorTypeInvalidAPIGroup, operation, "Invalid API Group"),
 			fmt.Sprintf("%s is a built-in Kubernetes resource and cannot use apiVersion '%s'. Use apiVersion '%s' instead.",
 				kind, apiVersion, correctVersion)
 
 	case IsCRDNotFoundError(err):
-		return "error", fmt.Sprintf("%s: Custom Resource Definition Not Found", operation),
+		return "error", classifiedTitle(ErrorTypeCRDNotFound, operation, "Custom Resource Definition Not Found"),
 			fmt.Sprintf("The Custom Resource Definition (CRD) for %s does not exist in the cluster.\n\n"+
 				"This usually means:\n"+
 				"1. The CRD hasn't been installed yet\n"+
@@ -269,12 +339,12 @@ func ClassifyError(err error, operation, resourceDesc, apiVersion string) (sever
 		// These errors contain patterns like "failed to convert" or "quantities must match"
 		if IsConversionError(err) {
 			fieldDetails := ExtractConversionErrorDetails(err)
-			return "error", fmt.Sprintf("%s: Field Validation Failed", operation),
+			return "error", classifiedTitle(ErrorTypeValidationFailed, operation, "Field Validation Failed"),
 				fmt.Sprintf("Field validation failed for %s.\n\n%s",
 					resourceDesc, fieldDetails)
 		}
 
-		return "error", fmt.Sprintf("%s: Kubernetes API Error", operation),
+		return "error", classifiedTitle(ErrorTypeAPIError, operation, "Kubernetes API Error"),
 			fmt.Sprintf("An unexpected error occurred while performing %s on %s. Details: %v",
 				operation, resourceDesc, err)
 	}
@@ -396,6 +466,28 @@ func isBuiltInKind(kind string) bool {
 	return builtInKinds[kind]
 }
 
+// UnsupportedOperationError is returned when the API server serving a resource doesn't
+// support the operation at all, e.g. a read-only resource of an aggregated API server
+type UnsupportedOperationError struct {
+	Message string
+}
+
+func (e *UnsupportedOperationError) Error() string {
+	return e.Message
+}
+
+// IsUnsupportedOperationError detects an UnsupportedOperationError, also when wrapped
+func IsUnsupportedOperationError(err error) bool {
+	var unsupported *UnsupportedOperationError
+	return stderrors.As(err, &unsupported)
+}
+
+// IsAggregatedAPIUnavailableError detects discovery failing because the aggregated
+// (extension) API server behind an APIService is not responding
+func IsAggregatedAPIUnavailableError(err error) bool {
+	return checkErrorContainsAny(err, "is served by the aggregated api server")
+}
+
 // IsCRDNotFoundError detects when a Custom Resource Definition doesn't exist yet
 func IsCRDNotFoundError(err error) bool {
 	// Kubernetes returns these messages when the CRD doesn't exist
@@ -427,6 +519,15 @@ func IsNamespaceNotFoundError(err error) bool {
 	return checkErrorContains(err, "namespaces", "not found")
 }
 
+// IsNamespaceTerminatingError detects a create rejected because the target namespace
+// is being deleted ("unable to create new content in namespace x because it is being terminated")
+func IsNamespaceTerminatingError(err error) bool {
+	if !errors.IsForbidden(err) {
+		return false
+	}
+	return errors.HasStatusCause(err, corev1.NamespaceTerminatingCause) || checkErrorContains(err, "because it is being terminated")
+}
+
 // IsDependencyNotReadyError detects temporary errors due to dependencies not being ready yet
 // This includes both CRD not found and namespace not found errors
 func IsDependencyNotReadyError(err error) bool {
@@ -452,34 +553,18 @@ func ExtractImmutableFields(err error) []string {
 
 // ExtractConflictDetailsAndPaths parses conflict error and returns both formatted details and field paths
 func ExtractConflictDetailsAndPaths(err error) (string, []string) {
-	// The Kubernetes error message typically contains field paths and managers
-	// Example: "conflict with \"kubectl\" with subresource \"scale\" using apps/v1: .spec.replicas"
-	errStr := err.Error()
-
-	// Parse out the conflicts
-	var details []string
-	var paths []string
-
-	// Look for patterns like: conflict with "manager" ... : .field.path
-	conflictPattern := regexp.MustCompile(`conflict with "([^"]+)".*?: ([\.\w\[\]]+)`)
-	matches := conflictPattern.FindAllStringSubmatch(errStr, -1)
-
-	for _, match := range matches {
-		if len(match) >= 3 {
-			manager := match[1]
-			rawFieldPath := match[2]
-			// Remove leading dot if present (.spec.replicas -> spec.replicas)
-			fieldPath := strings.TrimPrefix(rawFieldPath, ".")
-			details = append(details, fmt.Sprintf("  - %s (managed by \"%s\")", fieldPath, manager))
-			paths = append(paths, fieldPath)
-		}
-	}
-
-	if len(details) == 0 {
+	conflicts := ExtractFieldConflicts(err)
+	if len(conflicts) == 0 {
 		// Fallback if we can't parse
 		return "- Multiple field ownership conflicts detected", nil
 	}
 
+	details := make([]string, 0, len(conflicts))
+	paths := make([]string, 0, len(conflicts))
+	for _, conflict := range conflicts {
+		details = append(details, fmt.Sprintf("  - %s (managed by \"%s\")", conflict.Field, conflict.CurrentManager))
+		paths = append(paths, conflict.Field)
+	}
 	return strings.Join(details, "\n"), paths
 }
 
@@ -886,3 +971,75 @@ func ExtractInvalidFieldDetails(err error) string {
 	// Fallback for non-StatusError
 	return err.Error()
 }
+
+// schemaValidationRules names the CRD schema rule behind each status cause type
+var schemaValidationRules = map[metav1.CauseType]string{
+	metav1.CauseTypeFieldValueRequired:     "required",
+	metav1.CauseTypeTypeInvalid:            "type mismatch",
+	metav1.CauseTypeFieldValueNotSupported: "not an allowed value",
+	metav1.CauseTypeFieldValueDuplicate:    "duplicate",
+	metav1.CauseTypeTooLong:                "too long",
+	metav1.CauseTypeTooMany:                "too many items",
+}
+
+// IsSchemaValidationError checks if an Invalid error reports OpenAPI schema violations in
+// status.details.causes, as returned when a custom resource doesn't match its CRD schema
+func IsSchemaValidationError(err error) bool {
+	var statusErr *errors.StatusError
+	if !stderrors.As(err, &statusErr) || statusErr.ErrStatus.Details == nil {
+		return false
+	}
+	for _, cause := range statusErr.ErrStatus.Details.Causes {
+		if isSchemaValidationCause(cause) {
+			return true
+		}
+	}
+	return false
+}
+
+// isSchemaValidationCause reports whether a cause comes from OpenAPI schema validation.
+// Schema errors for invalid values (minimum, pattern, ...) say "<field> in body ...",
+// which tells them apart from CEL rule messages.
+func isSchemaValidationCause(cause metav1.StatusCause) bool {
+	if _, ok := schemaValidationRules[cause.Type]; ok {
+		return true
+	}
+	return cause.Type == metav1.CauseTypeFieldValueInvalid && strings.Contains(cause.Message, " in body ")
+}
+
+// ExtractSchemaValidationCauses formats each cause of a schema validation error as
+// "• field (rule): message", dropping the echoed value and field path from the message
+func ExtractSchemaValidationCauses(err error) []string {
+	var statusErr *errors.StatusError
+	if !stderrors.As(err, &statusErr) || statusErr.ErrStatus.Details == nil {
+		return nil
+	}
+
+	var causes []string
+	for _, cause := range statusErr.ErrStatus.Details.Causes {
+		rule, ok := schemaValidationRules[cause.Type]
+		if !ok {
+			rule = "invalid value"
+		}
+		message := cause.Message
+		if idx := strings.Index(message, " in body "); idx != -1 {
+			message = message[idx+len(" in body "):]
+		}
+		field := cause.Field
+		if field == "" {
+			field = "(object)"
+		}
+		causes = append(causes, fmt.Sprintf("• %s (%s): %s", field, rule, message))
+	}
+	return causes
+}
+
+// schemaExplainTarget returns the kubectl explain arguments for the kind in the error
+func schemaExplainTarget(err error, apiVersion string) string {
+	kind := "<kind>"
+	var statusErr *errors.StatusError
+	if stderrors.As(err, &statusErr) && statusErr.ErrStatus.Details != nil && statusErr.ErrStatus.Details.Kind != "" {
+		kind = strings.ToLower(statusErr.ErrStatus.Details.Kind)
+	}
+	return fmt.Sprintf("%s --api-version=%s --recursive", kind, apiVersion)
+}
@@ -8,6 +8,7 @@ import (
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 )
 
 // TestIsFieldValidationError tests detection of field validation errors
@@ -1116,3 +1117,45 @@ func TestClassifyError_AuthErrors(t *testing.T) {
 		})
 	}
 }
+
+func TestClassifyError_SchemaValidation(t *testing.T) {
+	widget := schema.GroupKind{Group: "example.com", Kind: "Widget"}
+	schemaErr := errors.NewInvalid(widget, "test", field.ErrorList{
+		field.Required(field.NewPath("spec", "size"), ""),
+		field.TypeInvalid(field.NewPath("spec", "replicas"), "three", "spec.replicas in body must be of type integer: \"string\""),
+		field.NotSupported(field.NewPath("spec", "tier"), "gold", []string{"free", "pro"}),
+		field.Invalid(field.NewPath("spec", "port"), 0, "spec.port in body should be greater than or equal to 1"),
+	})
+
+	severity, title, detail := ClassifyError(fmt.Errorf("apply failed: %w", schemaErr), "Create", "Widget test", "example.com/v1")
+	if severity != "error" || !strings.Contains(title, "Schema Validation Failed") {
+		t.Fatalf("unexpected classification: %s %q", severity, title)
+	}
+	for _, want := range []string{
+		"• spec.size (required): Required value",
+		"• spec.replicas (type mismatch): must be of type integer: \"string\"",
+		"• spec.tier (not an allowed value): Unsupported value: \"gold\": supported values: \"free\", \"pro\"",
+		"• spec.port (invalid value): should be greater than or equal to 1",
+		"kubectl explain widget --api-version=example.com/v1 --recursive",
+	} {
+		if !strings.Contains(detail, want) {
+			t.Errorf("detail missing %q:\n%s", want, detail)
+		}
+	}
+
+	// CEL rule failures keep their own diagnostic
+	celErr := errors.NewInvalid(widget, "test", field.ErrorList{
+		field.Invalid(field.NewPath("spec", "replicas"), 15, "replicas cannot exceed 10"),
+	})
+	if _, title, _ := ClassifyError(celErr, "Create", "Widget test", "example.com/v1"); !strings.Contains(title, "CEL Validation Failed") {
+		t.Errorf("expected a CEL classification, got %q", title)
+	}
+
+	// Built-in kinds keep the generic field validation diagnostic
+	deploymentErr := errors.NewInvalid(schema.GroupKind{Group: "apps", Kind: "Deployment"}, "web", field.ErrorList{
+		field.Required(field.NewPath("spec", "selector"), ""),
+	})
+	if _, title, _ := ClassifyError(deploymentErr, "Create", "Deployment web", "apps/v1"); strings.Contains(title, "Schema Validation Failed") {
+		t.Errorf("expected built-in kinds to keep their classification, got %q", title)
+	}
+}
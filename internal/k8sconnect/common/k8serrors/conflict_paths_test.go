@@ -1,6 +1,7 @@
 package k8serrors_test
 
 import (
+	"reflect"
 	"testing"
 
 	"github.com/jmorris0x0/terraform-provider-k8sconnect/internal/k8sconnect/common/k8serrors"
@@ -59,6 +60,65 @@ func TestExtractConflictDetailsAndPaths(t *testing.T) {
 	}
 }
 
+func TestExtractFieldConflicts(t *testing.T) {
+	tests := []struct {
+		name   string
+		status metav1.Status
+		want   []k8serrors.FieldConflict
+	}{
+		{
+			name: "status causes",
+			status: metav1.Status{
+				Message: `Apply failed with 2 conflicts: conflict with "kubectl" using apps/v1: .spec.replicas`,
+				Details: &metav1.StatusDetails{Causes: []metav1.StatusCause{
+					{Type: metav1.CauseTypeFieldManagerConflict, Message: `conflict with "kubectl" using apps/v1`, Field: ".spec.replicas"},
+					{Type: metav1.CauseTypeFieldManagerConflict, Message: `conflict with "hpa-controller" with subresource "scale" using autoscaling/v1`, Field: ".spec.template.spec.containers[name=\"app\"].image"},
+					{Type: metav1.CauseTypeFieldValueInvalid, Message: "unrelated", Field: ".spec.selector"},
+				}},
+			},
+			want: []k8serrors.FieldConflict{
+				{Field: "spec.replicas", CurrentManager: "kubectl"},
+				{Field: `spec.template.spec.containers[name="app"].image`, CurrentManager: "hpa-controller"},
+			},
+		},
+		{
+			name:   "message without causes",
+			status: metav1.Status{Message: `conflict with "kubectl": .spec.replicas; conflict with "helm": .metadata.labels`},
+			want: []k8serrors.FieldConflict{
+				{Field: "spec.replicas", CurrentManager: "kubectl"},
+				{Field: "metadata.labels", CurrentManager: "helm"},
+			},
+		},
+		{
+			name:   "unparseable",
+			status: metav1.Status{Message: "Operation cannot be fulfilled"},
+			want:   nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.status.Code = 409
+			tt.status.Reason = metav1.StatusReasonConflict
+			got := k8serrors.ExtractFieldConflicts(&errors.StatusError{ErrStatus: tt.status})
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ExtractFieldConflicts() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFormatFieldConflictsJSON(t *testing.T) {
+	got := k8serrors.FormatFieldConflictsJSON([]k8serrors.FieldConflict{{Field: "spec.replicas", CurrentManager: "kubectl"}})
+	want := `Conflicts (JSON): [{"field":"spec.replicas","current_manager":"kubectl"}]`
+	if got != want {
+		t.Errorf("FormatFieldConflictsJSON() = %q, want %q", got, want)
+	}
+	if got := k8serrors.FormatFieldConflictsJSON(nil); got != "" {
+		t.Errorf("FormatFieldConflictsJSON(nil) = %q, want empty", got)
+	}
+}
+
 func TestFormatIgnoreFieldsSuggestion(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -0,0 +1,71 @@
+package k8serrors
+
+import (
+	"encoding/json"
+	stderrors "errors"
+	"regexp"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// FieldConflict is one field of a Server-Side Apply conflict and the field manager that owns it
+type FieldConflict struct {
+	Field          string `json:"field"`
+	CurrentManager string `json:"current_manager"`
+}
+
+var (
+	// conflictManagerPattern finds the manager in a FieldManagerConflict cause message,
+	// e.g. conflict with "kubectl" with subresource "scale" using apps/v1
+	conflictManagerPattern = regexp.MustCompile(`conflict with "([^"]+)"`)
+
+	// conflictMessagePattern finds "manager ... : .field.path" pairs in a conflict message
+	// without causes
+	conflictMessagePattern = regexp.MustCompile(`conflict with "([^"]+)".*?: ([\.\w\[\]]+)`)
+)
+
+// ExtractFieldConflicts returns the conflicting fields of an SSA conflict error and who owns
+// them. The API server lists them as FieldManagerConflict causes in the status details;
+// errors without causes are parsed from the message instead. Paths drop the leading dot
+// (.spec.replicas -> spec.replicas), like ignore_fields.
+func ExtractFieldConflicts(err error) []FieldConflict {
+	var conflicts []FieldConflict
+
+	var statusErr *errors.StatusError
+	if stderrors.As(err, &statusErr) && statusErr.ErrStatus.Details != nil {
+		for _, cause := range statusErr.ErrStatus.Details.Causes {
+			if cause.Type != metav1.CauseTypeFieldManagerConflict || cause.Field == "" {
+				continue
+			}
+			manager := ""
+			if match := conflictManagerPattern.FindStringSubmatch(cause.Message); match != nil {
+				manager = match[1]
+			}
+			conflicts = append(conflicts, FieldConflict{Field: strings.TrimPrefix(cause.Field, "."), CurrentManager: manager})
+		}
+	}
+	if len(conflicts) > 0 {
+		return conflicts
+	}
+
+	for _, match := range conflictMessagePattern.FindAllStringSubmatch(err.Error(), -1) {
+		conflicts = append(conflicts, FieldConflict{Field: strings.TrimPrefix(match[2], "."), CurrentManager: match[1]})
+	}
+	return conflicts
+}
+
+// FormatFieldConflictsJSON returns a diagnostic line holding conflicts as JSON, for
+// automation that reads diagnostics (terraform apply -json) to decide how to proceed.
+// Empty when there are no conflicts.
+func FormatFieldConflictsJSON(conflicts []FieldConflict) string {
+	if len(conflicts) == 0 {
+		return ""
+	}
+	encoded, err := json.Marshal(conflicts)
+	if err != nil {
+		return ""
+	}
+	return "Conflicts (JSON): " + string(encoded)
+}
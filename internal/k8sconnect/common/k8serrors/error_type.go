@@ -0,0 +1,45 @@
+package k8serrors
+
+import "fmt"
+
+// ErrorType is a stable, machine-readable classification of a failure.
+// It prefixes diagnostic summaries (e.g. "[AuthFailed] Create: Authentication Failed")
+// so CI scripts can match failures without depending on free-text messages.
+// Values are part of the provider's public contract: add new ones, never rename.
+type ErrorType string
+
+const (
+	ErrorTypeAuthFailed           ErrorType = "AuthFailed"
+	ErrorTypeForbidden            ErrorType = "Forbidden"
+	ErrorTypeConnectionFailed     ErrorType = "ConnectionFailed"
+	ErrorTypeNotFound             ErrorType = "NotFound"
+	ErrorTypeConflict             ErrorType = "Conflict"
+	ErrorTypeAPITimeout           ErrorType = "APITimeout"
+	ErrorTypeValidationFailed     ErrorType = "ValidationFailed"
+	ErrorTypeImmutable            ErrorType = "Immutable"
+	ErrorTypeInvalidResource      ErrorType = "InvalidResource"
+	ErrorTypeAlreadyExists        ErrorType = "AlreadyExists"
+	ErrorTypeInvalidAPIGroup      ErrorType = "InvalidAPIGroup"
+	ErrorTypeCRDNotFound          ErrorType = "CRDNotFound"
+	ErrorTypeAPIError             ErrorType = "APIError"
+	ErrorTypeWaitTimeout          ErrorType = "WaitTimeout"
+	ErrorTypeWaitFailed           ErrorType = "WaitFailed"
+	ErrorTypeDeleteProtected      ErrorType = "DeleteProtected"
+	ErrorTypeDeleteTimeout        ErrorType = "DeleteTimeout"
+	ErrorTypeDeleteBlocked        ErrorType = "DeleteBlocked"
+	ErrorTypeOwnershipConflict    ErrorType = "OwnershipConflict"
+	ErrorTypeNotSupported         ErrorType = "NotSupported"
+	ErrorTypeThrottled            ErrorType = "Throttled"
+	ErrorTypeNamespaceTerminating ErrorType = "NamespaceTerminating"
+	ErrorTypePreconditionFailed   ErrorType = "PreconditionFailed"
+)
+
+// Summary prefixes a diagnostic summary with its error type
+func Summary(errType ErrorType, title string) string {
+	return fmt.Sprintf("[%s] %s", errType, title)
+}
+
+// classifiedTitle builds the summary for a classified API error, e.g. "[NotFound] Read: Resource Not Found"
+func classifiedTitle(errType ErrorType, operation, title string) string {
+	return Summary(errType, fmt.Sprintf("%s: %s", operation, title))
+}
@@ -0,0 +1,92 @@
+package k8serrors
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func TestClassifyErrorTitleHasErrorType(t *testing.T) {
+	tests := []struct {
+		name          string
+		err           error
+		expectedTitle string
+	}{
+		{
+			name:          "unauthorized",
+			err:           errors.NewUnauthorized("token expired"),
+			expectedTitle: "[AuthFailed] Create: Authentication Failed",
+		},
+		{
+			name:          "forbidden",
+			err:           errors.NewForbidden(schema.GroupResource{Resource: "configmaps"}, "test", nil),
+			expectedTitle: "[Forbidden] Create: Insufficient Permissions",
+		},
+		{
+			name:          "not found",
+			err:           errors.NewNotFound(schema.GroupResource{Resource: "configmaps"}, "test"),
+			expectedTitle: "[NotFound] Create: Resource Not Found",
+		},
+		{
+			name: "namespace terminating",
+			err: errors.NewForbidden(schema.GroupResource{Resource: "configmaps"}, "test",
+				fmt.Errorf("unable to create new content in namespace team-a because it is being terminated")),
+			expectedTitle: "[NamespaceTerminating] Create: Namespace Terminating",
+		},
+		{
+			name:          "conflict",
+			err:           errors.NewConflict(schema.GroupResource{Resource: "configmaps"}, "test", fmt.Errorf("conflict")),
+			expectedTitle: "[Conflict] Create: Field Manager Conflict",
+		},
+		{
+			name:          "throttled",
+			err:           fmt.Errorf("operation failed after 5 retries: %w", errors.NewTooManyRequests("too many requests", 1)),
+			expectedTitle: "[Throttled] Create: API Server Throttling",
+		},
+		{
+			name:          "unsupported operation",
+			err:           fmt.Errorf("wrapped: %w", &UnsupportedOperationError{Message: "read-only"}),
+			expectedTitle: "[NotSupported] Create: Operation Not Supported",
+		},
+		{
+			name:          "aggregated API unavailable",
+			err:           fmt.Errorf("failed to get resource info: API group/version metrics.k8s.io/v1beta1 is served by the aggregated API server behind Service kube-system/metrics-server, which is not responding"),
+			expectedTitle: "[ConnectionFailed] Create: Aggregated API Server Unavailable",
+		},
+		{
+			name:          "unexpected",
+			err:           fmt.Errorf("something went wrong"),
+			expectedTitle: "[APIError] Create: Kubernetes API Error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, title, _ := ClassifyError(tt.err, "Create", "ConfigMap test", "v1")
+			if title != tt.expectedTitle {
+				t.Errorf("ClassifyError() title = %q, want %q", title, tt.expectedTitle)
+			}
+		})
+	}
+}
+
+func TestSummary(t *testing.T) {
+	if got := Summary(ErrorTypeDeleteProtected, "Delete Protection Enabled"); got != "[DeleteProtected] Delete Protection Enabled" {
+		t.Errorf("Summary() = %q", got)
+	}
+}
+
+func TestClassifyErrorRequestTimeout(t *testing.T) {
+	err := fmt.Errorf(`Get "https://k8s.example.com/api/v1/namespaces/default/configmaps/test": net/http: request canceled (Client.Timeout exceeded while awaiting headers)`)
+
+	_, title, detail := ClassifyError(err, "Read", "ConfigMap test", "v1")
+	if title != "[APITimeout] Read: Kubernetes API Request Timeout" {
+		t.Errorf("ClassifyError() title = %q", title)
+	}
+	if !strings.Contains(detail, "request_timeout") {
+		t.Errorf("expected detail to mention request_timeout, got: %s", detail)
+	}
+}
@@ -17,11 +17,11 @@ import (
 type Cluster struct{}
 
 func (v Cluster) Description(ctx context.Context) string {
-	return "Ensures exactly one cluster connection mode is specified: inline (host + cluster_ca_certificate or insecure) or kubeconfig"
+	return "Ensures exactly one cluster connection mode is specified: inline (host + cluster_ca_certificate or insecure), kubeconfig, or use_env"
 }
 
 func (v Cluster) MarkdownDescription(ctx context.Context) string {
-	return "Ensures exactly one cluster connection mode is specified: inline (`host` + `cluster_ca_certificate` or `insecure`), `kubeconfig`"
+	return "Ensures exactly one cluster connection mode is specified: inline (`host` + `cluster_ca_certificate` or `insecure`), `kubeconfig`, or `use_env`"
 }
 
 func (v Cluster) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
@@ -324,6 +325,115 @@ func (v MergePatchValidator) ValidateString(ctx context.Context, req validator.S
 	}
 }
 
+// ApplyPatchValidator validates Server-Side Apply patch content (a partial object)
+type ApplyPatchValidator struct{}
+
+func (v ApplyPatchValidator) Description(ctx context.Context) string {
+	return "validates Server-Side Apply patch content for structure, container names, server-managed fields, and provider annotations"
+}
+
+func (v ApplyPatchValidator) MarkdownDescription(ctx context.Context) string {
+	return "validates Server-Side Apply patch content for structure, container names, server-managed fields, and provider annotations"
+}
+
+func (v ApplyPatchValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	patchContent := req.ConfigValue.ValueString()
+
+	// Skip validation if patch contains interpolations
+	if validation.ContainsInterpolation(patchContent) {
+		return
+	}
+
+	// Empty content is handled by the resource like an empty patch
+	if strings.TrimSpace(patchContent) == "" {
+		return
+	}
+
+	// An apply patch is a partial object, so it must be a YAML or JSON object
+	var patchObj map[string]interface{}
+	if err := sigsyaml.Unmarshal([]byte(patchContent), &patchObj); err != nil || patchObj == nil {
+		detail := "content is not an object"
+		if err != nil {
+			detail = err.Error()
+		}
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Apply Patch",
+			fmt.Sprintf("apply_patch must be a YAML or JSON object containing the fields to own.\n\n"+
+				"Parse error: %s\n\n"+
+				"Example of valid apply_patch:\n"+
+				"apply_patch = yamlencode({\n"+
+				"  metadata = {\n"+
+				"    labels = { team = \"platform\" }\n"+
+				"  }\n"+
+				"})", detail),
+		)
+		return
+	}
+
+	obj := &unstructured.Unstructured{Object: patchObj}
+
+	// Server-Side Apply merges container lists by name, like strategic merge
+	if err := validation.ValidateContainerNames(obj); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Apply Patch",
+			fmt.Sprintf("Container names are required for apply patches.\n\n"+
+				"Error: %s\n\n"+
+				"Server-Side Apply uses container names as list keys to decide which container each field belongs to.", err),
+		)
+		return
+	}
+
+	// Check for server-managed metadata fields
+	if hasFields, field := validation.HasServerManagedFields(obj); hasFields {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Server-Managed Field in Apply Patch",
+			fmt.Sprintf("The field 'metadata.%s' is managed by the Kubernetes API server and cannot be patched.\n\n"+
+				"Server-managed fields are:\n"+
+				"• uid\n"+
+				"• resourceVersion\n"+
+				"• generation\n"+
+				"• creationTimestamp\n"+
+				"• managedFields\n\n"+
+				validation.CopyPasteHintYAML+
+				"Please remove server-managed fields from your apply patch content.", field),
+		)
+		return
+	}
+
+	// Check for provider internal annotations
+	if hasAnnotations, key := validation.HasProviderAnnotations(obj); hasAnnotations {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Provider Internal Annotation in Apply Patch",
+			fmt.Sprintf("The annotation '%s' is used internally by the provider and should not be patched.\n\n"+
+				"Provider internal annotations (k8sconnect.terraform.io/*) are used for resource tracking and state management.\n\n"+
+				validation.CopyPasteHint+
+				"Please remove k8sconnect.terraform.io/* annotations from your apply patch content.", key),
+		)
+		return
+	}
+
+	// Check for status field
+	if validation.HasStatusField(obj) {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Status Field in Apply Patch",
+			"The 'status' field is a read-only subresource and cannot be patched.\n\n"+
+				"Use the 'wait_for' attribute if you need to wait for specific status conditions.\n\n"+
+				validation.CopyPasteHintYAML+
+				"Please remove the status field from your apply patch content.",
+		)
+		return
+	}
+}
+
 // isServerManagedPath checks if a JSON Pointer path targets a server-managed field
 func isServerManagedPath(path string) bool {
 	serverPaths := []string{
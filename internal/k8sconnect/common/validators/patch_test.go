@@ -681,6 +681,133 @@ func TestMergePatchValidator(t *testing.T) {
 	}
 }
 
+func TestApplyPatchValidator(t *testing.T) {
+	ctx := context.Background()
+	v := ApplyPatchValidator{}
+
+	tests := []struct {
+		name          string
+		patchContent  string
+		expectError   bool
+		errorContains string
+	}{
+		{
+			name: "valid partial object",
+			patchContent: `metadata:
+  labels:
+    team: platform
+spec:
+  replicas: 3`,
+			expectError: false,
+		},
+		{
+			name:         "valid yamlencode output",
+			patchContent: `{"data":{"key":"value"},"metadata":{"annotations":{"example.com/owner":"team-a"}}}`,
+			expectError:  false,
+		},
+		{
+			name: "valid with identity fields",
+			patchContent: `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: web
+spec:
+  replicas: 2`,
+			expectError: false,
+		},
+		{
+			name:          "not an object",
+			patchContent:  `["array", "is", "invalid"]`,
+			expectError:   true,
+			errorContains: "must be a YAML or JSON object",
+		},
+		{
+			name:          "scalar",
+			patchContent:  `just a string`,
+			expectError:   true,
+			errorContains: "must be a YAML or JSON object",
+		},
+		{
+			name: "container without name",
+			patchContent: `spec:
+  template:
+    spec:
+      containers:
+      - image: nginx:1.21`,
+			expectError:   true,
+			errorContains: "Container names are required",
+		},
+		{
+			name: "server-managed field",
+			patchContent: `metadata:
+  resourceVersion: "12345"`,
+			expectError:   true,
+			errorContains: "metadata.resourceVersion",
+		},
+		{
+			name: "provider annotation",
+			patchContent: `metadata:
+  annotations:
+    k8sconnect.terraform.io/terraform-id: abc`,
+			expectError:   true,
+			errorContains: "k8sconnect.terraform.io",
+		},
+		{
+			name: "status field",
+			patchContent: `status:
+  phase: Running`,
+			expectError:   true,
+			errorContains: "status",
+		},
+		{
+			name: "interpolation - skipped",
+			patchContent: `metadata:
+  uid: ${var.uid}`,
+			expectError: false,
+		},
+		{
+			name:         "empty content",
+			patchContent: ``,
+			expectError:  false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := validator.StringRequest{
+				Path:        path.Root("apply_patch"),
+				ConfigValue: types.StringValue(tt.patchContent),
+			}
+			resp := &validator.StringResponse{}
+
+			v.ValidateString(ctx, req, resp)
+
+			hasError := resp.Diagnostics.HasError()
+			if hasError != tt.expectError {
+				t.Errorf("expected error=%v, got error=%v", tt.expectError, hasError)
+				if hasError {
+					t.Logf("Diagnostics: %v", resp.Diagnostics)
+				}
+				return
+			}
+
+			if tt.expectError && tt.errorContains != "" {
+				found := false
+				for _, diag := range resp.Diagnostics {
+					if contains(diag.Detail(), tt.errorContains) || contains(diag.Summary(), tt.errorContains) {
+						found = true
+						break
+					}
+				}
+				if !found {
+					t.Errorf("expected error to contain '%s', but it didn't. Diagnostics: %v",
+						tt.errorContains, resp.Diagnostics)
+				}
+			}
+		})
+	}
+}
+
 func TestIsServerManagedPath(t *testing.T) {
 	tests := []struct {
 		path     string
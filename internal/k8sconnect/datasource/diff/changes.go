@@ -0,0 +1,157 @@
+package diff
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	actionAdd    = "add"
+	actionUpdate = "update"
+	actionRemove = "remove"
+)
+
+// ignoredPaths are set by the API server on every write; comparing them would report
+// a change for every dry-run
+var ignoredPaths = map[string]bool{
+	"status":                     true,
+	"metadata.managedFields":     true,
+	"metadata.resourceVersion":   true,
+	"metadata.generation":        true,
+	"metadata.uid":               true,
+	"metadata.creationTimestamp": true,
+}
+
+// computeChanges compares the live object with the dry-run result and returns one change
+// per differing leaf, sorted by path. A nil live object means the object does not exist.
+func computeChanges(live, dryRun map[string]interface{}) ([]changeModel, error) {
+	var changes []changeModel
+	if err := diffValue("", toValue(live), toValue(dryRun), &changes); err != nil {
+		return nil, err
+	}
+
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].Path.ValueString() < changes[j].Path.ValueString()
+	})
+	return changes, nil
+}
+
+// toValue keeps a nil map as an untyped nil so a missing object diffs like a missing field
+func toValue(obj map[string]interface{}) interface{} {
+	if obj == nil {
+		return nil
+	}
+	return obj
+}
+
+// diffValue recurses into maps and lists present on both sides and records anything else
+// that differs as a single change at path
+func diffValue(path string, before, after interface{}, changes *[]changeModel) error {
+	if ignoredPaths[path] {
+		return nil
+	}
+
+	beforeMap, beforeIsMap := before.(map[string]interface{})
+	afterMap, afterIsMap := after.(map[string]interface{})
+	if beforeIsMap && afterIsMap {
+		for _, key := range unionKeys(beforeMap, afterMap) {
+			if err := diffValue(joinPath(path, key), beforeMap[key], afterMap[key], changes); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	// A whole object appearing or disappearing is reported leaf by leaf, so the paths line
+	// up with managed_fields and managed_state_projection
+	if beforeIsMap && after == nil {
+		return diffValue(path, before, map[string]interface{}{}, changes)
+	}
+	if afterIsMap && before == nil {
+		return diffValue(path, map[string]interface{}{}, after, changes)
+	}
+
+	beforeList, beforeIsList := before.([]interface{})
+	afterList, afterIsList := after.([]interface{})
+	if beforeIsList && afterIsList {
+		for i := 0; i < max(len(beforeList), len(afterList)); i++ {
+			var b, a interface{}
+			if i < len(beforeList) {
+				b = beforeList[i]
+			}
+			if i < len(afterList) {
+				a = afterList[i]
+			}
+			if err := diffValue(fmt.Sprintf("%s[%d]", path, i), b, a, changes); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	if reflect.DeepEqual(before, after) {
+		return nil
+	}
+
+	action := actionUpdate
+	switch {
+	case before == nil:
+		action = actionAdd
+	case after == nil:
+		action = actionRemove
+	}
+
+	beforeJSON, err := encodeValue(before)
+	if err != nil {
+		return fmt.Errorf("failed to encode live value at %s: %w", path, err)
+	}
+	afterJSON, err := encodeValue(after)
+	if err != nil {
+		return fmt.Errorf("failed to encode dry-run value at %s: %w", path, err)
+	}
+
+	*changes = append(*changes, changeModel{
+		Path:   types.StringValue(path),
+		Action: types.StringValue(action),
+		Before: beforeJSON,
+		After:  afterJSON,
+	})
+	return nil
+}
+
+// encodeValue JSON-encodes a value, returning null for a missing field
+func encodeValue(v interface{}) (types.String, error) {
+	if v == nil {
+		return types.StringNull(), nil
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		return types.StringNull(), err
+	}
+	return types.StringValue(string(b)), nil
+}
+
+func unionKeys(a, b map[string]interface{}) []string {
+	keys := make([]string, 0, len(a)+len(b))
+	for k := range a {
+		keys = append(keys, k)
+	}
+	for k := range b {
+		if _, ok := a[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func joinPath(prefix, key string) string {
+	if prefix == "" {
+		return key
+	}
+	return prefix + "." + key
+}
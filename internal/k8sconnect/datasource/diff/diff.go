@@ -0,0 +1,266 @@
+package diff
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/yaml"
+
+	"github.com/jmorris0x0/terraform-provider-k8sconnect/internal/k8sconnect/common/auth"
+	"github.com/jmorris0x0/terraform-provider-k8sconnect/internal/k8sconnect/common/factory"
+	"github.com/jmorris0x0/terraform-provider-k8sconnect/internal/k8sconnect/common/k8sclient"
+	"github.com/jmorris0x0/terraform-provider-k8sconnect/internal/k8sconnect/common/k8serrors"
+	"github.com/jmorris0x0/terraform-provider-k8sconnect/internal/k8sconnect/common/validation"
+	"github.com/jmorris0x0/terraform-provider-k8sconnect/internal/k8sconnect/datasource/yaml_common"
+)
+
+// fieldManager matches k8sconnect_object so the dry-run predicts what that resource would apply
+const fieldManager = "k8sconnect"
+
+type diffDataSource struct {
+	clientFactory factory.ClientFactory
+}
+
+type diffDataSourceModel struct {
+	YAMLBody types.String `tfsdk:"yaml_body"`
+	Cluster  types.Object `tfsdk:"cluster"`
+
+	// Outputs
+	Exists     types.Bool `tfsdk:"exists"`
+	HasChanges types.Bool `tfsdk:"has_changes"`
+	Changes    types.List `tfsdk:"changes"`
+}
+
+type changeModel struct {
+	Path   types.String `tfsdk:"path"`
+	Action types.String `tfsdk:"action"`
+	Before types.String `tfsdk:"before"`
+	After  types.String `tfsdk:"after"`
+}
+
+var changeAttrTypes = map[string]attr.Type{
+	"path":   types.StringType,
+	"action": types.StringType,
+	"before": types.StringType,
+	"after":  types.StringType,
+}
+
+func NewDiffDataSource() datasource.DataSource {
+	return &diffDataSource{}
+}
+
+func (d *diffDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_diff"
+}
+
+func (d *diffDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	clientFactory, ok := req.ProviderData.(factory.ClientFactory)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data Type",
+			"Expected factory.ClientFactory",
+		)
+		return
+	}
+
+	d.clientFactory = clientFactory
+}
+
+func (d *diffDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Shows what applying a manifest would change in the cluster, like kubectl diff. " +
+			"Performs a server-side dry-run apply of yaml_body and compares the result with the live object. " +
+			"Nothing is written to the cluster. Use in CI to review or gate changes before they are applied.",
+		Attributes: map[string]schema.Attribute{
+			"yaml_body": schema.StringAttribute{
+				Required:    true,
+				Description: "Single Kubernetes manifest to compare against the cluster, in the same format as k8sconnect_object's yaml_body",
+			},
+			"cluster": schema.SingleNestedAttribute{
+				Required:    true,
+				Description: "Cluster connection configuration",
+				Attributes:  auth.GetConnectionSchemaForDataSource(),
+			},
+			// Outputs
+			"exists": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether the object currently exists in the cluster. When false, every field of the dry-run result is reported as added.",
+			},
+			"has_changes": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether applying yaml_body would change the live object",
+			},
+			"changes": schema.ListNestedAttribute{
+				Computed: true,
+				Description: "Fields that applying yaml_body would change, sorted by path. Status and server-managed metadata " +
+					"(managedFields, resourceVersion, generation, uid, creationTimestamp) are not compared.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"path": schema.StringAttribute{
+							Computed:    true,
+							Description: "Dotted field path (e.g., 'spec.template.spec.containers[0].image')",
+						},
+						"action": schema.StringAttribute{
+							Computed:    true,
+							Description: "One of 'add', 'update', or 'remove'",
+						},
+						"before": schema.StringAttribute{
+							Computed:    true,
+							Description: "JSON-encoded live value. Null when the field is added.",
+						},
+						"after": schema.StringAttribute{
+							Computed:    true,
+							Description: "JSON-encoded value after apply. Null when the field is removed.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *diffDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data diffDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	desired, err := parseManifest(data.YAMLBody.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid YAML Body", err.Error())
+		return
+	}
+
+	conn, err := auth.ObjectToConnectionModel(ctx, data.Cluster)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid connection", err.Error())
+		return
+	}
+
+	client, err := d.clientFactory.GetClient(conn)
+	if err != nil {
+		k8serrors.AddClassifiedError(&resp.Diagnostics, err, "Connect to Cluster", "cluster", "")
+		return
+	}
+
+	apiVersion := desired.GetAPIVersion()
+	resourceDesc := fmt.Sprintf("%s %s", desired.GetKind(), desired.GetName())
+	if desired.GetNamespace() != "" {
+		resourceDesc = fmt.Sprintf("%s %s/%s", desired.GetKind(), desired.GetNamespace(), desired.GetName())
+	}
+
+	gvr, err := client.DiscoverGVR(ctx, apiVersion, desired.GetKind())
+	if err != nil {
+		k8serrors.AddClassifiedError(&resp.Diagnostics, err, "Discover Resource Type", fmt.Sprintf("%s/%s", apiVersion, desired.GetKind()), apiVersion)
+		return
+	}
+
+	live, err := client.Get(ctx, gvr, desired.GetNamespace(), desired.GetName())
+	if err != nil && !errors.IsNotFound(err) {
+		k8serrors.AddClassifiedError(&resp.Diagnostics, err, "Read Resource", resourceDesc, apiVersion)
+		return
+	}
+	exists := err == nil
+
+	// k8sconnect_object re-applies its ownership annotations on every apply; carry them
+	// over so they are not reported as removed when yaml_body omits them
+	if exists {
+		preserveProviderAnnotations(desired, live)
+	}
+
+	result, err := client.DryRunApply(ctx, desired, k8sclient.ApplyOptions{
+		FieldManager:    fieldManager,
+		Force:           true,
+		FieldValidation: "Strict", // ADR-017: Validate fields against OpenAPI schema
+	})
+	k8sclient.SurfaceK8sWarnings(ctx, client, &resp.Diagnostics)
+	if err != nil {
+		k8serrors.AddClassifiedError(&resp.Diagnostics, err, "Dry-run Apply", resourceDesc, apiVersion)
+		return
+	}
+
+	var liveObj map[string]interface{}
+	if exists {
+		liveObj = live.Object
+	}
+	changes, err := computeChanges(liveObj, result.Object)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to Compute Diff", err.Error())
+		return
+	}
+
+	tflog.Debug(ctx, "Computed diff against live object", map[string]interface{}{
+		"resource":     resourceDesc,
+		"exists":       exists,
+		"change_count": len(changes),
+	})
+
+	changesValue, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: changeAttrTypes}, changes)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Exists = types.BoolValue(exists)
+	data.HasChanges = types.BoolValue(len(changes) > 0)
+	data.Changes = changesValue
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// parseManifest parses a single-document yaml_body and checks the identity fields
+func parseManifest(yamlBody string) (*unstructured.Unstructured, error) {
+	if len(yaml_common.SplitYAMLDocuments(yamlBody)) > 1 {
+		return nil, fmt.Errorf("multi-document YAML detected (contains '---' separator). Use the k8sconnect_yaml_split data source to split the documents first")
+	}
+
+	obj := &unstructured.Unstructured{}
+	if err := yaml.Unmarshal([]byte(yamlBody), obj); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal YAML: %w", err)
+	}
+
+	if obj.GetAPIVersion() == "" {
+		return nil, fmt.Errorf("apiVersion is required")
+	}
+	if obj.GetKind() == "" {
+		return nil, fmt.Errorf("kind is required")
+	}
+	if obj.GetName() == "" {
+		return nil, fmt.Errorf("metadata.name is required")
+	}
+
+	return obj, nil
+}
+
+// preserveProviderAnnotations copies k8sconnect.terraform.io/* annotations from the live
+// object into desired unless desired already sets them
+func preserveProviderAnnotations(desired, live *unstructured.Unstructured) {
+	annotations := desired.GetAnnotations()
+	for key, value := range live.GetAnnotations() {
+		if !strings.HasPrefix(key, validation.ProviderAnnotationPrefix) {
+			continue
+		}
+		if annotations == nil {
+			annotations = make(map[string]string)
+		}
+		if _, set := annotations[key]; !set {
+			annotations[key] = value
+		}
+	}
+	if annotations != nil {
+		desired.SetAnnotations(annotations)
+	}
+}
@@ -0,0 +1,162 @@
+package diff_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/config"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/jmorris0x0/terraform-provider-k8sconnect/internal/k8sconnect"
+	testhelpers "github.com/jmorris0x0/terraform-provider-k8sconnect/internal/k8sconnect/common/test"
+)
+
+func TestAccDiffDataSource_basic(t *testing.T) {
+	t.Parallel()
+
+	raw := os.Getenv("TF_ACC_KUBECONFIG")
+	if raw == "" {
+		t.Fatal("TF_ACC_KUBECONFIG must be set")
+	}
+
+	ns := fmt.Sprintf("diff-test-%d", time.Now().UnixNano()%1000000)
+	cmName := fmt.Sprintf("config-%d", time.Now().UnixNano()%1000000)
+	k8sClient := testhelpers.CreateK8sClient(t, raw)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"k8sconnect": providerserver.NewProtocol6WithError(k8sconnect.New()),
+		},
+		Steps: []resource.TestStep{
+			// Step 1: Diff a changed manifest against an object created by k8sconnect_object
+			{
+				Config: testAccDiffDataSourceConfig(ns, cmName),
+				ConfigVariables: config.Variables{
+					"raw":       config.StringVariable(raw),
+					"namespace": config.StringVariable(ns),
+					"name":      config.StringVariable(cmName),
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testhelpers.CheckConfigMapExists(k8sClient, ns, cmName),
+					// The dry-run must not touch the live object
+					testhelpers.CheckConfigMapData(k8sClient, ns, cmName, map[string]string{"key1": "value1", "key2": "value2"}),
+
+					resource.TestCheckResourceAttr("data.k8sconnect_diff.changed", "exists", "true"),
+					resource.TestCheckResourceAttr("data.k8sconnect_diff.changed", "has_changes", "true"),
+					resource.TestCheckResourceAttr("data.k8sconnect_diff.changed", "changes.#", "3"),
+					resource.TestCheckResourceAttr("data.k8sconnect_diff.changed", "changes.0.path", "data.key1"),
+					resource.TestCheckResourceAttr("data.k8sconnect_diff.changed", "changes.0.action", "update"),
+					resource.TestCheckResourceAttr("data.k8sconnect_diff.changed", "changes.0.before", `"value1"`),
+					resource.TestCheckResourceAttr("data.k8sconnect_diff.changed", "changes.0.after", `"changed"`),
+					resource.TestCheckResourceAttr("data.k8sconnect_diff.changed", "changes.1.path", "data.key2"),
+					resource.TestCheckResourceAttr("data.k8sconnect_diff.changed", "changes.1.action", "remove"),
+					resource.TestCheckResourceAttr("data.k8sconnect_diff.changed", "changes.2.path", "data.key3"),
+					resource.TestCheckResourceAttr("data.k8sconnect_diff.changed", "changes.2.action", "add"),
+
+					resource.TestCheckResourceAttr("data.k8sconnect_diff.unchanged", "has_changes", "false"),
+					resource.TestCheckResourceAttr("data.k8sconnect_diff.unchanged", "changes.#", "0"),
+
+					resource.TestCheckResourceAttr("data.k8sconnect_diff.missing", "exists", "false"),
+					resource.TestCheckResourceAttr("data.k8sconnect_diff.missing", "has_changes", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDiffDataSourceConfig(ns, name string) string {
+	return fmt.Sprintf(`
+variable "raw" {
+  type = string
+}
+variable "namespace" {
+  type = string
+}
+variable "name" {
+  type = string
+}
+
+provider "k8sconnect" {}
+
+resource "k8sconnect_object" "namespace" {
+  yaml_body = <<YAML
+apiVersion: v1
+kind: Namespace
+metadata:
+  name: %[1]s
+YAML
+
+  cluster = {
+    kubeconfig = var.raw
+  }
+}
+
+resource "k8sconnect_object" "test" {
+  yaml_body = <<YAML
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: %[2]s
+  namespace: %[1]s
+data:
+  key1: value1
+  key2: value2
+YAML
+
+  cluster = {
+    kubeconfig = var.raw
+  }
+
+  depends_on = [k8sconnect_object.namespace]
+}
+
+data "k8sconnect_diff" "changed" {
+  yaml_body = <<YAML
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: %[2]s
+  namespace: %[1]s
+data:
+  key1: changed
+  key3: added
+YAML
+
+  cluster = {
+    kubeconfig = var.raw
+  }
+
+  depends_on = [k8sconnect_object.test]
+}
+
+data "k8sconnect_diff" "unchanged" {
+  yaml_body = k8sconnect_object.test.yaml_body
+
+  cluster = {
+    kubeconfig = var.raw
+  }
+}
+
+data "k8sconnect_diff" "missing" {
+  yaml_body = <<YAML
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: %[2]s-new
+  namespace: %[1]s
+data:
+  key1: value1
+YAML
+
+  cluster = {
+    kubeconfig = var.raw
+  }
+
+  depends_on = [k8sconnect_object.namespace]
+}
+`, ns, name)
+}
@@ -0,0 +1,200 @@
+package diff
+
+import (
+	"strings"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestComputeChanges(t *testing.T) {
+	live := map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "ConfigMap",
+		"metadata": map[string]interface{}{
+			"name":            "app",
+			"namespace":       "default",
+			"resourceVersion": "100",
+			"labels":          map[string]interface{}{"team": "a", "old": "x"},
+		},
+		"data": map[string]interface{}{"keep": "1", "change": "before"},
+	}
+	dryRun := map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "ConfigMap",
+		"metadata": map[string]interface{}{
+			"name":            "app",
+			"namespace":       "default",
+			"resourceVersion": "101",
+			"labels":          map[string]interface{}{"team": "a"},
+		},
+		"data": map[string]interface{}{"keep": "1", "change": "after", "new": "2"},
+	}
+
+	changes, err := computeChanges(live, dryRun)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := []struct {
+		path, action, before, after string
+	}{
+		{"data.change", actionUpdate, `"before"`, `"after"`},
+		{"data.new", actionAdd, "", `"2"`},
+		{"metadata.labels.old", actionRemove, `"x"`, ""},
+	}
+	if len(changes) != len(want) {
+		t.Fatalf("got %d changes, want %d: %v", len(changes), len(want), changes)
+	}
+	for i, w := range want {
+		c := changes[i]
+		if c.Path.ValueString() != w.path || c.Action.ValueString() != w.action {
+			t.Errorf("change %d = %s %s, want %s %s", i, c.Action.ValueString(), c.Path.ValueString(), w.action, w.path)
+		}
+		if c.Before.ValueString() != w.before || c.Before.IsNull() != (w.before == "") {
+			t.Errorf("change %d before = %v, want %q", i, c.Before, w.before)
+		}
+		if c.After.ValueString() != w.after || c.After.IsNull() != (w.after == "") {
+			t.Errorf("change %d after = %v, want %q", i, c.After, w.after)
+		}
+	}
+}
+
+func TestComputeChanges_Lists(t *testing.T) {
+	live := map[string]interface{}{
+		"spec": map[string]interface{}{
+			"containers": []interface{}{
+				map[string]interface{}{"name": "app", "image": "nginx:1.25"},
+			},
+			"args": []interface{}{"a", "b"},
+		},
+	}
+	dryRun := map[string]interface{}{
+		"spec": map[string]interface{}{
+			"containers": []interface{}{
+				map[string]interface{}{"name": "app", "image": "nginx:1.26"},
+				map[string]interface{}{"name": "sidecar", "image": "envoy"},
+			},
+			"args": []interface{}{"a"},
+		},
+	}
+
+	changes, err := computeChanges(live, dryRun)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var got []string
+	for _, c := range changes {
+		got = append(got, c.Action.ValueString()+" "+c.Path.ValueString())
+	}
+	want := []string{
+		"remove spec.args[1]",
+		"update spec.containers[0].image",
+		"add spec.containers[1].image",
+		"add spec.containers[1].name",
+	}
+	if strings.Join(got, ",") != strings.Join(want, ",") {
+		t.Errorf("changes = %v, want %v", got, want)
+	}
+}
+
+func TestComputeChanges_ObjectDoesNotExist(t *testing.T) {
+	dryRun := map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "ConfigMap",
+		"metadata": map[string]interface{}{
+			"name":              "app",
+			"uid":               "abc",
+			"creationTimestamp": "2026-01-01T00:00:00Z",
+			"managedFields":     []interface{}{map[string]interface{}{"manager": "k8sconnect"}},
+		},
+		"data":   map[string]interface{}{"key": "value"},
+		"status": map[string]interface{}{"phase": "Active"},
+	}
+
+	changes, err := computeChanges(nil, dryRun)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var got []string
+	for _, c := range changes {
+		if c.Action.ValueString() != actionAdd {
+			t.Errorf("expected only adds for a new object, got %s %s", c.Action.ValueString(), c.Path.ValueString())
+		}
+		got = append(got, c.Path.ValueString())
+	}
+	want := []string{"apiVersion", "data.key", "kind", "metadata.name"}
+	if strings.Join(got, ",") != strings.Join(want, ",") {
+		t.Errorf("paths = %v, want %v", got, want)
+	}
+}
+
+func TestComputeChanges_NoChanges(t *testing.T) {
+	obj := map[string]interface{}{
+		"kind":     "ConfigMap",
+		"metadata": map[string]interface{}{"name": "app", "generation": int64(1)},
+		"data":     map[string]interface{}{"key": "value"},
+	}
+	changes, err := computeChanges(obj, obj)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(changes) != 0 {
+		t.Errorf("expected no changes, got %v", changes)
+	}
+}
+
+func TestParseManifest(t *testing.T) {
+	tests := []struct {
+		name    string
+		yaml    string
+		wantErr string
+	}{
+		{"valid", "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: app\n", ""},
+		{"missing name", "apiVersion: v1\nkind: ConfigMap\n", "metadata.name is required"},
+		{"missing kind", "apiVersion: v1\nmetadata:\n  name: app\n", "Kind"},
+		{"multi-document", "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: a\n---\napiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: b\n", "multi-document"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := parseManifest(tt.yaml)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("expected error containing %q, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestPreserveProviderAnnotations(t *testing.T) {
+	desired := &unstructured.Unstructured{Object: map[string]interface{}{}}
+	desired.SetAnnotations(map[string]string{"example.com/note": "desired"})
+
+	live := &unstructured.Unstructured{Object: map[string]interface{}{}}
+	live.SetAnnotations(map[string]string{
+		"k8sconnect.terraform.io/terraform-id": "abc123",
+		"example.com/note":                     "live",
+		"example.com/other":                    "live",
+	})
+
+	preserveProviderAnnotations(desired, live)
+
+	got := desired.GetAnnotations()
+	if got["k8sconnect.terraform.io/terraform-id"] != "abc123" {
+		t.Errorf("provider annotation not carried over: %v", got)
+	}
+	if got["example.com/note"] != "desired" {
+		t.Errorf("desired annotation overwritten: %v", got)
+	}
+	if _, ok := got["example.com/other"]; ok {
+		t.Errorf("non-provider annotation copied from live: %v", got)
+	}
+}
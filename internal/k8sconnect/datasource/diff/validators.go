@@ -0,0 +1,73 @@
+package diff
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+
+	"github.com/jmorris0x0/terraform-provider-k8sconnect/internal/k8sconnect/common/auth"
+)
+
+// ConfigValidators implements datasource.DataSourceWithConfigValidators
+func (d *diffDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		&diffClusterValidator{},
+	}
+}
+
+// =============================================================================
+// diffClusterValidator ensures exactly one connection mode is specified and
+// that exec auth is complete when present
+// =============================================================================
+
+type diffClusterValidator struct{}
+
+func (v *diffClusterValidator) Description(ctx context.Context) string {
+	return "Ensures exactly one cluster connection mode is specified and exec auth, if present, is complete"
+}
+
+func (v *diffClusterValidator) MarkdownDescription(ctx context.Context) string {
+	return "Ensures exactly one cluster connection mode is specified and `exec` auth, if present, is complete"
+}
+
+func (v *diffClusterValidator) ValidateDataSource(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var data diffDataSourceModel
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Skip validation for unknown connections (during planning)
+	if data.Cluster.IsUnknown() || data.Cluster.IsNull() {
+		return
+	}
+
+	connModel, err := auth.ObjectToConnectionModel(ctx, data.Cluster)
+	if err != nil {
+		// Unknown values during planning - skip validation
+		return
+	}
+
+	err = auth.ValidateConnectionWithUnknowns(ctx, connModel)
+	if err == nil {
+		return
+	}
+
+	if strings.Contains(err.Error(), "exec authentication") {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("cluster").AtName("exec"),
+			"Invalid Exec Authentication Configuration",
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.AddAttributeError(
+		path.Root("cluster"),
+		"Invalid Cluster Connection Configuration",
+		err.Error(),
+	)
+}
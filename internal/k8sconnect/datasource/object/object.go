@@ -80,8 +80,9 @@ func (d *objectDataSource) Schema(ctx context.Context, req datasource.SchemaRequ
 				Description: "Name of the resource",
 			},
 			"namespace": schema.StringAttribute{
-				Optional:    true,
-				Description: "Namespace of the resource (optional for cluster-scoped resources, defaults to 'default' for namespaced resources if not specified)",
+				Optional: true,
+				Description: "Namespace of the resource (optional for cluster-scoped resources). For namespaced resources it defaults to cluster.namespace, " +
+					"then the kubeconfig context's namespace, then 'default'",
 			},
 			"cluster": schema.SingleNestedAttribute{
 				Required:    true,
@@ -143,8 +144,12 @@ func (d *objectDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 	namespace := data.Namespace.ValueString()
 	name := data.Name.ValueString()
 
-	// Get the resource
-	obj, err := client.Get(ctx, gvr, namespace, name)
+	// Get the resource, defaulting the namespace like kubectl (ignored for cluster-scoped kinds)
+	requestNamespace := namespace
+	if requestNamespace == "" {
+		requestNamespace = auth.DefaultNamespace(conn)
+	}
+	obj, err := client.Get(ctx, gvr, requestNamespace, name)
 	if err != nil {
 		resourceDesc := fmt.Sprintf("%s %s", data.Kind.ValueString(), name)
 		if namespace != "" {
@@ -50,6 +50,16 @@ func ParseDocuments(content, sourceFile string) ([]DocumentInfo, error) {
 			// Document failed to parse - record error but continue
 			doc.ParseError = fmt.Errorf("invalid YAML at document %d: %w", i+1, err)
 			errors = append(errors, fmt.Sprintf("%s (document %d): %s", sourceFile, i+1, err.Error()))
+		} else if IsListKind(&obj) {
+			// kind: List (as emitted by kubectl get -o yaml) wraps the real objects in .items
+			items, err := expandListItems(doc, &obj)
+			if err != nil {
+				doc.ParseError = fmt.Errorf("invalid List at document %d: %w", i+1, err)
+				errors = append(errors, fmt.Sprintf("%s (document %d): %s", sourceFile, i+1, err.Error()))
+			} else {
+				documents = append(documents, items...)
+				continue
+			}
 		} else {
 			doc.Object = &obj
 		}
@@ -65,6 +75,50 @@ func ParseDocuments(content, sourceFile string) ([]DocumentInfo, error) {
 	return documents, err
 }
 
+// IsListKind reports whether obj is a list wrapper (kind: List, or a typed list such as
+// ConfigMapList) rather than an object the API server can apply
+func IsListKind(obj *unstructured.Unstructured) bool {
+	return strings.HasSuffix(obj.GetKind(), "List") && obj.IsList()
+}
+
+// expandListItems returns one document per entry of list's items, each sharing the
+// List's source location. Nested lists are expanded as well.
+func expandListItems(parent DocumentInfo, list *unstructured.Unstructured) ([]DocumentInfo, error) {
+	rawItems, _, _ := unstructured.NestedSlice(list.Object, "items")
+
+	var documents []DocumentInfo
+	for i, rawItem := range rawItems {
+		itemMap, ok := rawItem.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("items[%d] is not an object", i)
+		}
+		item := &unstructured.Unstructured{Object: itemMap}
+
+		if IsListKind(item) {
+			nested, err := expandListItems(parent, item)
+			if err != nil {
+				return nil, fmt.Errorf("items[%d]: %w", i, err)
+			}
+			documents = append(documents, nested...)
+			continue
+		}
+
+		content, err := yaml.Marshal(itemMap)
+		if err != nil {
+			return nil, fmt.Errorf("items[%d]: %w", i, err)
+		}
+		documents = append(documents, DocumentInfo{
+			Content:       strings.TrimSpace(string(content)),
+			SourceFile:    parent.SourceFile,
+			DocumentIndex: parent.DocumentIndex,
+			LineNumber:    parent.LineNumber,
+			Object:        item,
+		})
+	}
+
+	return documents, nil
+}
+
 // SplitYAMLDocuments splits YAML content on document separators
 func SplitYAMLDocuments(content string) []string {
 	separatorRegex := regexp.MustCompile(`(?m)^---\s*(?:#.*)?(?:\r?\n|$)`)
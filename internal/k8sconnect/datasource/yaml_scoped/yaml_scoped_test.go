@@ -312,6 +312,43 @@ metadata:
 	}
 }
 
+func TestListKindItemsCategorized(t *testing.T) {
+	d := &yamlScopedDataSource{}
+
+	content := `apiVersion: v1
+kind: List
+items:
+- apiVersion: v1
+  kind: Namespace
+  metadata:
+    name: app-system
+- apiVersion: v1
+  kind: ConfigMap
+  metadata:
+    name: app-config
+    namespace: app-system`
+
+	docs, err := yaml_common.ParseDocuments(content, "test")
+	if err != nil {
+		t.Fatalf("failed to parse documents: %v", err)
+	}
+
+	crds, clusterScoped, namespaced, err := d.categorizeManifests(docs)
+	if err != nil {
+		t.Fatalf("categorization failed: %v", err)
+	}
+
+	if len(crds) != 0 {
+		t.Errorf("expected 0 CRDs, got %d", len(crds))
+	}
+	if _, exists := clusterScoped["namespace.app-system"]; !exists || len(clusterScoped) != 1 {
+		t.Errorf("expected only the Namespace item in cluster_scoped, got %v", clusterScoped)
+	}
+	if _, exists := namespaced["configmap.app-system.app-config"]; !exists || len(namespaced) != 1 {
+		t.Errorf("expected only the ConfigMap item in namespaced, got %v", namespaced)
+	}
+}
+
 func TestInvalidYAMLHandling(t *testing.T) {
 	d := &yamlScopedDataSource{}
 
@@ -496,6 +496,65 @@ metadata:
 	})
 }
 
+func TestListKindExpansion(t *testing.T) {
+	d := &yamlSplitDataSource{}
+
+	content := `apiVersion: v1
+kind: List
+items:
+- apiVersion: v1
+  kind: ConfigMap
+  metadata:
+    name: one
+    namespace: default
+  data:
+    key: value
+- apiVersion: v1
+  kind: ConfigMapList
+  items:
+  - apiVersion: v1
+    kind: ConfigMap
+    metadata:
+      name: two
+      namespace: default
+---
+apiVersion: v1
+kind: Namespace
+metadata:
+  name: standalone`
+
+	docs, err := yaml_common.ParseDocuments(content, "test")
+	if err != nil {
+		t.Fatalf("failed to parse: %v", err)
+	}
+
+	manifests, err := d.generateManifests(docs)
+	if err != nil {
+		t.Fatalf("failed to generate manifests: %v", err)
+	}
+
+	if len(manifests) != 3 {
+		t.Fatalf("expected 3 manifests (List items expanded, nested list included), got %d: %v", len(manifests), manifests)
+	}
+	for _, key := range []string{"configmap.default.one", "configmap.default.two", "namespace.standalone"} {
+		if _, exists := manifests[key]; !exists {
+			t.Errorf("expected key %q not found in manifests", key)
+		}
+	}
+
+	expanded := manifests["configmap.default.one"].ValueString()
+	if strings.Contains(expanded, "kind: List") || !strings.Contains(expanded, "key: value") {
+		t.Errorf("expected the item manifest alone, got:\n%s", expanded)
+	}
+
+	t.Run("non-object item", func(t *testing.T) {
+		_, err := yaml_common.ParseDocuments("apiVersion: v1\nkind: List\nitems:\n- just-a-string\n", "test")
+		if err == nil || !strings.Contains(err.Error(), "items[0] is not an object") {
+			t.Errorf("expected error for non-object List item, got: %v", err)
+		}
+	})
+}
+
 func TestLineNumberEstimation(t *testing.T) {
 	content := `# Header comment
 apiVersion: v1
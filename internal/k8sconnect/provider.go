@@ -3,14 +3,20 @@ package k8sconnect
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 
 	"github.com/jmorris0x0/terraform-provider-k8sconnect/internal/k8sconnect/common/auth"
 	"github.com/jmorris0x0/terraform-provider-k8sconnect/internal/k8sconnect/common/factory"
 	"github.com/jmorris0x0/terraform-provider-k8sconnect/internal/k8sconnect/common/k8sclient"
+	"github.com/jmorris0x0/terraform-provider-k8sconnect/internal/k8sconnect/datasource/diff"
 	objectds "github.com/jmorris0x0/terraform-provider-k8sconnect/internal/k8sconnect/datasource/object"
 	"github.com/jmorris0x0/terraform-provider-k8sconnect/internal/k8sconnect/datasource/yaml_scoped"
 	"github.com/jmorris0x0/terraform-provider-k8sconnect/internal/k8sconnect/datasource/yaml_split"
@@ -27,19 +33,28 @@ var _ provider.Provider = (*k8sconnectProvider)(nil)
 
 // k8sconnectProviderModel describes the provider data model.
 type k8sconnectProviderModel struct {
-	// Empty - no provider configuration
+	ManageOwnershipAnnotation types.Bool   `tfsdk:"manage_ownership_annotation"`
+	ImportCluster             types.Object `tfsdk:"import_cluster"`
+	DryRun                    types.Bool   `tfsdk:"dry_run"`
+	MaxConcurrentOperations   types.Int64  `tfsdk:"max_concurrent_operations"`
 }
 
 // k8sconnectProvider is our Terraform provider
 type k8sconnectProvider struct {
-	// Client factory only
-	clientFactory factory.ClientFactory
+	clientFactory *factory.CachedClientFactory
+
+	// objectSettings is read by k8sconnect_object; resources are instantiated per request,
+	// after Configure
+	objectSettings objectres.ProviderSettings
 }
 
 // New returns a factory for k8sconnectProvider
 func New() provider.Provider {
 	return &k8sconnectProvider{
 		clientFactory: factory.NewCachedClientFactory(),
+		objectSettings: objectres.ProviderSettings{
+			ManageOwnershipAnnotation: true,
+		},
 	}
 }
 
@@ -51,7 +66,42 @@ func (p *k8sconnectProvider) Metadata(ctx context.Context, req provider.Metadata
 func (p *k8sconnectProvider) Schema(ctx context.Context, req provider.SchemaRequest, resp *provider.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Bootstrap Kubernetes clusters in a single apply. Supports inline connections, Server-Side Apply, multi-cluster deployments, and surgical patching of any Kubernetes resource.",
-		Attributes:  map[string]schema.Attribute{},
+		Attributes: map[string]schema.Attribute{
+			"manage_ownership_annotation": schema.BoolAttribute{
+				Optional: true,
+				Description: "Whether k8sconnect_object writes and reads the k8sconnect.terraform.io/terraform-id and created-at " +
+					"ownership annotations. Defaults to true. Set to false when admission policies reject extra annotations; " +
+					"ownership is then inferred from the k8sconnect field manager, which cannot tell which Terraform resource " +
+					"or state applied an object, so already-exists and replacement detection are weaker.",
+			},
+			"import_cluster": schema.SingleNestedAttribute{
+				Optional: true,
+				Description: "Cluster connection used by terraform import of k8sconnect_object, instead of KUBECONFIG or ~/.kube/config. " +
+					"Set it on a provider alias and import with that alias to import from a specific cluster; the imported " +
+					"cluster attribute is this connection. For kubeconfig connections without a context, the context in the " +
+					"import ID is used. Only import reads this setting; resources still connect with their own cluster attribute.",
+				Attributes: auth.GetConnectionSchemaForProvider(),
+			},
+			"dry_run": schema.BoolAttribute{
+				Optional: true,
+				Description: "Simulate every create, update, and delete with server-side dry-run (DryRun: [\"All\"]) instead of " +
+					"persisting it. The API server still validates each change, including admission webhooks and policies, so " +
+					"terraform apply becomes a non-destructive check for validation pipelines. Each simulated change is reported " +
+					"in a warning, k8sconnect_wait does not wait, and state records the simulated results, so later plans show " +
+					"the changes again. A simulated destroy, including the destroy of a replacement, fails with an error " +
+					"so the resource stays in state. Defaults to false.",
+			},
+			"max_concurrent_operations": schema.Int64Attribute{
+				Optional: true,
+				Description: "Maximum number of Kubernetes API requests the provider sends at a time, shared by all resources and " +
+					"data sources whatever cluster they connect to. Terraform's -parallelism limits resources, not requests, so " +
+					"set this to protect small control planes from large configurations. Requests beyond the limit wait for a " +
+					"free slot; watches are not counted. Defaults to no limit.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+		},
 	}
 }
 
@@ -63,6 +113,42 @@ func (p *k8sconnectProvider) Configure(ctx context.Context, req provider.Configu
 		return
 	}
 
+	if !config.ManageOwnershipAnnotation.IsNull() && !config.ManageOwnershipAnnotation.IsUnknown() {
+		p.objectSettings.ManageOwnershipAnnotation = config.ManageOwnershipAnnotation.ValueBool()
+	}
+
+	if config.DryRun.ValueBool() {
+		tflog.Info(ctx, "dry_run enabled, all writes are simulated with server-side dry-run", map[string]interface{}{})
+		p.clientFactory.SetDryRun(true)
+	}
+
+	if !config.MaxConcurrentOperations.IsNull() && !config.MaxConcurrentOperations.IsUnknown() {
+		tflog.Info(ctx, "Limiting concurrent API requests", map[string]interface{}{
+			"max_concurrent_operations": config.MaxConcurrentOperations.ValueInt64(),
+		})
+		p.clientFactory.SetMaxConcurrentOperations(int(config.MaxConcurrentOperations.ValueInt64()))
+	}
+
+	// Values unknown at Configure (connection built in the same run) can't be used for import;
+	// import then falls back to KUBECONFIG
+	if auth.IsConnectionReady(config.ImportCluster) {
+		conn, err := auth.ObjectToConnectionModel(ctx, config.ImportCluster)
+		if err == nil {
+			err = auth.ValidateConnection(ctx, conn)
+		}
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("import_cluster"),
+				"Invalid Import Cluster Connection",
+				err.Error(),
+			)
+			return
+		}
+		p.objectSettings.ImportCluster = &conn
+	} else if !config.ImportCluster.IsNull() {
+		tflog.Debug(ctx, "import_cluster not known at configure time, import will use KUBECONFIG", map[string]interface{}{})
+	}
+
 	// Pass client factory directly to resources and data sources
 	resp.DataSourceData = p.clientFactory
 	resp.ResourceData = p.clientFactory
@@ -74,7 +160,7 @@ func (p *k8sconnectProvider) Resources(ctx context.Context) []func() resource.Re
 			// For backward compatibility, wrap the new client factory to match old interface
 			return objectres.NewObjectResourceWithClientGetter(func(conn auth.ClusterModel) (k8sclient.K8sClient, error) {
 				return p.clientFactory.GetClient(conn)
-			})
+			}, p.objectSettings)
 		},
 		func() resource.Resource {
 			// Patch resource using same client getter pattern
@@ -96,5 +182,6 @@ func (p *k8sconnectProvider) DataSources(ctx context.Context) []func() datasourc
 		yaml_split.NewYamlSplitDataSource,
 		yaml_scoped.NewYamlScopedDataSource,
 		objectds.NewObjectDataSource,
+		diff.NewDiffDataSource,
 	}
 }
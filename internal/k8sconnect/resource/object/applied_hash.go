@@ -0,0 +1,150 @@
+package object
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// appliedHashKey is the private state key holding the hashes recorded by the last apply
+const appliedHashKey = "applied_hash"
+
+// appliedHash records what the last create or update applied, and the projection and
+// field ownership it left in state. A plan whose desired object hashes the same, against
+// state that Read refreshed to the same projection and ownership, can't change anything.
+type appliedHash struct {
+	Object        string `json:"object"`
+	Projection    string `json:"projection"`
+	ManagedFields string `json:"managed_fields"`
+}
+
+// hashJSON returns the SHA-256 of the canonical JSON encoding (sorted keys) of v, or ""
+// when it can't be encoded
+func hashJSON(v interface{}) string {
+	encoded, err := json.Marshal(v)
+	if err != nil {
+		return ""
+	}
+	sum := sha256.Sum256(encoded)
+	return hex.EncodeToString(sum[:])
+}
+
+// desiredObjectHash hashes the object built from the configuration: yaml_body with labels,
+// annotations, replacement strategy, generated name and default namespace resolved, before
+// the provider adds its ownership annotations
+func desiredObjectHash(obj *unstructured.Unstructured) string {
+	return hashJSON(obj.Object)
+}
+
+// hashStringMap hashes a map attribute. Unknown maps hash to "", which never matches.
+func hashStringMap(ctx context.Context, m types.Map) string {
+	if m.IsUnknown() {
+		return ""
+	}
+	var values map[string]string
+	if diags := m.ElementsAs(ctx, &values, false); diags.HasError() {
+		return ""
+	}
+	return hashJSON(values)
+}
+
+// saveAppliedHash records the desired object hash of a successful apply together with
+// the projection and managed_fields saved to state
+func saveAppliedHash(ctx context.Context, setter interface {
+	SetKey(context.Context, string, []byte) diag.Diagnostics
+}, objectHash string, data *objectResourceModel) {
+	hash := appliedHash{
+		Object:        objectHash,
+		Projection:    hashStringMap(ctx, data.ManagedStateProjection),
+		ManagedFields: hashStringMap(ctx, data.ManagedFields),
+	}
+	encoded, err := json.Marshal(hash)
+	if err != nil || objectHash == "" || hash.Projection == "" {
+		setter.SetKey(ctx, appliedHashKey, nil)
+		return
+	}
+	setter.SetKey(ctx, appliedHashKey, encoded)
+}
+
+// loadAppliedHash returns the hashes recorded by the last apply, if any
+func loadAppliedHash(ctx context.Context, getter interface {
+	GetKey(context.Context, string) ([]byte, diag.Diagnostics)
+}) (appliedHash, bool) {
+	var hash appliedHash
+	data, _ := getter.GetKey(ctx, appliedHashKey)
+	if data == nil || json.Unmarshal(data, &hash) != nil || hash.Object == "" {
+		return appliedHash{}, false
+	}
+	return hash, true
+}
+
+// appliedHashMatches reports whether an update plan would apply the same object as the
+// last apply onto an object that hasn't drifted since: the desired object hashes the
+// same, Read refreshed state to the projection and ownership the apply left, and none
+// of the settings that shape the projection changed
+func appliedHashMatches(ctx context.Context, hash appliedHash, objectHash string, plannedData, stateData *objectResourceModel) bool {
+	return hash.Object == objectHash &&
+		!stateData.ManagedStateProjection.IsNull() &&
+		hash.Projection == hashStringMap(ctx, stateData.ManagedStateProjection) &&
+		hash.ManagedFields == hashStringMap(ctx, stateData.ManagedFields) &&
+		projectionSettingsUnchanged(plannedData, stateData)
+}
+
+// projectionSettingsUnchanged reports whether none of the settings that shape what the
+// apply owns and projects changed, so managed_state_projection and managed_fields can be
+// kept from state. An apply that resets managedFields changes the ownership it leaves.
+func projectionSettingsUnchanged(plannedData, stateData *objectResourceModel) bool {
+	return plannedData.IgnoreFields.Equal(stateData.IgnoreFields) &&
+		plannedData.ManageScope.Equal(stateData.ManageScope) &&
+		plannedData.FieldManager.Equal(stateData.FieldManager) &&
+		plannedData.FieldManagerOperation.Equal(stateData.FieldManagerOperation) &&
+		plannedData.AllowStatus.Equal(stateData.AllowStatus) &&
+		!managedFieldsResetPending(plannedData, stateData)
+}
+
+// planFromAppliedHash plans no change to the object without a dry-run when the desired
+// object is the one last applied and Read found no drift, preserving the computed
+// attributes from state. Saves the server round-trip for stable objects, which matters
+// for large custom resources. Returns true when the plan was set.
+func (r *objectResource) planFromAppliedHash(ctx context.Context, req resource.ModifyPlanRequest, plannedData *objectResourceModel, desiredObj *unstructured.Unstructured, resp *resource.ModifyPlanResponse) bool {
+	if isCreateOperation(req) || checkPendingProjectionFlag(ctx, req.Private) || checkStaleReadFlag(ctx, req.Private) ||
+		checkImportedWithoutAnnotationsFlag(ctx, req.Private) {
+		return false
+	}
+	hash, ok := loadAppliedHash(ctx, req.Private)
+	if !ok {
+		return false
+	}
+
+	var stateData objectResourceModel
+	if diags := req.State.Get(ctx, &stateData); diags.HasError() {
+		return false
+	}
+	if !appliedHashMatches(ctx, hash, desiredObjectHash(desiredObj), plannedData, &stateData) {
+		return false
+	}
+
+	tflog.Debug(ctx, "Desired object unchanged since the last apply and no drift, skipping dry-run")
+	// As in checkDriftAndPreserveState, a yaml_body that only differs in formatting is kept
+	plannedData.YAMLBody = stateData.YAMLBody
+	plannedData.ManagedStateProjection = stateData.ManagedStateProjection
+	plannedData.ManagedFields = stateData.ManagedFields
+	plannedData.ObjectRef = stateData.ObjectRef
+	plannedData.AppliedYAML = stateData.AppliedYAML
+	plannedData.Generation = stateData.Generation
+	plannedData.ResourceVersion = stateData.ResourceVersion
+	plannedData.PodTemplateHash = stateData.PodTemplateHash
+	plannedData.CurrentReplicas = stateData.CurrentReplicas
+	plannedData.Status = stateData.Status
+
+	diags := resp.Plan.Set(ctx, plannedData)
+	resp.Diagnostics.Append(diags...)
+	return true
+}
@@ -0,0 +1,71 @@
+package object
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestAppliedHashMatches(t *testing.T) {
+	ctx := context.Background()
+	obj := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "ConfigMap",
+		"metadata":   map[string]interface{}{"name": "settings", "namespace": "default"},
+		"data":       map[string]interface{}{"mode": "fast"},
+	}}
+	projection, _ := types.MapValueFrom(ctx, types.StringType, map[string]string{"data.mode": "fast"})
+	managedFields, _ := types.MapValueFrom(ctx, types.StringType, map[string]string{"data.mode": "k8sconnect"})
+	applied := &objectResourceModel{
+		ManagedStateProjection: projection,
+		ManagedFields:          managedFields,
+		IgnoreFields:           types.ListNull(types.StringType),
+		ManageScope:            types.ListNull(types.StringType),
+	}
+
+	private := recordingPrivateState{}
+	saveAppliedHash(ctx, private, desiredObjectHash(obj), applied)
+	hash, ok := loadAppliedHash(ctx, private)
+	if !ok {
+		t.Fatal("expected the applied hash to be recorded")
+	}
+
+	refreshed := *applied
+	if !appliedHashMatches(ctx, hash, desiredObjectHash(obj), applied, &refreshed) {
+		t.Error("unchanged object without drift should skip the dry-run")
+	}
+
+	changed := obj.DeepCopy()
+	changed.Object["data"] = map[string]interface{}{"mode": "safe"}
+	if appliedHashMatches(ctx, hash, desiredObjectHash(changed), applied, &refreshed) {
+		t.Error("a changed desired object must be dry-run")
+	}
+
+	drifted := *applied
+	drifted.ManagedStateProjection, _ = types.MapValueFrom(ctx, types.StringType, map[string]string{"data.mode": "slow"})
+	if appliedHashMatches(ctx, hash, desiredObjectHash(obj), applied, &drifted) {
+		t.Error("drift found by refresh must be dry-run")
+	}
+
+	ownershipDrift := *applied
+	ownershipDrift.ManagedFields, _ = types.MapValueFrom(ctx, types.StringType, map[string]string{"data.mode": "kubectl"})
+	if appliedHashMatches(ctx, hash, desiredObjectHash(obj), applied, &ownershipDrift) {
+		t.Error("a field taken over by another manager must be dry-run")
+	}
+
+	planned := *applied
+	planned.IgnoreFields, _ = types.ListValueFrom(ctx, types.StringType, []string{"data.mode"})
+	if appliedHashMatches(ctx, hash, desiredObjectHash(obj), &planned, &refreshed) {
+		t.Error("a changed ignore_fields must be dry-run")
+	}
+
+	// A later apply whose projection isn't known yet leaves nothing to compare against
+	pending := *applied
+	pending.ManagedStateProjection = types.MapUnknown(types.StringType)
+	saveAppliedHash(ctx, private, desiredObjectHash(obj), &pending)
+	if _, ok := loadAppliedHash(ctx, private); ok {
+		t.Error("expected the applied hash to be cleared")
+	}
+}
@@ -14,6 +14,7 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
 	"github.com/jmorris0x0/terraform-provider-k8sconnect/internal/k8sconnect/common/k8sclient"
+	"github.com/jmorris0x0/terraform-provider-k8sconnect/internal/k8sconnect/common/k8serrors"
 )
 
 // checkResourceExistenceAndOwnership checks if resource exists and verifies ownership
@@ -28,7 +29,7 @@ func (r *objectResource) checkResourceExistenceAndOwnership(ctx context.Context,
 	existingObj, err := rc.Client.Get(ctx, rc.GVR, rc.Object.GetNamespace(), rc.Object.GetName())
 	if err == nil {
 		// Resource exists - check ownership
-		existingID := r.getOwnershipID(existingObj)
+		existingID := r.getOwnershipID(existingObj, data)
 		if existingID != "" && existingID != data.ID.ValueString() {
 			// Different ID - owned by another state
 			resp.Diagnostics.AddError(
@@ -38,6 +39,33 @@ func (r *objectResource) checkResourceExistenceAndOwnership(ctx context.Context,
 			)
 			return fmt.Errorf("resource already managed")
 		}
+		// Without the ownership annotation, the field manager is the only ownership signal left
+		fieldManager := fieldManagerFor(data)
+		if !r.usesOwnershipAnnotation(data) && isManagedByFieldManager(existingObj, fieldManager) {
+			reason := "The ownership annotation is disabled (manage_ownership_annotation = false), so the provider cannot tell " +
+				"which Terraform resource owns it."
+			if hasCustomFieldManager(data) {
+				reason = "Each k8sconnect_object sharing an object needs its own field_manager, so that it only owns its own fields."
+			}
+			resp.Diagnostics.AddError(
+				"Resource Already Managed",
+				fmt.Sprintf("%s already exists and has fields owned by the %q field manager, so another k8sconnect_object "+
+					"(possibly in a different Terraform state) manages it.\n\n%s\n\n"+
+					"Options:\n"+
+					"• If this configuration should manage it, remove it from the other configuration and use 'terraform import'\n"+
+					"• Otherwise, change metadata.name or metadata.namespace so the objects do not collide",
+					formatResource(rc.Object), fieldManager, reason),
+			)
+			return fmt.Errorf("resource already managed")
+		}
+		// With its own field_manager the object may already exist: other writers keep their fields
+		if hasCustomFieldManager(data) {
+			tflog.Info(ctx, "Applying onto an existing object with a separate field manager", map[string]interface{}{
+				"resource":      formatResource(rc.Object),
+				"field_manager": fieldManager,
+			})
+			return nil
+		}
 		// Block if resource exists without k8sconnect ownership
 		if existingID == "" {
 			kind := rc.Object.GetKind()
@@ -200,6 +228,9 @@ func (r *objectResource) applyResourceWithConflictHandling(ctx context.Context,
 		})
 	}
 
+	// Services: keep the cluster IP and node ports the server assigned when yaml_body omits them
+	objToApply = withServiceAssignedFields(ctx, rc.Client, rc.GVR, objToApply)
+
 	// On Update, filter out ignored fields to release ownership to other controllers
 	// On Create, send everything to establish initial state
 	if operation == "Update" {
@@ -211,23 +242,43 @@ func (r *objectResource) applyResourceWithConflictHandling(ctx context.Context,
 		}
 	}
 
+	// optimistic_concurrency: the server rejects the apply with 409 if the object has changed
+	if operation == "Update" && rc.PreconditionResourceVersion != "" {
+		objToApply.SetResourceVersion(rc.PreconditionResourceVersion)
+	}
+
 	// DEBUG: Log what we're actually sending in SSA apply
 	pathsInApply := extractAllFieldsFromYAML(objToApply.Object, "")
 	tflog.Debug(ctx, "=== APPLY PHASE - Fields being sent in SSA Apply ===", map[string]interface{}{
 		"operation":     operation,
 		"force":         true,
-		"field_manager": "k8sconnect",
+		"field_manager": fieldManagerFor(rc.Data),
 		"paths_count":   len(pathsInApply),
 		"paths":         pathsInApply,
 		"object_ref":    fmt.Sprintf("%s/%s %s/%s", objToApply.GetAPIVersion(), objToApply.GetKind(), objToApply.GetNamespace(), objToApply.GetName()),
 	})
 
 	// Apply the resource with CRD retry (always force conflicts)
+	done := k8sclient.TrackPhase(ctx, k8sclient.PhaseApply, formatResource(rc.Object))
 	err := r.applyWithCRDRetry(ctx, rc.Client, objToApply, k8sclient.ApplyOptions{
-		FieldManager:    "k8sconnect",
+		FieldManager:    fieldManagerFor(rc.Data),
 		Force:           true,     // Always force ownership of conflicted fields
 		FieldValidation: "Strict", // ADR-017: Validate fields against OpenAPI schema during apply
+		UpdateOperation: recordsUpdateOperation(rc.Data),
 	})
+	done()
+
+	// x-kubernetes-preserve-unknown-fields: an object the apply can't type goes in as a merge patch
+	if _, merged := mergePatchFallback(ctx, rc.Client, rc.GVR, objToApply, fieldManagerFor(rc.Data), err, false); merged {
+		err = nil
+	}
+
+	// manage_scope includes status: write it through the status subresource
+	if err == nil && managesStatus(ctx, data) {
+		done := k8sclient.TrackPhase(ctx, k8sclient.PhaseApply, formatResource(rc.Object)+" status")
+		_, err = applyStatus(ctx, rc.Client, rc.GVR, objToApply, fieldManagerFor(rc.Data), recordsUpdateOperation(rc.Data), false)
+		done()
+	}
 
 	if err != nil {
 		tflog.Error(ctx, "=== APPLY PHASE - SSA Apply FAILED ===", map[string]interface{}{
@@ -236,8 +287,10 @@ func (r *objectResource) applyResourceWithConflictHandling(ctx context.Context,
 			"object_ref": fmt.Sprintf("%s/%s %s/%s", objToApply.GetAPIVersion(), objToApply.GetKind(), objToApply.GetNamespace(), objToApply.GetName()),
 		})
 		resourceDesc := formatResource(rc.Object)
-		if isFieldConflictError(err) {
-			r.addFieldConflictError(resp, operation, resourceDesc)
+		if rc.PreconditionResourceVersion != "" && errors.IsConflict(err) {
+			r.addResourceVersionConflictError(resp, rc.Object, rc.PreconditionResourceVersion, err)
+		} else if isFieldConflictError(err) {
+			r.addFieldConflictError(resp, operation, resourceDesc, err)
 		} else {
 			r.addOperationError(resp, operation, resourceDesc, rc.Object.GetAPIVersion(), err)
 		}
@@ -253,6 +306,7 @@ func (r *objectResource) applyResourceWithConflictHandling(ctx context.Context,
 
 // readResourceAfterCreate reads resource back to get managedFields (Phase 2)
 func (r *objectResource) readResourceAfterCreate(ctx context.Context, rc *ResourceContext) {
+	defer k8sclient.TrackPhase(ctx, k8sclient.PhaseReadBack, formatResource(rc.Object))()
 	createdObj, err := rc.Client.Get(ctx, rc.GVR, rc.Object.GetNamespace(), rc.Object.GetName())
 	if err != nil {
 		tflog.Warn(ctx, "Failed to read resource after create", map[string]interface{}{
@@ -266,7 +320,7 @@ func (r *objectResource) readResourceAfterCreate(ctx context.Context, rc *Resour
 			"kind":          rc.Object.GetKind(),
 			"name":          rc.Object.GetName(),
 			"has_managed":   len(rc.Object.GetManagedFields()) > 0,
-			"field_manager": "k8sconnect",
+			"field_manager": fieldManagerFor(rc.Data),
 		})
 	}
 
@@ -323,7 +377,7 @@ func (r *objectResource) verifyOwnership(currentObj *unstructured.Unstructured,
 		msg.WriteString(fmt.Sprintf("4. Verify annotations: kubectl get %s %s%s -o yaml | grep terraform-id",
 			strings.ToLower(obj.GetKind()), obj.GetName(), nsFlag))
 
-		resp.Diagnostics.AddError("Resource Ownership Conflict", msg.String())
+		resp.Diagnostics.AddError(k8serrors.Summary(k8serrors.ErrorTypeOwnershipConflict, "Resource Ownership Conflict"), msg.String())
 		return fmt.Errorf("ownership conflict")
 	}
 	return nil
@@ -338,11 +392,11 @@ func (r *objectResource) updateProjectionFromCurrent(ctx context.Context, data *
 		tflog.Debug(ctx, "Using field ownership for projection during Read", map[string]interface{}{
 			"managers": len(currentObj.GetManagedFields()),
 		})
-		paths = extractOwnedPaths(ctx, currentObj.GetManagedFields(), obj.Object)
+		paths = extractOwnedPaths(ctx, currentObj.GetManagedFields(), fieldManagerFor(data), obj.Object)
 	} else {
 		tflog.Warn(ctx, "No managedFields available during Read, using all fields from YAML")
 		// When no ownership info, extract all fields from YAML
-		paths = extractOwnedPaths(ctx, []metav1.ManagedFieldsEntry{}, obj.Object)
+		paths = extractOwnedPaths(ctx, []metav1.ManagedFieldsEntry{}, fieldManagerFor(data), obj.Object)
 	}
 
 	// Apply ignore_fields filtering if specified
@@ -353,12 +407,16 @@ func (r *objectResource) updateProjectionFromCurrent(ctx context.Context, data *
 			"filtered_paths": len(paths),
 		})
 	}
+	paths = filterManageScope(paths, getManageScope(ctx, data))
 
 	// Project the current state to only include fields we manage
-	projection, err := projectFields(currentObj.Object, paths)
+	projection, err := projectFields(alignListOrder(currentObj, obj.Object), paths)
 	if err != nil {
 		return err
 	}
+	normalizeFinalizers(projection, obj.Object)
+	normalizeBinaryData(projection)
+	normalizeIntOrString(projection)
 
 	// Convert projection to flat map for clean diff display
 	projectionMap := flattenProjectionToMap(projection, paths)
@@ -386,15 +444,48 @@ func (r *objectResource) updateProjectionFromCurrent(ctx context.Context, data *
 }
 
 // Error handling helpers
-func (r *objectResource) addFieldConflictError(resp interface{}, operation string, resourceDesc string) {
+func (r *objectResource) addFieldConflictError(resp interface{}, operation string, resourceDesc string, err error) {
 	message := fmt.Sprintf("Another controller owns fields you're trying to set on %s. "+
 		"Add conflicting paths to ignore_fields to release ownership.", resourceDesc)
+	if conflicts := k8serrors.ExtractFieldConflicts(err); len(conflicts) > 0 {
+		details, _ := k8serrors.ExtractConflictDetailsAndPaths(err)
+		message += fmt.Sprintf("\n\nConflicting fields:\n%s\n\n%s", details, k8serrors.FormatFieldConflictsJSON(conflicts))
+	}
 
 	if createResp, ok := resp.(*resource.CreateResponse); ok {
-		createResp.Diagnostics.AddError("Field Manager Conflict", message)
+		createResp.Diagnostics.AddError(k8serrors.Summary(k8serrors.ErrorTypeConflict, "Field Manager Conflict"), message)
 	} else if updateResp, ok := resp.(*resource.UpdateResponse); ok {
-		updateResp.Diagnostics.AddError("Field Manager Conflict", message)
+		updateResp.Diagnostics.AddError(k8serrors.Summary(k8serrors.ErrorTypeConflict, "Field Manager Conflict"), message)
+	}
+}
+
+// resourceVersionPrecondition returns the resourceVersion an optimistic_concurrency update
+// must match: the one recorded in state at the last refresh or apply
+func resourceVersionPrecondition(ctx context.Context, state *objectResourceModel) string {
+	if state.ResourceVersion.IsNull() || state.ResourceVersion.IsUnknown() || state.ResourceVersion.ValueString() == "" {
+		tflog.Warn(ctx, "optimistic_concurrency is set but state has no resource_version yet, applying without precondition")
+		return ""
+	}
+	return state.ResourceVersion.ValueString()
+}
+
+// addResourceVersionConflictError reports an update rejected by the optimistic_concurrency precondition
+func (r *objectResource) addResourceVersionConflictError(resp interface{}, obj *unstructured.Unstructured, resourceVersion string, err error) {
+	updateResp, ok := resp.(*resource.UpdateResponse)
+	if !ok {
+		return
 	}
+	updateResp.Diagnostics.AddError(
+		k8serrors.Summary(k8serrors.ErrorTypeConflict, "Object Changed Since Last Read"),
+		fmt.Sprintf("%s was modified after Terraform last read it (resourceVersion %s), so the update was not applied. "+
+			"optimistic_concurrency is set, which keeps Terraform from overwriting concurrent changes.\n\n"+
+			"Options:\n"+
+			"• Run terraform plan again to review the current object, then apply\n"+
+			"• Find what else changes the object: kubectl get %s %s %s -o yaml --show-managed-fields\n"+
+			"• Unset optimistic_concurrency to let the update overwrite managed fields\n\n"+
+			"Details: %v", formatResource(obj), resourceVersion,
+			strings.ToLower(obj.GetKind()), obj.GetName(), r.namespaceFlag(obj), err),
+	)
 }
 
 func (r *objectResource) addOperationError(resp interface{}, operation string, resourceDesc string, apiVersion string, err error) {
@@ -423,6 +514,7 @@ func isFieldConflictError(err error) bool {
 
 // getIgnoreFields extracts the ignore_fields list from the model.
 // Returns nil if ignore_fields is not set or empty.
+// A trailing ".*" (e.g. "status.*") ignores the whole subtree, the same as its parent path.
 func getIgnoreFields(ctx context.Context, data *objectResourceModel) []string {
 	if data.IgnoreFields.IsNull() || data.IgnoreFields.IsUnknown() {
 		return nil
@@ -434,6 +526,9 @@ func getIgnoreFields(ctx context.Context, data *objectResourceModel) []string {
 		return nil
 	}
 
+	for i, field := range ignoreFields {
+		ignoreFields[i] = strings.TrimSuffix(field, ".*")
+	}
 	return ignoreFields
 }
 
@@ -0,0 +1,145 @@
+package object
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/jmorris0x0/terraform-provider-k8sconnect/internal/k8sconnect/common/k8sclient"
+)
+
+// applyPrioritySettle is how long after a gate becomes busy its applies wait before checking
+// for lower-priority applies. Terraform starts independent resources at nearly the same
+// time, so this gives the ones started alongside the first the chance to register. Applies
+// that join a gate busy for longer than that don't wait, so the cost is paid once per burst
+// of applies rather than once per resource.
+var applyPrioritySettle = 500 * time.Millisecond
+
+// applyPriorityGate orders the concurrent applies to one cluster by apply_priority:
+// an apply proceeds once no apply with a lower priority is pending or running.
+// Applies with the same priority run in parallel.
+type applyPriorityGate struct {
+	mu        sync.Mutex
+	active    map[int64]int // priority -> applies pending or running
+	changed   chan struct{} // closed and replaced whenever active changes
+	busySince time.Time     // when active last went from empty to non-empty
+}
+
+// applyPriorityGates holds one gate per cluster. The client factory caches one client per
+// cluster connection, so the client identifies the cluster.
+var applyPriorityGates = struct {
+	sync.Mutex
+	byClient map[k8sclient.K8sClient]*applyPriorityGate
+}{byClient: make(map[k8sclient.K8sClient]*applyPriorityGate)}
+
+func applyPriorityGateFor(client k8sclient.K8sClient) *applyPriorityGate {
+	applyPriorityGates.Lock()
+	defer applyPriorityGates.Unlock()
+
+	gate, ok := applyPriorityGates.byClient[client]
+	if !ok {
+		gate = &applyPriorityGate{active: make(map[int64]int), changed: make(chan struct{})}
+		applyPriorityGates.byClient[client] = gate
+	}
+	return gate
+}
+
+// enter registers an apply with priority and blocks until every lower-priority apply has
+// left the gate. The returned release must be called once the apply is done.
+func (g *applyPriorityGate) enter(ctx context.Context, priority int64) (func(), error) {
+	var settle time.Duration
+	g.update(func() {
+		if len(g.active) == 0 {
+			g.busySince = time.Now()
+		}
+		g.active[priority]++
+		settle = time.Until(g.busySince.Add(applyPrioritySettle))
+	})
+	var once sync.Once
+	release := func() {
+		once.Do(func() {
+			g.update(func() {
+				if g.active[priority]--; g.active[priority] <= 0 {
+					delete(g.active, priority)
+				}
+			})
+		})
+	}
+
+	if settle > 0 {
+		select {
+		case <-time.After(settle):
+		case <-ctx.Done():
+			release()
+			return nil, ctx.Err()
+		}
+	}
+
+	for {
+		g.mu.Lock()
+		lower := g.lowerPriorityCount(priority)
+		changed := g.changed
+		g.mu.Unlock()
+
+		if lower == 0 {
+			return release, nil
+		}
+
+		tflog.Debug(ctx, "Waiting for lower apply_priority applies", map[string]interface{}{
+			"apply_priority": priority,
+			"waiting_on":     lower,
+		})
+		select {
+		case <-changed:
+		case <-ctx.Done():
+			release()
+			return nil, ctx.Err()
+		}
+	}
+}
+
+// update changes active under the lock and wakes up every waiting apply
+func (g *applyPriorityGate) update(change func()) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	change()
+	close(g.changed)
+	g.changed = make(chan struct{})
+}
+
+// lowerPriorityCount returns the number of pending or running applies below priority.
+// Must be called with mu held.
+func (g *applyPriorityGate) lowerPriorityCount(priority int64) int {
+	count := 0
+	for p, n := range g.active {
+		if p < priority {
+			count += n
+		}
+	}
+	return count
+}
+
+// waitForApplyPriority holds an apply with apply_priority until lower-priority applies to
+// the same cluster are done. Returns a release func to call after the apply; without
+// apply_priority it returns immediately.
+func waitForApplyPriority(ctx context.Context, rc *ResourceContext) (func(), error) {
+	if rc.Data.ApplyPriority.IsNull() || rc.Data.ApplyPriority.IsUnknown() {
+		return func() {}, nil
+	}
+
+	priority := rc.Data.ApplyPriority.ValueInt64()
+	started := time.Now()
+	release, err := applyPriorityGateFor(rc.Client).enter(ctx, priority)
+	if err != nil {
+		return nil, err
+	}
+
+	tflog.Debug(ctx, "apply_priority gate passed", map[string]interface{}{
+		"apply_priority": priority,
+		"resource":       formatResource(rc.Object),
+		"waited":         time.Since(started).String(),
+	})
+	return release, nil
+}
@@ -0,0 +1,121 @@
+package object
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestApplyPriorityGateOrdersApplies(t *testing.T) {
+	defer func(settle time.Duration) { applyPrioritySettle = settle }(applyPrioritySettle)
+	applyPrioritySettle = 50 * time.Millisecond
+
+	gate := &applyPriorityGate{active: make(map[int64]int), changed: make(chan struct{})}
+	ctx := context.Background()
+
+	var mu sync.Mutex
+	var order []int64
+	var wg sync.WaitGroup
+	run := func(priority int64, startDelay time.Duration) {
+		defer wg.Done()
+		time.Sleep(startDelay)
+		release, err := gate.enter(ctx, priority)
+		if err != nil {
+			t.Errorf("priority %d: unexpected error: %v", priority, err)
+			return
+		}
+		mu.Lock()
+		order = append(order, priority)
+		mu.Unlock()
+		time.Sleep(20 * time.Millisecond) // the apply
+		release()
+	}
+
+	// The higher priorities start first, but within the settle window of each other
+	wg.Add(3)
+	go run(20, 0)
+	go run(10, 10*time.Millisecond)
+	go run(0, 20*time.Millisecond)
+	wg.Wait()
+
+	if len(order) != 3 || order[0] != 0 || order[1] != 10 || order[2] != 20 {
+		t.Errorf("applies ran in order %v, want [0 10 20]", order)
+	}
+	if len(gate.active) != 0 {
+		t.Errorf("expected no registered applies after release, got %v", gate.active)
+	}
+}
+
+func TestApplyPriorityGateSamePriorityRunsInParallel(t *testing.T) {
+	defer func(settle time.Duration) { applyPrioritySettle = settle }(applyPrioritySettle)
+	applyPrioritySettle = 10 * time.Millisecond
+
+	gate := &applyPriorityGate{active: make(map[int64]int), changed: make(chan struct{})}
+	ctx := context.Background()
+
+	first, err := gate.enter(ctx, 5)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	second, err := gate.enter(ctx, 5)
+	if err != nil {
+		t.Fatalf("an apply with the same priority should not wait: %v", err)
+	}
+	first()
+	first() // releasing twice is harmless
+	second()
+	if len(gate.active) != 0 {
+		t.Errorf("expected no registered applies after release, got %v", gate.active)
+	}
+}
+
+func TestApplyPriorityGateSettlesOncePerBurst(t *testing.T) {
+	defer func(settle time.Duration) { applyPrioritySettle = settle }(applyPrioritySettle)
+	applyPrioritySettle = 100 * time.Millisecond
+
+	gate := &applyPriorityGate{active: make(map[int64]int), changed: make(chan struct{})}
+	ctx := context.Background()
+
+	started := time.Now()
+	first, err := gate.enter(ctx, 5)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if waited := time.Since(started); waited < applyPrioritySettle {
+		t.Errorf("the first apply of a burst should settle, waited %s", waited)
+	}
+	defer first()
+
+	// The gate has been busy for longer than the settle window
+	started = time.Now()
+	second, err := gate.enter(ctx, 5)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	second()
+	if waited := time.Since(started); waited >= applyPrioritySettle {
+		t.Errorf("an apply joining a settled burst should not wait, waited %s", waited)
+	}
+}
+
+func TestApplyPriorityGateHonorsCancellation(t *testing.T) {
+	defer func(settle time.Duration) { applyPrioritySettle = settle }(applyPrioritySettle)
+	applyPrioritySettle = 10 * time.Millisecond
+
+	gate := &applyPriorityGate{active: make(map[int64]int), changed: make(chan struct{})}
+	blocker, err := gate.enter(context.Background(), 1)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer blocker()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if _, err := gate.enter(ctx, 2); err == nil {
+		t.Fatal("expected the wait to end with the context")
+	}
+	if gate.active[2] != 0 {
+		t.Errorf("a cancelled apply should be unregistered, got %v", gate.active)
+	}
+}
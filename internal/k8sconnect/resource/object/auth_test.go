@@ -304,3 +304,92 @@ YAML
 }
 `, ns1, ns2, cm1, ns1, cm2, ns2)
 }
+
+// TestAccObjectResource_DeferredAuthWithComputedKubeconfig tests that a kubeconfig and
+// context unknown at plan (e.g. built from an EKS data source) defer connecting to apply
+func TestAccObjectResource_DeferredAuthWithComputedKubeconfig(t *testing.T) {
+	t.Parallel()
+
+	host := os.Getenv("TF_ACC_K8S_HOST")
+	ca := os.Getenv("TF_ACC_K8S_CA")
+	token := os.Getenv("TF_ACC_K8S_TOKEN")
+	raw := os.Getenv("TF_ACC_KUBECONFIG")
+
+	if host == "" || ca == "" || token == "" {
+		t.Skip("TF_ACC_K8S_HOST, TF_ACC_K8S_CA, and TF_ACC_K8S_TOKEN must be set")
+	}
+
+	ns := fmt.Sprintf("computed-kubeconfig-ns-%d", time.Now().UnixNano()%1000000)
+	cmName := fmt.Sprintf("computed-kubeconfig-cm-%d", time.Now().UnixNano()%1000000)
+	k8sClient := testhelpers.CreateK8sClient(t, raw)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"k8sconnect": providerserver.NewProtocol6WithError(k8sconnect.New()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccManifestConfigDeferredAuthWithComputedKubeconfig(ns, cmName),
+				ConfigVariables: config.Variables{
+					"kubeconfig": config.StringVariable(createMultiContextKubeconfig(host, ca, token)),
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testhelpers.CheckNamespaceExists(k8sClient, ns),
+					testhelpers.CheckConfigMapExists(k8sClient, ns, cmName),
+					resource.TestCheckResourceAttr("k8sconnect_object.test_computed", "cluster.context", "context-b"),
+				),
+			},
+		},
+		CheckDestroy: testhelpers.CheckNamespaceDestroy(k8sClient, ns),
+	})
+}
+
+func testAccManifestConfigDeferredAuthWithComputedKubeconfig(namespace, cmName string) string {
+	return fmt.Sprintf(`
+variable "kubeconfig" { type = string }
+
+provider "k8sconnect" {}
+
+# terraform_data outputs are unknown until apply, like a kubeconfig built from a cluster
+# data source or module output
+resource "terraform_data" "cluster" {
+  input = {
+    kubeconfig = var.kubeconfig
+    context    = "context-b"
+  }
+}
+
+resource "k8sconnect_object" "test_namespace" {
+  yaml_body = <<YAML
+apiVersion: v1
+kind: Namespace
+metadata:
+  name: %s
+YAML
+
+  cluster = {
+    kubeconfig = terraform_data.cluster.output.kubeconfig
+    context    = "context-a"
+  }
+}
+
+resource "k8sconnect_object" "test_computed" {
+  depends_on = [k8sconnect_object.test_namespace]
+
+  yaml_body = <<YAML
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: %s
+  namespace: %s
+data:
+  test: "auth-was-deferred"
+YAML
+
+  cluster = {
+    kubeconfig = terraform_data.cluster.output.kubeconfig
+    context    = terraform_data.cluster.output.context
+  }
+}
+`, namespace, cmName, namespace)
+}
@@ -23,6 +23,10 @@ type ResourceContext struct {
 	Object                     *unstructured.Unstructured
 	GVR                        schema.GroupVersionResource
 	ImportedWithoutAnnotations bool // Private state flag
+
+	// PreconditionResourceVersion, when set, is sent as metadata.resourceVersion on an
+	// update so the API server rejects the apply if the object changed (optimistic_concurrency)
+	PreconditionResourceVersion string
 }
 
 // prepareContext sets up the ResourceContext with all common elements
@@ -51,6 +55,13 @@ func (r *objectResource) prepareContext(
 		if err != nil {
 			return nil, fmt.Errorf("invalid YAML: %w", err)
 		}
+		if err := mergeCommonMetadata(ctx, obj, data); err != nil {
+			return nil, err
+		}
+		if err := applyReplacementStrategy(obj, data); err != nil {
+			return nil, err
+		}
+		resolveGeneratedName(ctx, obj, data.ObjectRef)
 		rc.Object = obj
 	}
 
@@ -73,6 +84,11 @@ func (r *objectResource) prepareContext(
 		}
 		rc.Client = client
 
+		// Step 3a: Resolve the namespace of namespaced objects that omit metadata.namespace
+		if rc.Object != nil {
+			resolveObjectNamespace(ctx, client, conn, rc.Object, data.ObjectRef)
+		}
+
 		// Step 4: Get GVR (if we have an object)
 		if rc.Object != nil {
 			gvr, err := client.GetGVR(ctx, rc.Object)
@@ -150,6 +166,9 @@ func (r *objectResource) updateProjection(rc *ResourceContext) error {
 		}
 	}
 
+	// Map and set lists follow yaml_body's order, like the paths computed during plan
+	aligned := alignListOrder(currentObj, rc.Object.Object)
+
 	// Extract paths - use field ownership if flag is enabled
 	var paths []string
 
@@ -157,27 +176,31 @@ func (r *objectResource) updateProjection(rc *ResourceContext) error {
 		tflog.Debug(rc.Ctx, "Using field ownership for projection", map[string]interface{}{
 			"managers": len(currentObj.GetManagedFields()),
 		})
-		paths = extractOwnedPaths(rc.Ctx, currentObj.GetManagedFields(), currentObj.Object)
+		paths = extractOwnedPaths(rc.Ctx, currentObj.GetManagedFields(), fieldManagerFor(rc.Data), aligned)
 	} else {
 		tflog.Warn(rc.Ctx, "No managedFields available, using all fields from YAML")
 		// When no ownership info, extract all fields from object
-		paths = extractOwnedPaths(rc.Ctx, []metav1.ManagedFieldsEntry{}, rc.Object.Object)
+		paths = extractOwnedPaths(rc.Ctx, []metav1.ManagedFieldsEntry{}, fieldManagerFor(rc.Data), rc.Object.Object)
 	}
 
 	// Apply ignore_fields filtering if specified
 	if ignoreFields := getIgnoreFields(rc.Ctx, rc.Data); ignoreFields != nil {
-		paths = filterIgnoredPaths(paths, ignoreFields, currentObj.Object)
+		paths = filterIgnoredPaths(paths, ignoreFields, aligned)
 		tflog.Debug(rc.Ctx, "Applied ignore_fields filtering in projection update", map[string]interface{}{
 			"ignored_count":  len(ignoreFields),
 			"filtered_paths": len(paths),
 		})
 	}
+	paths = filterManageScope(paths, getManageScope(rc.Ctx, rc.Data))
 
 	// Create projection - always project from the current K8s object
-	projection, err := projectFields(currentObj.Object, paths)
+	projection, err := projectFields(aligned, paths)
 	if err != nil {
 		return fmt.Errorf("failed to project fields: %w", err)
 	}
+	normalizeFinalizers(projection, rc.Object.Object)
+	normalizeBinaryData(projection)
+	normalizeIntOrString(projection)
 
 	// Convert projection to flat map for clean diff display
 	projectionMap := flattenProjectionToMap(projection, paths)
@@ -135,6 +135,126 @@ resource "k8sconnect_object" "test_cr" {
 `, crdName, plural, crName, namespace)
 }
 
+// TestAccObjectResource_CRDAndCRWithoutDependsOn applies a CRD and a custom resource of it
+// in parallel: the custom resource finds its CRD and waits for it to be Established
+func TestAccObjectResource_CRDAndCRWithoutDependsOn(t *testing.T) {
+	t.Parallel()
+
+	raw := os.Getenv("TF_ACC_KUBECONFIG")
+	if raw == "" {
+		t.Fatal("TF_ACC_KUBECONFIG must be set")
+	}
+
+	suffix := fmt.Sprintf("%d", time.Now().UnixNano()%1000000)
+	plural := fmt.Sprintf("parallelcrds%s", suffix)
+	crdName := fmt.Sprintf("%s.crdtest.example.com", plural)
+	kind := fmt.Sprintf("ParallelCRD%s", suffix)
+	crName := fmt.Sprintf("parallel-instance-%s", suffix)
+	ns := fmt.Sprintf("crd-parallel-ns-%s", suffix)
+
+	k8sClient := testhelpers.CreateK8sClient(t, raw)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"k8sconnect": providerserver.NewProtocol6WithError(k8sconnect.New()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccManifestConfigCRDWithCRNoDependsOn(crdName, plural, kind, crName, ns),
+				ConfigVariables: config.Variables{
+					"kubeconfig": config.StringVariable(raw),
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("k8sconnect_object.test_crd", "id"),
+					resource.TestCheckResourceAttrSet("k8sconnect_object.test_cr", "id"),
+					resource.TestCheckResourceAttr("k8sconnect_object.test_cr", "managed_state_projection.spec.foo", "bar"),
+				),
+			},
+			{
+				Config: testAccManifestConfigCRDWithCRNoDependsOn(crdName, plural, kind, crName, ns),
+				ConfigVariables: config.Variables{
+					"kubeconfig": config.StringVariable(raw),
+				},
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: false,
+			},
+		},
+		CheckDestroy: testhelpers.CheckNamespaceDestroy(k8sClient, ns),
+	})
+}
+
+func testAccManifestConfigCRDWithCRNoDependsOn(crdName, plural, kind, crName, namespace string) string {
+	return fmt.Sprintf(`
+variable "kubeconfig" {
+  type = string
+}
+
+resource "k8sconnect_object" "test_namespace" {
+  yaml_body = <<-YAML
+    apiVersion: v1
+    kind: Namespace
+    metadata:
+      name: %[5]s
+  YAML
+
+  cluster = {
+    kubeconfig = var.kubeconfig
+  }
+}
+
+resource "k8sconnect_object" "test_crd" {
+  yaml_body = <<-YAML
+    apiVersion: apiextensions.k8s.io/v1
+    kind: CustomResourceDefinition
+    metadata:
+      name: %[1]s
+    spec:
+      group: crdtest.example.com
+      names:
+        kind: %[3]s
+        plural: %[2]s
+      scope: Namespaced
+      versions:
+      - name: v1
+        served: true
+        storage: true
+        schema:
+          openAPIV3Schema:
+            type: object
+            properties:
+              spec:
+                type: object
+                properties:
+                  foo:
+                    type: string
+  YAML
+
+  cluster = {
+    kubeconfig = var.kubeconfig
+  }
+}
+
+# No depends_on on the CRD: both are created in parallel
+resource "k8sconnect_object" "test_cr" {
+  yaml_body = <<-YAML
+    apiVersion: crdtest.example.com/v1
+    kind: %[3]s
+    metadata:
+      name: %[4]s
+      namespace: %[5]s
+    spec:
+      foo: bar
+  YAML
+
+  cluster = {
+    kubeconfig = var.kubeconfig
+  }
+
+  depends_on = [k8sconnect_object.test_namespace]
+}
+`, crdName, plural, kind, crName, namespace)
+}
+
 // TestAccObjectResource_NonCRDErrorFailsImmediately verifies that non-CRD errors
 // (like validation errors, invalid fields, etc.) fail immediately without triggering
 // the 30-second CRD retry logic. This ensures good UX by not making users wait
@@ -45,14 +45,65 @@ func (r *objectResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
+	// 3a. generateName: pick the name now, server-side apply needs one
+	if usesGenerateName(rc.Object) {
+		assignGeneratedName(rc.Object)
+		tflog.Info(ctx, "Generated object name from metadata.generateName", map[string]interface{}{
+			"generate_name": rc.Object.GetGenerateName(),
+			"name":          rc.Object.GetName(),
+		})
+	}
+
+	// 3b. depends_on_ready: wait for referenced namespaces and CRDs to be ready, not just to exist
+	if err := r.waitForDependenciesReady(ctx, rc, &data, resp); err != nil {
+		return
+	}
+
+	// 3c. A custom resource applied with its CRD: wait for the CRD to be Established
+	if err := r.waitForDefiningCRD(ctx, rc, &data, resp); err != nil {
+		return
+	}
+
+	// 3d. precondition: fail before touching the cluster when the prerequisite isn't met
+	if err := r.checkPrecondition(ctx, rc, &data, &resp.Diagnostics); err != nil {
+		return
+	}
+
+	objectHash := desiredObjectHash(rc.Object)
+
 	// 4. Set ownership annotation
-	r.setOwnershipAnnotation(rc.Object, data.ID.ValueString())
+	r.setOwnershipAnnotation(rc.Object, &data)
+
+	// 4a. wait_for_deletion: let a terminating object with the same name disappear first
+	if err := r.waitForPriorDeletion(ctx, rc, &data, resp); err != nil {
+		return
+	}
+
+	// 4b. A terminating namespace rejects new objects: fail clearly, or wait_for_namespace_termination
+	if err := r.checkNamespaceTerminating(ctx, rc, &data, resp); err != nil {
+		return
+	}
 
 	// 5. Check if resource exists and verify ownership
 	if err := r.checkResourceExistenceAndOwnership(ctx, rc, &data, resp); err != nil {
 		return
 	}
 
+	// 5a. apply_priority: let lower-priority applies to the same cluster go first
+	release, err := waitForApplyPriority(ctx, rc)
+	if err != nil {
+		resp.Diagnostics.AddError("Apply Priority Wait Interrupted", err.Error())
+		return
+	}
+	defer release()
+
+	// 5b. reset_managed_fields: clear the ownership of an existing object being adopted
+	if resetsManagedFields(&data) {
+		if err := r.resetManagedFields(ctx, rc, &resp.Diagnostics); err != nil {
+			return
+		}
+	}
+
 	// 6. Apply the resource
 	if err := r.applyResourceWithConflictHandling(ctx, rc, rc.Data, resp, "Create"); err != nil {
 		return
@@ -60,6 +111,10 @@ func (r *objectResource) Create(ctx context.Context, req resource.CreateRequest,
 
 	// 6a. Surface any API warnings from apply operation
 	k8sclient.SurfaceK8sWarningsWithIdentity(ctx, rc.Client, rc.Object, &resp.Diagnostics)
+	warnOnAPIVersionConversion(ctx, rc.Client, rc.GVR, rc.Object, &resp.Diagnostics)
+
+	// 6b. strip_last_applied_configuration: remove kubectl's client-side apply annotation
+	r.removeLastAppliedConfiguration(ctx, rc, &resp.Diagnostics)
 
 	// 7. Phase 2 - Read back to get managedFields
 	r.readResourceAfterCreate(ctx, rc)
@@ -75,7 +130,7 @@ func (r *objectResource) Create(ctx context.Context, req resource.CreateRequest,
 	}
 
 	// 8a. Populate object_ref output
-	if err := r.populateObjectRef(ctx, rc); err != nil {
+	if err := populateObjectRef(ctx, rc); err != nil {
 		resp.Diagnostics.AddError("Failed to populate object_ref",
 			fmt.Sprintf("Failed to populate object_ref for %s: %s", formatResource(rc.Object), err.Error()))
 		return
@@ -84,10 +139,26 @@ func (r *objectResource) Create(ctx context.Context, req resource.CreateRequest,
 	// 8b. Update managed_fields attribute in state
 	updateManagedFieldsData(ctx, rc.Data, rc.Object)
 
-	// 8c. Save ownership baseline to private state for drift detection (ADR-021)
+	// 8c. Record the object as accepted by the server
+	updateAppliedYAMLData(ctx, rc.Data, rc.Object)
+	updateObjectVersionData(rc.Data, rc.Object)
+	if rc.Data.PodTemplateHash.IsUnknown() {
+		updatePodTemplateHashData(rc.Data, rc.Object)
+	}
+	if rc.Data.CurrentReplicas.IsUnknown() || rc.Data.Status.IsUnknown() {
+		updateSubresourceData(ctx, rc.Data, rc.Client, rc.GVR, rc.Object)
+	}
+
+	// 8d. Save ownership baseline to private state for drift detection (ADR-021)
 	ignoreFields := getIgnoreFields(ctx, rc.Data)
 	saveOwnershipBaseline(ctx, resp.Private, rc.Object, ignoreFields)
 
+	// 8e. Record what was applied, so an unchanged plan can skip the dry-run
+	saveAppliedHash(ctx, resp.Private, objectHash, rc.Data)
+
+	// 8f. dry_run: the object was only simulated
+	k8sclient.SurfaceDryRunWarning(ctx, rc.Client, "Create", formatResource(rc.Object), &resp.Diagnostics)
+
 	// 9. SAVE STATE after successful creation
 	diags = resp.State.Set(ctx, rc.Data)
 	resp.Diagnostics.Append(diags...)
@@ -138,8 +209,9 @@ func (r *objectResource) Read(ctx context.Context, req resource.ReadRequest, res
 	}
 
 	// 2a. If GVR is empty, the resource type is not discoverable (e.g., CRD was deleted)
-	// Treat this the same as resource not found - remove from state
-	if rc.GVR.Empty() {
+	// Treat this the same as resource not found - remove from state. A generateName object
+	// without a recorded name was never created, so the same applies.
+	if rc.GVR.Empty() || usesGenerateName(rc.Object) {
 		tflog.Info(ctx, "Resource type not discoverable during read, treating as deleted", map[string]interface{}{
 			"kind":      rc.Object.GetKind(),
 			"name":      rc.Object.GetName(),
@@ -149,8 +221,8 @@ func (r *objectResource) Read(ctx context.Context, req resource.ReadRequest, res
 		return
 	}
 
-	// 3. Read current state from Kubernetes
-	currentObj, err := rc.Client.Get(ctx, rc.GVR, rc.Object.GetNamespace(), rc.Object.GetName())
+	// 3. Read current state from Kubernetes (refresh_from_cache: from the API server's cache)
+	currentObj, err := readForRefresh(ctx, rc, &data)
 	if err != nil {
 		if errors.IsNotFound(err) {
 			// Resource was deleted outside Terraform
@@ -186,7 +258,10 @@ func (r *objectResource) Read(ctx context.Context, req resource.ReadRequest, res
 	// When a resource is imported without k8sconnect annotations, we skip the ownership
 	// check until Update adds the annotations. The flag is cleared by Update after applying.
 	annotationsMissing := false
-	if !checkImportedWithoutAnnotationsFlag(ctx, req.Private) {
+	if !r.usesOwnershipAnnotation(&data) {
+		// manage_ownership_annotation = false or field_manager: nothing to verify or restore
+		tflog.Debug(ctx, "Skipped ownership verification - ownership annotation disabled")
+	} else if !checkImportedWithoutAnnotationsFlag(ctx, req.Private) {
 		// Check if annotations are missing before calling verifyOwnership
 		annotations := currentObj.GetAnnotations()
 		terraformID := ""
@@ -204,6 +279,20 @@ func (r *objectResource) Read(ctx context.Context, req resource.ReadRequest, res
 		tflog.Debug(ctx, "Skipped ownership verification for imported resource without annotations")
 	}
 
+	// 4a. strip_last_applied_configuration: kubectl apply added the annotation back
+	lastAppliedReturned := stripsLastAppliedConfiguration(&data) && hasLastAppliedConfiguration(currentObj)
+
+	// 4b. detect_drift = false: the object exists, assume it's unchanged and keep the prior projection
+	if driftDetectionDisabled(&data) && !hasPendingProjection && !annotationsMissing && !lastAppliedReturned {
+		tflog.Debug(ctx, "Skipped projection refresh - drift detection disabled", map[string]interface{}{
+			"kind": rc.Object.GetKind(),
+			"name": rc.Object.GetName(),
+		})
+		diags = resp.State.Set(ctx, &data)
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
 	// 5. Update projection (with opportunistic recovery)
 	if err := r.updateProjectionFromCurrent(ctx, &data, currentObj, rc.Object); err != nil {
 		// If we had a pending projection, keep the flag and continue (don't fail refresh)
@@ -235,12 +324,29 @@ func (r *objectResource) Read(ctx context.Context, req resource.ReadRequest, res
 			// Set projection to empty to force a diff
 			emptyMap, _ := types.MapValueFrom(ctx, types.StringType, map[string]string{})
 			data.ManagedStateProjection = emptyMap
+		} else if lastAppliedReturned {
+			// Same for a last-applied-configuration that has to be removed again
+			tflog.Info(ctx, "Clearing projection to force update to remove last-applied-configuration", map[string]interface{}{
+				"kind":      rc.Object.GetKind(),
+				"name":      rc.Object.GetName(),
+				"namespace": rc.Object.GetNamespace(),
+			})
+			emptyMap, _ := types.MapValueFrom(ctx, types.StringType, map[string]string{})
+			data.ManagedStateProjection = emptyMap
 		}
 	}
 
 	// 6. Update field ownership
 	updateManagedFieldsData(ctx, &data, currentObj)
 
+	// 6a. Refresh applied_yaml, generation, resource_version and pod_template_hash so they reflect current live state
+	updateAppliedYAMLData(ctx, &data, currentObj)
+	updateObjectVersionData(&data, currentObj)
+	updatePodTemplateHashData(&data, currentObj)
+
+	// 6b. Refresh current_replicas and status from the scale and status subresources
+	updateSubresourceData(ctx, &data, rc.Client, rc.GVR, currentObj)
+
 	// 7. Save refreshed state
 	diags = resp.State.Set(ctx, &data)
 	resp.Diagnostics.Append(diags...)
@@ -270,9 +376,50 @@ func (r *objectResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
+	// 2a. generateName: keep the name generated at create, even while object_ref is unknown in the plan
+	resolveGeneratedName(ctx, rc.Object, state.ObjectRef)
+	if usesGenerateName(rc.Object) {
+		resp.Diagnostics.AddError("Generated Name Unknown During Update",
+			fmt.Sprintf("The name generated from metadata.generateName %q is not recorded in state, so the object can't be updated in place. "+
+				"Run terraform plan again so a replacement is planned.", rc.Object.GetGenerateName()))
+		return
+	}
+
+	// 2b. Blue-green objects must never be renamed in place
+	if err := r.checkBlueGreenNameUnchanged(ctx, &state, &plan, rc.Object); err != nil {
+		resp.Diagnostics.AddError("Blue-Green Name Changed During Update", err.Error())
+		return
+	}
+
 	// 3. Preserve ID and set ownership
+	objectHash := desiredObjectHash(rc.Object)
 	plan.ID = state.ID
-	r.setOwnershipAnnotation(rc.Object, plan.ID.ValueString())
+	r.setOwnershipAnnotation(rc.Object, &plan)
+
+	// 3a. optimistic_concurrency: only apply onto the resourceVersion last read into state
+	if plan.OptimisticConcurrency.ValueBool() {
+		rc.PreconditionResourceVersion = resourceVersionPrecondition(ctx, &state)
+	}
+
+	// 3b. precondition: fail before touching the cluster when the prerequisite isn't met
+	if err := r.checkPrecondition(ctx, rc, &plan, &resp.Diagnostics); err != nil {
+		return
+	}
+
+	// 3c. apply_priority: let lower-priority applies to the same cluster go first
+	release, err := waitForApplyPriority(ctx, rc)
+	if err != nil {
+		resp.Diagnostics.AddError("Apply Priority Wait Interrupted", err.Error())
+		return
+	}
+	defer release()
+
+	// 3d. reset_managed_fields: clear every manager's ownership on the update that enables it
+	if managedFieldsResetPending(&plan, &state) {
+		if err := r.resetManagedFields(ctx, rc, &resp.Diagnostics); err != nil {
+			return
+		}
+	}
 
 	// 4. Apply the updated resource
 	if err := r.applyResourceWithConflictHandling(ctx, rc, rc.Data, resp, "Update"); err != nil {
@@ -281,6 +428,7 @@ func (r *objectResource) Update(ctx context.Context, req resource.UpdateRequest,
 
 	// 4a. Surface any API warnings from apply operation
 	k8sclient.SurfaceK8sWarningsWithIdentity(ctx, rc.Client, rc.Object, &resp.Diagnostics)
+	warnOnAPIVersionConversion(ctx, rc.Client, rc.GVR, rc.Object, &resp.Diagnostics)
 
 	tflog.Info(ctx, "Resource updated", map[string]interface{}{
 		"kind":      rc.Object.GetKind(),
@@ -288,8 +436,13 @@ func (r *objectResource) Update(ctx context.Context, req resource.UpdateRequest,
 		"namespace": rc.Object.GetNamespace(),
 	})
 
-	// 4b. Fetch fresh object with updated managedFields after apply
+	// 4b. strip_last_applied_configuration: remove kubectl's client-side apply annotation
+	r.removeLastAppliedConfiguration(ctx, rc, &resp.Diagnostics)
+
+	// 4c. Fetch fresh object with updated managedFields after apply
+	done := k8sclient.TrackPhase(ctx, k8sclient.PhaseReadBack, formatResource(rc.Object))
 	updatedObj, err := rc.Client.Get(ctx, rc.GVR, rc.Object.GetNamespace(), rc.Object.GetName())
+	done()
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to read resource after update",
 			fmt.Sprintf("Failed to read %s after update: %s", formatResource(rc.Object), err.Error()))
@@ -310,7 +463,7 @@ func (r *objectResource) Update(ctx context.Context, req resource.UpdateRequest,
 	handleProjectionSuccess(ctx, hasPendingProjection, resp.Private, "from previous apply")
 
 	// 6. Populate object_ref output
-	if err := r.populateObjectRef(ctx, rc); err != nil {
+	if err := populateObjectRef(ctx, rc); err != nil {
 		resp.Diagnostics.AddError("Failed to populate object_ref",
 			fmt.Sprintf("Failed to populate object_ref for %s: %s", formatResource(rc.Object), err.Error()))
 		return
@@ -324,10 +477,32 @@ func (r *objectResource) Update(ctx context.Context, req resource.UpdateRequest,
 	// 7. Update managed_fields attribute in state
 	updateManagedFieldsData(ctx, &plan, rc.Object)
 
+	// 7a. Record the object as accepted by the server. When ModifyPlan preserved
+	// applied_yaml (no Kubernetes changes), keep the planned value to stay consistent
+	// with the plan; the next refresh picks up any server-side changes.
+	if plan.AppliedYAML.IsUnknown() {
+		updateAppliedYAMLData(ctx, &plan, rc.Object)
+	}
+	if plan.ResourceVersion.IsUnknown() || plan.Generation.IsUnknown() {
+		updateObjectVersionData(&plan, rc.Object)
+	}
+	if plan.PodTemplateHash.IsUnknown() {
+		updatePodTemplateHashData(&plan, rc.Object)
+	}
+	if plan.CurrentReplicas.IsUnknown() || plan.Status.IsUnknown() {
+		updateSubresourceData(ctx, &plan, rc.Client, rc.GVR, rc.Object)
+	}
+
 	// 7b. Save ownership baseline to private state for drift detection (ADR-021)
 	ignoreFields := getIgnoreFields(ctx, &plan)
 	saveOwnershipBaseline(ctx, resp.Private, rc.Object, ignoreFields)
 
+	// 7c. Record what was applied, so an unchanged plan can skip the dry-run
+	saveAppliedHash(ctx, resp.Private, objectHash, &plan)
+
+	// 7d. dry_run: the update was only simulated
+	k8sclient.SurfaceDryRunWarning(ctx, rc.Client, "Update", formatResource(rc.Object), &resp.Diagnostics)
+
 	// 8. Save updated state
 	diags = resp.State.Set(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -345,7 +520,7 @@ func (r *objectResource) Delete(ctx context.Context, req resource.DeleteRequest,
 	// 2. Check delete protection
 	if !data.DeleteProtection.IsNull() && data.DeleteProtection.ValueBool() {
 		resp.Diagnostics.AddError(
-			"Delete Protection Enabled",
+			k8serrors.Summary(k8serrors.ErrorTypeDeleteProtected, "Delete Protection Enabled"),
 			"This resource has delete protection enabled. Set delete_protection = false to allow deletion.",
 		)
 		return
@@ -358,8 +533,9 @@ func (r *objectResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
-	// 3a. If GVR discovery failed (e.g., CRD deleted before CR), assume already deleted
-	if rc.GVR.Empty() {
+	// 3a. If GVR discovery failed (e.g., CRD deleted before CR), assume already deleted.
+	// A generateName object without a recorded name was never created.
+	if rc.GVR.Empty() || usesGenerateName(rc.Object) {
 		tflog.Info(ctx, "Resource type no longer discoverable, assuming already deleted", map[string]interface{}{
 			"kind":      rc.Object.GetKind(),
 			"name":      rc.Object.GetName(),
@@ -393,7 +569,7 @@ func (r *objectResource) Delete(ctx context.Context, req resource.DeleteRequest,
 	}
 
 	// 5a. Verify ownership - if resource has different terraform-id, it's been replaced
-	existingID := r.getOwnershipID(liveObj)
+	existingID := r.getOwnershipID(liveObj, &data)
 	expectedID := data.ID.ValueString()
 	if existingID != "" && existingID != expectedID {
 		// Resource exists but is owned by a different Terraform instance
@@ -408,8 +584,20 @@ func (r *objectResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
+	// 5b. With its own field_manager the object is shared: while other writers still apply
+	// to it, release this resource's fields and leave the object and their fields in place
+	if hasCustomFieldManager(&data) {
+		if others := otherApplyManagers(liveObj, fieldManagerFor(&data)); len(others) > 0 {
+			r.releaseFieldManager(ctx, rc.Client, liveObj, &data, others, resp)
+			if !resp.Diagnostics.HasError() {
+				k8sclient.SurfaceDryRunDelete(ctx, rc.Client, "Field release", formatResource(rc.Object), &resp.Diagnostics)
+			}
+			return
+		}
+	}
+
 	// 6. Attempt normal deletion
-	err = rc.Client.Delete(ctx, rc.GVR, rc.Object.GetNamespace(), rc.Object.GetName(), k8sclient.DeleteOptions{})
+	err = rc.Client.Delete(ctx, rc.GVR, rc.Object.GetNamespace(), rc.Object.GetName(), getDeleteOptions(data))
 	if err != nil && !errors.IsNotFound(err) {
 		resourceDesc := fmt.Sprintf("%s %s", rc.Object.GetKind(), rc.Object.GetName())
 		severity, title, detail := r.classifyK8sError(err, "Delete", resourceDesc, rc.Object.GetAPIVersion())
@@ -429,7 +617,7 @@ func (r *objectResource) Delete(ctx context.Context, req resource.DeleteRequest,
 	liveObj, err = rc.Client.Get(ctx, rc.GVR, rc.Object.GetNamespace(), rc.Object.GetName())
 	if err == nil {
 		// Object still exists - check if it's been replaced
-		currentID := r.getOwnershipID(liveObj)
+		currentID := r.getOwnershipID(liveObj, &data)
 		if currentID != "" && currentID != expectedID {
 			// Resource has been replaced by a different Terraform instance during deletion
 			tflog.Info(ctx, "Resource was replaced during deletion - skipping wait", map[string]interface{}{
@@ -449,6 +637,9 @@ func (r *objectResource) Delete(ctx context.Context, req resource.DeleteRequest,
 	}
 
 	// 7. Wait for deletion with timeout, continuing to check ownership on each iteration
+	if !r.usesOwnershipAnnotation(&data) {
+		expectedID = "" // no annotation to tell a replacement apart
+	}
 	err = r.waitForDeletion(ctx, rc.Client, rc.GVR, rc.Object, timeout, expectedID)
 	if err != nil {
 		if forceDestroy {
@@ -469,6 +660,12 @@ func (r *objectResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		}
 	}
 
+	// 7a. dry_run: the delete was only simulated, keep the resource in state
+	k8sclient.SurfaceDryRunDelete(ctx, rc.Client, "Delete", formatResource(rc.Object), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// 8. Log successful deletion
 	tflog.Info(ctx, "Resource deleted", map[string]interface{}{
 		"kind":      rc.Object.GetKind(),
@@ -570,6 +767,24 @@ func handleProjectionFailure(
 	emptyMap, _ := types.MapValueFrom(ctx, types.StringType, map[string]string{})
 	rc.Data.ManagedStateProjection = emptyMap
 	rc.Data.ManagedFields = emptyMap
+	if rc.Data.ObjectRef.IsUnknown() {
+		// A generated name is only known from object_ref, so state must record it
+		if err := populateObjectRef(ctx, rc); err != nil {
+			tflog.Warn(ctx, "Failed to populate object_ref after projection failure", map[string]interface{}{"error": err.Error()})
+		}
+	}
+	if rc.Data.AppliedYAML.IsUnknown() {
+		updateAppliedYAMLData(ctx, rc.Data, rc.Object)
+	}
+	if rc.Data.ResourceVersion.IsUnknown() || rc.Data.Generation.IsUnknown() {
+		updateObjectVersionData(rc.Data, rc.Object)
+	}
+	if rc.Data.PodTemplateHash.IsUnknown() {
+		updatePodTemplateHashData(rc.Data, rc.Object)
+	}
+	if rc.Data.CurrentReplicas.IsUnknown() || rc.Data.Status.IsUnknown() {
+		updateSubresourceData(ctx, rc.Data, rc.Client, rc.GVR, rc.Object)
+	}
 
 	// Save state with pending projection flag in Private state
 	setPendingProjectionFlag(ctx, privateSetter)
@@ -586,7 +801,7 @@ func handleProjectionFailure(
 }
 
 // populateObjectRef extracts resource identity and populates object_ref output
-func (r *objectResource) populateObjectRef(ctx context.Context, rc *ResourceContext) error {
+func populateObjectRef(ctx context.Context, rc *ResourceContext) error {
 	objRef := objectRefModel{
 		APIVersion: types.StringValue(rc.Object.GetAPIVersion()),
 		Kind:       types.StringValue(rc.Object.GetKind()),
@@ -0,0 +1,135 @@
+package object
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/jmorris0x0/terraform-provider-k8sconnect/internal/k8sconnect/common/k8sclient"
+	"github.com/jmorris0x0/terraform-provider-k8sconnect/internal/k8sconnect/common/k8serrors"
+)
+
+// definingCRDAppearTimeout is how long a custom resource waits for its CRD to be created.
+// A CRD applied in the same run shows up within moments; applyWithCRDRetry still retries
+// after this, so it is kept short.
+var definingCRDAppearTimeout = 10 * time.Second
+
+// waitForDefiningCRD lets a custom resource be applied in the same run as its CRD without
+// depends_on. Terraform runs the two in parallel, so the CRD may not exist yet when the
+// custom resource is created, or may exist without being Established, which the API server
+// answers with "no matches for kind". When the resource type wasn't discoverable in
+// prepareContext, this looks for the CRD defining the object's group and kind, waits for it
+// to be Established, and resolves the GVR. When no CRD turns up within
+// definingCRDAppearTimeout, apply reports the missing CRD as before.
+//
+// The type isn't discoverable, so neither is its plural: CRDs are listed until the one
+// for the group and kind turns up, and from then on it is read by name.
+func (r *objectResource) waitForDefiningCRD(ctx context.Context, rc *ResourceContext, data *objectResourceModel, resp *resource.CreateResponse) error {
+	if !rc.GVR.Empty() || rc.Client == nil || rc.Object == nil {
+		return nil
+	}
+	gv, err := schema.ParseGroupVersion(rc.Object.GetAPIVersion())
+	if err != nil || gv.Group == "" {
+		return nil
+	}
+
+	kind := rc.Object.GetKind()
+	timeout := dependsOnReadyTimeout(data)
+	start := time.Now()
+	var crdName string
+	for {
+		crd, err := lookupDefiningCRD(ctx, rc.Client, crdName, gv.Group, kind)
+		if err != nil {
+			// e.g. no permission to list CRDs: leave it to apply's retry
+			tflog.Debug(ctx, "Could not look up the CRD for a custom resource", map[string]interface{}{
+				"resource": formatResource(rc.Object),
+				"error":    err.Error(),
+			})
+			return nil
+		}
+
+		reason := "not created yet"
+		crdName = ""
+		if crd != nil {
+			crdName = crd.GetName()
+			reason = dependencyReadiness(crd)
+			if reason == "" {
+				if gvr, err := rc.Client.GetGVR(ctx, rc.Object); err == nil {
+					rc.GVR = gvr
+				}
+				return nil
+			}
+		}
+
+		elapsed := time.Since(start)
+		if crd == nil && elapsed > definingCRDAppearTimeout {
+			tflog.Debug(ctx, "No CRD found for custom resource, applying anyway", map[string]interface{}{
+				"resource": formatResource(rc.Object),
+			})
+			return nil
+		}
+		if crd != nil && elapsed > timeout {
+			resp.Diagnostics.AddError(
+				k8serrors.Summary(k8serrors.ErrorTypeWaitTimeout, "CRD Not Established"),
+				fmt.Sprintf("%s was not created because CustomResourceDefinition %s was %s within %v.\n\n"+
+					"Options:\n"+
+					"• Wait longer: depends_on_ready_timeout = \"10m\"\n"+
+					"• Check the CRD's conditions: kubectl get crd %s -o yaml\n"+
+					"• Run terraform apply again once it is Established",
+					formatResource(rc.Object), crd.GetName(), reason, timeout, crd.GetName()),
+			)
+			return fmt.Errorf("CRD %s not established", crd.GetName())
+		}
+
+		tflog.Debug(ctx, "Waiting for the CRD of a custom resource", map[string]interface{}{
+			"resource": formatResource(rc.Object),
+			"group":    gv.Group,
+			"kind":     kind,
+			"reason":   reason,
+		})
+
+		select {
+		case <-ctx.Done():
+			resp.Diagnostics.AddError("CRD Wait Interrupted", ctx.Err().Error())
+			return ctx.Err()
+		case <-time.After(dependencyReadyPollInterval):
+		}
+	}
+}
+
+// lookupDefiningCRD reads the CRD named name, or finds the one defining kind in group when
+// name isn't known yet. Returns nil when there is none.
+func lookupDefiningCRD(ctx context.Context, client k8sclient.K8sClient, name, group, kind string) (*unstructured.Unstructured, error) {
+	if name == "" {
+		return findDefiningCRD(ctx, client, group, kind)
+	}
+	crd, err := client.Get(ctx, crdGVR, "", name)
+	if errors.IsNotFound(err) {
+		return nil, nil
+	}
+	return crd, err
+}
+
+// findDefiningCRD returns the CRD that defines kind in group, or nil when there is none
+func findDefiningCRD(ctx context.Context, client k8sclient.K8sClient, group, kind string) (*unstructured.Unstructured, error) {
+	list, err := client.List(ctx, crdGVR, "", metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	for i := range list.Items {
+		crd := &list.Items[i]
+		crdGroup, _, _ := unstructured.NestedString(crd.Object, "spec", "group")
+		crdKind, _, _ := unstructured.NestedString(crd.Object, "spec", "names", "kind")
+		if crdGroup == group && crdKind == kind {
+			return crd, nil
+		}
+	}
+	return nil, nil
+}
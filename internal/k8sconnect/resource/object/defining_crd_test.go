@@ -0,0 +1,144 @@
+package object
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	k8sschema "k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/jmorris0x0/terraform-provider-k8sconnect/internal/k8sconnect/common/k8sclient"
+)
+
+// crdSequenceClient answers CRD lookups, lists and gets alike, from responses in turn,
+// repeating the last one
+type crdSequenceClient struct {
+	k8sclient.K8sClient
+	responses [][]unstructured.Unstructured
+	lists     int
+	gets      int
+}
+
+func (c *crdSequenceClient) next() []unstructured.Unstructured {
+	i := c.lists + c.gets
+	if i >= len(c.responses) {
+		i = len(c.responses) - 1
+	}
+	return c.responses[i]
+}
+
+func (c *crdSequenceClient) List(ctx context.Context, gvr k8sschema.GroupVersionResource, namespace string, opts metav1.ListOptions) (*unstructured.UnstructuredList, error) {
+	items := c.next()
+	c.lists++
+	return &unstructured.UnstructuredList{Items: items}, nil
+}
+
+func (c *crdSequenceClient) Get(ctx context.Context, gvr k8sschema.GroupVersionResource, namespace, name string) (*unstructured.Unstructured, error) {
+	items := c.next()
+	c.gets++
+	for i := range items {
+		if items[i].GetName() == name {
+			return &items[i], nil
+		}
+	}
+	return nil, apierrors.NewNotFound(gvr.GroupResource(), name)
+}
+
+func definingCRD(group, kind, established string) unstructured.Unstructured {
+	crd := unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "apiextensions.k8s.io/v1",
+		"kind":       "CustomResourceDefinition",
+		"metadata":   map[string]interface{}{"name": "widgets." + group},
+		"spec": map[string]interface{}{
+			"group": group,
+			"names": map[string]interface{}{"kind": kind, "plural": "widgets"},
+		},
+	}}
+	if established != "" {
+		_ = unstructured.SetNestedSlice(crd.Object, []interface{}{
+			map[string]interface{}{"type": "Established", "status": established},
+		}, "status", "conditions")
+	}
+	return crd
+}
+
+func TestWaitForDefiningCRD(t *testing.T) {
+	ctx := context.Background()
+	r := &objectResource{}
+	defer func(interval, appear time.Duration) {
+		dependencyReadyPollInterval, definingCRDAppearTimeout = interval, appear
+	}(dependencyReadyPollInterval, definingCRDAppearTimeout)
+	dependencyReadyPollInterval = 10 * time.Millisecond
+	definingCRDAppearTimeout = 50 * time.Millisecond
+
+	widget := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "example.com/v1",
+		"kind":       "Widget",
+		"metadata":   map[string]interface{}{"name": "sprocket", "namespace": "default"},
+	}}
+	data := &objectResourceModel{DependsOnReadyTimeout: types.StringValue("200ms")}
+
+	t.Run("waits for the CRD to be created and established", func(t *testing.T) {
+		client := &crdSequenceClient{K8sClient: k8sclient.NewStubK8sClient(), responses: [][]unstructured.Unstructured{
+			nil,
+			{definingCRD("other.io", "Widget", "True")},
+			{definingCRD("example.com", "Widget", "")},
+			{definingCRD("example.com", "Widget", "True")},
+		}}
+		rc := &ResourceContext{Client: client, Object: widget}
+		if err := r.waitForDefiningCRD(ctx, rc, data, &resource.CreateResponse{}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if client.lists != 3 || client.gets != 1 {
+			t.Errorf("expected 3 lists until the CRD was found and a get after, got %d lists and %d gets", client.lists, client.gets)
+		}
+		if rc.GVR.Empty() {
+			t.Error("expected the GVR to be resolved once the CRD is established")
+		}
+	})
+
+	t.Run("applies anyway when no CRD appears", func(t *testing.T) {
+		client := &crdSequenceClient{K8sClient: k8sclient.NewStubK8sClient(), responses: [][]unstructured.Unstructured{nil}}
+		resp := &resource.CreateResponse{}
+		if err := r.waitForDefiningCRD(ctx, &ResourceContext{Client: client, Object: widget}, data, resp); err != nil {
+			t.Fatalf("expected apply to report the missing CRD, got %v", err)
+		}
+		if resp.Diagnostics.HasError() {
+			t.Errorf("unexpected diagnostics: %v", resp.Diagnostics)
+		}
+	})
+
+	t.Run("bounded by depends_on_ready_timeout", func(t *testing.T) {
+		client := &crdSequenceClient{K8sClient: k8sclient.NewStubK8sClient(), responses: [][]unstructured.Unstructured{
+			{definingCRD("example.com", "Widget", "False")},
+		}}
+		resp := &resource.CreateResponse{}
+		if err := r.waitForDefiningCRD(ctx, &ResourceContext{Client: client, Object: widget}, data, resp); err == nil {
+			t.Fatal("expected an error when the CRD never becomes established")
+		}
+		if client.lists != 1 || client.gets == 0 {
+			t.Errorf("expected the CRD to be listed once and then read by name, got %d lists and %d gets", client.lists, client.gets)
+		}
+		diag := resp.Diagnostics.Errors()[0]
+		if diag.Summary() != "[WaitTimeout] CRD Not Established" || !strings.Contains(diag.Detail(), "CustomResourceDefinition widgets.example.com was not Established yet") {
+			t.Errorf("unexpected diagnostic: %s\n%s", diag.Summary(), diag.Detail())
+		}
+	})
+
+	t.Run("skipped when the type is already known", func(t *testing.T) {
+		client := &crdSequenceClient{K8sClient: k8sclient.NewStubK8sClient(), responses: [][]unstructured.Unstructured{nil}}
+		rc := &ResourceContext{Client: client, Object: widget, GVR: k8sschema.GroupVersionResource{Group: "example.com", Version: "v1", Resource: "widgets"}}
+		if err := r.waitForDefiningCRD(ctx, rc, data, &resource.CreateResponse{}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if client.lists != 0 {
+			t.Errorf("expected no lookups, got %d", client.lists)
+		}
+	})
+}
@@ -10,12 +10,23 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/jmorris0x0/terraform-provider-k8sconnect/internal/k8sconnect/common/k8sclient"
+	"github.com/jmorris0x0/terraform-provider-k8sconnect/internal/k8sconnect/common/k8serrors"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	k8sschema "k8s.io/apimachinery/pkg/runtime/schema"
 )
 
+// delete_cascade values, mapped to the delete request's propagationPolicy
+const (
+	deleteCascadeBackground = "background"
+	deleteCascadeForeground = "foreground"
+	deleteCascadeOrphan     = "orphan"
+)
+
+// maxDependentsToShow caps the dependents listed when foreground deletion times out
+const maxDependentsToShow = 10
+
 // FinalizerInfo provides explanation and documentation for a finalizer
 type FinalizerInfo struct {
 	Explanation string
@@ -59,9 +70,11 @@ func (r *objectResource) forceDestroy(ctx context.Context, client k8sclient.K8sC
 		return fmt.Errorf("failed to get object for force destroy: %w", err)
 	}
 
-	// Check if object has finalizers
+	// Check if object has finalizers. Namespaces also have spec.finalizers, which only
+	// the finalize subresource can clear.
 	finalizers := liveObj.GetFinalizers()
-	if len(finalizers) == 0 {
+	specFinalizers := namespaceSpecFinalizers(liveObj)
+	if len(finalizers) == 0 && len(specFinalizers) == 0 {
 		// No finalizers, but still stuck - this is unusual
 		tflog.Warn(ctx, "Object has no finalizers but deletion timed out", map[string]interface{}{
 			"resource": fmt.Sprintf("%s/%s", obj.GetKind(), obj.GetName()),
@@ -78,33 +91,78 @@ func (r *objectResource) forceDestroy(ctx context.Context, client k8sclient.K8sC
 	}
 
 	// Log what finalizers we're about to remove
+	removing := fmt.Sprintf("%v", finalizers)
+	if len(specFinalizers) > 0 {
+		removing = fmt.Sprintf("%v (metadata.finalizers), %v (spec.finalizers, via the finalize subresource)", finalizers, specFinalizers)
+	}
 	resp.Diagnostics.AddWarning(
 		"Force Destroying Resource with Finalizers",
-		fmt.Sprintf("Removing finalizers from %s %s to force deletion: %v\n\n"+
+		fmt.Sprintf("Removing finalizers from %s %s to force deletion: %s\n\n"+
 			"⚠️  WARNING: This bypasses Kubernetes safety mechanisms and may cause:\n"+
 			"• Data loss or corruption\n"+
 			"• Orphaned dependent resources\n"+
 			"• Incomplete cleanup operations\n\n"+
 			"Only use force_destroy when you understand the implications for your specific resource.",
-			obj.GetKind(), obj.GetName(), finalizers),
+			obj.GetKind(), obj.GetName(), removing),
 	)
 
-	// Remove all finalizers
-	liveObj.SetFinalizers([]string{})
+	if len(finalizers) > 0 {
+		// Remove all finalizers
+		liveObj.SetFinalizers([]string{})
 
-	// Apply the change (remove finalizers)
-	err = client.Apply(ctx, liveObj, k8sclient.ApplyOptions{
-		FieldManager: "k8sconnect-force-destroy",
-		Force:        true,
-	})
-	if err != nil {
-		return fmt.Errorf("failed to remove finalizers: %w", err)
+		// Apply the change (remove finalizers)
+		err = client.Apply(ctx, liveObj, k8sclient.ApplyOptions{
+			FieldManager: "k8sconnect-force-destroy",
+			Force:        true,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to remove finalizers: %w", err)
+		}
+	}
+
+	if len(specFinalizers) > 0 {
+		if err := clearNamespaceSpecFinalizers(ctx, client, gvr, obj); err != nil {
+			return err
+		}
 	}
 
 	// Wait for deletion to complete (should be quick now, no ownership check needed)
 	return r.waitForDeletion(ctx, client, gvr, obj, 60*time.Second, "")
 }
 
+// namespaceSpecFinalizers returns spec.finalizers of a core Namespace (e.g. "kubernetes",
+// held until the namespace controller has deleted its contents), nil for other kinds
+func namespaceSpecFinalizers(obj *unstructured.Unstructured) []string {
+	if obj.GetKind() != "Namespace" || obj.GroupVersionKind().Group != "" {
+		return nil
+	}
+	finalizers, _, _ := unstructured.NestedStringSlice(obj.Object, "spec", "finalizers")
+	return finalizers
+}
+
+// clearNamespaceSpecFinalizers empties a Namespace's spec.finalizers through the finalize
+// subresource; the main endpoint ignores changes to them. Like kubectl replace --raw
+// /api/v1/namespaces/<name>/finalize, it sends the latest object so the update isn't
+// rejected as a conflict.
+func clearNamespaceSpecFinalizers(ctx context.Context, client k8sclient.K8sClient, gvr k8sschema.GroupVersionResource, obj *unstructured.Unstructured) error {
+	current, err := client.Get(ctx, gvr, obj.GetNamespace(), obj.GetName())
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to get namespace for finalize: %w", err)
+	}
+
+	if err := unstructured.SetNestedStringSlice(current.Object, []string{}, "spec", "finalizers"); err != nil {
+		return fmt.Errorf("failed to clear spec.finalizers: %w", err)
+	}
+	_, err = client.UpdateSubresource(ctx, gvr, current, metav1.UpdateOptions{FieldManager: "k8sconnect-force-destroy"}, "finalize")
+	if err != nil && !errors.IsNotFound(err) {
+		return fmt.Errorf("failed to clear spec.finalizers through the finalize subresource: %w", err)
+	}
+	return nil
+}
+
 // handleDeletionTimeout provides helpful guidance when normal deletion times out
 func (r *objectResource) handleDeletionTimeout(resp *resource.DeleteResponse, client k8sclient.K8sClient, gvr k8sschema.GroupVersionResource, obj *unstructured.Unstructured, timeout time.Duration, timeoutErr error) {
 	ctx := context.Background()
@@ -120,7 +178,7 @@ func (r *objectResource) handleDeletionTimeout(resp *resource.DeleteResponse, cl
 
 		// Can't get object state, provide generic timeout error
 		resp.Diagnostics.AddError(
-			"Deletion Timeout",
+			k8serrors.Summary(k8serrors.ErrorTypeDeleteTimeout, "Deletion Timeout"),
 			fmt.Sprintf("Resource %s %s could not be deleted within %v.\n\n"+
 				"The resource may still be terminating in the background. "+
 				"Check its status with: kubectl get %s %s %s\n\n"+
@@ -154,12 +212,21 @@ func (r *objectResource) handleDeletionTimeout(resp *resource.DeleteResponse, cl
 			msg.WriteString(diagnostics)
 		}
 
+		// Foreground deletion keeps the object until the garbage collector deleted its dependents
+		foreground := hasFinalizer(liveObj, metav1.FinalizerDeleteDependents)
+		if foreground {
+			msg.WriteString(r.explainForegroundDeletion(ctx, client, liveObj))
+		}
+
 		msg.WriteString("\n\nOptions:\n")
 		msg.WriteString(fmt.Sprintf("• Wait longer: delete_timeout = \"20m\"\n"))
 		msg.WriteString(fmt.Sprintf("• Investigate: kubectl describe %s %s %s\n", strings.ToLower(kind), name, r.namespaceFlag(obj)))
+		if foreground {
+			msg.WriteString("• Don't wait for dependents: delete_cascade = \"background\"\n")
+		}
 		msg.WriteString(fmt.Sprintf("• Force delete: force_destroy = true"))
 
-		resp.Diagnostics.AddError("Deletion Blocked by Finalizers", msg.String())
+		resp.Diagnostics.AddError(k8serrors.Summary(k8serrors.ErrorTypeDeleteBlocked, "Deletion Blocked by Finalizers"), msg.String())
 
 	} else if deletionTimestamp != nil {
 		// Object is terminating but no finalizers
@@ -171,12 +238,22 @@ func (r *objectResource) handleDeletionTimeout(resp *resource.DeleteResponse, cl
 			diagnostics := r.explainNamespaceDeletionFailure(ctx, client, name)
 			msg.WriteString(diagnostics)
 
+			// spec.finalizers block deletion too but can only be cleared via the finalize subresource
+			if specFinalizers := namespaceSpecFinalizers(liveObj); len(specFinalizers) > 0 {
+				msg.WriteString("\n\nspec.finalizers:\n")
+				for _, finalizer := range specFinalizers {
+					msg.WriteString(explainFinalizer(finalizer))
+					msg.WriteString("\n")
+				}
+				msg.WriteString("force_destroy clears these through the namespace's finalize subresource.")
+			}
+
 			msg.WriteString("\n\nOptions:\n")
 			msg.WriteString("• Increase timeout: delete_timeout = \"20m\"\n")
 			msg.WriteString(fmt.Sprintf("• Check status: kubectl get all -n %s\n", name))
 			msg.WriteString("• Force delete: force_destroy = true")
 
-			resp.Diagnostics.AddError("Namespace Deletion Timeout", msg.String())
+			resp.Diagnostics.AddError(k8serrors.Summary(k8serrors.ErrorTypeDeleteTimeout, "Namespace Deletion Timeout"), msg.String())
 		} else {
 			msg.WriteString(fmt.Sprintf("%s \"%s\" did not delete within %v\n\n", kind, name, timeout))
 			msg.WriteString("Options:\n")
@@ -184,7 +261,7 @@ func (r *objectResource) handleDeletionTimeout(resp *resource.DeleteResponse, cl
 			msg.WriteString(fmt.Sprintf("• Check status: kubectl describe %s %s %s\n", strings.ToLower(kind), name, r.namespaceFlag(obj)))
 			msg.WriteString("• Force delete: force_destroy = true")
 
-			resp.Diagnostics.AddError("Deletion Timeout", msg.String())
+			resp.Diagnostics.AddError(k8serrors.Summary(k8serrors.ErrorTypeDeleteTimeout, "Deletion Timeout"), msg.String())
 		}
 
 	} else {
@@ -197,10 +274,81 @@ func (r *objectResource) handleDeletionTimeout(resp *resource.DeleteResponse, cl
 		msg.WriteString(fmt.Sprintf("• Check status: kubectl describe %s %s %s\n", strings.ToLower(kind), name, r.namespaceFlag(obj)))
 		msg.WriteString("• Force delete: force_destroy = true")
 
-		resp.Diagnostics.AddError("Deletion Not Initiated", msg.String())
+		resp.Diagnostics.AddError(k8serrors.Summary(k8serrors.ErrorTypeDeleteTimeout, "Deletion Not Initiated"), msg.String())
 	}
 }
 
+// otherApplyManagers returns the field managers other than fieldManager that write obj
+// with Server-Side Apply, sorted. Update entries are left out, since controllers record
+// one on most objects they touch (the Deployment revision annotation, for example), and so
+// are status subresource entries.
+func otherApplyManagers(obj *unstructured.Unstructured, fieldManager string) []string {
+	seen := make(map[string]bool)
+	var managers []string
+	for _, entry := range obj.GetManagedFields() {
+		if entry.Manager == fieldManager || entry.Operation != metav1.ManagedFieldsOperationApply || entry.Subresource != "" || seen[entry.Manager] {
+			continue
+		}
+		seen[entry.Manager] = true
+		managers = append(managers, entry.Manager)
+	}
+	sort.Strings(managers)
+	return managers
+}
+
+// releaseFieldManager destroys data's share of an object other writers still apply to,
+// instead of deleting the object: applying it with no fields under the same field manager
+// removes the fields only this manager owned, and fields the other managers own stay set.
+func (r *objectResource) releaseFieldManager(ctx context.Context, client k8sclient.K8sClient, obj *unstructured.Unstructured, data *objectResourceModel, others []string, resp *resource.DeleteResponse) {
+	fieldManager := fieldManagerFor(data)
+	tflog.Info(ctx, "Object shared with other field managers, releasing its fields instead of deleting it", map[string]interface{}{
+		"resource":       formatResource(obj),
+		"field_manager":  fieldManager,
+		"other_managers": others,
+	})
+
+	empty := &unstructured.Unstructured{}
+	empty.SetAPIVersion(obj.GetAPIVersion())
+	empty.SetKind(obj.GetKind())
+	empty.SetName(obj.GetName())
+	empty.SetNamespace(obj.GetNamespace())
+
+	err := client.Apply(ctx, empty, k8sclient.ApplyOptions{FieldManager: fieldManager, Force: true})
+	if err != nil && !errors.IsNotFound(err) {
+		resourceDesc := fmt.Sprintf("%s %s", obj.GetKind(), obj.GetName())
+		severity, title, detail := r.classifyK8sError(err, "Delete", resourceDesc, obj.GetAPIVersion())
+		if severity == "warning" {
+			resp.Diagnostics.AddWarning(title, detail)
+		} else {
+			resp.Diagnostics.AddError(title, detail)
+		}
+	}
+}
+
+// getDeleteOptions builds the options for the delete request from delete_grace_period
+// and delete_cascade
+func getDeleteOptions(data objectResourceModel) k8sclient.DeleteOptions {
+	var options k8sclient.DeleteOptions
+	if !data.DeleteGracePeriod.IsNull() && !data.DeleteGracePeriod.IsUnknown() {
+		gracePeriod := data.DeleteGracePeriod.ValueInt64()
+		options.GracePeriodSeconds = &gracePeriod
+	}
+
+	var policy metav1.DeletionPropagation
+	switch data.DeleteCascade.ValueString() {
+	case deleteCascadeBackground:
+		policy = metav1.DeletePropagationBackground
+	case deleteCascadeForeground:
+		policy = metav1.DeletePropagationForeground
+	case deleteCascadeOrphan:
+		policy = metav1.DeletePropagationOrphan
+	}
+	if policy != "" {
+		options.PropagationPolicy = &policy
+	}
+	return options
+}
+
 // getDeleteTimeout determines the appropriate timeout for resource deletion
 func (r *objectResource) getDeleteTimeout(data objectResourceModel) time.Duration {
 	// If user specified a timeout, use it
@@ -274,7 +422,7 @@ func (r *objectResource) waitForDeletion(ctx context.Context, client k8sclient.K
 				})
 			} else if expectedTerraformID != "" {
 				// Object exists - check if it's been replaced by a different Terraform resource
-				currentID := r.getOwnershipID(liveObj)
+				currentID := r.getOwnershipID(liveObj, nil)
 				if currentID != "" && currentID != expectedTerraformID {
 					// Resource has been replaced during deletion - exit gracefully
 					tflog.Info(ctx, "Resource was replaced during deletion wait - exiting early", map[string]interface{}{
@@ -300,6 +448,60 @@ func (r *objectResource) waitForDeletion(ctx context.Context, client k8sclient.K
 	}
 }
 
+// waitForPriorDeletion blocks creation while an earlier object with the same name is still
+// terminating, when wait_for_deletion is set. Applying onto a terminating object doesn't
+// stop its deletion, so the new object would disappear with it. Objects that exist but are
+// not being deleted are left to the existence and ownership check.
+func (r *objectResource) waitForPriorDeletion(ctx context.Context, rc *ResourceContext, data *objectResourceModel, resp *resource.CreateResponse) error {
+	if !data.WaitForDeletion.ValueBool() || rc.GVR.Empty() {
+		return nil
+	}
+
+	existingObj, err := rc.Client.Get(ctx, rc.GVR, rc.Object.GetNamespace(), rc.Object.GetName())
+	if err != nil || existingObj.GetDeletionTimestamp() == nil {
+		return nil
+	}
+
+	timeout := r.getDeleteTimeout(*data)
+	tflog.Info(ctx, "Previous object still terminating, waiting for deletion before create", map[string]interface{}{
+		"kind":       rc.Object.GetKind(),
+		"name":       rc.Object.GetName(),
+		"namespace":  rc.Object.GetNamespace(),
+		"timeout":    timeout.String(),
+		"finalizers": existingObj.GetFinalizers(),
+	})
+
+	if err := r.waitForDeletion(ctx, rc.Client, rc.GVR, rc.Object, timeout, ""); err != nil {
+		kind := rc.Object.GetKind()
+		name := rc.Object.GetName()
+
+		var msg strings.Builder
+		msg.WriteString(fmt.Sprintf("A previous %s \"%s\" is still terminating and was not deleted within %v, so the new object was not created. "+
+			"Creating it now would apply onto the terminating object, which would then disappear.\n\n", kind, name, timeout))
+		if finalizers := existingObj.GetFinalizers(); len(finalizers) > 0 {
+			msg.WriteString("Deletion is waiting on finalizers:\n\n")
+			for _, finalizer := range finalizers {
+				msg.WriteString(explainFinalizer(finalizer))
+				msg.WriteString("\n")
+			}
+			msg.WriteString("\n")
+		}
+		msg.WriteString("Options:\n")
+		msg.WriteString("• Wait longer: delete_timeout = \"20m\"\n")
+		msg.WriteString(fmt.Sprintf("• Investigate: kubectl describe %s %s %s\n", strings.ToLower(kind), name, r.namespaceFlag(rc.Object)))
+		msg.WriteString("• Run terraform apply again once the old object is gone")
+
+		resp.Diagnostics.AddError(k8serrors.Summary(k8serrors.ErrorTypeDeleteTimeout, "Previous Object Still Terminating"), msg.String())
+		return err
+	}
+
+	tflog.Info(ctx, "Previous object deleted, continuing with create", map[string]interface{}{
+		"kind": rc.Object.GetKind(),
+		"name": rc.Object.GetName(),
+	})
+	return nil
+}
+
 // namespaceFlag returns the kubectl namespace flag for the given object
 func (r *objectResource) namespaceFlag(obj *unstructured.Unstructured) string {
 	if namespace := obj.GetNamespace(); namespace != "" {
@@ -316,12 +518,88 @@ func explainFinalizer(finalizer string) string {
 	return fmt.Sprintf("  • %s (custom finalizer - check controller logs)", finalizer)
 }
 
+// hasFinalizer reports whether obj carries finalizer in metadata.finalizers
+func hasFinalizer(obj *unstructured.Unstructured, finalizer string) bool {
+	for _, f := range obj.GetFinalizers() {
+		if f == finalizer {
+			return true
+		}
+	}
+	return false
+}
+
+// explainForegroundDeletion lists the dependents foreground deletion is still waiting for:
+// the objects owned by owner through ownerReferences, directly or through other dependents.
+// Dependents of a namespaced owner live in its namespace; those of a cluster-scoped owner
+// can be anywhere.
+func (r *objectResource) explainForegroundDeletion(ctx context.Context, client k8sclient.K8sClient, owner *unstructured.Unstructured) string {
+	objects, err := r.listObjects(ctx, client, owner.GetNamespace(), owner.GetNamespace() == "")
+	if err != nil {
+		return "\n(Could not list the remaining dependents: check cluster connectivity)"
+	}
+
+	dependents := remainingDependents(owner, objects)
+	if len(dependents) == 0 {
+		return "\nForeground deletion (delete_cascade = \"foreground\") has no dependents left; " +
+			"the garbage collector removes the foregroundDeletion finalizer next."
+	}
+
+	var msg strings.Builder
+	msg.WriteString(fmt.Sprintf("\nForeground deletion (delete_cascade = \"foreground\") is waiting for %d dependents to be deleted:\n", len(dependents)))
+	for i, dependent := range dependents {
+		if i >= maxDependentsToShow {
+			msg.WriteString(fmt.Sprintf("  ... and %d more\n", len(dependents)-maxDependentsToShow))
+			break
+		}
+		msg.WriteString(fmt.Sprintf("  - %s\n", dependent))
+	}
+	return strings.TrimSuffix(msg.String(), "\n")
+}
+
+// remainingDependents returns the objects that reference owner, or one of its dependents,
+// in their ownerReferences, as Kind/name with the namespace when it differs from owner's,
+// and the finalizers holding them
+func remainingDependents(owner *unstructured.Unstructured, objects []unstructured.Unstructured) []string {
+	owners := map[string]bool{string(owner.GetUID()): true}
+	found := map[string]bool{}
+	var dependents []string
+
+	// Each pass picks up the next generation, e.g. a Deployment's ReplicaSets, then their Pods
+	for changed := true; changed; {
+		changed = false
+		for i := range objects {
+			obj := &objects[i]
+			uid := string(obj.GetUID())
+			if found[uid] || owners[uid] {
+				continue
+			}
+			for _, ref := range obj.GetOwnerReferences() {
+				if !owners[string(ref.UID)] {
+					continue
+				}
+				owners[uid] = true
+				found[uid] = true
+				changed = true
+
+				desc := fmt.Sprintf("%s/%s", obj.GetKind(), obj.GetName())
+				if obj.GetNamespace() != owner.GetNamespace() {
+					desc = fmt.Sprintf("%s/%s/%s", obj.GetKind(), obj.GetNamespace(), obj.GetName())
+				}
+				if finalizers := obj.GetFinalizers(); len(finalizers) > 0 {
+					desc += fmt.Sprintf(" (finalizers: %v)", finalizers)
+				}
+				dependents = append(dependents, desc)
+				break
+			}
+		}
+	}
+	return dependents
+}
+
 // explainNamespaceDeletionFailure performs a diagnostic API call to understand why namespace deletion is slow
 func (r *objectResource) explainNamespaceDeletionFailure(ctx context.Context, client k8sclient.K8sClient, namespace string) string {
-	// Get all API resources to list everything in the namespace
-	// This is dynamic - no hardcoded resource types
-	_, apiResourcesList, err := r.getDiscoveryClient(client).ServerGroupsAndResources()
-	if err != nil && apiResourcesList == nil {
+	objects, err := r.listObjects(ctx, client, namespace, false)
+	if err != nil {
 		return "(Could not check namespace contents: check cluster connectivity)"
 	}
 
@@ -330,6 +608,37 @@ func (r *objectResource) explainNamespaceDeletionFailure(ctx context.Context, cl
 	resourcesWithFinalizers := []string{}
 	totalResources := 0
 
+	for _, item := range objects {
+		kind := item.GetKind()
+		resourceCounts[kind]++
+		totalResources++
+
+		// Check for finalizers
+		if finalizers := item.GetFinalizers(); len(finalizers) > 0 {
+			resourcesWithFinalizers = append(resourcesWithFinalizers,
+				fmt.Sprintf("%s/%s (finalizers: %v)", kind, item.GetName(), finalizers))
+		}
+	}
+
+	if totalResources == 0 {
+		return "Namespace is empty but deletion is taking longer than expected."
+	}
+
+	// Build the diagnostic message
+	return formatNamespaceDeletionDiagnostics(resourceCounts, resourcesWithFinalizers, totalResources)
+}
+
+// listObjects lists the objects of every listable resource type in namespace ("" for all
+// namespaces), only namespaced types unless includeClusterScoped is set. This is dynamic -
+// no hardcoded resource types. Types that can't be listed are skipped; an error is only
+// returned when discovery fails entirely.
+func (r *objectResource) listObjects(ctx context.Context, client k8sclient.K8sClient, namespace string, includeClusterScoped bool) ([]unstructured.Unstructured, error) {
+	_, apiResourcesList, err := r.getDiscoveryClient(client).ServerGroupsAndResources()
+	if err != nil && apiResourcesList == nil {
+		return nil, err
+	}
+
+	var objects []unstructured.Unstructured
 	// Iterate through all discoverable resource types
 	for _, apiResources := range apiResourcesList {
 		if apiResources == nil {
@@ -347,8 +656,7 @@ func (r *objectResource) explainNamespaceDeletionFailure(ctx context.Context, cl
 				continue
 			}
 
-			// Only list namespaced resources
-			if !apiResource.Namespaced {
+			if !apiResource.Namespaced && !includeClusterScoped {
 				continue
 			}
 
@@ -370,36 +678,25 @@ func (r *objectResource) explainNamespaceDeletionFailure(ctx context.Context, cl
 				Resource: apiResource.Name,
 			}
 
-			// List resources of this type in the namespace
-			list, err := client.List(ctx, gvr, namespace, metav1.ListOptions{})
+			listNamespace := namespace
+			if !apiResource.Namespaced {
+				listNamespace = ""
+			}
+			list, err := client.List(ctx, gvr, listNamespace, metav1.ListOptions{})
 			if err != nil {
 				// Ignore errors for individual resource types (might be deprecated or inaccessible)
 				continue
 			}
 
 			for _, item := range list.Items {
-				kind := item.GetKind()
-				if kind == "" {
-					kind = apiResource.Kind
-				}
-				resourceCounts[kind]++
-				totalResources++
-
-				// Check for finalizers
-				if finalizers := item.GetFinalizers(); len(finalizers) > 0 {
-					resourcesWithFinalizers = append(resourcesWithFinalizers,
-						fmt.Sprintf("%s/%s (finalizers: %v)", kind, item.GetName(), finalizers))
+				if item.GetKind() == "" {
+					item.SetKind(apiResource.Kind)
 				}
+				objects = append(objects, item)
 			}
 		}
 	}
-
-	if totalResources == 0 {
-		return "Namespace is empty but deletion is taking longer than expected."
-	}
-
-	// Build the diagnostic message
-	return formatNamespaceDeletionDiagnostics(resourceCounts, resourcesWithFinalizers, totalResources)
+	return objects, nil
 }
 
 // formatNamespaceDeletionDiagnostics creates a helpful message about namespace deletion progress
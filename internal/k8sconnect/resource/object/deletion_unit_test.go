@@ -14,6 +14,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	k8sschema "k8s.io/apimachinery/pkg/runtime/schema"
+	k8stypes "k8s.io/apimachinery/pkg/types"
 )
 
 func TestExplainFinalizer(t *testing.T) {
@@ -320,6 +321,50 @@ func TestGetDeleteTimeout(t *testing.T) {
 	}
 }
 
+func TestGetDeleteOptions(t *testing.T) {
+	tests := []struct {
+		name        string
+		gracePeriod types.Int64
+		expected    *int64
+	}{
+		{name: "unset", gracePeriod: types.Int64Null()},
+		{name: "unknown", gracePeriod: types.Int64Unknown()},
+		{name: "immediate", gracePeriod: types.Int64Value(0), expected: new(int64)},
+		{name: "explicit", gracePeriod: types.Int64Value(45), expected: func() *int64 { v := int64(45); return &v }()},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			options := getDeleteOptions(objectResourceModel{DeleteGracePeriod: tc.gracePeriod})
+			if options.PropagationPolicy != nil {
+				t.Errorf("expected no propagation policy, got %v", *options.PropagationPolicy)
+			}
+			switch {
+			case tc.expected == nil && options.GracePeriodSeconds != nil:
+				t.Errorf("expected no grace period, got %d", *options.GracePeriodSeconds)
+			case tc.expected != nil && options.GracePeriodSeconds == nil:
+				t.Errorf("expected grace period %d, got none", *tc.expected)
+			case tc.expected != nil && *options.GracePeriodSeconds != *tc.expected:
+				t.Errorf("expected grace period %d, got %d", *tc.expected, *options.GracePeriodSeconds)
+			}
+		})
+	}
+}
+
+func TestGetDeleteOptionsCascade(t *testing.T) {
+	tests := map[string]metav1.DeletionPropagation{
+		deleteCascadeBackground: metav1.DeletePropagationBackground,
+		deleteCascadeForeground: metav1.DeletePropagationForeground,
+		deleteCascadeOrphan:     metav1.DeletePropagationOrphan,
+	}
+	for cascade, expected := range tests {
+		options := getDeleteOptions(objectResourceModel{DeleteCascade: types.StringValue(cascade)})
+		if options.PropagationPolicy == nil || *options.PropagationPolicy != expected {
+			t.Errorf("delete_cascade %q: expected propagation policy %s, got %v", cascade, expected, options.PropagationPolicy)
+		}
+	}
+}
+
 func TestNamespaceFlag(t *testing.T) {
 	r := &objectResource{}
 
@@ -596,3 +641,249 @@ func TestForceDestroy(t *testing.T) {
 		})
 	}
 }
+
+func TestForceDestroyNamespaceSpecFinalizers(t *testing.T) {
+	ctx := context.Background()
+	r := &objectResource{}
+	gvr := k8sschema.GroupVersionResource{Version: "v1", Resource: "namespaces"}
+	namespace := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "Namespace",
+		"metadata":   map[string]interface{}{"name": "team-a"},
+		"spec":       map[string]interface{}{"finalizers": []interface{}{"kubernetes"}},
+		"status":     map[string]interface{}{"phase": "Terminating"},
+	}}
+
+	stub := k8sclient.NewStubK8sClient()
+	stub.GetResponse = namespace.DeepCopy()
+	stub.SimulateDeletedAfterMutation = true
+	resp := &resource.DeleteResponse{}
+
+	if err := r.forceDestroy(ctx, stub, gvr, namespace, resp); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// spec.finalizers are cleared through the finalize subresource, not a regular apply
+	if len(stub.ApplyCalls) != 0 {
+		t.Errorf("expected no apply without metadata.finalizers, got %d", len(stub.ApplyCalls))
+	}
+	if len(stub.UpdateSubresourceCalls) != 1 || stub.UpdateSubresourceCalls[0].Subresource != "finalize" {
+		t.Fatalf("expected one update of the finalize subresource, got %+v", stub.UpdateSubresourceCalls)
+	}
+	finalizers, found, _ := unstructured.NestedStringSlice(stub.UpdateSubresourceCalls[0].Object.Object, "spec", "finalizers")
+	if !found || len(finalizers) != 0 {
+		t.Errorf("expected empty spec.finalizers in the finalize request, got %v", finalizers)
+	}
+	if warnings := resp.Diagnostics.Warnings(); len(warnings) == 0 || !strings.Contains(warnings[0].Detail(), "[kubernetes] (spec.finalizers") {
+		t.Errorf("expected the warning to name the spec.finalizers, got %v", resp.Diagnostics)
+	}
+
+	// Other kinds have no spec.finalizers
+	pod := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "Pod",
+		"spec":       map[string]interface{}{"finalizers": []interface{}{"kubernetes"}},
+	}}
+	if got := namespaceSpecFinalizers(pod); got != nil {
+		t.Errorf("namespaceSpecFinalizers(Pod) = %v, want nil", got)
+	}
+}
+
+func TestWaitForPriorDeletion(t *testing.T) {
+	ctx := context.Background()
+	r := &objectResource{}
+	gvr := k8sschema.GroupVersionResource{Group: "example.com", Version: "v1", Resource: "databases"}
+
+	desired := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "example.com/v1",
+		"kind":       "Database",
+		"metadata":   map[string]interface{}{"name": "orders", "namespace": "default"},
+	}}
+	terminating := desired.DeepCopy()
+	terminating.SetDeletionTimestamp(&metav1.Time{Time: time.Now()})
+	terminating.SetFinalizers([]string{"example.com/backup"})
+
+	newData := func(waitForDeletion types.Bool) *objectResourceModel {
+		return &objectResourceModel{
+			WaitForDeletion: waitForDeletion,
+			DeleteTimeout:   types.StringValue("1s"),
+			YAMLBody:        types.StringValue("apiVersion: example.com/v1\nkind: Database\nmetadata:\n  name: orders\n"),
+		}
+	}
+
+	t.Run("disabled skips the lookup", func(t *testing.T) {
+		stub := k8sclient.NewStubK8sClient()
+		stub.GetResponse = terminating
+		rc := &ResourceContext{Client: stub, GVR: gvr, Object: desired}
+		resp := &resource.CreateResponse{}
+
+		if err := r.waitForPriorDeletion(ctx, rc, newData(types.BoolNull()), resp); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(stub.GetCalls) != 0 {
+			t.Errorf("expected no Get without wait_for_deletion, got %d", len(stub.GetCalls))
+		}
+	})
+
+	t.Run("existing object not terminating", func(t *testing.T) {
+		stub := k8sclient.NewStubK8sClient()
+		stub.GetResponse = desired
+		rc := &ResourceContext{Client: stub, GVR: gvr, Object: desired}
+		resp := &resource.CreateResponse{}
+
+		if err := r.waitForPriorDeletion(ctx, rc, newData(types.BoolValue(true)), resp); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if resp.Diagnostics.HasError() {
+			t.Errorf("unexpected diagnostics: %v", resp.Diagnostics)
+		}
+	})
+
+	t.Run("no previous object", func(t *testing.T) {
+		stub := k8sclient.NewStubK8sClient()
+		stub.GetError = errors.NewNotFound(gvr.GroupResource(), "orders")
+		rc := &ResourceContext{Client: stub, GVR: gvr, Object: desired}
+		resp := &resource.CreateResponse{}
+
+		if err := r.waitForPriorDeletion(ctx, rc, newData(types.BoolValue(true)), resp); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("terminating object that won't go away", func(t *testing.T) {
+		stub := k8sclient.NewStubK8sClient()
+		stub.GetResponse = terminating
+		rc := &ResourceContext{Client: stub, GVR: gvr, Object: desired}
+		resp := &resource.CreateResponse{}
+
+		if err := r.waitForPriorDeletion(ctx, rc, newData(types.BoolValue(true)), resp); err == nil {
+			t.Fatal("expected an error when the previous object is not deleted in time")
+		}
+		if !resp.Diagnostics.HasError() {
+			t.Fatal("expected an error diagnostic")
+		}
+		detail := resp.Diagnostics.Errors()[0].Detail()
+		for _, want := range []string{"still terminating", "example.com/backup", "delete_timeout"} {
+			if !strings.Contains(detail, want) {
+				t.Errorf("diagnostic detail missing %q:\n%s", want, detail)
+			}
+		}
+	})
+}
+
+// ownedObject builds an object with uid, optionally owned by ownerUID
+func ownedObject(kind, namespace, name, uid, ownerUID string) unstructured.Unstructured {
+	obj := unstructured.Unstructured{}
+	obj.SetKind(kind)
+	obj.SetNamespace(namespace)
+	obj.SetName(name)
+	obj.SetUID(k8stypes.UID(uid))
+	if ownerUID != "" {
+		obj.SetOwnerReferences([]metav1.OwnerReference{{Kind: "Owner", Name: "owner", UID: k8stypes.UID(ownerUID)}})
+	}
+	return obj
+}
+
+// listingClient lists the given objects by resource on top of stubWithDiscovery
+type listingClient struct {
+	stubWithDiscovery
+	objects map[string][]unstructured.Unstructured
+}
+
+func (c *listingClient) List(ctx context.Context, gvr k8sschema.GroupVersionResource, namespace string, opts metav1.ListOptions) (*unstructured.UnstructuredList, error) {
+	return &unstructured.UnstructuredList{Items: c.objects[gvr.Resource]}, nil
+}
+
+func TestExplainForegroundDeletion(t *testing.T) {
+	ctx := context.Background()
+	r := &objectResource{}
+
+	deployment := ownedObject("Deployment", "default", "web", "deploy-uid", "")
+	replicaSet := ownedObject("ReplicaSet", "default", "web-5d4f", "rs-uid", "deploy-uid")
+	pod := ownedObject("Pod", "default", "web-5d4f-abcde", "pod-uid", "rs-uid")
+	pod.SetFinalizers([]string{"example.com/drain"})
+	unrelated := ownedObject("Pod", "default", "other", "other-uid", "other-rs-uid")
+
+	client := &listingClient{
+		stubWithDiscovery: stubWithDiscovery{
+			K8sClient: k8sclient.NewStubK8sClient(),
+			resources: []*metav1.APIResourceList{
+				{GroupVersion: "v1", APIResources: []metav1.APIResource{{Name: "pods", Kind: "Pod", Namespaced: true, Verbs: []string{"list"}}}},
+				{GroupVersion: "apps/v1", APIResources: []metav1.APIResource{
+					{Name: "deployments", Kind: "Deployment", Namespaced: true, Verbs: []string{"list"}},
+					{Name: "replicasets", Kind: "ReplicaSet", Namespaced: true, Verbs: []string{"list"}},
+				}},
+			},
+		},
+		// Pods are listed before the ReplicaSet that owns them
+		objects: map[string][]unstructured.Unstructured{
+			"pods":        {pod, unrelated},
+			"deployments": {deployment},
+			"replicasets": {replicaSet},
+		},
+	}
+
+	msg := r.explainForegroundDeletion(ctx, client, &deployment)
+	if !strings.Contains(msg, "waiting for 2 dependents") {
+		t.Errorf("expected the ReplicaSet and its Pod as dependents, got: %s", msg)
+	}
+	if !strings.Contains(msg, "ReplicaSet/web-5d4f") || !strings.Contains(msg, "Pod/web-5d4f-abcde (finalizers: [example.com/drain])") {
+		t.Errorf("expected dependents with their finalizers, got: %s", msg)
+	}
+	if strings.Contains(msg, "Pod/other") {
+		t.Errorf("unrelated pod listed as a dependent: %s", msg)
+	}
+
+	client.objects = map[string][]unstructured.Unstructured{"deployments": {deployment}}
+	if msg := r.explainForegroundDeletion(ctx, client, &deployment); !strings.Contains(msg, "no dependents left") {
+		t.Errorf("expected no remaining dependents, got: %s", msg)
+	}
+}
+
+func TestReleaseFieldManagerKeepsOtherWriters(t *testing.T) {
+	ctx := context.Background()
+	r := &objectResource{}
+	shared := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "ConfigMap",
+		"metadata":   map[string]interface{}{"name": "shared", "namespace": "default"},
+		"data":       map[string]interface{}{"labels-key": "a", "data-key": "b"},
+	}}
+	shared.SetManagedFields([]metav1.ManagedFieldsEntry{
+		{Manager: "team-labels", Operation: metav1.ManagedFieldsOperationApply},
+		{Manager: "team-data", Operation: metav1.ManagedFieldsOperationApply},
+		{Manager: "kube-controller-manager", Operation: metav1.ManagedFieldsOperationUpdate},
+		{Manager: "status-writer", Operation: metav1.ManagedFieldsOperationApply, Subresource: "status"},
+	})
+
+	// Only other Server-Side Apply writers of the object itself keep it alive
+	if others := otherApplyManagers(shared, "team-labels"); len(others) != 1 || others[0] != "team-data" {
+		t.Fatalf("otherApplyManagers() = %v, want [team-data]", others)
+	}
+	if others := otherApplyManagers(shared, "k8sconnect"); len(others) != 2 || others[0] != "team-data" || others[1] != "team-labels" {
+		t.Errorf("otherApplyManagers() = %v, want [team-data team-labels]", others)
+	}
+
+	// Destroying team-labels applies an empty object with its manager and deletes nothing
+	stub := k8sclient.NewStubK8sClient()
+	data := &objectResourceModel{FieldManager: types.StringValue("team-labels")}
+	resp := &resource.DeleteResponse{}
+	r.releaseFieldManager(ctx, stub, shared, data, []string{"team-data"}, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+	if len(stub.DeleteCalls) != 0 {
+		t.Errorf("expected no delete, got %d", len(stub.DeleteCalls))
+	}
+	if len(stub.ApplyCalls) != 1 {
+		t.Fatalf("expected one apply, got %d", len(stub.ApplyCalls))
+	}
+	applied := stub.ApplyCalls[0]
+	if applied.Options.FieldManager != "team-labels" {
+		t.Errorf("expected the release to apply as team-labels, got %q", applied.Options.FieldManager)
+	}
+	if _, hasData := applied.Object.Object["data"]; hasData || applied.Object.GetName() != "shared" || applied.Object.GetNamespace() != "default" {
+		t.Errorf("expected an empty ConfigMap default/shared, got %v", applied.Object.Object)
+	}
+}
@@ -0,0 +1,217 @@
+package object
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/jmorris0x0/terraform-provider-k8sconnect/internal/k8sconnect/common/k8sclient"
+	"github.com/jmorris0x0/terraform-provider-k8sconnect/internal/k8sconnect/common/k8serrors"
+)
+
+// defaultDependsOnReadyTimeout bounds the depends_on_ready wait when depends_on_ready_timeout is not set
+const defaultDependsOnReadyTimeout = 5 * time.Minute
+
+// dependencyReadyPollInterval is how often depends_on_ready checks the dependencies still pending
+var dependencyReadyPollInterval = 2 * time.Second
+
+// dependencyRefAttrTypes are the attributes of a depends_on_ready entry: object_ref's, so an
+// object_ref can be passed as it is, plus an optional condition
+var dependencyRefAttrTypes = map[string]attr.Type{
+	"api_version": types.StringType,
+	"kind":        types.StringType,
+	"name":        types.StringType,
+	"namespace":   types.StringType,
+	"condition":   types.StringType,
+}
+
+// dependencyRefModel is a depends_on_ready entry
+type dependencyRefModel struct {
+	APIVersion types.String `tfsdk:"api_version"`
+	Kind       types.String `tfsdk:"kind"`
+	Name       types.String `tfsdk:"name"`
+	Namespace  types.String `tfsdk:"namespace"`
+	Condition  types.String `tfsdk:"condition"`
+}
+
+// ref returns the object the entry names
+func (d dependencyRefModel) ref() objectRefModel {
+	return objectRefModel{APIVersion: d.APIVersion, Kind: d.Kind, Name: d.Name, Namespace: d.Namespace}
+}
+
+// waitForDependenciesReady blocks creation until every depends_on_ready object is ready.
+// Terraform's ordering only guarantees that a namespace or CRD was applied, not that the API
+// server accepts objects for it yet, so parallel applies can still fail with "namespace not
+// found" or "no matches for kind" without this.
+func (r *objectResource) waitForDependenciesReady(ctx context.Context, rc *ResourceContext, data *objectResourceModel, resp *resource.CreateResponse) error {
+	if data.DependsOnReady.IsNull() || data.DependsOnReady.IsUnknown() || rc.Client == nil {
+		return nil
+	}
+
+	var dependencies []dependencyRefModel
+	if diags := data.DependsOnReady.ElementsAs(ctx, &dependencies, false); diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return fmt.Errorf("invalid depends_on_ready")
+	}
+	if len(dependencies) == 0 {
+		return nil
+	}
+
+	timeout := dependsOnReadyTimeout(data)
+	deadline := time.Now().Add(timeout)
+	for {
+		pending := pendingDependencies(ctx, rc.Client, dependencies)
+		if len(pending) == 0 {
+			break
+		}
+
+		if time.Now().After(deadline) {
+			var msg strings.Builder
+			msg.WriteString(fmt.Sprintf("%s was not created because depends_on_ready objects were not ready within %v:\n\n", formatResource(rc.Object), timeout))
+			for _, reason := range pending {
+				msg.WriteString(fmt.Sprintf("  • %s\n", reason))
+			}
+			msg.WriteString("\nOptions:\n")
+			msg.WriteString("• Wait longer: depends_on_ready_timeout = \"10m\"\n")
+			msg.WriteString("• Check that the dependencies are managed in this configuration or already exist in the cluster\n")
+			msg.WriteString("• Run terraform apply again once they are ready")
+
+			resp.Diagnostics.AddError(k8serrors.Summary(k8serrors.ErrorTypeWaitTimeout, "Dependencies Not Ready"), msg.String())
+			return fmt.Errorf("depends_on_ready timed out")
+		}
+
+		tflog.Debug(ctx, "Waiting for depends_on_ready objects", map[string]interface{}{
+			"resource": formatResource(rc.Object),
+			"pending":  pending,
+		})
+
+		select {
+		case <-ctx.Done():
+			resp.Diagnostics.AddError("Dependency Wait Interrupted", ctx.Err().Error())
+			return ctx.Err()
+		case <-time.After(dependencyReadyPollInterval):
+		}
+	}
+
+	// A CRD that wasn't established yet left the GVR unresolved in prepareContext
+	if rc.GVR.Empty() && rc.Object != nil {
+		if gvr, err := rc.Client.GetGVR(ctx, rc.Object); err == nil {
+			rc.GVR = gvr
+		}
+	}
+	return nil
+}
+
+// dependsOnReadyTimeout returns depends_on_ready_timeout, or the default when it's not set
+func dependsOnReadyTimeout(data *objectResourceModel) time.Duration {
+	if !data.DependsOnReadyTimeout.IsNull() && !data.DependsOnReadyTimeout.IsUnknown() {
+		if timeout, err := time.ParseDuration(data.DependsOnReadyTimeout.ValueString()); err == nil {
+			return timeout
+		}
+	}
+	return defaultDependsOnReadyTimeout
+}
+
+// pendingDependencies describes each dependency that isn't ready yet
+func pendingDependencies(ctx context.Context, client k8sclient.K8sClient, dependencies []dependencyRefModel) []string {
+	var pending []string
+	for _, dep := range dependencies {
+		if reason := dependencyNotReadyReason(ctx, client, dep); reason != "" {
+			pending = append(pending, fmt.Sprintf("%s: %s", dependencyDisplayName(dep.ref()), reason))
+		}
+	}
+	return pending
+}
+
+// dependencyNotReadyReason returns why dep isn't ready, or "" when it is
+func dependencyNotReadyReason(ctx context.Context, client k8sclient.K8sClient, dep dependencyRefModel) string {
+	ref := &unstructured.Unstructured{}
+	ref.SetAPIVersion(dep.APIVersion.ValueString())
+	ref.SetKind(dep.Kind.ValueString())
+
+	gvr, err := client.GetGVR(ctx, ref)
+	if err != nil {
+		return fmt.Sprintf("resource type not available (%v)", err)
+	}
+	live, err := client.Get(ctx, gvr, dep.Namespace.ValueString(), dep.Name.ValueString())
+	if errors.IsNotFound(err) {
+		return "not found"
+	}
+	if err != nil {
+		return err.Error()
+	}
+	if reason := dependencyReadiness(live); reason != "" {
+		return reason
+	}
+	if condition := dep.Condition.ValueString(); condition != "" {
+		return dependencyConditionReason(live, condition)
+	}
+	return ""
+}
+
+// dependencyConditionReason returns why obj's condition isn't True, or "" when it is
+func dependencyConditionReason(obj *unstructured.Unstructured, condition string) string {
+	conditions, _, _ := unstructured.NestedSlice(obj.Object, "status", "conditions")
+	for _, cond := range conditions {
+		condMap, ok := cond.(map[string]interface{})
+		if !ok || condMap["type"] != condition {
+			continue
+		}
+		if condMap["status"] == "True" {
+			return ""
+		}
+		if message, _ := condMap["message"].(string); message != "" {
+			return fmt.Sprintf("%s is %v: %s", condition, condMap["status"], message)
+		}
+		return fmt.Sprintf("%s is %v", condition, condMap["status"])
+	}
+	return fmt.Sprintf("no %s condition yet", condition)
+}
+
+// dependencyReadiness checks the kinds whose existence doesn't mean objects can be created
+// for them yet: a Namespace must be Active and a CRD Established. Other kinds are ready once
+// they exist.
+func dependencyReadiness(obj *unstructured.Unstructured) string {
+	switch obj.GetKind() {
+	case "Namespace":
+		phase, _, _ := unstructured.NestedString(obj.Object, "status", "phase")
+		if phase != "Active" {
+			if phase == "" {
+				return "phase is not Active yet"
+			}
+			return fmt.Sprintf("phase is %s", phase)
+		}
+	case "CustomResourceDefinition":
+		conditions, _, _ := unstructured.NestedSlice(obj.Object, "status", "conditions")
+		for _, cond := range conditions {
+			condMap, ok := cond.(map[string]interface{})
+			if !ok || condMap["type"] != "Established" {
+				continue
+			}
+			if condMap["status"] == "True" {
+				return ""
+			}
+			if message, _ := condMap["message"].(string); message != "" {
+				return fmt.Sprintf("not Established: %s", message)
+			}
+		}
+		return "not Established yet"
+	}
+	return ""
+}
+
+// dependencyDisplayName formats a dependency as Kind namespace/name, or Kind name when cluster-scoped
+func dependencyDisplayName(dep objectRefModel) string {
+	if namespace := dep.Namespace.ValueString(); namespace != "" {
+		return fmt.Sprintf("%s %s/%s", dep.Kind.ValueString(), namespace, dep.Name.ValueString())
+	}
+	return fmt.Sprintf("%s %s", dep.Kind.ValueString(), dep.Name.ValueString())
+}
@@ -0,0 +1,152 @@
+package object
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/jmorris0x0/terraform-provider-k8sconnect/internal/k8sconnect/common/k8sclient"
+)
+
+func TestDependencyReadiness(t *testing.T) {
+	namespace := func(phase string) *unstructured.Unstructured {
+		obj := &unstructured.Unstructured{Object: map[string]interface{}{"apiVersion": "v1", "kind": "Namespace"}}
+		if phase != "" {
+			_ = unstructured.SetNestedField(obj.Object, phase, "status", "phase")
+		}
+		return obj
+	}
+	crd := func(status string) *unstructured.Unstructured {
+		return &unstructured.Unstructured{Object: map[string]interface{}{
+			"apiVersion": "apiextensions.k8s.io/v1",
+			"kind":       "CustomResourceDefinition",
+			"status": map[string]interface{}{
+				"conditions": []interface{}{map[string]interface{}{"type": "Established", "status": status}},
+			},
+		}}
+	}
+
+	tests := []struct {
+		name string
+		obj  *unstructured.Unstructured
+		want string
+	}{
+		{"active namespace", namespace("Active"), ""},
+		{"terminating namespace", namespace("Terminating"), "phase is Terminating"},
+		{"namespace without phase", namespace(""), "phase is not Active yet"},
+		{"established CRD", crd("True"), ""},
+		{"CRD not yet established", crd("False"), "not Established yet"},
+		{"other kinds only need to exist", &unstructured.Unstructured{Object: map[string]interface{}{"kind": "ConfigMap"}}, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := dependencyReadiness(tt.obj); got != tt.want {
+				t.Errorf("dependencyReadiness() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDependencyConditionReason(t *testing.T) {
+	deployment := func(conditions ...interface{}) *unstructured.Unstructured {
+		return &unstructured.Unstructured{Object: map[string]interface{}{
+			"kind":   "Deployment",
+			"status": map[string]interface{}{"conditions": conditions},
+		}}
+	}
+
+	tests := []struct {
+		name string
+		obj  *unstructured.Unstructured
+		want string
+	}{
+		{"condition true", deployment(map[string]interface{}{"type": "Available", "status": "True"}), ""},
+		{"condition false", deployment(map[string]interface{}{"type": "Available", "status": "False", "message": "0/1 replicas available"}),
+			"Available is False: 0/1 replicas available"},
+		{"condition missing", deployment(map[string]interface{}{"type": "Progressing", "status": "True"}), "no Available condition yet"},
+		{"no status", &unstructured.Unstructured{Object: map[string]interface{}{"kind": "Deployment"}}, "no Available condition yet"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := dependencyConditionReason(tt.obj, "Available"); got != tt.want {
+				t.Errorf("dependencyConditionReason() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWaitForDependenciesReady(t *testing.T) {
+	ctx := context.Background()
+	r := &objectResource{}
+	defer func(interval time.Duration) { dependencyReadyPollInterval = interval }(dependencyReadyPollInterval)
+	dependencyReadyPollInterval = 10 * time.Millisecond
+
+	desired := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "ConfigMap",
+		"metadata":   map[string]interface{}{"name": "settings", "namespace": "team-a"},
+	}}
+	newData := func() *objectResourceModel {
+		namespaceRef := types.ObjectValueMust(dependencyRefAttrTypes, map[string]attr.Value{
+			"api_version": types.StringValue("v1"),
+			"kind":        types.StringValue("Namespace"),
+			"name":        types.StringValue("team-a"),
+			"namespace":   types.StringNull(),
+			"condition":   types.StringNull(),
+		})
+		return &objectResourceModel{
+			DependsOnReady:        types.ListValueMust(types.ObjectType{AttrTypes: dependencyRefAttrTypes}, []attr.Value{namespaceRef}),
+			DependsOnReadyTimeout: types.StringValue("200ms"),
+		}
+	}
+	namespace := func(phase string) *unstructured.Unstructured {
+		return &unstructured.Unstructured{Object: map[string]interface{}{
+			"apiVersion": "v1",
+			"kind":       "Namespace",
+			"metadata":   map[string]interface{}{"name": "team-a"},
+			"status":     map[string]interface{}{"phase": phase},
+		}}
+	}
+
+	t.Run("waits until the namespace is active", func(t *testing.T) {
+		client := &namespaceSequenceClient{K8sClient: k8sclient.NewStubK8sClient(), responses: []*unstructured.Unstructured{
+			nil, namespace(""), namespace("Active"),
+		}}
+		resp := &resource.CreateResponse{}
+		if err := r.waitForDependenciesReady(ctx, &ResourceContext{Client: client, Object: desired}, newData(), resp); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if client.gets != 3 {
+			t.Errorf("expected 3 lookups, got %d", client.gets)
+		}
+	})
+
+	t.Run("bounded by depends_on_ready_timeout", func(t *testing.T) {
+		client := &namespaceSequenceClient{K8sClient: k8sclient.NewStubK8sClient(), responses: []*unstructured.Unstructured{nil}}
+		resp := &resource.CreateResponse{}
+		if err := r.waitForDependenciesReady(ctx, &ResourceContext{Client: client, Object: desired}, newData(), resp); err == nil {
+			t.Fatal("expected an error when the namespace never appears")
+		}
+		diag := resp.Diagnostics.Errors()[0]
+		if diag.Summary() != "[WaitTimeout] Dependencies Not Ready" || !strings.Contains(diag.Detail(), "Namespace team-a: not found") {
+			t.Errorf("unexpected diagnostic: %s\n%s", diag.Summary(), diag.Detail())
+		}
+	})
+
+	t.Run("no dependencies", func(t *testing.T) {
+		client := &namespaceSequenceClient{K8sClient: k8sclient.NewStubK8sClient(), responses: []*unstructured.Unstructured{nil}}
+		data := &objectResourceModel{DependsOnReady: types.ListNull(types.ObjectType{AttrTypes: dependencyRefAttrTypes})}
+		if err := r.waitForDependenciesReady(ctx, &ResourceContext{Client: client, Object: desired}, data, &resource.CreateResponse{}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if client.gets != 0 {
+			t.Errorf("expected no lookups, got %d", client.gets)
+		}
+	})
+}
@@ -0,0 +1,77 @@
+package object
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestDriftCheckSkippable(t *testing.T) {
+	projection := types.MapValueMust(types.StringType, map[string]attr.Value{
+		"data.key": types.StringValue("value"),
+	})
+	model := func(detectDrift types.Bool, yamlBody string) objectResourceModel {
+		return objectResourceModel{
+			YAMLBody:               types.StringValue(yamlBody),
+			DetectDrift:            detectDrift,
+			Labels:                 types.MapNull(types.StringType),
+			Annotations:            types.MapNull(types.StringType),
+			IgnoreFields:           types.ListNull(types.StringType),
+			ManageScope:            types.ListNull(types.StringType),
+			FieldManager:           types.StringNull(),
+			FieldManagerOperation:  types.StringNull(),
+			AllowStatus:            types.BoolNull(),
+			ReplacementStrategy:    types.StringNull(),
+			ManagedStateProjection: projection,
+		}
+	}
+	disabled := types.BoolValue(false)
+
+	tests := []struct {
+		name    string
+		planned objectResourceModel
+		state   objectResourceModel
+		want    bool
+	}{
+		{"disabled and unchanged", model(disabled, "a"), model(disabled, "a"), true},
+		{"default enabled", model(types.BoolNull(), "a"), model(types.BoolNull(), "a"), false},
+		{"explicitly enabled", model(types.BoolValue(true), "a"), model(types.BoolValue(true), "a"), false},
+		{"yaml_body changed", model(disabled, "b"), model(disabled, "a"), false},
+		{"just disabled", model(disabled, "a"), model(types.BoolNull(), "a"), false},
+		{"just enabled", model(types.BoolValue(true), "a"), model(disabled, "a"), false},
+		{"labels changed", func() objectResourceModel {
+			m := model(disabled, "a")
+			m.Labels = types.MapValueMust(types.StringType, map[string]attr.Value{"team": types.StringValue("platform")})
+			return m
+		}(), model(disabled, "a"), false},
+		{"field_manager changed", func() objectResourceModel {
+			m := model(disabled, "a")
+			m.FieldManager = types.StringValue("team-labels")
+			return m
+		}(), model(disabled, "a"), false},
+		{"manage_scope changed", func() objectResourceModel {
+			m := model(disabled, "a")
+			m.ManageScope = types.ListValueMust(types.StringType, []attr.Value{types.StringValue("status")})
+			return m
+		}(), model(disabled, "a"), false},
+		{"reset_managed_fields turned on", func() objectResourceModel {
+			m := model(disabled, "a")
+			m.ResetManagedFields = types.BoolValue(true)
+			return m
+		}(), model(disabled, "a"), false},
+		{"no projection in state", model(disabled, "a"), func() objectResourceModel {
+			m := model(disabled, "a")
+			m.ManagedStateProjection = types.MapNull(types.StringType)
+			return m
+		}(), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := driftCheckSkippable(&tt.planned, &tt.state); got != tt.want {
+				t.Errorf("driftCheckSkippable() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -11,10 +11,12 @@ import (
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/hashicorp/terraform-plugin-testing/config"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
 
 	"github.com/jmorris0x0/terraform-provider-k8sconnect/internal/k8sconnect"
 	testhelpers "github.com/jmorris0x0/terraform-provider-k8sconnect/internal/k8sconnect/common/test"
@@ -303,6 +305,124 @@ YAML
 `, namespace, cmName, namespace)
 }
 
+func TestAccObjectResource_ExternalAnnotationNoDrift(t *testing.T) {
+	t.Parallel()
+
+	raw := os.Getenv("TF_ACC_KUBECONFIG")
+	if raw == "" {
+		t.Fatal("TF_ACC_KUBECONFIG must be set")
+	}
+
+	ns := fmt.Sprintf("ext-annot-ns-%d", time.Now().UnixNano()%1000000)
+	cmName := fmt.Sprintf("ext-annot-cm-%d", time.Now().UnixNano()%1000000)
+	k8sClient := testhelpers.CreateK8sClient(t, raw)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"k8sconnect": providerserver.NewProtocol6WithError(k8sconnect.New()),
+		},
+		Steps: []resource.TestStep{
+			// Step 1: Create the ConfigMap with our label and annotation
+			{
+				Config: testAccManifestConfigExternalAnnotation(ns, cmName, "value1"),
+				ConfigVariables: config.Variables{
+					"raw": config.StringVariable(raw),
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("k8sconnect_object.annotated", "managed_state_projection.metadata.annotations.example.com/team", "payments"),
+					testhelpers.CheckConfigMapAnnotation(k8sClient, ns, cmName, "example.com/team", "payments"),
+				),
+			},
+			// Step 2: A controller adds its own label and annotation - no drift
+			{
+				PreConfig: func() {
+					patch := []byte(`{"metadata":{"labels":{"app.kubernetes.io/managed-by":"operator"},"annotations":{"prometheus.io/scrape":"true"}}}`)
+					_, err := k8sClient.CoreV1().ConfigMaps(ns).Patch(context.Background(), cmName,
+						types.MergePatchType, patch, metav1.PatchOptions{FieldManager: "prometheus-operator"})
+					if err != nil {
+						t.Fatalf("Failed to add external annotation: %v", err)
+					}
+					t.Log("✅ Added external label and annotation")
+				},
+				Config: testAccManifestConfigExternalAnnotation(ns, cmName, "value1"),
+				ConfigVariables: config.Variables{
+					"raw": config.StringVariable(raw),
+				},
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: false,
+			},
+			// Step 3: Re-apply with a change - the controller's keys survive
+			{
+				Config: testAccManifestConfigExternalAnnotation(ns, cmName, "value2"),
+				ConfigVariables: config.Variables{
+					"raw": config.StringVariable(raw),
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testhelpers.CheckConfigMapData(k8sClient, ns, cmName, map[string]string{"key1": "value2"}),
+					testhelpers.CheckConfigMapAnnotation(k8sClient, ns, cmName, "example.com/team", "payments"),
+					testhelpers.CheckConfigMapAnnotation(k8sClient, ns, cmName, "prometheus.io/scrape", "true"),
+					func(*terraform.State) error {
+						cm, err := k8sClient.CoreV1().ConfigMaps(ns).Get(context.Background(), cmName, metav1.GetOptions{})
+						if err != nil {
+							return err
+						}
+						if cm.Labels["app.kubernetes.io/managed-by"] != "operator" {
+							return fmt.Errorf("external label was removed: %v", cm.Labels)
+						}
+						return nil
+					},
+				),
+			},
+		},
+		CheckDestroy: testhelpers.CheckConfigMapDestroy(k8sClient, ns, cmName),
+	})
+}
+
+func testAccManifestConfigExternalAnnotation(namespace, cmName, value string) string {
+	return fmt.Sprintf(`
+variable "raw" {
+  type = string
+}
+
+provider "k8sconnect" {}
+
+resource "k8sconnect_object" "annotated_namespace" {
+  yaml_body = <<YAML
+apiVersion: v1
+kind: Namespace
+metadata:
+  name: %s
+YAML
+
+  cluster = {
+    kubeconfig = var.raw
+  }
+}
+
+resource "k8sconnect_object" "annotated" {
+  yaml_body = <<YAML
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: %s
+  namespace: %s
+  labels:
+    app.kubernetes.io/name: settings
+  annotations:
+    example.com/team: payments
+data:
+  key1: %s
+YAML
+
+  cluster = {
+    kubeconfig = var.raw
+  }
+
+  depends_on = [k8sconnect_object.annotated_namespace]
+}
+`, namespace, cmName, namespace, value)
+}
+
 func TestAccObjectResource_DriftDetectionNestedStructures(t *testing.T) {
 	t.Parallel()
 
@@ -660,6 +780,182 @@ YAML
 `, namespace, name, namespace)
 }
 
+// TestAccObjectResource_ServiceAssignedFields verifies that the cluster IP and node ports the
+// API server assigns are adopted after the first apply: an update keeps them, and they
+// are owned by k8sconnect in managed_fields
+func TestAccObjectResource_ServiceAssignedFields(t *testing.T) {
+	t.Parallel()
+
+	raw := os.Getenv("TF_ACC_KUBECONFIG")
+	if raw == "" {
+		t.Fatal("TF_ACC_KUBECONFIG must be set")
+	}
+
+	ns := fmt.Sprintf("svc-assigned-ns-%d", time.Now().UnixNano()%1000000)
+	k8sClient := testhelpers.CreateK8sClient(t, raw)
+
+	// Values the server assigned on create, compared after the update
+	assigned := map[string]string{}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"k8sconnect": providerserver.NewProtocol6WithError(k8sconnect.New()),
+		},
+		Steps: []resource.TestStep{
+			// Step 1: Create a ClusterIP and a NodePort Service without clusterIP or nodePort
+			{
+				Config: testAccServiceAssignedFieldsConfig(ns, "v1"),
+				ConfigVariables: config.Variables{
+					"raw": config.StringVariable(raw),
+				},
+				Check: resource.ComposeTestCheckFunc(
+					recordServiceAssignedFields(k8sClient, ns, "cluster-ip", assigned),
+					recordServiceAssignedFields(k8sClient, ns, "node-port", assigned),
+				),
+			},
+			// Step 2: Update both and verify the assigned values survived
+			{
+				Config: testAccServiceAssignedFieldsConfig(ns, "v2"),
+				ConfigVariables: config.Variables{
+					"raw": config.StringVariable(raw),
+				},
+				Check: resource.ComposeTestCheckFunc(
+					checkServiceAssignedFieldsUnchanged(k8sClient, ns, "cluster-ip", assigned),
+					checkServiceAssignedFieldsUnchanged(k8sClient, ns, "node-port", assigned),
+					resource.TestCheckResourceAttr("k8sconnect_object.cluster_ip", "managed_fields.spec.clusterIP", "k8sconnect"),
+					resource.TestCheckResourceAttr("k8sconnect_object.node_port", "managed_fields.spec.clusterIP", "k8sconnect"),
+				),
+			},
+			// Step 3: No drift after adopting them
+			{
+				Config: testAccServiceAssignedFieldsConfig(ns, "v2"),
+				ConfigVariables: config.Variables{
+					"raw": config.StringVariable(raw),
+				},
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: false,
+			},
+		},
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			testhelpers.CheckServiceDestroy(k8sClient, ns, "cluster-ip"),
+			testhelpers.CheckServiceDestroy(k8sClient, ns, "node-port"),
+		),
+	})
+}
+
+func serviceAssignedFields(client kubernetes.Interface, namespace, name string) (string, error) {
+	svc, err := client.CoreV1().Services(namespace).Get(context.Background(), name, metav1.GetOptions{})
+	if err != nil {
+		return "", err
+	}
+	fields := svc.Spec.ClusterIP
+	for _, port := range svc.Spec.Ports {
+		fields += fmt.Sprintf(" %d:%d", port.Port, port.NodePort)
+	}
+	return fields, nil
+}
+
+func recordServiceAssignedFields(client kubernetes.Interface, namespace, name string, assigned map[string]string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		fields, err := serviceAssignedFields(client, namespace, name)
+		if err != nil {
+			return err
+		}
+		assigned[name] = fields
+		return nil
+	}
+}
+
+func checkServiceAssignedFieldsUnchanged(client kubernetes.Interface, namespace, name string, assigned map[string]string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		fields, err := serviceAssignedFields(client, namespace, name)
+		if err != nil {
+			return err
+		}
+		if fields != assigned[name] {
+			return fmt.Errorf("service %s/%s: assigned fields changed from %q to %q", namespace, name, assigned[name], fields)
+		}
+		return nil
+	}
+}
+
+func testAccServiceAssignedFieldsConfig(namespace, version string) string {
+	return fmt.Sprintf(`
+variable "raw" {
+  type = string
+}
+
+provider "k8sconnect" {}
+
+resource "k8sconnect_object" "namespace" {
+  yaml_body = <<YAML
+apiVersion: v1
+kind: Namespace
+metadata:
+  name: %[1]s
+YAML
+
+  cluster = {
+    kubeconfig = var.raw
+  }
+}
+
+resource "k8sconnect_object" "cluster_ip" {
+  yaml_body = <<YAML
+apiVersion: v1
+kind: Service
+metadata:
+  name: cluster-ip
+  namespace: %[1]s
+  labels:
+    version: %[2]s
+spec:
+  selector:
+    app: web
+  ports:
+  - name: http
+    port: 80
+    targetPort: 8080
+YAML
+
+  cluster = {
+    kubeconfig = var.raw
+  }
+
+  depends_on = [k8sconnect_object.namespace]
+}
+
+resource "k8sconnect_object" "node_port" {
+  yaml_body = <<YAML
+apiVersion: v1
+kind: Service
+metadata:
+  name: node-port
+  namespace: %[1]s
+  labels:
+    version: %[2]s
+spec:
+  type: NodePort
+  selector:
+    app: web
+  ports:
+  - name: http
+    port: 80
+    targetPort: 8080
+  - name: metrics
+    port: 9090
+    protocol: TCP
+YAML
+
+  cluster = {
+    kubeconfig = var.raw
+  }
+
+  depends_on = [k8sconnect_object.namespace]
+}
+`, namespace, version)
+}
+
 // TODO Need to add apply to this as well
 // And/Or, insert a Step 2.5 that tries to apply without force_conflicts and expects an error.
 func TestAccObjectResource_CombinedDriftScenarios(t *testing.T) {
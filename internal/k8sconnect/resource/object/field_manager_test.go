@@ -0,0 +1,134 @@
+package object_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/config"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/jmorris0x0/terraform-provider-k8sconnect/internal/k8sconnect"
+	testhelpers "github.com/jmorris0x0/terraform-provider-k8sconnect/internal/k8sconnect/common/test"
+)
+
+// Two resources own separate fields of one ConfigMap with their own field_manager.
+// Destroying one releases its fields and leaves the object and the other's fields in place.
+func TestAccObjectResource_FieldManagerDestroyKeepsOtherWriter(t *testing.T) {
+	t.Parallel()
+
+	raw := os.Getenv("TF_ACC_KUBECONFIG")
+	if raw == "" {
+		t.Fatal("TF_ACC_KUBECONFIG must be set")
+	}
+
+	ns := fmt.Sprintf("field-manager-ns-%d", time.Now().UnixNano()%1000000)
+	cmName := "shared-settings"
+	k8sClient := testhelpers.CreateK8sClient(t, raw)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"k8sconnect": providerserver.NewProtocol6WithError(k8sconnect.New()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:          testAccFieldManagerSharedConfig(ns, cmName, true),
+				ConfigVariables: config.Variables{"raw": config.StringVariable(raw)},
+				Check: resource.ComposeTestCheckFunc(
+					testhelpers.CheckConfigMapData(k8sClient, ns, cmName, map[string]string{
+						"theme":   "dark",
+						"timeout": "30s",
+					}),
+				),
+			},
+			// Drop the team-ui writer: its key goes, the object and team-api's key stay
+			{
+				Config:          testAccFieldManagerSharedConfig(ns, cmName, false),
+				ConfigVariables: config.Variables{"raw": config.StringVariable(raw)},
+				Check: resource.ComposeTestCheckFunc(
+					testhelpers.CheckConfigMapData(k8sClient, ns, cmName, map[string]string{"timeout": "30s"}),
+					checkConfigMapKeyAbsent(k8sClient, ns, cmName, "theme"),
+				),
+			},
+		},
+		CheckDestroy: testhelpers.CheckConfigMapDestroy(k8sClient, ns, cmName),
+	})
+}
+
+func testAccFieldManagerSharedConfig(namespace, cmName string, withUI bool) string {
+	ui := ""
+	if withUI {
+		ui = fmt.Sprintf(`
+resource "k8sconnect_object" "ui" {
+  yaml_body = <<YAML
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: %[2]s
+  namespace: %[1]s
+data:
+  theme: dark
+YAML
+
+  cluster       = { kubeconfig = var.raw }
+  field_manager = "team-ui"
+  depends_on    = [k8sconnect_object.api]
+}
+`, namespace, cmName)
+	}
+
+	return fmt.Sprintf(`
+variable "raw" {
+  type = string
+}
+
+provider "k8sconnect" {}
+
+resource "k8sconnect_object" "namespace" {
+  yaml_body = <<YAML
+apiVersion: v1
+kind: Namespace
+metadata:
+  name: %[1]s
+YAML
+
+  cluster = { kubeconfig = var.raw }
+}
+
+resource "k8sconnect_object" "api" {
+  yaml_body = <<YAML
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: %[2]s
+  namespace: %[1]s
+data:
+  timeout: 30s
+YAML
+
+  cluster       = { kubeconfig = var.raw }
+  field_manager = "team-api"
+  depends_on    = [k8sconnect_object.namespace]
+}
+%[3]s`, namespace, cmName, ui)
+}
+
+func checkConfigMapKeyAbsent(client kubernetes.Interface, namespace, name, key string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		cm, err := client.CoreV1().ConfigMaps(namespace).Get(context.Background(), name, metav1.GetOptions{})
+		if err != nil {
+			return fmt.Errorf("failed to get configmap %s/%s: %v", namespace, name, err)
+		}
+		if value, exists := cm.Data[key]; exists {
+			return fmt.Errorf("configmap %s/%s still has key %q (%q) after its writer was destroyed", namespace, name, key, value)
+		}
+		return nil
+	}
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"gopkg.in/yaml.v3"
+	"k8s.io/client-go/util/jsonpath"
 
 	"github.com/jmorris0x0/terraform-provider-k8sconnect/internal/k8sconnect/common/validation"
 )
@@ -38,6 +39,32 @@ func (v durationValidator) ValidateString(ctx context.Context, req validator.Str
 	}
 }
 
+// jsonPathValidator validates that a string is a JSONPath expression
+type jsonPathValidator struct{}
+
+func (v jsonPathValidator) Description(ctx context.Context) string {
+	return "validates that the value is a valid JSONPath expression"
+}
+
+func (v jsonPathValidator) MarkdownDescription(ctx context.Context) string {
+	return "validates that the value is a valid JSONPath expression (e.g., 'status.phase', '{.spec.replicas}')"
+}
+
+func (v jsonPathValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+	if err := jsonpath.New("validate").Parse(jsonPathTemplate(value)); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid JSONPath",
+			fmt.Sprintf("The value '%s' is not a valid JSONPath expression: %s. Use a path like 'status.phase' or '{.spec.replicas}'", value, err),
+		)
+	}
+}
+
 // yamlValidator validates that a string is valid YAML
 type yamlValidator struct {
 	singleDoc bool // If true, ensure it's a single document
@@ -0,0 +1,70 @@
+package object
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	utilrand "k8s.io/apimachinery/pkg/util/rand"
+)
+
+const (
+	// generatedNameSuffixLength and maxGeneratedNameBaseLength match the API server's
+	// name generator: a random suffix of 5 characters, with the prefix cut so the whole
+	// name fits the 63 characters that most kinds allow
+	generatedNameSuffixLength  = 5
+	maxGeneratedNameBaseLength = 63 - generatedNameSuffixLength
+)
+
+// usesGenerateName reports whether obj is named by metadata.generateName, i.e. yaml_body
+// has a generateName and no metadata.name
+func usesGenerateName(obj *unstructured.Unstructured) bool {
+	return obj.GetName() == "" && obj.GetGenerateName() != ""
+}
+
+// generatedNamePrefix returns the part of metadata.generateName a generated name starts with
+func generatedNamePrefix(obj *unstructured.Unstructured) string {
+	prefix := obj.GetGenerateName()
+	if len(prefix) > maxGeneratedNameBaseLength {
+		prefix = prefix[:maxGeneratedNameBaseLength]
+	}
+	return prefix
+}
+
+// resolveGeneratedName sets the name generated when a generateName object was created,
+// recorded in object_ref.name, so read, update and delete address the same object.
+// A name that doesn't start with the current generateName belongs to an earlier
+// prefix and is left unresolved, which plans a replacement.
+func resolveGeneratedName(ctx context.Context, obj *unstructured.Unstructured, objectRef types.Object) {
+	if !usesGenerateName(obj) {
+		return
+	}
+	name, known := objectRefName(ctx, objectRef)
+	if !known || name == "" || !strings.HasPrefix(name, generatedNamePrefix(obj)) {
+		return
+	}
+	obj.SetName(name)
+}
+
+// assignGeneratedName names a generateName object being created. Server-side apply
+// requires a name, so the provider generates it the way the API server would: the
+// generateName prefix plus a random suffix. A collision with an existing object fails
+// the ownership check like any other name.
+func assignGeneratedName(obj *unstructured.Unstructured) {
+	obj.SetName(generatedNamePrefix(obj) + utilrand.String(generatedNameSuffixLength))
+}
+
+// objectRefName returns object_ref.name. known is false when object_ref is null or
+// unknown, i.e. the object hasn't been created yet.
+func objectRefName(ctx context.Context, objectRef types.Object) (name string, known bool) {
+	if objectRef.IsNull() || objectRef.IsUnknown() {
+		return "", false
+	}
+	var ref objectRefModel
+	if diags := objectRef.As(ctx, &ref, basetypes.ObjectAsOptions{}); diags.HasError() || ref.Name.IsUnknown() {
+		return "", false
+	}
+	return ref.Name.ValueString(), true
+}
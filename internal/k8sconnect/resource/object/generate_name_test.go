@@ -0,0 +1,111 @@
+package object
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func generateNameObject(generateName string) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "ConfigMap",
+		"metadata":   map[string]interface{}{"namespace": "default"},
+	}}
+	obj.SetGenerateName(generateName)
+	return obj
+}
+
+var objectRefTestAttrTypes = map[string]attr.Type{
+	"api_version": types.StringType,
+	"kind":        types.StringType,
+	"name":        types.StringType,
+	"namespace":   types.StringType,
+}
+
+func objectRefWithName(t *testing.T, name types.String) types.Object {
+	t.Helper()
+	ref, diags := types.ObjectValue(objectRefTestAttrTypes, map[string]attr.Value{
+		"api_version": types.StringValue("v1"),
+		"kind":        types.StringValue("ConfigMap"),
+		"name":        name,
+		"namespace":   types.StringValue("default"),
+	})
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+	return ref
+}
+
+func TestAssignGeneratedName(t *testing.T) {
+	obj := generateNameObject("test-run-")
+	assignGeneratedName(obj)
+	if name := obj.GetName(); !strings.HasPrefix(name, "test-run-") || len(name) != len("test-run-")+generatedNameSuffixLength {
+		t.Errorf("expected test-run- plus a %d character suffix, got %q", generatedNameSuffixLength, name)
+	}
+	if usesGenerateName(obj) {
+		t.Error("a named object should no longer use generateName")
+	}
+
+	first := obj.GetName()
+	again := generateNameObject("test-run-")
+	assignGeneratedName(again)
+	if again.GetName() == first {
+		t.Errorf("expected a new name for each create, got %q twice", first)
+	}
+
+	long := generateNameObject(strings.Repeat("a", 70))
+	assignGeneratedName(long)
+	if len(long.GetName()) != 63 {
+		t.Errorf("expected the prefix to be cut to fit 63 characters, got %d", len(long.GetName()))
+	}
+}
+
+func TestResolveGeneratedName(t *testing.T) {
+	ctx := context.Background()
+	tests := []struct {
+		name      string
+		obj       *unstructured.Unstructured
+		objectRef types.Object
+		want      string
+	}{
+		{"recorded name", generateNameObject("test-run-"), objectRefWithName(t, types.StringValue("test-run-x7k2p")), "test-run-x7k2p"},
+		{"not created yet", generateNameObject("test-run-"), types.ObjectUnknown(objectRefTestAttrTypes), ""},
+		{"no object_ref", generateNameObject("test-run-"), types.ObjectNull(objectRefTestAttrTypes), ""},
+		{"unknown name", generateNameObject("test-run-"), objectRefWithName(t, types.StringUnknown()), ""},
+		{"prefix changed", generateNameObject("smoke-"), objectRefWithName(t, types.StringValue("test-run-x7k2p")), ""},
+		{"explicit name wins", func() *unstructured.Unstructured {
+			obj := generateNameObject("test-run-")
+			obj.SetName("fixed")
+			return obj
+		}(), objectRefWithName(t, types.StringValue("test-run-x7k2p")), "fixed"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resolveGeneratedName(ctx, tt.obj, tt.objectRef)
+			if got := tt.obj.GetName(); got != tt.want {
+				t.Errorf("name = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseYAML_GenerateName(t *testing.T) {
+	r := &objectResource{}
+	obj, err := r.parseYAML("apiVersion: v1\nkind: ConfigMap\nmetadata:\n  generateName: test-run-\n")
+	if err != nil {
+		t.Fatalf("expected generateName without a name to parse, got %v", err)
+	}
+	if !usesGenerateName(obj) {
+		t.Error("expected the object to use generateName")
+	}
+
+	if _, err := r.parseYAML("apiVersion: v1\nkind: ConfigMap\nmetadata:\n  labels:\n    a: b\n"); err == nil ||
+		!strings.Contains(err.Error(), "metadata.name or metadata.generateName is required") {
+		t.Errorf("expected an error without name or generateName, got %v", err)
+	}
+}
@@ -9,6 +9,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/jmorris0x0/terraform-provider-k8sconnect/internal/k8sconnect/common/auth"
 )
 
 // IdentityChange represents a change to a Kubernetes resource identity field
@@ -47,6 +49,15 @@ func (r *objectResource) checkResourceIdentityChanges(
 		return false
 	}
 
+	// Blue-green names derive from the full object, so an unknown yaml_body,
+	// labels/annotations, or recreate_token means the name may change - replace rather
+	// than risk an in-place update that would create a second object and orphan the first
+	if isBlueGreen(plannedData) && (plannedData.YAMLBody.IsUnknown() || hasUnknownCommonMetadata(plannedData) || plannedData.RecreateToken.IsUnknown()) {
+		tflog.Info(ctx, "Blue-green object name unknown during plan, triggering replacement")
+		resp.RequiresReplace = append(resp.RequiresReplace, path.Root("yaml_body"))
+		return true
+	}
+
 	// Parse plan YAML (what user wants to apply now)
 	planObj, err := r.parseYAML(plannedData.YAMLBody.ValueString())
 	if err != nil {
@@ -55,6 +66,27 @@ func (r *objectResource) checkResourceIdentityChanges(
 		return false
 	}
 
+	// Compare the names that are actually applied when either side uses blue-green
+	if isBlueGreen(plannedData) || isBlueGreen(&stateData) {
+		if err := resolveAppliedName(ctx, stateObj, &stateData); err != nil {
+			return false
+		}
+		if err := resolveAppliedName(ctx, planObj, plannedData); err != nil {
+			// Reported by ModifyPlan with full context
+			return false
+		}
+	}
+
+	// generateName: both sides resolve to the name generated at create, unless the prefix changed
+	resolveGeneratedName(ctx, stateObj, stateData.ObjectRef)
+	resolveGeneratedName(ctx, planObj, stateData.ObjectRef)
+
+	// An omitted metadata.namespace resolves to the connection's default namespace, which
+	// changes with cluster.namespace or the kubeconfig context
+	if stateObj.GetNamespace() == "" && planObj.GetNamespace() == "" {
+		r.resolveDefaultNamespaces(ctx, stateObj, planObj, &stateData, plannedData, resp)
+	}
+
 	// Detect identity changes
 	identityChanges := r.detectIdentityChanges(stateObj, planObj)
 
@@ -94,6 +126,48 @@ func (r *objectResource) checkResourceIdentityChanges(
 	return false
 }
 
+// resolveAppliedName applies the same metadata merge and naming as prepareContext,
+// so the object carries the name that is sent to the cluster
+func resolveAppliedName(ctx context.Context, obj *unstructured.Unstructured, data *objectResourceModel) error {
+	if err := mergeCommonMetadata(ctx, obj, data); err != nil {
+		return err
+	}
+	return applyReplacementStrategy(obj, data)
+}
+
+// resolveDefaultNamespaces sets the namespace the state object was created in (from
+// object_ref) and the one the plan object will be applied to. Only a changed
+// cluster.namespace is an identity change; when just the implicit default changed, the
+// object stays in its namespace and a warning says where new objects would go. Both stay
+// empty for cluster-scoped objects and when the planned connection isn't known yet.
+func (r *objectResource) resolveDefaultNamespaces(ctx context.Context, stateObj, planObj *unstructured.Unstructured, stateData, plannedData *objectResourceModel, resp *resource.ModifyPlanResponse) {
+	stateNamespace, known := objectRefNamespace(ctx, stateData.ObjectRef)
+	if !known || stateNamespace == "" || !r.isConnectionReady(plannedData.Cluster) {
+		return
+	}
+	conn, err := r.convertObjectToConnectionModel(ctx, plannedData.Cluster)
+	if err != nil {
+		return
+	}
+	stateObj.SetNamespace(stateNamespace)
+
+	defaultNamespace := auth.DefaultNamespace(conn)
+	if _, kept := stateDefaultNamespace(ctx, stateData, plannedData); !kept {
+		planObj.SetNamespace(defaultNamespace)
+		return
+	}
+	planObj.SetNamespace(stateNamespace)
+	if defaultNamespace != stateNamespace {
+		resp.Diagnostics.AddWarning(
+			"Default Namespace Changed",
+			fmt.Sprintf("%s omits metadata.namespace and stays in namespace %q, where it was created. "+
+				"The connection's default namespace is now %q, so new objects without a namespace would go there.\n\n"+
+				"To move this object, set metadata.namespace or cluster.namespace; it will be replaced in the new namespace.",
+				formatResourceIdentity(stateObj), stateNamespace, defaultNamespace),
+		)
+	}
+}
+
 // detectIdentityChanges compares identity fields between state and plan objects.
 // Returns a list of changes found.
 func (r *objectResource) detectIdentityChanges(stateObj, planObj *unstructured.Unstructured) []IdentityChange {
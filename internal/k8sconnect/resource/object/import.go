@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/tools/clientcmd"
 
 	"github.com/jmorris0x0/terraform-provider-k8sconnect/internal/k8sconnect/common"
 	"github.com/jmorris0x0/terraform-provider-k8sconnect/internal/k8sconnect/common/auth"
@@ -34,7 +35,7 @@ func (r *objectResource) loadKubeconfig(ctx context.Context, resp *resource.Impo
 				"KUBECONFIG environment variable is not set and HOME directory could not be determined.\n\n"+
 					"Set KUBECONFIG environment variable:\n"+
 					"  export KUBECONFIG=~/.kube/config\n"+
-					"  terraform import k8sconnect_object.example \"prod/default/Pod/nginx\"",
+					"  terraform import k8sconnect_object.example \"prod:default:v1/Pod:nginx\"",
 			)
 			return "", nil, false
 		}
@@ -52,7 +53,7 @@ func (r *objectResource) loadKubeconfig(ctx context.Context, resp *resource.Impo
 			fmt.Sprintf("Kubeconfig file not found at: %s\n\n"+
 				"Ensure your kubeconfig file exists or set KUBECONFIG environment variable:\n"+
 				"  export KUBECONFIG=/path/to/your/kubeconfig\n"+
-				"  terraform import k8sconnect_object.example \"prod/default/Pod/nginx\"", kubeconfigPath),
+				"  terraform import k8sconnect_object.example \"prod:default:v1/Pod:nginx\"", kubeconfigPath),
 		)
 		return "", nil, false
 	}
@@ -70,17 +71,73 @@ func (r *objectResource) loadKubeconfig(ctx context.Context, resp *resource.Impo
 	return kubeconfigPath, kubeconfigData, true
 }
 
-// createImportClient creates a Kubernetes client for import operations
-// Returns the client and true on success; nil and false on error
-func (r *objectResource) createImportClient(ctx context.Context, kubeconfigData []byte, kubeconfigPath, kubeContext string, resp *resource.ImportStateResponse) (k8sclient.K8sClient, bool) {
-	// Create temporary connection model for import
-	tempConn := auth.ClusterModel{
-		Kubeconfig: types.StringValue(string(kubeconfigData)),
-		Context:    types.StringValue(kubeContext),
+// resolveImportConnection picks the connection used to import: the provider's import_cluster
+// when configured, otherwise the kubeconfig from KUBECONFIG or ~/.kube/config.
+// Returns the connection, where it came from (for messages), and true on success.
+func (r *objectResource) resolveImportConnection(ctx context.Context, kubeContext string, resp *resource.ImportStateResponse) (auth.ClusterModel, string, bool) {
+	if r.importCluster == nil {
+		kubeconfigPath, kubeconfigData, ok := r.loadKubeconfig(ctx, resp)
+		if !ok {
+			return auth.ClusterModel{}, "", false
+		}
+		// Use the file contents, not the path, so state does not depend on the importing machine
+		return auth.ClusterModel{
+			Host:                 types.StringNull(),
+			ClusterCACertificate: types.StringNull(),
+			Kubeconfig:           types.StringValue(string(kubeconfigData)),
+			Context:              types.StringValue(kubeContext),
+			Exec:                 nil,
+		}, kubeconfigPath, true
 	}
 
+	conn, err := importConnectionForContext(*r.importCluster, kubeContext)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Import Failed: Context Mismatch",
+			fmt.Sprintf("%s\n\n"+
+				"Solutions:\n"+
+				"• Use %q as the first part of the import ID\n"+
+				"• Remove context from import_cluster so the import ID selects it\n"+
+				"• Import with a provider alias whose import_cluster targets context %q",
+				err.Error(), r.importCluster.Context.ValueString(), kubeContext),
+		)
+		return auth.ClusterModel{}, "", false
+	}
+
+	return conn, "provider import_cluster", true
+}
+
+// importConnectionForContext applies the import ID's context to the provider's import_cluster.
+// Kubeconfig connections without a context use the ID's context, and a configured context must
+// match it. Inline connections have no contexts, so the ID's context is only a label there.
+// The result is stored as the imported cluster, so the context is only added when it changes
+// which context is used: a resource configured like import_cluster then plans without a diff.
+func importConnectionForContext(conn auth.ClusterModel, kubeContext string) (auth.ClusterModel, error) {
+	if !conn.Host.IsNull() {
+		return conn, nil
+	}
+
+	if conn.Context.IsNull() || conn.Context.ValueString() == "" {
+		if !conn.Kubeconfig.IsNull() && kubeconfigSoleContext([]byte(conn.Kubeconfig.ValueString())) == kubeContext {
+			return conn, nil
+		}
+		conn.Context = types.StringValue(kubeContext)
+		return conn, nil
+	}
+
+	if conn.Context.ValueString() != kubeContext {
+		return conn, fmt.Errorf("the import ID uses context %q, but the provider's import_cluster sets context = %q",
+			kubeContext, conn.Context.ValueString())
+	}
+
+	return conn, nil
+}
+
+// createImportClient creates a Kubernetes client for import operations
+// Returns the client and true on success; nil and false on error
+func (r *objectResource) createImportClient(ctx context.Context, conn auth.ClusterModel, source string, resp *resource.ImportStateResponse) (k8sclient.K8sClient, bool) {
 	// Create REST config from connection model
-	restConfig, err := auth.CreateRESTConfig(ctx, tempConn)
+	restConfig, err := auth.CreateRESTConfig(ctx, conn)
 	if err != nil {
 		// Provide context-specific error messages
 		if strings.Contains(err.Error(), "context") && strings.Contains(err.Error(), "not found") {
@@ -89,25 +146,25 @@ func (r *objectResource) createImportClient(ctx context.Context, kubeconfigData
 				fmt.Sprintf("Context \"%s\" not found in kubeconfig.\n\n"+
 					"Available contexts:\n"+
 					"  kubectl config get-contexts\n\n"+
-					"Details: %s", kubeContext, err.Error()),
+					"Details: %s", conn.Context.ValueString(), err.Error()),
 			)
 		} else if strings.Contains(err.Error(), "kubeconfig") {
 			resp.Diagnostics.AddError(
 				"Import Failed: Invalid Kubeconfig",
-				fmt.Sprintf("Failed to parse kubeconfig file at %s.\n\n"+
+				fmt.Sprintf("Failed to parse kubeconfig from %s.\n\n"+
 					"Ensure your kubeconfig is valid:\n"+
 					"  kubectl config view\n\n"+
-					"Details: %s", kubeconfigPath, err.Error()),
+					"Details: %s", source, err.Error()),
 			)
 		} else {
 			resp.Diagnostics.AddError(
 				"Import Failed: Connection Error",
-				fmt.Sprintf("Failed to create Kubernetes client from kubeconfig.\n\n"+
+				fmt.Sprintf("Failed to create Kubernetes client from %s.\n\n"+
 					"This usually means:\n"+
 					"1. Invalid kubeconfig file\n"+
 					"2. Cluster is unreachable\n"+
 					"3. Context credentials have expired\n\n"+
-					"Details: %s", err.Error()),
+					"Details: %s", source, err.Error()),
 			)
 		}
 		return nil, false
@@ -210,6 +267,9 @@ func (r *objectResource) extractProjectionAndOwnership(ctx context.Context, live
 			fmt.Sprintf("Failed to project managed fields during import: %s", err))
 		return nil, types.MapNull(types.StringType), types.MapNull(types.StringType), nil, false
 	}
+	normalizeFinalizers(projection, liveObj.Object)
+	normalizeBinaryData(projection)
+	normalizeIntOrString(projection)
 
 	// Convert projection to flat map for clean diff display
 	projectionMap := flattenProjectionToMap(projection, paths)
@@ -253,17 +313,8 @@ func (r *objectResource) extractProjectionAndOwnership(ctx context.Context, live
 
 // buildImportState builds the final import state and sets it on the response
 // Returns true on success; false on error
-func (r *objectResource) buildImportState(ctx context.Context, resourceID string, yamlBytes []byte, kubeconfigData []byte, kubeContext string, liveObj *unstructured.Unstructured, projectionMapValue, managedFieldsMap types.Map, kubeconfigPath string, namespace, name, kind string, paths []string, resp *resource.ImportStateResponse) bool {
-	// Create connection model for import - use the file contents, not the path
-	conn := auth.ClusterModel{
-		Host:                 types.StringNull(),
-		ClusterCACertificate: types.StringNull(),
-		Kubeconfig:           types.StringValue(string(kubeconfigData)), // Use contents, not path!
-		Context:              types.StringValue(kubeContext),
-		Exec:                 nil,
-	}
-
-	// Convert to types.Object
+func (r *objectResource) buildImportState(ctx context.Context, resourceID string, yamlBytes []byte, conn auth.ClusterModel, kubeContext string, liveObj *unstructured.Unstructured, projectionMapValue, managedFieldsMap types.Map, source string, namespace, name, kind string, paths []string, resp *resource.ImportStateResponse) bool {
+	// Convert the import connection to types.Object for the cluster attribute
 	connectionObj, err := r.convertConnectionToObject(ctx, conn)
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -310,10 +361,18 @@ func (r *objectResource) buildImportState(ctx context.Context, resourceID string
 		Cluster:                connectionObj,
 		DeleteProtection:       types.BoolValue(false),
 		IgnoreFields:           types.ListNull(types.StringType),
+		Labels:                 types.MapNull(types.StringType),
+		Annotations:            types.MapNull(types.StringType),
 		ManagedStateProjection: projectionMapValue,
 		ManagedFields:          managedFieldsMap,
 		ObjectRef:              objRefValue,
+		// current_replicas and status are read by the refresh that follows import
+		CurrentReplicas: types.Int64Null(),
+		Status:          types.MapNull(types.StringType),
 	}
+	updateAppliedYAMLData(ctx, &importedData, liveObj)
+	updateObjectVersionData(&importedData, liveObj)
+	updatePodTemplateHashData(&importedData, liveObj)
 
 	diags := resp.State.Set(ctx, &importedData)
 	resp.Diagnostics.Append(diags...)
@@ -331,7 +390,7 @@ func (r *objectResource) buildImportState(ctx context.Context, resourceID string
 		"kind":          kind,
 		"name":          name,
 		"namespace":     namespace,
-		"kubeconfig":    kubeconfigPath,
+		"connection":    source,
 		"context":       kubeContext,
 		"managed_paths": len(paths),
 	})
@@ -346,9 +405,7 @@ func (r *objectResource) validateImportIDParts(kubeContext, kind, name string, r
 		resp.Diagnostics.AddError(
 			"Import Failed: Missing Context",
 			"The import ID must include a kubeconfig context as the first part.\n\n"+
-				"Format:\n"+
-				"  Namespaced: context:namespace:kind:name\n"+
-				"  Cluster-scoped: context:kind:name\n\n"+
+				importIDFormatHelp+"\n\n"+
 				"Available contexts: kubectl config get-contexts",
 		)
 		return false
@@ -357,7 +414,7 @@ func (r *objectResource) validateImportIDParts(kubeContext, kind, name string, r
 		resp.Diagnostics.AddError(
 			"Import Failed: Missing Kind",
 			"The resource kind cannot be empty in the import ID.\n\n"+
-				"Example: prod:default:Deployment:nginx",
+				"Example: prod:default:apps/v1/Deployment:nginx",
 		)
 		return false
 	}
@@ -365,14 +422,101 @@ func (r *objectResource) validateImportIDParts(kubeContext, kind, name string, r
 		resp.Diagnostics.AddError(
 			"Import Failed: Missing Name",
 			"The resource name cannot be empty in the import ID.\n\n"+
-				"Example: prod:default:Deployment:nginx",
+				"Example: prod:default:apps/v1/Deployment:nginx",
 		)
 		return false
 	}
 	return true
 }
 
-// ImportState method implementing kubeconfig strategy with managed fields tracking
+// importIDFormatHelp describes the accepted import ID formats for error messages
+const importIDFormatHelp = "Import ID format:\n" +
+	"  Namespaced: context:namespace:apiVersion/kind:name\n" +
+	"  Cluster-scoped: context:apiVersion/kind:name\n\n" +
+	"The scope is detected from the cluster: a namespaced kind imported without a namespace uses the\n" +
+	"context's namespace (or \"default\"), and a namespace given for a cluster-scoped kind is ignored.\n\n" +
+	"Examples:\n" +
+	"  prod:default:apps/v1/Deployment:nginx\n" +
+	"  prod:kube-system:v1/Service:coredns\n" +
+	"  prod:v1/Namespace:my-namespace\n" +
+	"  prod:rbac.authorization.k8s.io/v1/ClusterRole:admin\n" +
+	"  prod:stable.example.com/v1/MyCustomResource:instance-1"
+
+// resolveImportNamespace uses discovery to pick the namespace for the import ID.
+// If discovery fails the namespace is returned unchanged; the fetch reports the error.
+func (r *objectResource) resolveImportNamespace(ctx context.Context, client k8sclient.K8sClient, kubeconfigData []byte, kubeContext, apiVersion, kind, namespace string, resp *resource.ImportStateResponse) string {
+	namespaced, err := client.IsResourceNamespaced(ctx, apiVersion, kind)
+	if err != nil {
+		tflog.Debug(ctx, "Could not determine resource scope for import", map[string]interface{}{
+			"apiVersion": apiVersion,
+			"kind":       kind,
+			"error":      err.Error(),
+		})
+		return namespace
+	}
+
+	resolved, ignored := importNamespaceForScope(namespaced, namespace, kubeconfigContextNamespace(kubeconfigData, kubeContext))
+	if ignored {
+		resp.Diagnostics.AddWarning(
+			"Namespace Ignored for Cluster-Scoped Resource",
+			fmt.Sprintf("%s is cluster-scoped, so namespace %q in the import ID was ignored.\n\n"+
+				"Use the cluster-scoped format next time: %s:%s/%s:<name>",
+				kind, namespace, kubeContext, apiVersion, kind),
+		)
+	}
+
+	if resolved != namespace {
+		tflog.Info(ctx, "Resolved import namespace from resource scope", map[string]interface{}{
+			"kind":       kind,
+			"namespaced": namespaced,
+			"namespace":  resolved,
+		})
+	}
+
+	return resolved
+}
+
+// importNamespaceForScope returns the namespace to fetch from and whether a namespace
+// from the import ID was dropped because the kind is cluster-scoped
+func importNamespaceForScope(namespaced bool, namespace, contextNamespace string) (string, bool) {
+	if !namespaced {
+		return "", namespace != ""
+	}
+	if namespace != "" {
+		return namespace, false
+	}
+	if contextNamespace != "" {
+		return contextNamespace, false
+	}
+	return "default", false
+}
+
+// kubeconfigContextNamespace returns the namespace configured for a kubeconfig context, if any
+func kubeconfigContextNamespace(kubeconfigData []byte, kubeContext string) string {
+	config, err := clientcmd.Load(kubeconfigData)
+	if err != nil {
+		return ""
+	}
+	if kubeCtx, ok := config.Contexts[kubeContext]; ok && kubeCtx != nil {
+		return kubeCtx.Namespace
+	}
+	return ""
+}
+
+// kubeconfigSoleContext returns the name of a kubeconfig's context when it has exactly one,
+// which is the context a connection without context uses
+func kubeconfigSoleContext(kubeconfigData []byte) string {
+	config, err := clientcmd.Load(kubeconfigData)
+	if err != nil || len(config.Contexts) != 1 {
+		return ""
+	}
+	for name := range config.Contexts {
+		return name
+	}
+	return ""
+}
+
+// ImportState method implementing kubeconfig (or provider import_cluster) strategy with managed fields tracking
 func (r *objectResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	tflog.Info(ctx, "ImportState called", map[string]interface{}{"import_id": req.ID})
 
@@ -382,20 +526,7 @@ func (r *objectResource) ImportState(ctx context.Context, req resource.ImportSta
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Invalid Import ID Format",
-			fmt.Sprintf("%s\n\n"+
-				"Import ID format:\n"+
-				"  Namespaced: context:namespace:kind:name\n"+
-				"  Cluster-scoped: context:kind:name\n\n"+
-				"Examples:\n"+
-				"  prod:default:Deployment:nginx\n"+
-				"  prod:kube-system:Service:coredns\n"+
-				"  prod:Namespace:my-namespace\n"+
-				"  prod:ClusterRole:admin\n\n"+
-				"For disambiguation, include apiVersion:\n"+
-				"  prod:default:apps/v1/Deployment:nginx\n"+
-				"  prod:networking.k8s.io/v1/Ingress:my-ingress\n"+
-				"  prod:stable.example.com/v1/MyCustomResource:instance-1",
-				err.Error()),
+			fmt.Sprintf("%s\n\n%s", err.Error(), importIDFormatHelp),
 		)
 		return
 	}
@@ -405,14 +536,14 @@ func (r *objectResource) ImportState(ctx context.Context, req resource.ImportSta
 		return
 	}
 
-	// Load kubeconfig file
-	kubeconfigPath, kubeconfigData, ok := r.loadKubeconfig(ctx, resp)
+	// Use the provider's import_cluster, or fall back to the kubeconfig file
+	conn, source, ok := r.resolveImportConnection(ctx, kubeContext, resp)
 	if !ok {
 		return
 	}
 
-	tflog.Info(ctx, "import using kubeconfig", map[string]interface{}{
-		"path":      kubeconfigPath,
+	tflog.Info(ctx, "import using connection", map[string]interface{}{
+		"source":    source,
 		"context":   kubeContext,
 		"kind":      kind,
 		"name":      name,
@@ -420,11 +551,14 @@ func (r *objectResource) ImportState(ctx context.Context, req resource.ImportSta
 	})
 
 	// Create Kubernetes client for import
-	client, ok := r.createImportClient(ctx, kubeconfigData, kubeconfigPath, kubeContext, resp)
+	client, ok := r.createImportClient(ctx, conn, source, resp)
 	if !ok {
 		return
 	}
 
+	// Auto-detect scope so both ID variants work for any kind
+	namespace = r.resolveImportNamespace(ctx, client, []byte(conn.Kubeconfig.ValueString()), kubeContext, apiVersion, kind, namespace, resp)
+
 	// Fetch the resource from Kubernetes
 	liveObj, ok := r.fetchImportResource(ctx, client, apiVersion, kind, namespace, name, kubeContext, resp)
 	if !ok {
@@ -432,7 +566,7 @@ func (r *objectResource) ImportState(ctx context.Context, req resource.ImportSta
 	}
 
 	// Check for existing ownership and generate ID accordingly
-	existingID := r.getOwnershipID(liveObj)
+	existingID := r.getOwnershipID(liveObj, nil)
 
 	var resourceID string
 
@@ -461,6 +595,9 @@ func (r *objectResource) ImportState(ctx context.Context, req resource.ImportSta
 		resourceID = common.GenerateID()
 	}
 
+	// Objects adopted from client-side kubectl apply keep its annotation until told otherwise
+	addLastAppliedImportWarning(liveObj, &resp.Diagnostics)
+
 	// Extract YAML, projection, and ownership
 	yamlBytes, projectionMapValue, managedFieldsMap, paths, ok := r.extractProjectionAndOwnership(ctx, liveObj, resp)
 	if !ok {
@@ -468,13 +605,14 @@ func (r *objectResource) ImportState(ctx context.Context, req resource.ImportSta
 	}
 
 	// Build and set final import state
-	if !r.buildImportState(ctx, resourceID, yamlBytes, kubeconfigData, kubeContext, liveObj, projectionMapValue, managedFieldsMap, kubeconfigPath, namespace, name, kind, paths, resp) {
+	if !r.buildImportState(ctx, resourceID, yamlBytes, conn, kubeContext, liveObj, projectionMapValue, managedFieldsMap, source, namespace, name, kind, paths, resp) {
 		return
 	}
 }
 
 // parseImportID parses the import ID and extracts components
-// Format: context:namespace:kind:name (namespaced) or context:kind:name (cluster-scoped)
+// Format: context:namespace:kind:name (namespaced) or context:kind:name (cluster-scoped).
+// The scope in the ID is a hint; resolveImportNamespace corrects it from discovery.
 // The kind field may optionally include apiVersion: apiVersion/kind
 func (r *objectResource) parseImportID(importID string) (context, namespace, apiVersion, kind, name string, err error) {
 	parts := strings.Split(importID, ":")
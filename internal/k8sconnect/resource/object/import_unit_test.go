@@ -1,10 +1,16 @@
 package object
 
 import (
+	"context"
 	"strings"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/jmorris0x0/terraform-provider-k8sconnect/internal/k8sconnect/common/auth"
 )
 
 func TestParseImportID(t *testing.T) {
@@ -402,3 +408,257 @@ func TestValidateImportIDParts(t *testing.T) {
 		})
 	}
 }
+
+func TestImportNamespaceForScope(t *testing.T) {
+	tests := []struct {
+		name             string
+		namespaced       bool
+		namespace        string
+		contextNamespace string
+		wantNamespace    string
+		wantIgnored      bool
+	}{
+		{"namespaced with explicit namespace", true, "apps", "team-a", "apps", false},
+		{"namespaced without namespace uses context namespace", true, "", "team-a", "team-a", false},
+		{"namespaced without namespace defaults to default", true, "", "", "default", false},
+		{"cluster-scoped without namespace", false, "", "team-a", "", false},
+		{"cluster-scoped with namespace is ignored", false, "default", "", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ignored := importNamespaceForScope(tt.namespaced, tt.namespace, tt.contextNamespace)
+			if got != tt.wantNamespace || ignored != tt.wantIgnored {
+				t.Errorf("importNamespaceForScope() = (%q, %v), want (%q, %v)", got, ignored, tt.wantNamespace, tt.wantIgnored)
+			}
+		})
+	}
+}
+
+func TestKubeconfigContextNamespace(t *testing.T) {
+	kubeconfig := []byte(`apiVersion: v1
+kind: Config
+clusters:
+- name: prod
+  cluster:
+    server: https://prod.example.com
+contexts:
+- name: prod
+  context:
+    cluster: prod
+    user: admin
+    namespace: team-a
+- name: prod-default
+  context:
+    cluster: prod
+    user: admin
+users:
+- name: admin
+  user:
+    token: test
+`)
+
+	if got := kubeconfigContextNamespace(kubeconfig, "prod"); got != "team-a" {
+		t.Errorf("expected context namespace team-a, got %q", got)
+	}
+	if got := kubeconfigContextNamespace(kubeconfig, "prod-default"); got != "" {
+		t.Errorf("expected empty namespace for context without one, got %q", got)
+	}
+	if got := kubeconfigContextNamespace(kubeconfig, "missing"); got != "" {
+		t.Errorf("expected empty namespace for unknown context, got %q", got)
+	}
+}
+
+func TestImportConnectionForContext(t *testing.T) {
+	tests := []struct {
+		name        string
+		conn        auth.ClusterModel
+		wantContext string
+		wantErr     bool
+	}{
+		{
+			name:        "kubeconfig without context uses import ID context",
+			conn:        auth.ClusterModel{Kubeconfig: types.StringValue("kubeconfig"), Context: types.StringNull()},
+			wantContext: "prod",
+		},
+		{
+			name:        "env mode without context uses import ID context",
+			conn:        auth.ClusterModel{UseEnv: types.BoolValue(true), Context: types.StringNull()},
+			wantContext: "prod",
+		},
+		{
+			name:        "matching context",
+			conn:        auth.ClusterModel{Kubeconfig: types.StringValue("kubeconfig"), Context: types.StringValue("prod")},
+			wantContext: "prod",
+		},
+		{
+			name:    "mismatched context",
+			conn:    auth.ClusterModel{Kubeconfig: types.StringValue("kubeconfig"), Context: types.StringValue("staging")},
+			wantErr: true,
+		},
+		{
+			name: "inline connection ignores import ID context",
+			conn: auth.ClusterModel{Host: types.StringValue("https://prod.example.com"), Context: types.StringNull()},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := importConnectionForContext(tt.conn, "prod")
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected context mismatch error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got.Context.ValueString() != tt.wantContext {
+				t.Errorf("context = %q, want %q", got.Context.ValueString(), tt.wantContext)
+			}
+		})
+	}
+}
+
+func TestResolveImportConnectionUsesImportCluster(t *testing.T) {
+	importCluster := auth.ClusterModel{Kubeconfig: types.StringValue("kubeconfig"), Context: types.StringNull()}
+	r := NewObjectResourceWithClientGetter(nil, ProviderSettings{ManageOwnershipAnnotation: true, ImportCluster: &importCluster}).(*objectResource)
+
+	// KUBECONFIG must not be consulted when the provider sets import_cluster
+	t.Setenv("KUBECONFIG", "/nonexistent/kubeconfig")
+
+	resp := &resource.ImportStateResponse{}
+	conn, source, ok := r.resolveImportConnection(context.Background(), "prod", resp)
+	if !ok {
+		t.Fatalf("unexpected failure: %v", resp.Diagnostics)
+	}
+	if source != "provider import_cluster" {
+		t.Errorf("source = %q, want provider import_cluster", source)
+	}
+	if conn.Kubeconfig.ValueString() != "kubeconfig" || conn.Context.ValueString() != "prod" {
+		t.Errorf("unexpected connection: kubeconfig=%q context=%q", conn.Kubeconfig.ValueString(), conn.Context.ValueString())
+	}
+	if !importCluster.Context.IsNull() {
+		t.Error("provider import_cluster was modified")
+	}
+}
+
+// connectionConfig builds a cluster value as Terraform decodes it from configuration:
+// every attribute not in overrides is null
+func connectionConfig(t *testing.T, overrides map[string]attr.Value) types.Object {
+	t.Helper()
+	values := make(map[string]attr.Value)
+	for name, attrType := range auth.GetConnectionAttributeTypes() {
+		switch typ := attrType.(type) {
+		case types.ObjectType:
+			values[name] = types.ObjectNull(typ.AttrTypes)
+		case basetypes.BoolType:
+			values[name] = types.BoolNull()
+		default:
+			values[name] = types.StringNull()
+		}
+	}
+	for name, value := range overrides {
+		values[name] = value
+	}
+	obj, diags := types.ObjectValue(auth.GetConnectionAttributeTypes(), values)
+	if diags.HasError() {
+		t.Fatalf("invalid connection config: %v", diags)
+	}
+	return obj
+}
+
+func TestImportConnectionRoundTrip(t *testing.T) {
+	singleContextKubeconfig := `apiVersion: v1
+kind: Config
+clusters:
+- name: prod
+  cluster:
+    server: https://prod.example.com
+contexts:
+- name: prod
+  context:
+    cluster: prod
+    user: admin
+users:
+- name: admin
+  user:
+    token: test
+`
+
+	tests := []struct {
+		name   string
+		config map[string]attr.Value
+	}{
+		{
+			name: "token",
+			config: map[string]attr.Value{
+				"host":                   types.StringValue("https://prod.example.com"),
+				"cluster_ca_certificate": types.StringValue("Y2EtY2VydA=="),
+				"token":                  types.StringValue("secret-token"),
+			},
+		},
+		{
+			name: "exec",
+			config: map[string]attr.Value{
+				"host":                   types.StringValue("https://prod.example.com"),
+				"cluster_ca_certificate": types.StringValue("Y2EtY2VydA=="),
+				"exec": types.ObjectValueMust(auth.GetExecAttributeTypes(), map[string]attr.Value{
+					"api_version": types.StringValue("client.authentication.k8s.io/v1"),
+					"command":     types.StringValue("aws"),
+					"args":        types.ListNull(types.StringType),
+					"env":         types.MapNull(types.StringType),
+				}),
+			},
+		},
+		{
+			name: "exec with args and env",
+			config: map[string]attr.Value{
+				"host":                   types.StringValue("https://prod.example.com"),
+				"cluster_ca_certificate": types.StringValue("Y2EtY2VydA=="),
+				"exec": types.ObjectValueMust(auth.GetExecAttributeTypes(), map[string]attr.Value{
+					"api_version": types.StringValue("client.authentication.k8s.io/v1"),
+					"command":     types.StringValue("aws"),
+					"args":        types.ListValueMust(types.StringType, []attr.Value{types.StringValue("eks"), types.StringValue("get-token")}),
+					"env":         types.MapValueMust(types.StringType, map[string]attr.Value{"AWS_PROFILE": types.StringValue("prod")}),
+				}),
+			},
+		},
+		{
+			name: "kubeconfig whose only context is the import ID context",
+			config: map[string]attr.Value{
+				"kubeconfig": types.StringValue(singleContextKubeconfig),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			configured := connectionConfig(t, tt.config)
+			importCluster, err := auth.ObjectToConnectionModel(context.Background(), configured)
+			if err != nil {
+				t.Fatalf("failed to decode config: %v", err)
+			}
+			r := NewObjectResourceWithClientGetter(nil, ProviderSettings{ManageOwnershipAnnotation: true, ImportCluster: &importCluster}).(*objectResource)
+
+			resp := &resource.ImportStateResponse{}
+			conn, source, ok := r.resolveImportConnection(context.Background(), "prod", resp)
+			if !ok {
+				t.Fatalf("unexpected failure: %v", resp.Diagnostics)
+			}
+			if strings.Contains(source, "secret-token") {
+				t.Errorf("connection source leaks credentials: %q", source)
+			}
+
+			imported, err := r.convertConnectionToObject(context.Background(), conn)
+			if err != nil {
+				t.Fatalf("failed to encode imported cluster: %v", err)
+			}
+			// A resource configured with the same connection must plan without a cluster diff
+			if !imported.Equal(configured) {
+				t.Errorf("imported cluster differs from configuration:\n  imported: %s\n  config:   %s", imported, configured)
+			}
+		})
+	}
+}
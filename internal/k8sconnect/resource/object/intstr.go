@@ -0,0 +1,144 @@
+package object
+
+import (
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/kubernetes/scheme"
+)
+
+var intOrStringType = reflect.TypeOf(intstr.IntOrString{})
+
+// intOrStringField is one node of the tree of int-or-string fields in a built-in kind,
+// keyed by JSON field name. A leaf marks an intstr.IntOrString field; list items are
+// walked through, so spec.ports[*].targetPort is spec -> ports -> targetPort.
+type intOrStringField struct {
+	leaf     bool
+	children map[string]*intOrStringField
+}
+
+// intOrStringFieldsCache holds the field tree per GroupVersionKind, nil for kinds the
+// built-in scheme doesn't know or that have no int-or-string fields
+var intOrStringFieldsCache sync.Map
+
+// intOrStringFields returns the int-or-string fields of a built-in kind, read from its Go
+// type in client-go's scheme. CRDs aren't in the scheme and get nil.
+func intOrStringFields(gvk schema.GroupVersionKind) *intOrStringField {
+	if cached, ok := intOrStringFieldsCache.Load(gvk); ok {
+		return cached.(*intOrStringField)
+	}
+	var fields *intOrStringField
+	if obj, err := scheme.Scheme.New(gvk); err == nil {
+		fields = buildIntOrStringFields(reflect.TypeOf(obj), map[reflect.Type]bool{})
+	}
+	intOrStringFieldsCache.Store(gvk, fields)
+	return fields
+}
+
+// buildIntOrStringFields walks t's JSON fields and returns the subtree leading to
+// int-or-string fields, or nil when there are none. visiting guards recursive types.
+func buildIntOrStringFields(t reflect.Type, visiting map[reflect.Type]bool) *intOrStringField {
+	for t.Kind() == reflect.Ptr || t.Kind() == reflect.Slice || t.Kind() == reflect.Array {
+		t = t.Elem()
+	}
+	if t == intOrStringType {
+		return &intOrStringField{leaf: true}
+	}
+	if t.Kind() != reflect.Struct || visiting[t] {
+		return nil
+	}
+	visiting[t] = true
+	defer delete(visiting, t)
+
+	node := &intOrStringField{children: map[string]*intOrStringField{}}
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		name, opts, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "-" {
+			continue
+		}
+		child := buildIntOrStringFields(field.Type, visiting)
+		if child == nil {
+			continue
+		}
+		if name == "" && (field.Anonymous || strings.Contains(opts, "inline")) {
+			// Embedded structs such as TypeMeta and ObjectMeta flatten into the parent
+			for k, v := range child.children {
+				node.children[k] = v
+			}
+			continue
+		}
+		node.children[name] = child
+	}
+	if len(node.children) == 0 {
+		return nil
+	}
+	return node
+}
+
+// normalizeIntOrString writes int-or-string fields of a projected built-in object in one
+// form: a number, or a string holding a decimal integer, becomes an int64, so drift
+// comparison treats 8080 and "8080" alike. This is only a comparison rule; it doesn't mean
+// Kubernetes reads the two the same way. Named ports and percentages are left as they are.
+func normalizeIntOrString(projection map[string]interface{}) {
+	apiVersion, _ := projection["apiVersion"].(string)
+	kind, _ := projection["kind"].(string)
+	if apiVersion == "" || kind == "" {
+		return
+	}
+	gv, err := schema.ParseGroupVersion(apiVersion)
+	if err != nil {
+		return
+	}
+	if fields := intOrStringFields(gv.WithKind(kind)); fields != nil {
+		normalizeIntOrStringValue(projection, fields)
+	}
+}
+
+// normalizeIntOrStringValue normalizes value's int-or-string fields in place and returns
+// the value to store in its parent
+func normalizeIntOrStringValue(value interface{}, fields *intOrStringField) interface{} {
+	if fields.leaf {
+		return canonicalIntOrString(value)
+	}
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, child := range fields.children {
+			if childValue, ok := v[key]; ok {
+				v[key] = normalizeIntOrStringValue(childValue, child)
+			}
+		}
+	case []interface{}:
+		for i := range v {
+			v[i] = normalizeIntOrStringValue(v[i], fields)
+		}
+	}
+	return value
+}
+
+// canonicalIntOrString returns an int-or-string value as an int64 when it is an integer,
+// in whichever numeric type or string form it was decoded
+func canonicalIntOrString(value interface{}) interface{} {
+	switch v := value.(type) {
+	case string:
+		if n, err := strconv.ParseInt(v, 10, 64); err == nil {
+			return n
+		}
+	case int:
+		return int64(v)
+	case int32:
+		return int64(v)
+	case float64:
+		if v == float64(int64(v)) {
+			return int64(v)
+		}
+	}
+	return value
+}
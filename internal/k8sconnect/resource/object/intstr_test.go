@@ -0,0 +1,105 @@
+package object
+
+import (
+	"reflect"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func TestNormalizeIntOrString(t *testing.T) {
+	t.Run("service ports", func(t *testing.T) {
+		projection := map[string]interface{}{
+			"apiVersion": "v1",
+			"kind":       "Service",
+			"spec": map[string]interface{}{
+				"ports": []interface{}{
+					map[string]interface{}{"port": int64(80), "targetPort": "8080"},
+					map[string]interface{}{"port": int64(443), "targetPort": "https"},
+					map[string]interface{}{"port": int64(9090), "targetPort": float64(9090)},
+				},
+			},
+		}
+		normalizeIntOrString(projection)
+
+		ports := projection["spec"].(map[string]interface{})["ports"].([]interface{})
+		for i, want := range []interface{}{int64(8080), "https", int64(9090)} {
+			if got := ports[i].(map[string]interface{})["targetPort"]; got != want {
+				t.Errorf("ports[%d].targetPort = %#v, want %#v", i, got, want)
+			}
+		}
+		if got := ports[0].(map[string]interface{})["port"]; got != int64(80) {
+			t.Errorf("port is not int-or-string and should be untouched, got %#v", got)
+		}
+	})
+
+	t.Run("deployment strategy and probes", func(t *testing.T) {
+		projection := map[string]interface{}{
+			"apiVersion": "apps/v1",
+			"kind":       "Deployment",
+			"spec": map[string]interface{}{
+				"strategy": map[string]interface{}{
+					"rollingUpdate": map[string]interface{}{"maxSurge": "25%", "maxUnavailable": "1"},
+				},
+				"template": map[string]interface{}{
+					"spec": map[string]interface{}{
+						"containers": []interface{}{
+							map[string]interface{}{
+								"name":           "app",
+								"readinessProbe": map[string]interface{}{"httpGet": map[string]interface{}{"port": "8080"}},
+							},
+						},
+					},
+				},
+			},
+		}
+		normalizeIntOrString(projection)
+
+		rollingUpdate := projection["spec"].(map[string]interface{})["strategy"].(map[string]interface{})["rollingUpdate"].(map[string]interface{})
+		if rollingUpdate["maxSurge"] != "25%" || rollingUpdate["maxUnavailable"] != int64(1) {
+			t.Errorf("rollingUpdate = %#v, want maxSurge 25%% and maxUnavailable 1", rollingUpdate)
+		}
+		container := projection["spec"].(map[string]interface{})["template"].(map[string]interface{})["spec"].(map[string]interface{})["containers"].([]interface{})[0].(map[string]interface{})
+		if got := container["readinessProbe"].(map[string]interface{})["httpGet"].(map[string]interface{})["port"]; got != int64(8080) {
+			t.Errorf("readinessProbe.httpGet.port = %#v, want 8080", got)
+		}
+	})
+
+	t.Run("projected values compare equal", func(t *testing.T) {
+		asString := map[string]interface{}{"apiVersion": "v1", "kind": "Service", "spec": map[string]interface{}{
+			"ports": []interface{}{map[string]interface{}{"port": int64(80), "targetPort": "8080"}},
+		}}
+		asInt := map[string]interface{}{"apiVersion": "v1", "kind": "Service", "spec": map[string]interface{}{
+			"ports": []interface{}{map[string]interface{}{"port": int64(80), "targetPort": int64(8080)}},
+		}}
+		normalizeIntOrString(asString)
+		normalizeIntOrString(asInt)
+
+		paths := []string{"spec.ports"}
+		if a, b := flattenProjectionToMap(asString, paths), flattenProjectionToMap(asInt, paths); !reflect.DeepEqual(a, b) {
+			t.Errorf("expected equal projections, got %v and %v", a, b)
+		}
+	})
+
+	t.Run("custom resources are untouched", func(t *testing.T) {
+		projection := map[string]interface{}{
+			"apiVersion": "example.com/v1",
+			"kind":       "Widget",
+			"spec":       map[string]interface{}{"targetPort": "8080"},
+		}
+		normalizeIntOrString(projection)
+		if got := projection["spec"].(map[string]interface{})["targetPort"]; got != "8080" {
+			t.Errorf("targetPort = %#v, want it untouched", got)
+		}
+	})
+}
+
+func TestIntOrStringFields(t *testing.T) {
+	if fields := intOrStringFields(schema.GroupVersionKind{Version: "v1", Kind: "ConfigMap"}); fields != nil {
+		t.Errorf("ConfigMap has no int-or-string fields, got %#v", fields)
+	}
+	fields := intOrStringFields(schema.GroupVersionKind{Group: "policy", Version: "v1", Kind: "PodDisruptionBudget"})
+	if fields == nil || !fields.children["spec"].children["minAvailable"].leaf || !fields.children["spec"].children["maxUnavailable"].leaf {
+		t.Errorf("expected spec.minAvailable and spec.maxUnavailable, got %#v", fields)
+	}
+}
@@ -0,0 +1,93 @@
+package object
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+)
+
+// lastAppliedConfigAnnotation is written by client-side kubectl apply for its three-way merge
+const lastAppliedConfigAnnotation = "kubectl.kubernetes.io/last-applied-configuration"
+
+// stripsLastAppliedConfiguration reports whether strip_last_applied_configuration is set
+func stripsLastAppliedConfiguration(data *objectResourceModel) bool {
+	return !data.StripLastApplied.IsNull() && data.StripLastApplied.ValueBool()
+}
+
+// hasLastAppliedConfiguration reports whether obj carries kubectl's last-applied-configuration
+func hasLastAppliedConfiguration(obj *unstructured.Unstructured) bool {
+	if obj == nil {
+		return false
+	}
+	_, ok := obj.GetAnnotations()[lastAppliedConfigAnnotation]
+	return ok
+}
+
+// removeLastAppliedConfiguration deletes kubectl's last-applied-configuration from the live
+// object after apply. Server-side apply can't remove it: the annotation belongs to the
+// client-side apply field manager, not ours. A JSON patch removes it whoever owns it.
+func (r *objectResource) removeLastAppliedConfiguration(ctx context.Context, rc *ResourceContext, diagnostics *diag.Diagnostics) {
+	if !stripsLastAppliedConfiguration(rc.Data) || rc.GVR.Empty() {
+		return
+	}
+	current, err := rc.Client.Get(ctx, rc.GVR, rc.Object.GetNamespace(), rc.Object.GetName())
+	if err != nil || !hasLastAppliedConfiguration(current) {
+		return
+	}
+
+	path := "/metadata/annotations/" + strings.NewReplacer("~", "~0", "/", "~1").Replace(lastAppliedConfigAnnotation)
+	patch := fmt.Sprintf(`[{"op":"remove","path":%q}]`, path)
+	_, err = rc.Client.Patch(ctx, rc.GVR, current.GetNamespace(), current.GetName(), k8stypes.JSONPatchType, []byte(patch),
+		metav1.PatchOptions{FieldManager: fieldManagerFor(rc.Data)})
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return
+		}
+		// Retried on the next apply: Read forces an update while the annotation is present
+		diagnostics.AddWarning(
+			"kubectl last-applied-configuration Not Removed",
+			fmt.Sprintf("Failed to remove the %s annotation from %s: %s\n\n"+
+				"The next terraform apply will try again.",
+				lastAppliedConfigAnnotation, formatResource(rc.Object), err),
+		)
+		return
+	}
+
+	tflog.Info(ctx, "Removed kubectl last-applied-configuration", map[string]interface{}{
+		"resource": formatResource(rc.Object),
+	})
+	diagnostics.AddWarning(
+		"kubectl last-applied-configuration Removed",
+		fmt.Sprintf("Removed the %s annotation from %s (strip_last_applied_configuration = true).\n\n"+
+			"k8sconnect manages the object with server-side apply, so the annotation kubectl's client-side apply "+
+			"keeps for its three-way merge was only a stale copy of an old manifest. Without it, a client-side "+
+			"'kubectl apply' can no longer tell which fields it set before and won't remove fields dropped from "+
+			"its manifest. Use 'kubectl apply --server-side' for any changes outside Terraform.\n\n"+
+			"If the annotation is added again, it is removed on the next apply.",
+			lastAppliedConfigAnnotation, formatResource(rc.Object)),
+	)
+}
+
+// addLastAppliedImportWarning points an import of an object managed by client-side
+// kubectl apply at strip_last_applied_configuration
+func addLastAppliedImportWarning(obj *unstructured.Unstructured, diagnostics *diag.Diagnostics) {
+	if !hasLastAppliedConfiguration(obj) {
+		return
+	}
+	diagnostics.AddWarning(
+		"Imported Object Was Managed by kubectl apply",
+		fmt.Sprintf("%s carries the %s annotation left by client-side 'kubectl apply'.\n\n"+
+			"k8sconnect uses server-side apply and never updates it, so it goes stale and misleads anyone who later "+
+			"runs 'kubectl apply' or 'kubectl diff' against the object.\n\n"+
+			"To remove it on the next apply and keep it removed, set:\n"+
+			"  strip_last_applied_configuration = true",
+			formatResource(obj), lastAppliedConfigAnnotation),
+	)
+}
@@ -0,0 +1,97 @@
+package object
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	k8sschema "k8s.io/apimachinery/pkg/runtime/schema"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+
+	"github.com/jmorris0x0/terraform-provider-k8sconnect/internal/k8sconnect/common/k8sclient"
+)
+
+// patchRecordingClient serves live from Get and records the patches sent to it
+type patchRecordingClient struct {
+	k8sclient.K8sClient
+	live    *unstructured.Unstructured
+	patches []string
+}
+
+func (c *patchRecordingClient) Get(ctx context.Context, gvr k8sschema.GroupVersionResource, namespace, name string) (*unstructured.Unstructured, error) {
+	return c.live, nil
+}
+
+func (c *patchRecordingClient) Patch(ctx context.Context, gvr k8sschema.GroupVersionResource, namespace, name string, patchType k8stypes.PatchType, data []byte, options metav1.PatchOptions, subresources ...string) (*unstructured.Unstructured, error) {
+	c.patches = append(c.patches, string(patchType)+" "+string(data))
+	return c.live, nil
+}
+
+func kubectlAppliedConfigMap(annotations map[string]interface{}) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "ConfigMap",
+		"metadata":   map[string]interface{}{"name": "settings", "namespace": "default", "annotations": annotations},
+	}}
+}
+
+func TestRemoveLastAppliedConfiguration(t *testing.T) {
+	ctx := context.Background()
+	r := &objectResource{}
+	gvr := k8sschema.GroupVersionResource{Version: "v1", Resource: "configmaps"}
+
+	t.Run("removes the annotation with a JSON patch", func(t *testing.T) {
+		live := kubectlAppliedConfigMap(map[string]interface{}{lastAppliedConfigAnnotation: `{"kind":"ConfigMap"}`})
+		client := &patchRecordingClient{K8sClient: k8sclient.NewStubK8sClient(), live: live}
+		rc := &ResourceContext{Client: client, GVR: gvr, Object: live, Data: &objectResourceModel{StripLastApplied: types.BoolValue(true)}}
+		var diags diag.Diagnostics
+		r.removeLastAppliedConfiguration(ctx, rc, &diags)
+
+		want := `application/json-patch+json [{"op":"remove","path":"/metadata/annotations/kubectl.kubernetes.io~1last-applied-configuration"}]`
+		if len(client.patches) != 1 || client.patches[0] != want {
+			t.Fatalf("patches = %v, want [%s]", client.patches, want)
+		}
+		if len(diags) != 1 || !strings.Contains(diags[0].Detail(), "kubectl apply --server-side") {
+			t.Errorf("expected a warning describing the change, got %v", diags)
+		}
+	})
+
+	t.Run("nothing to remove", func(t *testing.T) {
+		live := kubectlAppliedConfigMap(map[string]interface{}{"team": "payments"})
+		client := &patchRecordingClient{K8sClient: k8sclient.NewStubK8sClient(), live: live}
+		rc := &ResourceContext{Client: client, GVR: gvr, Object: live, Data: &objectResourceModel{StripLastApplied: types.BoolValue(true)}}
+		var diags diag.Diagnostics
+		r.removeLastAppliedConfiguration(ctx, rc, &diags)
+		if len(client.patches) != 0 || len(diags) != 0 {
+			t.Errorf("expected no patch and no warning, got %v and %v", client.patches, diags)
+		}
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		live := kubectlAppliedConfigMap(map[string]interface{}{lastAppliedConfigAnnotation: `{}`})
+		client := &patchRecordingClient{K8sClient: k8sclient.NewStubK8sClient(), live: live}
+		rc := &ResourceContext{Client: client, GVR: gvr, Object: live, Data: &objectResourceModel{StripLastApplied: types.BoolNull()}}
+		var diags diag.Diagnostics
+		r.removeLastAppliedConfiguration(ctx, rc, &diags)
+		if len(client.patches) != 0 {
+			t.Errorf("expected no patch, got %v", client.patches)
+		}
+	})
+}
+
+func TestAddLastAppliedImportWarning(t *testing.T) {
+	var diags diag.Diagnostics
+	addLastAppliedImportWarning(kubectlAppliedConfigMap(map[string]interface{}{"team": "payments"}), &diags)
+	if len(diags) != 0 {
+		t.Fatalf("expected no warning, got %v", diags)
+	}
+
+	addLastAppliedImportWarning(kubectlAppliedConfigMap(map[string]interface{}{lastAppliedConfigAnnotation: `{}`}), &diags)
+	if len(diags) != 1 || !strings.Contains(diags[0].Detail(), "strip_last_applied_configuration = true") {
+		t.Errorf("expected a warning pointing at strip_last_applied_configuration, got %v", diags)
+	}
+}
@@ -1,6 +1,7 @@
 package object_test
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"regexp"
@@ -11,6 +12,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/hashicorp/terraform-plugin-testing/config"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/jmorris0x0/terraform-provider-k8sconnect/internal/k8sconnect"
 	testhelpers "github.com/jmorris0x0/terraform-provider-k8sconnect/internal/k8sconnect/common/test"
@@ -366,6 +369,142 @@ YAML
 `, namespace)
 }
 
+// Test delete_cascade = "foreground": destroy returns only after the garbage collector
+// deleted the Deployment's ReplicaSets and Pods
+func TestAccObjectResource_DeleteCascadeForeground(t *testing.T) {
+	t.Parallel()
+
+	raw := os.Getenv("TF_ACC_KUBECONFIG")
+	if raw == "" {
+		t.Fatal("TF_ACC_KUBECONFIG must be set")
+	}
+
+	ns := fmt.Sprintf("cascade-ns-%d", time.Now().UnixNano()%1000000)
+	deployName := fmt.Sprintf("cascade-deploy-%d", time.Now().UnixNano()%1000000)
+	k8sClient := testhelpers.CreateK8sClient(t, raw)
+	selector := metav1.ListOptions{LabelSelector: "app=" + deployName}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"k8sconnect": providerserver.NewProtocol6WithError(k8sconnect.New()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccManifestConfigDeleteCascade(ns, deployName, true),
+				ConfigVariables: config.Variables{
+					"raw": config.StringVariable(raw),
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("k8sconnect_object.test", "delete_cascade", "foreground"),
+					testhelpers.CheckDeploymentExists(k8sClient, ns, deployName),
+					func(s *terraform.State) error {
+						// Wait for the ReplicaSet to create the pods the destroy has to collect
+						for i := 0; i < 30; i++ {
+							pods, err := k8sClient.CoreV1().Pods(ns).List(context.Background(), selector)
+							if err != nil {
+								return err
+							}
+							if len(pods.Items) > 0 {
+								return nil
+							}
+							time.Sleep(1 * time.Second)
+						}
+						return fmt.Errorf("no pods created for deployment %s/%s", ns, deployName)
+					},
+				),
+			},
+			{
+				// Remove the Deployment; the destroy waits for its dependents
+				Config: testAccManifestConfigDeleteCascade(ns, deployName, false),
+				ConfigVariables: config.Variables{
+					"raw": config.StringVariable(raw),
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testhelpers.CheckDeploymentDestroy(k8sClient, ns, deployName),
+					func(s *terraform.State) error {
+						// No polling: with foreground cascade they are gone before the Deployment is
+						ctx := context.Background()
+						replicaSets, err := k8sClient.AppsV1().ReplicaSets(ns).List(ctx, selector)
+						if err != nil {
+							return err
+						}
+						pods, err := k8sClient.CoreV1().Pods(ns).List(ctx, selector)
+						if err != nil {
+							return err
+						}
+						if len(replicaSets.Items) > 0 || len(pods.Items) > 0 {
+							return fmt.Errorf("expected the deployment's dependents to be deleted first, %d replicasets and %d pods remain",
+								len(replicaSets.Items), len(pods.Items))
+						}
+						return nil
+					},
+				),
+			},
+		},
+		CheckDestroy: testhelpers.CheckNamespaceDestroy(k8sClient, ns),
+	})
+}
+
+func testAccManifestConfigDeleteCascade(namespace, deployName string, withDeployment bool) string {
+	cfg := fmt.Sprintf(`
+variable "raw" {
+  type = string
+}
+
+provider "k8sconnect" {}
+
+resource "k8sconnect_object" "namespace" {
+  yaml_body = <<YAML
+apiVersion: v1
+kind: Namespace
+metadata:
+  name: %s
+YAML
+
+  cluster = {
+    kubeconfig = var.raw
+  }
+}
+`, namespace)
+	if !withDeployment {
+		return cfg
+	}
+	return cfg + fmt.Sprintf(`
+resource "k8sconnect_object" "test" {
+  yaml_body = <<YAML
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: %s
+  namespace: %s
+spec:
+  replicas: 2
+  selector:
+    matchLabels:
+      app: %s
+  template:
+    metadata:
+      labels:
+        app: %s
+    spec:
+      terminationGracePeriodSeconds: 5
+      containers:
+      - name: nginx
+        image: public.ecr.aws/nginx/nginx:1.21
+YAML
+
+  delete_cascade = "foreground"
+  delete_timeout = "2m"
+
+  cluster = {
+    kubeconfig = var.raw
+  }
+
+  depends_on = [k8sconnect_object.namespace]
+}
+`, deployName, namespace, deployName, deployName)
+}
+
 func testAccManifestConfigDeleteProtectionProviderOnly() string {
 	return `
 variable "raw" {
@@ -598,6 +737,143 @@ YAML
 `, namespace, name, namespace)
 }
 
+// Test generateName: the generated name is kept across refresh and update, and an
+// object deleted outside Terraform is recreated under a new generated name
+func TestAccObjectResource_GenerateNameLifecycle(t *testing.T) {
+	t.Parallel()
+
+	raw := os.Getenv("TF_ACC_KUBECONFIG")
+	if raw == "" {
+		t.Fatal("TF_ACC_KUBECONFIG must be set")
+	}
+
+	ns := fmt.Sprintf("generate-name-ns-%d", time.Now().UnixNano()%1000000)
+	k8sClient := testhelpers.CreateK8sClient(t, raw)
+	generatedName := regexp.MustCompile(`^test-run-[a-z0-9]{5}$`)
+
+	var firstName, secondName string
+	captureName := func(name *string) resource.CheckResourceAttrWithFunc {
+		return func(value string) error {
+			*name = value
+			return nil
+		}
+	}
+	configMapExists := func(name *string) resource.TestCheckFunc {
+		return func(s *terraform.State) error {
+			return testhelpers.CheckConfigMapExists(k8sClient, ns, *name)(s)
+		}
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"k8sconnect": providerserver.NewProtocol6WithError(k8sconnect.New()),
+		},
+		Steps: []resource.TestStep{
+			// Step 1: Create - the provider generates the name and records it in object_ref
+			{
+				Config: testAccManifestConfigGenerateName(ns, "one"),
+				ConfigVariables: config.Variables{
+					"raw": config.StringVariable(raw),
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr("k8sconnect_object.test_generate", "object_ref.name", generatedName),
+					resource.TestCheckResourceAttrWith("k8sconnect_object.test_generate", "object_ref.name", captureName(&firstName)),
+					configMapExists(&firstName),
+				),
+			},
+			// Step 2: Refresh and plan - the generated name resolves, so there is no diff
+			{
+				Config: testAccManifestConfigGenerateName(ns, "one"),
+				ConfigVariables: config.Variables{
+					"raw": config.StringVariable(raw),
+				},
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: false,
+			},
+			// Step 3: Update data - applied in place to the same generated name
+			{
+				Config: testAccManifestConfigGenerateName(ns, "two"),
+				ConfigVariables: config.Variables{
+					"raw": config.StringVariable(raw),
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrWith("k8sconnect_object.test_generate", "object_ref.name", func(value string) error {
+						if value != firstName {
+							return fmt.Errorf("expected update to keep %q, got %q", firstName, value)
+						}
+						return nil
+					}),
+					configMapExists(&firstName),
+				),
+			},
+			// Step 4: Delete the ConfigMap outside Terraform - it is recreated under a new name
+			{
+				PreConfig: func() {
+					if err := k8sClient.CoreV1().ConfigMaps(ns).Delete(context.Background(), firstName, metav1.DeleteOptions{}); err != nil {
+						t.Fatalf("failed to delete ConfigMap %s externally: %v", firstName, err)
+					}
+				},
+				Config: testAccManifestConfigGenerateName(ns, "two"),
+				ConfigVariables: config.Variables{
+					"raw": config.StringVariable(raw),
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr("k8sconnect_object.test_generate", "object_ref.name", generatedName),
+					resource.TestCheckResourceAttrWith("k8sconnect_object.test_generate", "object_ref.name", func(value string) error {
+						if value == firstName {
+							return fmt.Errorf("expected a new generated name after external deletion, still %q", value)
+						}
+						return nil
+					}),
+					resource.TestCheckResourceAttrWith("k8sconnect_object.test_generate", "object_ref.name", captureName(&secondName)),
+					configMapExists(&secondName),
+				),
+			},
+		},
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			testhelpers.CheckNamespaceDestroy(k8sClient, ns),
+			func(s *terraform.State) error {
+				return testhelpers.CheckConfigMapDestroy(k8sClient, ns, secondName)(s)
+			},
+		),
+	})
+}
+
+func testAccManifestConfigGenerateName(namespace, value string) string {
+	return fmt.Sprintf(`
+variable "raw" { type = string }
+provider "k8sconnect" {}
+
+resource "k8sconnect_object" "namespace" {
+  yaml_body = <<YAML
+apiVersion: v1
+kind: Namespace
+metadata:
+  name: %s
+YAML
+  cluster = {
+    kubeconfig = var.raw
+  }
+}
+
+resource "k8sconnect_object" "test_generate" {
+  yaml_body = <<YAML
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  generateName: test-run-
+  namespace: %s
+data:
+  key: %s
+YAML
+  cluster = {
+    kubeconfig = var.raw
+  }
+  depends_on = [k8sconnect_object.namespace]
+}
+`, namespace, namespace, value)
+}
+
 // ADR-010: Test resource identity change triggers replacement (Namespace change)
 func TestAccObjectResource_IdentityChange_Namespace(t *testing.T) {
 	t.Parallel()
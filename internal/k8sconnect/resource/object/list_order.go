@@ -0,0 +1,181 @@
+package object
+
+import (
+	"encoding/json"
+	"reflect"
+	"sort"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/jmorris0x0/terraform-provider-k8sconnect/internal/k8sconnect/common"
+)
+
+// alignListOrder returns obj's content with its associative and set lists in the order of
+// the same lists in desired. managedFields records the list type: items of a map list are
+// "k:" entries keyed by their merge keys, and items of a set list are "v:" entries. The
+// server keeps its own order for both, e.g. when merging into an existing list, while
+// projection paths address list items by their position in yaml_body, so projecting an
+// unaligned object attributes values to the wrong items and reports false drift.
+// Atomic lists are left in the server's order, since their order is part of the value.
+// A list is only reordered when every desired item is found in it; otherwise positions
+// can't correspond, and it is left as it is.
+func alignListOrder(obj *unstructured.Unstructured, desired map[string]interface{}) map[string]interface{} {
+	listTypes := make(map[string]interface{})
+	for _, mf := range obj.GetManagedFields() {
+		if mf.FieldsV1 == nil {
+			continue
+		}
+		var fields map[string]interface{}
+		if err := json.Unmarshal(mf.FieldsV1.Raw, &fields); err != nil {
+			continue
+		}
+		mergeFields(listTypes, fields)
+	}
+	if len(listTypes) == 0 {
+		return obj.Object
+	}
+
+	aligned := obj.DeepCopy().Object
+	alignFields(listTypes, aligned, desired)
+	return aligned
+}
+
+// alignFields walks the fieldsV1 tree alongside source and desired, reordering the lists below it
+func alignFields(fields map[string]interface{}, source, desired interface{}) {
+	sourceMap, ok := source.(map[string]interface{})
+	if !ok {
+		return
+	}
+	desiredMap, ok := desired.(map[string]interface{})
+	if !ok {
+		return
+	}
+
+	for key, value := range fields {
+		subFields, ok := value.(map[string]interface{})
+		if !strings.HasPrefix(key, "f:") || !ok || len(subFields) == 0 {
+			continue
+		}
+		name := strings.TrimPrefix(key, "f:")
+
+		sourceList, isList := sourceMap[name].([]interface{})
+		desiredList, _ := desiredMap[name].([]interface{})
+		if !isList {
+			alignFields(subFields, sourceMap[name], desiredMap[name])
+			continue
+		}
+
+		switch {
+		case hasFieldsPrefix(subFields, "k:"):
+			sourceMap[name] = alignMapList(subFields, sourceList, desiredList)
+		case hasFieldsPrefix(subFields, "v:"):
+			sourceMap[name] = alignSetList(sourceList, desiredList)
+		}
+	}
+}
+
+// alignMapList orders a map list's items like desired, matching them by the merge keys
+// of the list's "k:" entries, and aligns the lists nested in each matched item
+func alignMapList(fields map[string]interface{}, source, desired []interface{}) []interface{} {
+	keys := make([]string, 0, len(fields))
+	for key := range fields {
+		if strings.HasPrefix(key, "k:") {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	used := make([]bool, len(source))
+	aligned := make([]interface{}, 0, len(source))
+	for _, desiredItem := range desired {
+		desiredMap, ok := desiredItem.(map[string]interface{})
+		if !ok {
+			return source
+		}
+
+		matched := false
+		for _, key := range keys {
+			mergeKey, err := matcher.ParseMergeKey(key)
+			if err != nil || !matcher.ItemMatchesMergeKey(desiredMap, mergeKey) {
+				continue
+			}
+			i := findUnusedItem(source, used, func(item interface{}) bool {
+				itemMap, ok := item.(map[string]interface{})
+				return ok && matcher.ItemMatchesMergeKey(itemMap, mergeKey)
+			})
+			if i < 0 {
+				continue
+			}
+			used[i] = true
+			if subFields, ok := fields[key].(map[string]interface{}); ok {
+				alignFields(subFields, source[i], desiredItem)
+			}
+			aligned = append(aligned, source[i])
+			matched = true
+			break
+		}
+		if !matched {
+			return source
+		}
+	}
+
+	return appendUnusedItems(aligned, source, used)
+}
+
+// alignSetList orders a set list's values like desired
+func alignSetList(source, desired []interface{}) []interface{} {
+	used := make([]bool, len(source))
+	aligned := make([]interface{}, 0, len(source))
+	for _, desiredItem := range desired {
+		i := findUnusedItem(source, used, func(item interface{}) bool {
+			return setValuesEqual(item, desiredItem)
+		})
+		if i < 0 {
+			return source
+		}
+		used[i] = true
+		aligned = append(aligned, source[i])
+	}
+
+	return appendUnusedItems(aligned, source, used)
+}
+
+// findUnusedItem returns the index of the first item not yet used that matches, or -1
+func findUnusedItem(items []interface{}, used []bool, matches func(interface{}) bool) int {
+	for i, item := range items {
+		if !used[i] && matches(item) {
+			return i
+		}
+	}
+	return -1
+}
+
+// appendUnusedItems appends the items no desired item matched, e.g. those added by other
+// managers, after the aligned ones in the server's order
+func appendUnusedItems(aligned, source []interface{}, used []bool) []interface{} {
+	for i, item := range source {
+		if !used[i] {
+			aligned = append(aligned, item)
+		}
+	}
+	return aligned
+}
+
+// setValuesEqual compares set values, ignoring the numeric types YAML and JSON decode to
+func setValuesEqual(a, b interface{}) bool {
+	if reflect.DeepEqual(a, b) {
+		return true
+	}
+	return common.FormatValueForDisplay(a) == common.FormatValueForDisplay(b)
+}
+
+// hasFieldsPrefix reports whether any key of a fieldsV1 node has prefix
+func hasFieldsPrefix(fields map[string]interface{}, prefix string) bool {
+	for key := range fields {
+		if strings.HasPrefix(key, prefix) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,149 @@
+package object
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestAlignListOrder(t *testing.T) {
+	// The server keeps the containers, their ports, and the set of gates in its own order
+	live := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "example.com/v1",
+		"kind":       "Worker",
+		"metadata": map[string]interface{}{
+			"name": "web",
+			"managedFields": []interface{}{
+				map[string]interface{}{
+					"manager":    "k8sconnect",
+					"operation":  "Apply",
+					"fieldsType": "FieldsV1",
+					"fieldsV1": map[string]interface{}{
+						"f:spec": map[string]interface{}{
+							"f:containers": map[string]interface{}{
+								`k:{"name":"app"}`: map[string]interface{}{
+									".": map[string]interface{}{}, "f:name": map[string]interface{}{}, "f:image": map[string]interface{}{},
+									"f:ports": map[string]interface{}{
+										`k:{"containerPort":80,"protocol":"TCP"}`:   map[string]interface{}{".": map[string]interface{}{}, "f:containerPort": map[string]interface{}{}},
+										`k:{"containerPort":8080,"protocol":"TCP"}`: map[string]interface{}{".": map[string]interface{}{}, "f:containerPort": map[string]interface{}{}},
+									},
+								},
+								`k:{"name":"sidecar"}`: map[string]interface{}{
+									".": map[string]interface{}{}, "f:name": map[string]interface{}{}, "f:image": map[string]interface{}{},
+								},
+							},
+							"f:gates": map[string]interface{}{`v:"beta"`: map[string]interface{}{}, `v:"alpha"`: map[string]interface{}{}},
+							"f:args":  map[string]interface{}{},
+						},
+					},
+				},
+			},
+		},
+		"spec": map[string]interface{}{
+			"containers": []interface{}{
+				map[string]interface{}{"name": "sidecar", "image": "proxy:2"},
+				map[string]interface{}{"name": "app", "image": "app:1", "ports": []interface{}{
+					map[string]interface{}{"containerPort": int64(8080), "protocol": "TCP"},
+					map[string]interface{}{"containerPort": int64(80), "protocol": "TCP"},
+				}},
+			},
+			"gates": []interface{}{"beta", "alpha", "added-by-controller"},
+			"args":  []interface{}{"--b", "--a"},
+		},
+	}}
+	desired := map[string]interface{}{
+		"apiVersion": "example.com/v1",
+		"kind":       "Worker",
+		"metadata":   map[string]interface{}{"name": "web"},
+		"spec": map[string]interface{}{
+			"containers": []interface{}{
+				map[string]interface{}{"name": "app", "image": "app:1", "ports": []interface{}{
+					map[string]interface{}{"containerPort": 80},
+					map[string]interface{}{"containerPort": 8080},
+				}},
+				map[string]interface{}{"name": "sidecar", "image": "proxy:2"},
+			},
+			"gates": []interface{}{"alpha", "beta"},
+			"args":  []interface{}{"--a", "--b"},
+		},
+	}
+
+	aligned := alignListOrder(live, desired)
+	spec := aligned["spec"].(map[string]interface{})
+
+	containers := spec["containers"].([]interface{})
+	if name := containers[0].(map[string]interface{})["name"]; name != "app" {
+		t.Errorf("expected app first, got %v", name)
+	}
+	ports := containers[0].(map[string]interface{})["ports"].([]interface{})
+	if port := ports[0].(map[string]interface{})["containerPort"]; port != int64(80) {
+		t.Errorf("expected port 80 first, got %v", port)
+	}
+	if want := []interface{}{"alpha", "beta", "added-by-controller"}; !reflect.DeepEqual(spec["gates"], want) {
+		t.Errorf("gates = %v, want %v", spec["gates"], want)
+	}
+	// args is atomic: its order is part of the value
+	if want := []interface{}{"--b", "--a"}; !reflect.DeepEqual(spec["args"], want) {
+		t.Errorf("args = %v, want %v", spec["args"], want)
+	}
+
+	// The live object itself is not modified
+	if name := live.Object["spec"].(map[string]interface{})["containers"].([]interface{})[0].(map[string]interface{})["name"]; name != "sidecar" {
+		t.Errorf("live object was reordered")
+	}
+
+	// Positional paths from yaml_body now project the right items
+	paths := extractOwnedPaths(context.Background(), live.GetManagedFields(), objectFieldManager, desired)
+	projection, err := projectFields(aligned, paths)
+	if err != nil {
+		t.Fatalf("projectFields: %v", err)
+	}
+	flat := flattenProjectionToMap(projection, paths)
+	if got := flat["spec.containers[0].image"]; got != "app:1" {
+		t.Errorf("spec.containers[0].image = %q, want app:1", got)
+	}
+	if got := flat["spec.containers[1].image"]; got != "proxy:2" {
+		t.Errorf("spec.containers[1].image = %q, want proxy:2", got)
+	}
+}
+
+func TestAlignListOrder_UnmatchedItems(t *testing.T) {
+	live := &unstructured.Unstructured{Object: map[string]interface{}{
+		"metadata": map[string]interface{}{"name": "web"},
+		"spec": map[string]interface{}{
+			"containers": []interface{}{
+				map[string]interface{}{"name": "sidecar"},
+				map[string]interface{}{"name": "app"},
+			},
+		},
+	}}
+	live.SetManagedFields([]metav1.ManagedFieldsEntry{{
+		Manager:  "k8sconnect",
+		FieldsV1: &metav1.FieldsV1{Raw: []byte(`{"f:spec":{"f:containers":{"k:{\"name\":\"app\"}":{"f:name":{}},"k:{\"name\":\"sidecar\"}":{"f:name":{}}}}}`)},
+	}})
+
+	// A desired item missing from the live list leaves the server's order in place
+	desired := map[string]interface{}{
+		"spec": map[string]interface{}{
+			"containers": []interface{}{
+				map[string]interface{}{"name": "app"},
+				map[string]interface{}{"name": "new"},
+				map[string]interface{}{"name": "sidecar"},
+			},
+		},
+	}
+	aligned := alignListOrder(live, desired)
+	containers := aligned["spec"].(map[string]interface{})["containers"].([]interface{})
+	if name := containers[0].(map[string]interface{})["name"]; name != "sidecar" {
+		t.Errorf("expected the server's order, got %v", containers)
+	}
+
+	// Without managedFields there is no list type information
+	live.SetManagedFields(nil)
+	if aligned := alignListOrder(live, desired); !reflect.DeepEqual(aligned, live.Object) {
+		t.Errorf("expected the object unchanged without managedFields")
+	}
+}
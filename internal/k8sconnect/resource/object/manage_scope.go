@@ -0,0 +1,52 @@
+package object
+
+import (
+	"context"
+	"regexp"
+	"strings"
+)
+
+// manageScopeFieldPattern matches a top-level field name, not a nested path
+var manageScopeFieldPattern = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9]*$`)
+
+// getManageScope returns the top-level fields manage_scope restricts the projection to,
+// or nil when every field is in scope
+func getManageScope(ctx context.Context, data *objectResourceModel) []string {
+	if data.ManageScope.IsNull() || data.ManageScope.IsUnknown() {
+		return nil
+	}
+	var scope []string
+	if diags := data.ManageScope.ElementsAs(ctx, &scope, false); diags.HasError() || len(scope) == 0 {
+		return nil
+	}
+	return scope
+}
+
+// filterManageScope keeps the paths under one of the top-level fields in scope. Fields
+// outside it are still applied from yaml_body, they are just not compared for drift.
+func filterManageScope(paths []string, scope []string) []string {
+	if scope == nil {
+		return paths
+	}
+	filtered := make([]string, 0, len(paths))
+	for _, path := range paths {
+		if inManageScope(path, scope) {
+			filtered = append(filtered, path)
+		}
+	}
+	return filtered
+}
+
+// inManageScope reports whether path lies under one of the top-level fields in scope
+func inManageScope(path string, scope []string) bool {
+	top := path
+	if i := strings.IndexAny(path, ".["); i >= 0 {
+		top = path[:i]
+	}
+	for _, field := range scope {
+		if top == field {
+			return true
+		}
+	}
+	return false
+}
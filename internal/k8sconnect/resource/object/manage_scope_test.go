@@ -0,0 +1,86 @@
+package object
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestFilterManageScope(t *testing.T) {
+	paths := []string{
+		"metadata.name",
+		"metadata.labels.app",
+		"metadata.annotations.example.com/owner",
+		"spec.replicas",
+		"spec.template.spec.containers[0].image",
+		"specification.value",
+		"data.key",
+	}
+
+	got := filterManageScope(paths, []string{"spec"})
+	want := []string{"spec.replicas", "spec.template.spec.containers[0].image"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("filterManageScope(spec) = %v, want %v", got, want)
+	}
+
+	got = filterManageScope(paths, []string{"spec", "data"})
+	want = []string{"spec.replicas", "spec.template.spec.containers[0].image", "data.key"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("filterManageScope(spec, data) = %v, want %v", got, want)
+	}
+
+	if got := filterManageScope(paths, nil); !reflect.DeepEqual(got, paths) {
+		t.Errorf("without manage_scope every path is kept, got %v", got)
+	}
+}
+
+func TestManageScopeFieldPattern(t *testing.T) {
+	for field, want := range map[string]bool{
+		"spec":          true,
+		"data":          true,
+		"stringData":    true,
+		"spec.replicas": false,
+		"spec[0]":       false,
+		"":              false,
+	} {
+		if got := manageScopeFieldPattern.MatchString(field); got != want {
+			t.Errorf("manageScopeFieldPattern.MatchString(%q) = %v, want %v", field, got, want)
+		}
+	}
+}
+
+func TestUpdateManagedFieldsData_ManageScope(t *testing.T) {
+	ctx := context.Background()
+	obj := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"metadata":   map[string]interface{}{"name": "web", "namespace": "default"},
+	}}
+	obj.SetManagedFields([]metav1.ManagedFieldsEntry{
+		{
+			Manager:   "k8sconnect",
+			Operation: metav1.ManagedFieldsOperationApply,
+			FieldsV1:  &metav1.FieldsV1{Raw: []byte(`{"f:spec":{"f:replicas":{}}}`)},
+		},
+		{
+			Manager:   "label-controller",
+			Operation: metav1.ManagedFieldsOperationUpdate,
+			FieldsV1:  &metav1.FieldsV1{Raw: []byte(`{"f:metadata":{"f:labels":{"f:team":{}}}}`)},
+		},
+	})
+
+	scope, _ := types.ListValueFrom(ctx, types.StringType, []string{"spec"})
+	data := &objectResourceModel{ManageScope: scope}
+	updateManagedFieldsData(ctx, data, obj)
+
+	var managedFields map[string]string
+	data.ManagedFields.ElementsAs(ctx, &managedFields, false)
+	want := map[string]string{"spec.replicas": "k8sconnect"}
+	if !reflect.DeepEqual(managedFields, want) {
+		t.Errorf("managed_fields = %v, want only the ownership inside manage_scope: %v", managedFields, want)
+	}
+}
@@ -3,7 +3,6 @@ package object
 import (
 	"context"
 	"encoding/json"
-	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -26,10 +25,12 @@ func updateManagedFieldsData(ctx context.Context, data *objectResourceModel, cur
 	ownership := fieldmanagement.ExtractAllManagedFields(currentObj)
 
 	// Filter out unwanted fields
+	manageScope := getManageScope(ctx, data)
 	filteredOwnership := make(map[string][]string)
 	for path, managers := range ownership {
-		// Skip status fields - they're always owned by controllers and provide no actionable information
-		if strings.HasPrefix(path, "status.") || path == "status" {
+		// Skip status fields - they're owned by controllers and provide no actionable information,
+		// unless manage_scope includes status
+		if isStatusPath(path) && !inManageScope(path, manageScope) {
 			continue
 		}
 
@@ -39,11 +40,16 @@ func updateManagedFieldsData(ctx context.Context, data *objectResourceModel, cur
 			continue
 		}
 
+		// Changes of ownership outside manage_scope would plan an update like drift does
+		if manageScope != nil && !inManageScope(path, manageScope) {
+			continue
+		}
+
 		filteredOwnership[path] = managers
 	}
 
 	// Flatten using the common logic
-	ownershipMap := fieldmanagement.FlattenManagedFields(filteredOwnership)
+	ownershipMap := fieldmanagement.FlattenManagedFieldsFor(filteredOwnership, fieldManagerFor(data))
 
 	// Convert to types.Map
 	mapValue, diags := types.MapValueFrom(ctx, types.StringType, ownershipMap)
@@ -73,8 +79,8 @@ func saveOwnershipBaseline(ctx context.Context, privateState interface {
 	// IMPORTANT: Filter out ignored fields before saving to baseline (ADR-021 fix)
 	baselineOwnership := make(map[string]string)
 	for path, managers := range ownership {
-		// Skip fields that are currently in ignore_fields
-		if stringSliceContains(ignoreFields, path) {
+		// Skip fields that are currently in ignore_fields, including children of an ignored parent
+		if isIgnoredPath(path, ignoreFields, obj.Object) {
 			continue
 		}
 		if len(managers) > 0 {
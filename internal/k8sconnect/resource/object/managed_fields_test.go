@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/hashicorp/terraform-plugin-testing/config"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
 
@@ -1037,3 +1038,99 @@ YAML
 		CheckDestroy: testhelpers.CheckDeploymentDestroy(k8sClient, ns, deployName),
 	})
 }
+
+// field_manager_operation = "Update" records k8sconnect's writes as Update operations,
+// on create and on later changes
+func TestAccObjectResource_FieldManagerOperationUpdate(t *testing.T) {
+	t.Parallel()
+
+	raw := os.Getenv("TF_ACC_KUBECONFIG")
+	if raw == "" {
+		t.Fatal("TF_ACC_KUBECONFIG must be set")
+	}
+
+	ns := "default"
+	cmName := fmt.Sprintf("update-op-cm-%d", time.Now().UnixNano()%1000000)
+	k8sClient := testhelpers.CreateK8sClient(t, raw)
+
+	checkOperation := func(expectedValue string) func(*terraform.State) error {
+		return func(*terraform.State) error {
+			cm, err := k8sClient.CoreV1().ConfigMaps(ns).Get(context.Background(), cmName, metav1.GetOptions{})
+			if err != nil {
+				return fmt.Errorf("failed to get configmap: %v", err)
+			}
+			if cm.Data["setting"] != expectedValue {
+				return fmt.Errorf("data.setting = %q, want %q", cm.Data["setting"], expectedValue)
+			}
+			found := false
+			for _, mf := range cm.ManagedFields {
+				if mf.Manager != "k8sconnect" {
+					continue
+				}
+				if mf.Operation != metav1.ManagedFieldsOperationUpdate {
+					return fmt.Errorf("k8sconnect managedFields entry has operation %s, want Update", mf.Operation)
+				}
+				found = true
+			}
+			if !found {
+				return fmt.Errorf("no managedFields entry for k8sconnect: %+v", cm.ManagedFields)
+			}
+			return nil
+		}
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"k8sconnect": providerserver.NewProtocol6WithError(k8sconnect.New()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccManifestConfigFieldManagerOperation(ns, cmName, "first"),
+				ConfigVariables: config.Variables{
+					"raw": config.StringVariable(raw),
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("k8sconnect_object.test", "field_manager_operation", "Update"),
+					resource.TestCheckResourceAttr("k8sconnect_object.test", "managed_fields.data.setting", "k8sconnect"),
+					checkOperation("first"),
+				),
+			},
+			{
+				Config: testAccManifestConfigFieldManagerOperation(ns, cmName, "second"),
+				ConfigVariables: config.Variables{
+					"raw": config.StringVariable(raw),
+				},
+				Check: checkOperation("second"),
+			},
+		},
+		CheckDestroy: testhelpers.CheckConfigMapDestroy(k8sClient, ns, cmName),
+	})
+}
+
+func testAccManifestConfigFieldManagerOperation(namespace, name, value string) string {
+	return fmt.Sprintf(`
+variable "raw" {
+  type = string
+}
+
+provider "k8sconnect" {}
+
+resource "k8sconnect_object" "test" {
+  yaml_body = <<YAML
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: %s
+  namespace: %s
+data:
+  setting: %s
+YAML
+
+  field_manager_operation = "Update"
+
+  cluster = {
+    kubeconfig = var.raw
+  }
+}
+`, name, namespace, value)
+}
@@ -0,0 +1,79 @@
+package object
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// mergeCommonMetadata merges the labels and annotations attributes into the object's
+// metadata before apply. Values set in yaml_body take precedence on conflict, so the
+// attributes act as shared defaults (team, env, managed-by) that a manifest can override.
+// Merged keys are sent with the object and therefore become part of the managed
+// projection and drift detection like any other field. The restart_on annotation is
+// merged into the pod template the same way.
+func mergeCommonMetadata(ctx context.Context, obj *unstructured.Unstructured, data *objectResourceModel) error {
+	labels, err := commonMetadataValues(ctx, data.Labels, "labels")
+	if err != nil {
+		return err
+	}
+	if len(labels) > 0 {
+		obj.SetLabels(mergeStringMaps(labels, obj.GetLabels()))
+	}
+
+	annotations, err := commonMetadataValues(ctx, data.Annotations, "annotations")
+	if err != nil {
+		return err
+	}
+	if len(annotations) > 0 {
+		obj.SetAnnotations(mergeStringMaps(annotations, obj.GetAnnotations()))
+	}
+
+	return applyRestartOn(obj, data)
+}
+
+// hasUnknownCommonMetadata reports whether labels, annotations or restart_on aren't known yet
+// (e.g., interpolated from resources that haven't been created during plan)
+func hasUnknownCommonMetadata(data *objectResourceModel) bool {
+	if data.RestartOn.IsUnknown() {
+		return true
+	}
+	for _, m := range []types.Map{data.Labels, data.Annotations} {
+		if m.IsUnknown() {
+			return true
+		}
+		for _, v := range m.Elements() {
+			if v.IsUnknown() {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// commonMetadataValues converts a labels/annotations attribute to a plain map
+func commonMetadataValues(ctx context.Context, m types.Map, attrName string) (map[string]string, error) {
+	if m.IsNull() || m.IsUnknown() {
+		return nil, nil
+	}
+
+	values := make(map[string]string, len(m.Elements()))
+	if diags := m.ElementsAs(ctx, &values, false); diags.HasError() {
+		return nil, fmt.Errorf("failed to read %s: %v", attrName, diags)
+	}
+	return values, nil
+}
+
+// mergeStringMaps returns defaults overlaid with overrides (overrides win)
+func mergeStringMaps(defaults, overrides map[string]string) map[string]string {
+	merged := make(map[string]string, len(defaults)+len(overrides))
+	for k, v := range defaults {
+		merged[k] = v
+	}
+	for k, v := range overrides {
+		merged[k] = v
+	}
+	return merged
+}
@@ -0,0 +1,93 @@
+package object
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestMergeCommonMetadata(t *testing.T) {
+	ctx := context.Background()
+
+	obj := &unstructured.Unstructured{}
+	obj.SetAPIVersion("v1")
+	obj.SetKind("ConfigMap")
+	obj.SetName("test")
+	obj.SetLabels(map[string]string{"app": "web", "team": "yaml-team"})
+
+	data := &objectResourceModel{
+		Labels: types.MapValueMust(types.StringType, map[string]attr.Value{
+			"team": types.StringValue("platform"),
+			"env":  types.StringValue("prod"),
+		}),
+		Annotations: types.MapValueMust(types.StringType, map[string]attr.Value{
+			"owner": types.StringValue("platform@example.com"),
+		}),
+	}
+
+	if err := mergeCommonMetadata(ctx, obj, data); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	wantLabels := map[string]string{"app": "web", "team": "yaml-team", "env": "prod"}
+	if got := obj.GetLabels(); !reflect.DeepEqual(got, wantLabels) {
+		t.Errorf("labels = %v, want %v (yaml_body should win on conflict)", got, wantLabels)
+	}
+
+	wantAnnotations := map[string]string{"owner": "platform@example.com"}
+	if got := obj.GetAnnotations(); !reflect.DeepEqual(got, wantAnnotations) {
+		t.Errorf("annotations = %v, want %v", got, wantAnnotations)
+	}
+}
+
+func TestMergeCommonMetadataNullLeavesObjectUntouched(t *testing.T) {
+	obj := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "ConfigMap",
+		"metadata":   map[string]interface{}{"name": "test"},
+	}}
+
+	data := &objectResourceModel{
+		Labels:      types.MapNull(types.StringType),
+		Annotations: types.MapNull(types.StringType),
+	}
+
+	if err := mergeCommonMetadata(context.Background(), obj, data); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if _, found, _ := unstructured.NestedMap(obj.Object, "metadata", "labels"); found {
+		t.Error("expected no labels to be added when attribute is null")
+	}
+	if _, found, _ := unstructured.NestedMap(obj.Object, "metadata", "annotations"); found {
+		t.Error("expected no annotations to be added when attribute is null")
+	}
+}
+
+func TestHasUnknownCommonMetadata(t *testing.T) {
+	known := types.MapValueMust(types.StringType, map[string]attr.Value{"team": types.StringValue("platform")})
+	unknownElem := types.MapValueMust(types.StringType, map[string]attr.Value{"team": types.StringUnknown()})
+
+	tests := []struct {
+		name string
+		data objectResourceModel
+		want bool
+	}{
+		{"null", objectResourceModel{Labels: types.MapNull(types.StringType), Annotations: types.MapNull(types.StringType)}, false},
+		{"known", objectResourceModel{Labels: known, Annotations: types.MapNull(types.StringType)}, false},
+		{"unknown map", objectResourceModel{Labels: types.MapUnknown(types.StringType), Annotations: types.MapNull(types.StringType)}, true},
+		{"unknown element", objectResourceModel{Labels: types.MapNull(types.StringType), Annotations: unknownElem}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := hasUnknownCommonMetadata(&tt.data); got != tt.want {
+				t.Errorf("hasUnknownCommonMetadata() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,75 @@
+package object
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the resource implements the MoveState interface
+var _ resource.ResourceWithMoveState = (*objectResource)(nil)
+
+// legacyManifestTypes maps the resource types k8sconnect_object replaced to the provider
+// type that served them: k8sconnect_manifest, and k8sinline_manifest from the provider's
+// earlier k8sinline name. Their state has the v1 shape with cluster_connection.
+var legacyManifestTypes = map[string]string{
+	"k8sconnect_manifest": "k8sconnect",
+	"k8sinline_manifest":  "k8sinline",
+}
+
+// MoveState implements resource.ResourceWithMoveState, so a moved block from a legacy
+// manifest resource migrates its state in place instead of destroying and recreating
+func (r *objectResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		{
+			SourceSchema: getLegacyObjectSchema(),
+			StateMover:   moveLegacyManifestState,
+		},
+	}
+}
+
+// moveLegacyManifestState converts legacy manifest state to k8sconnect_object, keeping
+// id (which the ownership annotation on the object references) and the projection
+func moveLegacyManifestState(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+	if !isLegacyManifestSource(req.SourceTypeName, req.SourceProviderAddress) {
+		return
+	}
+	if req.SourceState == nil {
+		resp.Diagnostics.AddError(
+			"Cannot Move Legacy Manifest State",
+			fmt.Sprintf("The state of %s (schema version %d) does not match the legacy manifest schema, "+
+				"so it cannot be moved to k8sconnect_object.\n\n"+
+				"Options:\n"+
+				"• Remove the moved block and the old resource from state (terraform state rm), then import the object",
+				req.SourceTypeName, req.SourceSchemaVersion),
+		)
+		return
+	}
+
+	dataV1, diags := readLegacyObjectState(ctx, req.SourceState)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Moving legacy manifest state to k8sconnect_object", map[string]interface{}{
+		"source_type":     req.SourceTypeName,
+		"source_provider": req.SourceProviderAddress,
+		"id":              dataV1.ID.ValueString(),
+	})
+
+	resp.Diagnostics.Append(resp.TargetState.Set(ctx, objectModelFromV1(dataV1))...)
+}
+
+// isLegacyManifestSource reports whether a moved block's source is a legacy manifest
+// resource. The hostname and namespace of the provider address are ignored.
+func isLegacyManifestSource(typeName, providerAddress string) bool {
+	providerType, ok := legacyManifestTypes[typeName]
+	if !ok {
+		return false
+	}
+	return providerAddress[strings.LastIndex(providerAddress, "/")+1:] == providerType
+}
@@ -0,0 +1,113 @@
+package object
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// legacyManifestStateJSON is k8sconnect_manifest state as written before cluster was
+// renamed, including an attribute the current schema no longer has
+const legacyManifestStateJSON = `{
+  "id": "a1b2c3d4e5f6",
+  "yaml_body": "apiVersion: v1\nkind: Namespace\nmetadata:\n  name: legacy\n",
+  "cluster_connection": {
+    "host": "https://prod.example.com",
+    "cluster_ca_certificate": "Y2EtY2VydA==",
+    "token": "secret-token"
+  },
+  "delete_protection": true,
+  "managed_state_projection": {"metadata.name": "legacy"},
+  "object_ref": {"api_version": "v1", "kind": "Namespace", "name": "legacy", "namespace": null},
+  "previous_owners": {"metadata.name": "kubectl"}
+}`
+
+// legacyState decodes raw state JSON against the legacy schema, as the framework does
+func legacyState(t *testing.T, rawJSON string) *tfsdk.State {
+	t.Helper()
+	legacySchema := getLegacyObjectSchema()
+	raw, err := (&tfprotov6.RawState{JSON: []byte(rawJSON)}).UnmarshalWithOpts(
+		legacySchema.Type().TerraformType(context.Background()),
+		tfprotov6.UnmarshalOpts{ValueFromJSONOpts: tftypes.ValueFromJSONOpts{IgnoreUndefinedAttributes: true}},
+	)
+	if err != nil {
+		t.Fatalf("failed to decode legacy state: %v", err)
+	}
+	return &tfsdk.State{Raw: raw, Schema: *legacySchema}
+}
+
+func currentObjectState(t *testing.T) tfsdk.State {
+	t.Helper()
+	var schemaResp resource.SchemaResponse
+	(&objectResource{}).Schema(context.Background(), resource.SchemaRequest{}, &schemaResp)
+	return tfsdk.State{Schema: schemaResp.Schema}
+}
+
+func TestMoveLegacyManifestState(t *testing.T) {
+	ctx := context.Background()
+	req := resource.MoveStateRequest{
+		SourceProviderAddress: "registry.terraform.io/jmorris0x0/k8sinline",
+		SourceTypeName:        "k8sinline_manifest",
+		SourceState:           legacyState(t, legacyManifestStateJSON),
+	}
+	resp := &resource.MoveStateResponse{TargetState: currentObjectState(t)}
+
+	moveLegacyManifestState(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	var moved objectResourceModel
+	if diags := resp.TargetState.Get(ctx, &moved); diags.HasError() {
+		t.Fatalf("failed to read moved state: %v", diags)
+	}
+	if moved.ID.ValueString() != "a1b2c3d4e5f6" {
+		t.Errorf("id not preserved: %q", moved.ID.ValueString())
+	}
+	host, ok := moved.Cluster.Attributes()["host"]
+	if !ok || host.String() != `"https://prod.example.com"` {
+		t.Errorf("cluster_connection not mapped to cluster: %v", moved.Cluster)
+	}
+	if got := moved.ManagedStateProjection.Elements()["metadata.name"]; got == nil || got.String() != `"legacy"` {
+		t.Errorf("projection not preserved: %v", moved.ManagedStateProjection)
+	}
+	if !moved.DeleteProtection.ValueBool() {
+		t.Error("delete_protection not preserved")
+	}
+	if !moved.ManagedFields.IsNull() || !moved.Labels.IsNull() {
+		t.Error("attributes added after the legacy schema should be null")
+	}
+}
+
+func TestMoveLegacyManifestStateIgnoresOtherSources(t *testing.T) {
+	tests := []struct {
+		typeName, providerAddress string
+	}{
+		{"kubernetes_manifest", "registry.terraform.io/hashicorp/kubernetes"},
+		{"k8sinline_manifest", "registry.terraform.io/hashicorp/kubernetes"},
+		{"k8sconnect_patch", "registry.terraform.io/jmorris0x0/k8sconnect"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.typeName, func(t *testing.T) {
+			req := resource.MoveStateRequest{
+				SourceProviderAddress: tt.providerAddress,
+				SourceTypeName:        tt.typeName,
+				SourceState:           legacyState(t, legacyManifestStateJSON),
+			}
+			resp := &resource.MoveStateResponse{TargetState: currentObjectState(t)}
+
+			moveLegacyManifestState(context.Background(), req, resp)
+			if resp.Diagnostics.HasError() || !resp.TargetState.Raw.IsNull() {
+				t.Errorf("expected the move to be left to other movers, got state %v, diagnostics %v", resp.TargetState.Raw, resp.Diagnostics)
+			}
+		})
+	}
+
+	if !isLegacyManifestSource("k8sconnect_manifest", "registry.terraform.io/jmorris0x0/k8sconnect") {
+		t.Error("expected k8sconnect_manifest to be a legacy manifest source")
+	}
+}
@@ -0,0 +1,228 @@
+package object
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	k8sschema "k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/jmorris0x0/terraform-provider-k8sconnect/internal/k8sconnect/common/auth"
+	"github.com/jmorris0x0/terraform-provider-k8sconnect/internal/k8sconnect/common/k8sclient"
+	"github.com/jmorris0x0/terraform-provider-k8sconnect/internal/k8sconnect/common/k8serrors"
+)
+
+var namespaceGVR = k8sschema.GroupVersionResource{Version: "v1", Resource: "namespaces"}
+
+// namespaceTerminationPollInterval is how often wait_for_namespace_termination checks the namespace
+var namespaceTerminationPollInterval = 2 * time.Second
+
+// resolveObjectNamespace sets metadata.namespace on a namespaced object whose yaml_body
+// omits it. object_ref records the namespace the object was planned or created in, so an
+// existing object is still found after the connection's default namespace changes.
+// Without object_ref the connection's default namespace is used.
+func resolveObjectNamespace(ctx context.Context, client k8sclient.K8sClient, conn auth.ClusterModel, obj *unstructured.Unstructured, objectRef types.Object) {
+	if obj.GetNamespace() != "" || k8sclient.IsClusterScopedResource(obj.GetAPIVersion(), obj.GetKind()) {
+		return
+	}
+
+	if namespace, known := objectRefNamespace(ctx, objectRef); known {
+		// Null for cluster-scoped objects
+		obj.SetNamespace(namespace)
+		return
+	}
+
+	namespaced, err := client.IsResourceNamespaced(ctx, obj.GetAPIVersion(), obj.GetKind())
+	if err != nil {
+		// e.g. the CRD doesn't exist yet; the client falls back to "default"
+		tflog.Debug(ctx, "Could not determine resource scope for default namespace", map[string]interface{}{
+			"kind":  obj.GetKind(),
+			"name":  obj.GetName(),
+			"error": err.Error(),
+		})
+		return
+	}
+	if namespaced {
+		obj.SetNamespace(auth.DefaultNamespace(conn))
+	}
+}
+
+// stateDefaultNamespace returns the namespace an existing object was created in when its
+// yaml_body omits metadata.namespace and cluster.namespace is unchanged. The object stays
+// there when only the implicit default changes, e.g. the kubeconfig context's namespace
+// or a provider upgrade that changed how the default is resolved; only an explicit
+// cluster.namespace change moves it.
+func stateDefaultNamespace(ctx context.Context, stateData, plannedData *objectResourceModel) (string, bool) {
+	namespace, known := objectRefNamespace(ctx, stateData.ObjectRef)
+	if !known || namespace == "" {
+		return "", false
+	}
+	stateConn, err := auth.ObjectToConnectionModel(ctx, stateData.Cluster)
+	if err != nil {
+		return "", false
+	}
+	planConn, err := auth.ObjectToConnectionModel(ctx, plannedData.Cluster)
+	if err != nil || planConn.Namespace.IsUnknown() {
+		return "", false
+	}
+	if stateConn.Namespace.ValueString() != planConn.Namespace.ValueString() {
+		return "", false
+	}
+	return namespace, true
+}
+
+// objectRefNamespace returns object_ref.namespace. known is false when object_ref is
+// null or unknown, i.e. the namespace hasn't been resolved yet.
+func objectRefNamespace(ctx context.Context, objectRef types.Object) (namespace string, known bool) {
+	if objectRef.IsNull() || objectRef.IsUnknown() {
+		return "", false
+	}
+	var ref objectRefModel
+	if diags := objectRef.As(ctx, &ref, basetypes.ObjectAsOptions{}); diags.HasError() || ref.Namespace.IsUnknown() {
+		return "", false
+	}
+	return ref.Namespace.ValueString(), true
+}
+
+// checkNamespaceTerminating stops creation into a namespace that is still terminating, for
+// example right after a destroy. The API server would reject the object with a Forbidden error
+// that reads like an RBAC problem. With wait_for_namespace_termination set, creation waits up
+// to delete_timeout for the namespace to be deleted or recreated. A namespace that can't be
+// read is left to the apply and its error classification.
+func (r *objectResource) checkNamespaceTerminating(ctx context.Context, rc *ResourceContext, data *objectResourceModel, resp *resource.CreateResponse) error {
+	namespace := rc.Object.GetNamespace()
+	if namespace == "" {
+		return nil
+	}
+
+	ns, err := rc.Client.Get(ctx, namespaceGVR, "", namespace)
+	if err != nil || !isNamespaceTerminating(ns) {
+		return nil
+	}
+
+	waitEnabled := data.WaitForNamespaceTermination.ValueBool()
+	timeout := r.getDeleteTimeout(*data)
+	if waitEnabled {
+		tflog.Info(ctx, "Namespace terminating, waiting before create", map[string]interface{}{
+			"kind":      rc.Object.GetKind(),
+			"name":      rc.Object.GetName(),
+			"namespace": namespace,
+			"timeout":   timeout.String(),
+		})
+		observed, err := waitForNamespaceTermination(ctx, rc.Client, namespace, timeout)
+		if err == nil {
+			tflog.Info(ctx, "Namespace no longer terminating, continuing with create", map[string]interface{}{
+				"namespace": namespace,
+			})
+			return nil
+		}
+		if ctx.Err() != nil {
+			resp.Diagnostics.AddError("Namespace Termination Wait Interrupted", err.Error())
+			return err
+		}
+		if observed != nil {
+			ns = observed
+		}
+	}
+
+	var msg strings.Builder
+	if waitEnabled {
+		msg.WriteString(fmt.Sprintf("Namespace \"%s\" was still terminating after %v, so %s was not created. ", namespace, timeout, formatResource(rc.Object)))
+	} else {
+		msg.WriteString(fmt.Sprintf("Namespace \"%s\" is terminating, so %s cannot be created in it. ", namespace, formatResource(rc.Object)))
+	}
+	msg.WriteString("The API server rejects new objects in a namespace that is being deleted.\n\n")
+	if reasons := namespaceTerminationReasons(ns); len(reasons) > 0 {
+		msg.WriteString("Deletion is waiting on:\n")
+		for _, reason := range reasons {
+			msg.WriteString(fmt.Sprintf("  • %s\n", reason))
+		}
+		msg.WriteString("\n")
+	}
+	msg.WriteString("Options:\n")
+	if waitEnabled {
+		msg.WriteString("• Wait longer: delete_timeout = \"20m\"\n")
+	} else {
+		msg.WriteString("• Wait for the namespace: wait_for_namespace_termination = true (bounded by delete_timeout)\n")
+	}
+	msg.WriteString(fmt.Sprintf("• Investigate: kubectl describe namespace %s\n", namespace))
+	msg.WriteString("• Run terraform apply again once the namespace is gone")
+
+	if waitEnabled {
+		resp.Diagnostics.AddError(k8serrors.Summary(k8serrors.ErrorTypeDeleteTimeout, "Namespace Still Terminating"), msg.String())
+	} else {
+		resp.Diagnostics.AddError(k8serrors.Summary(k8serrors.ErrorTypeNamespaceTerminating, "Namespace Terminating"), msg.String())
+	}
+	return fmt.Errorf("namespace %s is terminating", namespace)
+}
+
+// waitForNamespaceTermination polls until the namespace is deleted or has been recreated.
+// A deleted namespace ends the wait; the apply's dependency retry covers it being recreated
+// by a namespace resource in the same configuration. On timeout the last observed
+// namespace is returned with the error.
+func waitForNamespaceTermination(ctx context.Context, client k8sclient.K8sClient, namespace string, timeout time.Duration) (*unstructured.Unstructured, error) {
+	ticker := time.NewTicker(namespaceTerminationPollInterval)
+	defer ticker.Stop()
+
+	deadline := time.Now().Add(timeout)
+	var lastObserved *unstructured.Unstructured
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-ticker.C:
+			ns, err := client.Get(ctx, namespaceGVR, "", namespace)
+			switch {
+			case errors.IsNotFound(err):
+				return nil, nil
+			case err != nil:
+				tflog.Warn(ctx, "Error checking namespace termination", map[string]interface{}{
+					"namespace": namespace,
+					"error":     err.Error(),
+				})
+			case !isNamespaceTerminating(ns):
+				return nil, nil
+			default:
+				lastObserved = ns
+			}
+
+			if time.Now().After(deadline) {
+				return lastObserved, fmt.Errorf("timeout after %v waiting for namespace %s to terminate", timeout, namespace)
+			}
+		}
+	}
+}
+
+// isNamespaceTerminating reports whether a namespace is being deleted
+func isNamespaceTerminating(ns *unstructured.Unstructured) bool {
+	if ns == nil {
+		return false
+	}
+	phase, _, _ := unstructured.NestedString(ns.Object, "status", "phase")
+	return phase == "Terminating" || ns.GetDeletionTimestamp() != nil
+}
+
+// namespaceTerminationReasons returns the messages of the namespace's deletion conditions
+// that are still true, e.g. content or finalizers that remain
+func namespaceTerminationReasons(ns *unstructured.Unstructured) []string {
+	conditions, _, _ := unstructured.NestedSlice(ns.Object, "status", "conditions")
+	var reasons []string
+	for _, cond := range conditions {
+		condMap, ok := cond.(map[string]interface{})
+		if !ok || condMap["status"] != "True" {
+			continue
+		}
+		if message, _ := condMap["message"].(string); message != "" {
+			reasons = append(reasons, message)
+		}
+	}
+	return reasons
+}
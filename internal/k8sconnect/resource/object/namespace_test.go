@@ -0,0 +1,271 @@
+package object
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	k8sschema "k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/jmorris0x0/terraform-provider-k8sconnect/internal/k8sconnect/common/auth"
+	"github.com/jmorris0x0/terraform-provider-k8sconnect/internal/k8sconnect/common/k8sclient"
+)
+
+const namespacedContextKubeconfig = `apiVersion: v1
+kind: Config
+clusters:
+- name: test
+  cluster:
+    server: https://test.example.com
+contexts:
+- name: team-a
+  context:
+    cluster: test
+    user: admin
+    namespace: foo
+users:
+- name: admin
+  user:
+    token: test
+`
+
+func objectRefWithNamespace(t *testing.T, namespace types.String) types.Object {
+	t.Helper()
+	ref, diags := types.ObjectValue(map[string]attr.Type{
+		"api_version": types.StringType,
+		"kind":        types.StringType,
+		"name":        types.StringType,
+		"namespace":   types.StringType,
+	}, map[string]attr.Value{
+		"api_version": types.StringValue("v1"),
+		"kind":        types.StringValue("ConfigMap"),
+		"name":        types.StringValue("settings"),
+		"namespace":   namespace,
+	})
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+	return ref
+}
+
+func TestResolveObjectNamespace(t *testing.T) {
+	ctx := context.Background()
+	client := k8sclient.NewStubK8sClient()
+	conn := auth.ClusterModel{Kubeconfig: types.StringValue(namespacedContextKubeconfig)}
+	unresolved := types.ObjectUnknown(objectRefWithNamespace(t, types.StringNull()).AttributeTypes(ctx))
+
+	configMap := func(namespace string) *unstructured.Unstructured {
+		obj := &unstructured.Unstructured{Object: map[string]interface{}{"apiVersion": "v1", "kind": "ConfigMap"}}
+		obj.SetName("settings")
+		obj.SetNamespace(namespace)
+		return obj
+	}
+
+	// A context with namespace: foo puts an unnamespaced ConfigMap in foo, like kubectl
+	obj := configMap("")
+	resolveObjectNamespace(ctx, client, conn, obj, unresolved)
+	if obj.GetNamespace() != "foo" {
+		t.Errorf("expected the context namespace foo, got %q", obj.GetNamespace())
+	}
+
+	// cluster.namespace overrides the context
+	obj = configMap("")
+	override := conn
+	override.Namespace = types.StringValue("bar")
+	resolveObjectNamespace(ctx, client, override, obj, unresolved)
+	if obj.GetNamespace() != "bar" {
+		t.Errorf("expected cluster.namespace bar, got %q", obj.GetNamespace())
+	}
+
+	// An explicit metadata.namespace always wins
+	obj = configMap("explicit")
+	resolveObjectNamespace(ctx, client, override, obj, unresolved)
+	if obj.GetNamespace() != "explicit" {
+		t.Errorf("expected the explicit namespace, got %q", obj.GetNamespace())
+	}
+
+	// An existing object stays where object_ref says it was created
+	obj = configMap("")
+	resolveObjectNamespace(ctx, client, conn, obj, objectRefWithNamespace(t, types.StringValue("default")))
+	if obj.GetNamespace() != "default" {
+		t.Errorf("expected the object_ref namespace, got %q", obj.GetNamespace())
+	}
+
+	// Cluster-scoped objects get no namespace
+	namespace := &unstructured.Unstructured{Object: map[string]interface{}{"apiVersion": "v1", "kind": "Namespace"}}
+	resolveObjectNamespace(ctx, client, conn, namespace, unresolved)
+	if namespace.GetNamespace() != "" {
+		t.Errorf("expected no namespace for a Namespace, got %q", namespace.GetNamespace())
+	}
+}
+
+func TestResolveDefaultNamespaces(t *testing.T) {
+	ctx := context.Background()
+	r := &objectResource{}
+	cluster := func(namespace types.String) types.Object {
+		obj, err := auth.ConnectionToObject(ctx, auth.ClusterModel{Kubeconfig: types.StringValue(namespacedContextKubeconfig), Namespace: namespace})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return obj
+	}
+	configMap := func() *unstructured.Unstructured {
+		obj := &unstructured.Unstructured{Object: map[string]interface{}{"apiVersion": "v1", "kind": "ConfigMap"}}
+		obj.SetName("settings")
+		return obj
+	}
+
+	tests := []struct {
+		name          string
+		stateRef      string
+		stateCluster  types.String
+		planCluster   types.String
+		wantNamespace string
+		wantWarning   bool
+	}{
+		// Created in default before the context's namespace (foo) was honored
+		{"implicit default changed", "default", types.StringNull(), types.StringNull(), "default", true},
+		{"implicit default unchanged", "foo", types.StringNull(), types.StringNull(), "foo", false},
+		{"cluster.namespace set", "default", types.StringNull(), types.StringValue("bar"), "bar", false},
+		{"cluster.namespace changed", "bar", types.StringValue("bar"), types.StringValue("baz"), "baz", false},
+		{"cluster.namespace unchanged", "bar", types.StringValue("bar"), types.StringValue("bar"), "bar", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stateData := &objectResourceModel{Cluster: cluster(tt.stateCluster), ObjectRef: objectRefWithNamespace(t, types.StringValue(tt.stateRef))}
+			plannedData := &objectResourceModel{Cluster: cluster(tt.planCluster)}
+			stateObj, planObj := configMap(), configMap()
+			resp := &resource.ModifyPlanResponse{}
+
+			r.resolveDefaultNamespaces(ctx, stateObj, planObj, stateData, plannedData, resp)
+
+			if stateObj.GetNamespace() != tt.stateRef {
+				t.Errorf("state namespace = %q, want the object_ref namespace %q", stateObj.GetNamespace(), tt.stateRef)
+			}
+			if planObj.GetNamespace() != tt.wantNamespace {
+				t.Errorf("plan namespace = %q, want %q", planObj.GetNamespace(), tt.wantNamespace)
+			}
+			if got := resp.Diagnostics.WarningsCount() > 0; got != tt.wantWarning {
+				t.Errorf("warning = %v, want %v: %v", got, tt.wantWarning, resp.Diagnostics)
+			}
+			if identityChanged := len(r.detectIdentityChanges(stateObj, planObj)) > 0; identityChanged != (tt.stateRef != tt.wantNamespace) {
+				t.Errorf("identity changed = %v for %q -> %q", identityChanged, tt.stateRef, tt.wantNamespace)
+			}
+		})
+	}
+}
+
+// namespaceSequenceClient returns each namespace response in turn, then the last one
+// again; a nil response is NotFound
+type namespaceSequenceClient struct {
+	k8sclient.K8sClient
+	responses []*unstructured.Unstructured
+	gets      int
+}
+
+func (c *namespaceSequenceClient) Get(ctx context.Context, gvr k8sschema.GroupVersionResource, namespace, name string) (*unstructured.Unstructured, error) {
+	i := c.gets
+	if i >= len(c.responses) {
+		i = len(c.responses) - 1
+	}
+	c.gets++
+	if c.responses[i] == nil {
+		return nil, errors.NewNotFound(gvr.GroupResource(), name)
+	}
+	return c.responses[i], nil
+}
+
+func TestCheckNamespaceTerminating(t *testing.T) {
+	ctx := context.Background()
+	r := &objectResource{}
+	defer func(interval time.Duration) { namespaceTerminationPollInterval = interval }(namespaceTerminationPollInterval)
+	namespaceTerminationPollInterval = 10 * time.Millisecond
+
+	desired := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "ConfigMap",
+		"metadata":   map[string]interface{}{"name": "settings", "namespace": "team-a"},
+	}}
+	namespace := func(phase string) *unstructured.Unstructured {
+		return &unstructured.Unstructured{Object: map[string]interface{}{
+			"apiVersion": "v1",
+			"kind":       "Namespace",
+			"metadata":   map[string]interface{}{"name": "team-a"},
+			"status": map[string]interface{}{
+				"phase": phase,
+				"conditions": []interface{}{map[string]interface{}{
+					"type":    "NamespaceContentRemaining",
+					"status":  "True",
+					"message": "Some resources are remaining: pods. has 2 resource instances",
+				}},
+			},
+		}}
+	}
+	newData := func(wait types.Bool) *objectResourceModel {
+		return &objectResourceModel{
+			WaitForNamespaceTermination: wait,
+			DeleteTimeout:               types.StringValue("1s"),
+			YAMLBody:                    types.StringValue("apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: settings\n"),
+		}
+	}
+
+	t.Run("active namespace", func(t *testing.T) {
+		client := &namespaceSequenceClient{responses: []*unstructured.Unstructured{namespace("Active")}}
+		resp := &resource.CreateResponse{}
+		if err := r.checkNamespaceTerminating(ctx, &ResourceContext{Client: client, Object: desired}, newData(types.BoolNull()), resp); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("terminating namespace fails without waiting", func(t *testing.T) {
+		client := &namespaceSequenceClient{responses: []*unstructured.Unstructured{namespace("Terminating")}}
+		resp := &resource.CreateResponse{}
+		if err := r.checkNamespaceTerminating(ctx, &ResourceContext{Client: client, Object: desired}, newData(types.BoolNull()), resp); err == nil {
+			t.Fatal("expected an error for a terminating namespace")
+		}
+		if client.gets != 1 {
+			t.Errorf("expected a single namespace lookup, got %d", client.gets)
+		}
+		diag := resp.Diagnostics.Errors()[0]
+		if diag.Summary() != "[NamespaceTerminating] Namespace Terminating" {
+			t.Errorf("unexpected summary %q", diag.Summary())
+		}
+		for _, want := range []string{`Namespace "team-a" is terminating`, "Some resources are remaining", "wait_for_namespace_termination = true"} {
+			if !strings.Contains(diag.Detail(), want) {
+				t.Errorf("diagnostic detail missing %q:\n%s", want, diag.Detail())
+			}
+		}
+	})
+
+	t.Run("waits until the namespace is recreated", func(t *testing.T) {
+		client := &namespaceSequenceClient{responses: []*unstructured.Unstructured{
+			namespace("Terminating"), namespace("Terminating"), nil, namespace("Active"),
+		}}
+		resp := &resource.CreateResponse{}
+		if err := r.checkNamespaceTerminating(ctx, &ResourceContext{Client: client, Object: desired}, newData(types.BoolValue(true)), resp); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if resp.Diagnostics.HasError() {
+			t.Errorf("unexpected diagnostics: %v", resp.Diagnostics)
+		}
+	})
+
+	t.Run("wait is bounded by delete_timeout", func(t *testing.T) {
+		client := &namespaceSequenceClient{responses: []*unstructured.Unstructured{namespace("Terminating")}}
+		resp := &resource.CreateResponse{}
+		if err := r.checkNamespaceTerminating(ctx, &ResourceContext{Client: client, Object: desired}, newData(types.BoolValue(true)), resp); err == nil {
+			t.Fatal("expected an error when the namespace keeps terminating")
+		}
+		diag := resp.Diagnostics.Errors()[0]
+		if !strings.HasPrefix(diag.Summary(), "[DeleteTimeout]") || !strings.Contains(diag.Detail(), "still terminating after 1s") {
+			t.Errorf("unexpected diagnostic: %s\n%s", diag.Summary(), diag.Detail())
+		}
+	})
+}
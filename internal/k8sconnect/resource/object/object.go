@@ -3,10 +3,14 @@ package object
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -29,19 +33,62 @@ type ClientGetter func(auth.ClusterModel) (k8sclient.K8sClient, error)
 type objectResource struct {
 	clientGetter  ClientGetter // Keep for now
 	clientFactory factory.ClientFactory
+
+	// skipOwnershipAnnotation is set by the provider's manage_ownership_annotation = false
+	skipOwnershipAnnotation bool
+
+	// importCluster is the provider's import_cluster connection; nil falls back to KUBECONFIG
+	importCluster *auth.ClusterModel
+}
+
+// ProviderSettings carries the provider-level configuration read by k8sconnect_object
+type ProviderSettings struct {
+	ManageOwnershipAnnotation bool
+
+	// ImportCluster is used by terraform import instead of KUBECONFIG when set
+	ImportCluster *auth.ClusterModel
 }
 
 type objectResourceModel struct {
-	ID                     types.String `tfsdk:"id"`
-	YAMLBody               types.String `tfsdk:"yaml_body"`
-	Cluster                types.Object `tfsdk:"cluster"`
-	DeleteProtection       types.Bool   `tfsdk:"delete_protection"`
-	DeleteTimeout          types.String `tfsdk:"delete_timeout"`
-	ForceDestroy           types.Bool   `tfsdk:"force_destroy"`
-	IgnoreFields           types.List   `tfsdk:"ignore_fields"`
-	ManagedStateProjection types.Map    `tfsdk:"managed_state_projection"`
-	ManagedFields          types.Map    `tfsdk:"managed_fields"`
-	ObjectRef              types.Object `tfsdk:"object_ref"`
+	ID                          types.String `tfsdk:"id"`
+	YAMLBody                    types.String `tfsdk:"yaml_body"`
+	Cluster                     types.Object `tfsdk:"cluster"`
+	DeleteProtection            types.Bool   `tfsdk:"delete_protection"`
+	DeleteTimeout               types.String `tfsdk:"delete_timeout"`
+	DeleteGracePeriod           types.Int64  `tfsdk:"delete_grace_period"`
+	DeleteCascade               types.String `tfsdk:"delete_cascade"`
+	ForceDestroy                types.Bool   `tfsdk:"force_destroy"`
+	WaitForDeletion             types.Bool   `tfsdk:"wait_for_deletion"`
+	WaitForNamespaceTermination types.Bool   `tfsdk:"wait_for_namespace_termination"`
+	DependsOnReady              types.List   `tfsdk:"depends_on_ready"`
+	DependsOnReadyTimeout       types.String `tfsdk:"depends_on_ready_timeout"`
+	Precondition                types.Object `tfsdk:"precondition"`
+	AllowStatus                 types.Bool   `tfsdk:"allow_status"`
+	IgnoreFields                types.List   `tfsdk:"ignore_fields"`
+	ManageScope                 types.List   `tfsdk:"manage_scope"`
+	DetectDrift                 types.Bool   `tfsdk:"detect_drift"`
+	RefreshFromCache            types.Bool   `tfsdk:"refresh_from_cache"`
+	FieldManager                types.String `tfsdk:"field_manager"`
+	FieldManagerOperation       types.String `tfsdk:"field_manager_operation"`
+	ReplaceOnUpdate             types.Bool   `tfsdk:"replace_on_update"`
+	ReplacementStrategy         types.String `tfsdk:"replacement_strategy"`
+	RecreateToken               types.String `tfsdk:"recreate_token"`
+	RestartOn                   types.String `tfsdk:"restart_on"`
+	OptimisticConcurrency       types.Bool   `tfsdk:"optimistic_concurrency"`
+	ApplyPriority               types.Int64  `tfsdk:"apply_priority"`
+	StripLastApplied            types.Bool   `tfsdk:"strip_last_applied_configuration"`
+	ResetManagedFields          types.Bool   `tfsdk:"reset_managed_fields"`
+	Labels                      types.Map    `tfsdk:"labels"`
+	Annotations                 types.Map    `tfsdk:"annotations"`
+	ManagedStateProjection      types.Map    `tfsdk:"managed_state_projection"`
+	ManagedFields               types.Map    `tfsdk:"managed_fields"`
+	ObjectRef                   types.Object `tfsdk:"object_ref"`
+	AppliedYAML                 types.String `tfsdk:"applied_yaml"`
+	Generation                  types.Int64  `tfsdk:"generation"`
+	ResourceVersion             types.String `tfsdk:"resource_version"`
+	PodTemplateHash             types.String `tfsdk:"pod_template_hash"`
+	CurrentReplicas             types.Int64  `tfsdk:"current_replicas"`
+	Status                      types.Map    `tfsdk:"status"`
 }
 
 type objectRefModel struct {
@@ -52,9 +99,11 @@ type objectRefModel struct {
 }
 
 // Creates a object resource with custom client getter
-func NewObjectResourceWithClientGetter(getter ClientGetter) resource.Resource {
+func NewObjectResourceWithClientGetter(getter ClientGetter, settings ProviderSettings) resource.Resource {
 	return &objectResource{
-		clientGetter: getter,
+		clientGetter:            getter,
+		skipOwnershipAnnotation: !settings.ManageOwnershipAnnotation,
+		importCluster:           settings.ImportCluster,
 	}
 }
 
@@ -124,9 +173,197 @@ func (r *objectResource) Schema(ctx context.Context, req resource.SchemaRequest,
 					durationValidator{},
 				},
 			},
+			"delete_grace_period": schema.Int64Attribute{
+				Optional: true,
+				MarkdownDescription: "Seconds the object is given to terminate gracefully when it is deleted, sent as the delete request's `gracePeriodSeconds`. " +
+					"Overrides the object's own grace period, such as a Pod's `terminationGracePeriodSeconds`. `0` deletes Pods immediately, without waiting for " +
+					"the kubelet to confirm that their containers stopped (like `kubectl delete --grace-period=0 --force`). Kinds without graceful termination ignore it. " +
+					"The wait for the deletion is still bounded by `delete_timeout`.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"delete_cascade": schema.StringAttribute{
+				Optional: true,
+				MarkdownDescription: "How the object's dependents, the objects listing it in their `metadata.ownerReferences`, are deleted with it, sent as the delete request's `propagationPolicy`. " +
+					"`background` deletes the object at once and lets the garbage collector delete its dependents afterwards. `foreground` keeps the object, with a " +
+					"`foregroundDeletion` finalizer, until the garbage collector has deleted its dependents, so destroy only completes once they are gone, e.g. a " +
+					"Deployment's ReplicaSets and Pods. `orphan` leaves the dependents in the cluster. Defaults to the kind's own policy, `background` for most kinds. " +
+					"The wait for the deletion is still bounded by `delete_timeout`.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(deleteCascadeBackground, deleteCascadeForeground, deleteCascadeOrphan),
+				},
+			},
 			"force_destroy": schema.BoolAttribute{
 				Optional:            true,
-				MarkdownDescription: `Force deletion by removing finalizers. **WARNING:** Unlike other providers, this REMOVES finalizers after timeout. May cause data loss and orphaned cloud resources. Consult documentation before enabling.`,
+				MarkdownDescription: `Force deletion by removing finalizers. **WARNING:** Unlike other providers, this REMOVES finalizers after timeout. For Namespaces, ` + "`spec.finalizers` (e.g. `kubernetes`)" + ` are also cleared through the finalize subresource. May cause data loss and orphaned cloud resources. Consult documentation before enabling.`,
+			},
+			"wait_for_deletion": schema.BoolAttribute{
+				Optional: true,
+				MarkdownDescription: "Before creating the object, wait for a previous object with the same name that is still terminating (for example held by finalizers " +
+					"after a replacement) to be fully deleted, instead of applying onto it. Honors `delete_timeout`; creation fails with a diagnostic if the old object " +
+					"is not gone in time.",
+			},
+			"wait_for_namespace_termination": schema.BoolAttribute{
+				Optional: true,
+				MarkdownDescription: "Before creating the object, wait for its namespace to finish terminating (or to be recreated, for example by a namespace " +
+					"managed in the same configuration) instead of failing. The API server rejects new objects in a terminating namespace, which is common " +
+					"in quick destroy and apply cycles. Honors `delete_timeout`; without it, creating into a terminating namespace fails with a diagnostic right away.",
+			},
+			"depends_on_ready": schema.ListNestedAttribute{
+				Optional: true,
+				MarkdownDescription: "Objects that must be ready before this object is created, typically the `object_ref` of a Namespace or CustomResourceDefinition " +
+					"managed by another `k8sconnect_object`: `depends_on_ready = [k8sconnect_object.namespace.object_ref]`. A Namespace is ready once its phase is `Active`, " +
+					"a CustomResourceDefinition once it is `Established`, and any other object once it exists; with `condition`, that condition must also be True. " +
+					"Waits up to `depends_on_ready_timeout`.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"api_version": schema.StringAttribute{
+							Required:    true,
+							Description: "API version of the dependency (e.g., 'v1', 'apiextensions.k8s.io/v1')",
+						},
+						"kind": schema.StringAttribute{
+							Required:    true,
+							Description: "Kind of the dependency (e.g., 'Namespace', 'CustomResourceDefinition')",
+						},
+						"name": schema.StringAttribute{
+							Required:    true,
+							Description: "Name of the dependency",
+						},
+						"namespace": schema.StringAttribute{
+							Optional:    true,
+							Description: "Namespace of the dependency. Omit for cluster-scoped objects.",
+						},
+						"condition": schema.StringAttribute{
+							Optional:    true,
+							Description: "Status condition that must be True before the dependency is ready (e.g., 'Available' for a Deployment)",
+						},
+					},
+				},
+			},
+			"depends_on_ready_timeout": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "How long to wait for the objects in `depends_on_ready`, and for the CRD of a custom resource, to become ready before creation fails. Defaults to 5m.",
+				Validators: []validator.String{
+					durationValidator{},
+				},
+			},
+			"precondition": schema.SingleNestedAttribute{
+				Optional: true,
+				MarkdownDescription: "A cluster prerequisite checked before every create and update, failing the apply with a `PreconditionFailed` diagnostic when it " +
+					"is not met. The object must exist; with `jsonpath` the value there must be non-empty, and with `expected` it must equal `expected`. " +
+					"It is read once, not waited for: use `depends_on_ready` for objects created by the same configuration.",
+				Attributes: map[string]schema.Attribute{
+					"api_version": schema.StringAttribute{
+						Required:    true,
+						Description: "API version of the object to check (e.g., 'v1', 'apiextensions.k8s.io/v1')",
+					},
+					"kind": schema.StringAttribute{
+						Required:    true,
+						Description: "Kind of the object to check (e.g., 'CustomResourceDefinition', 'ConfigMap')",
+					},
+					"name": schema.StringAttribute{
+						Required:    true,
+						Description: "Name of the object to check",
+					},
+					"namespace": schema.StringAttribute{
+						Optional:    true,
+						Description: "Namespace of the object to check. Omit for cluster-scoped objects.",
+					},
+					"jsonpath": schema.StringAttribute{
+						Optional: true,
+						MarkdownDescription: "JSONPath into the object, either bare (`status.phase`) or in kubectl's form (`{.status.phase}`). " +
+							"Maps and lists are compared as JSON.",
+						Validators: []validator.String{
+							jsonPathValidator{},
+						},
+					},
+					"expected": schema.StringAttribute{
+						Optional:            true,
+						MarkdownDescription: "Value `jsonpath` must have. Omit to only require a non-empty value.",
+						Validators: []validator.String{
+							stringvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName("jsonpath")),
+						},
+					},
+				},
+			},
+			"allow_status": schema.BoolAttribute{
+				Optional: true,
+				MarkdownDescription: "Allow a top-level `status` in `yaml_body`, which is rejected by default. Only set this for kinds without a status subresource, " +
+					"where `status` is a regular field written with the rest of the object. For kinds with a status subresource the API server ignores it.",
+			},
+			"replace_on_update": schema.BoolAttribute{
+				Optional: true,
+				MarkdownDescription: "Replace the object (delete then create) instead of updating it in place whenever a managed field changes. " +
+					"Use for objects that must be recreated cleanly, such as Secrets consumers only read at startup or resources whose controller caches stale state. " +
+					"The delete honors `delete_timeout` and `force_destroy`. " +
+					"When the plan can't dry-run, e.g. with values known only after apply, any change to `yaml_body`, `labels` or `annotations` replaces the object.",
+			},
+			"replacement_strategy": schema.StringAttribute{
+				Optional: true,
+				MarkdownDescription: "How a replacement of this named object is carried out. `recreate` (default) deletes the old object before creating the new one under the same name. " +
+					"`blue-green` appends a content hash to `metadata.name` so every change creates a differently named object; pair it with `lifecycle { create_before_destroy = true }` " +
+					"and reference `object_ref.name` from dependents so they cut over before the old object is deleted. Every change to the object is a replacement in this mode.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(replacementStrategyRecreate, replacementStrategyBlueGreen),
+				},
+			},
+			"recreate_token": schema.StringAttribute{
+				Optional: true,
+				MarkdownDescription: "Arbitrary value whose change replaces the object (delete then create) even when `yaml_body` is unchanged, " +
+					"e.g. to rerun a Job or regenerate a one-shot resource. Setting, changing, or removing it all replace the object. " +
+					"Unlike `lifecycle.replace_triggered_by` it needs no other resource to reference. The delete honors `delete_timeout` and `force_destroy`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"restart_on": schema.StringAttribute{
+				Optional: true,
+				MarkdownDescription: "Arbitrary value written to the `kubectl.kubernetes.io/restartedAt` annotation of `spec.template.metadata.annotations`, like `kubectl rollout restart`. " +
+					"Changing it rolls the pods of a Deployment, StatefulSet or DaemonSet without a spec change, e.g. `restart_on = sha256(local.app_config)`. " +
+					"`pod_template_hash` changes with it, so a `k8sconnect_wait` with `rollout = true` can be re-run on the restart. " +
+					"A value set in `yaml_body` takes precedence. `timestamp()` restarts on every apply.",
+			},
+			"optimistic_concurrency": schema.BoolAttribute{
+				Optional: true,
+				MarkdownDescription: "Apply updates only if the live object's `resourceVersion` still matches `resource_version` from the last refresh or apply. " +
+					"If the object was changed since then (for example by another controller or a concurrent run between plan and apply), the update fails with a conflict " +
+					"diagnostic instead of overwriting the change. Creates are unaffected.",
+			},
+			"apply_priority": schema.Int64Attribute{
+				Optional: true,
+				MarkdownDescription: "Order this object's create or update among the `k8sconnect_object` applies Terraform runs in parallel against the same cluster: " +
+					"an apply waits until no apply with a lower `apply_priority` is pending or running. Use for bootstrapping order that `depends_on` can't express, " +
+					"such as a webhook configuration and the objects it validates. Applies with the same priority, or without `apply_priority`, are not ordered. " +
+					"Only applies that are in flight together are ordered, so use `depends_on` where the order must be guaranteed. " +
+					"Applies starting while no apply with `apply_priority` is in flight wait 0.5s for others to register, which adds that latency to each such burst of applies.",
+			},
+			"strip_last_applied_configuration": schema.BoolAttribute{
+				Optional: true,
+				MarkdownDescription: "Remove the `kubectl.kubernetes.io/last-applied-configuration` annotation left by client-side `kubectl apply` after each create or update, " +
+					"and plan an update whenever it reappears. Use when adopting objects previously managed with `kubectl apply`: this provider uses server-side apply, " +
+					"so the annotation is never updated and misleads later `kubectl apply` and `kubectl diff` runs. Use `kubectl apply --server-side` for changes outside Terraform afterwards.",
+			},
+			"reset_managed_fields": schema.BoolAttribute{
+				Optional: true,
+				MarkdownDescription: "Clear the object's `metadata.managedFields` before the apply that sets this option (create, or the update that turns it on), " +
+					"then re-apply with this provider's field manager. Recovers from managedFields corrupted by a buggy controller or a migration. " +
+					"**Affects all managers:** every other manager's field ownership is removed until it writes again. Setting it again after removing it resets again.",
+			},
+			"labels": schema.MapAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Labels merged into metadata.labels before apply. Use to stamp common labels (team, env, managed-by) onto objects without editing each yaml_body. " +
+					"Labels set in yaml_body take precedence on conflict. Merged labels are managed and drift-detected like any other field.",
+			},
+			"annotations": schema.MapAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Annotations merged into metadata.annotations before apply. Annotations set in yaml_body take precedence on conflict. " +
+					"Merged annotations are managed and drift-detected like any other field. Provider internal annotations (k8sconnect.terraform.io/*) are not allowed.",
+				Validators: []validator.Map{
+					mapvalidator.KeysAre(commonAnnotationKeyValidator{}),
+				},
 			},
 			"managed_state_projection": schema.MapAttribute{
 				Computed:    true,
@@ -143,6 +380,44 @@ func (r *objectResource) Schema(ctx context.Context, req resource.SchemaRequest,
 					"When ownership changes appear in diffs, it indicates another system has taken control of those fields. " +
 					"Use ignore_fields to delegate field management to external controllers and stop tracking their ownership.",
 			},
+			"applied_yaml": schema.StringAttribute{
+				Computed: true,
+				Description: "The complete object as accepted by the API server after the last apply or refresh, rendered as YAML. " +
+					"Includes server-defaulted values and server-populated fields (uid, resourceVersion, status); metadata.managedFields and empty placeholders (creationTimestamp: null, status: {}) are omitted. " +
+					"Distinct from yaml_body (your input) and managed_state_projection (only fields owned by k8sconnect). " +
+					"Refreshed on every read, so it reflects current live state.",
+			},
+			"generation": schema.Int64Attribute{
+				Computed: true,
+				Description: "metadata.generation of the live object after the last apply or refresh. " +
+					"The API server increments it on spec changes only, so it can key a k8sconnect_wait or trigger on spec changes " +
+					"without reacting to status updates. Null for kinds that don't track generation.",
+			},
+			"resource_version": schema.StringAttribute{
+				Computed: true,
+				Description: "metadata.resourceVersion of the live object after the last apply or refresh. " +
+					"Changes on every write, including status and metadata updates by controllers; comparing it across refreshes shows external churn. " +
+					"Treat it as an opaque string.",
+			},
+			"pod_template_hash": schema.StringAttribute{
+				Computed: true,
+				Description: "Stable hash of spec.template for Deployments, StatefulSets and DaemonSets, computed from the server's view of the object " +
+					"(including defaulted values). Known during plan when the dry-run succeeds, so a pod spec change is visible at a glance and can " +
+					"trigger dependent resources (e.g. via replace_triggered_by) without reading the full YAML diff. Null for other kinds.",
+			},
+			"current_replicas": schema.Int64Attribute{
+				Computed: true,
+				Description: "Current replica count of a Deployment, StatefulSet or ReplicaSet, read from its scale subresource (status.replicas) " +
+					"after the last apply or refresh. Follows scaling by a HorizontalPodAutoscaler even when spec.replicas is in ignore_fields. " +
+					"Null for other kinds.",
+			},
+			"status": schema.MapAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "Status of a Deployment, StatefulSet, ReplicaSet or DaemonSet, read from its status subresource after the last apply or refresh " +
+					"and flattened to dotted paths (e.g. 'readyReplicas'); lists such as conditions are JSON-encoded. " +
+					"Output only: status is never compared for drift. Null for other kinds.",
+			},
 			"ignore_fields": schema.ListAttribute{
 				Optional:    true,
 				ElementType: types.StringType,
@@ -150,11 +425,60 @@ func (r *objectResource) Schema(ctx context.Context, req resource.SchemaRequest,
 					"(HPA replicas, cert-manager CA bundles, operator annotations). " +
 					"Supports dot notation ('spec.replicas'), positional arrays ('webhooks[0].caBundle'), " +
 					"and JSONPath predicates ('containers[?(@.name==\"nginx\")].image'). " +
+					"A parent path such as 'status' (or 'status.*') ignores its whole subtree. " +
 					"Example: 'spec.template.spec.containers[?(@.name==\"app\")].env[?(@.name==\"EXTERNAL_VAR\")].value'",
 				Validators: []validator.List{
 					listvalidator.ValueStringsAre(ignoreFieldsValidator{}),
 				},
 			},
+			"manage_scope": schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				MarkdownDescription: "Top-level fields, such as `[\"spec\"]`, that `managed_state_projection`, `managed_fields` and drift detection are restricted to. " +
+					"Changes other actors make outside them, e.g. to labels and annotations, never produce a plan. A coarser alternative to listing many `ignore_fields`. " +
+					"Fields outside the scope are still applied from `yaml_body`, but changing only them doesn't plan an update either: they are sent with the next in-scope change. " +
+					"Include `status` only for custom resources whose status is user-writable: it allows a top-level `status` in `yaml_body`, which is written through the status subresource after each apply and checked for drift.",
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.UniqueValues(),
+					listvalidator.ValueStringsAre(
+						stringvalidator.RegexMatches(manageScopeFieldPattern, "must be a top-level field name such as spec or data"),
+						stringvalidator.NoneOf("apiVersion", "kind"),
+					),
+				},
+			},
+			"detect_drift": schema.BoolAttribute{
+				Optional: true,
+				MarkdownDescription: "Detect changes made to the object outside Terraform. Defaults to `true`. When `false`, refresh only checks that the object still exists " +
+					"and plans with unchanged configuration skip the dry-run, which speeds up large plans. External changes to managed fields are then not detected or reverted " +
+					"until the configuration changes.",
+			},
+			"refresh_from_cache": schema.BoolAttribute{
+				Optional: true,
+				MarkdownDescription: "Read the object during refresh with `resourceVersion=0`, which the API server serves from its watch cache instead of a quorum read " +
+					"from etcd. Lowers control-plane load for large configurations, but the object read can lag the latest write by a short time, so a recent " +
+					"external change may only show as drift on the next refresh. Applies, plans and deletes always read the latest version. Defaults to `false`.",
+			},
+			"field_manager": schema.StringAttribute{
+				Optional: true,
+				MarkdownDescription: "Server-Side Apply field manager the object is applied with. Defaults to `k8sconnect`. Give each `k8sconnect_object` that writes " +
+					"to the same object its own field manager, with disjoint fields in `yaml_body`: each one then only owns, projects and detects drift on its own " +
+					"fields, and applies onto the object even if it already exists. Such resources are not tracked with the ownership annotation. Destroying one " +
+					"while other Server-Side Apply writers remain releases its fields and leaves the object in place; the last one deletes it.",
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 128),
+				},
+			},
+			"field_manager_operation": schema.StringAttribute{
+				Optional: true,
+				MarkdownDescription: "Operation `metadata.managedFields` records for the field manager: `Apply` (the default) writes with Server-Side Apply, `Update` " +
+					"creates the object and then writes it with JSON merge patches, like a controller or `kubectl edit` would, for tools and controllers that only " +
+					"act on one of the two. Update operations never report field conflicts and take over fields owned by other managers silently, replace lists " +
+					"instead of merging them by key, and keep owning fields removed from `yaml_body`, which stay set on the object.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(fieldManagerOperationApply, fieldManagerOperationUpdate),
+				},
+			},
 			"object_ref": schema.SingleNestedAttribute{
 				Computed: true,
 				Description: "Kubernetes object reference containing the identity of the applied resource. " +
@@ -0,0 +1,105 @@
+package object
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/jmorris0x0/terraform-provider-k8sconnect/internal/k8sconnect/common/k8sclient"
+)
+
+func optimisticConcurrencyContext(client k8sclient.K8sClient, precondition string) *ResourceContext {
+	obj := &unstructured.Unstructured{}
+	obj.SetAPIVersion("v1")
+	obj.SetKind("ConfigMap")
+	obj.SetName("app-config")
+	obj.SetNamespace("default")
+	return &ResourceContext{
+		Client:                      client,
+		Object:                      obj,
+		GVR:                         schema.GroupVersionResource{Version: "v1", Resource: "configmaps"},
+		PreconditionResourceVersion: precondition,
+	}
+}
+
+func TestApplySendsResourceVersionPrecondition(t *testing.T) {
+	stub := k8sclient.NewStubK8sClient()
+	rc := optimisticConcurrencyContext(stub, "41")
+	resp := &resource.UpdateResponse{}
+
+	if err := (&objectResource{}).applyResourceWithConflictHandling(context.Background(), rc, &objectResourceModel{}, resp, "Update"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(stub.ApplyCalls) != 1 {
+		t.Fatalf("expected 1 apply, got %d", len(stub.ApplyCalls))
+	}
+	if got := stub.ApplyCalls[0].Object.GetResourceVersion(); got != "41" {
+		t.Errorf("applied resourceVersion = %q, want 41", got)
+	}
+	if rc.Object.GetResourceVersion() != "" {
+		t.Error("the precondition must only be set on the applied copy, not the desired object")
+	}
+
+	// Creates never carry a precondition
+	stub = k8sclient.NewStubK8sClient()
+	rc = optimisticConcurrencyContext(stub, "41")
+	if err := (&objectResource{}).applyResourceWithConflictHandling(context.Background(), rc, &objectResourceModel{}, &resource.CreateResponse{}, "Create"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := stub.ApplyCalls[0].Object.GetResourceVersion(); got != "" {
+		t.Errorf("create should not send a resourceVersion, got %q", got)
+	}
+}
+
+func TestApplyResourceVersionConflict(t *testing.T) {
+	stub := k8sclient.NewStubK8sClient()
+	stub.ApplyError = apierrors.NewConflict(schema.GroupResource{Resource: "configmaps"}, "app-config",
+		fmt.Errorf("the object has been modified; please apply your changes to the latest version and try again"))
+	rc := optimisticConcurrencyContext(stub, "41")
+	resp := &resource.UpdateResponse{}
+
+	if err := (&objectResource{}).applyResourceWithConflictHandling(context.Background(), rc, &objectResourceModel{}, resp, "Update"); err == nil {
+		t.Fatal("expected the conflict to be returned")
+	}
+	if resp.Diagnostics.ErrorsCount() != 1 {
+		t.Fatalf("expected 1 error diagnostic, got %v", resp.Diagnostics)
+	}
+	diag := resp.Diagnostics.Errors()[0]
+	if !strings.Contains(diag.Summary(), "Object Changed Since Last Read") {
+		t.Errorf("unexpected summary: %s", diag.Summary())
+	}
+	for _, want := range []string{"resourceVersion 41", "terraform plan", "optimistic_concurrency",
+		"kubectl get configmap app-config -n default -o yaml --show-managed-fields"} {
+		if !strings.Contains(diag.Detail(), want) {
+			t.Errorf("detail missing %q:\n%s", want, diag.Detail())
+		}
+	}
+}
+
+func TestResourceVersionPrecondition(t *testing.T) {
+	tests := []struct {
+		name  string
+		value types.String
+		want  string
+	}{
+		{"recorded", types.StringValue("41"), "41"},
+		{"empty", types.StringValue(""), ""},
+		{"null", types.StringNull(), ""},
+		{"unknown", types.StringUnknown(), ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			state := objectResourceModel{ResourceVersion: tt.value}
+			if got := resourceVersionPrecondition(context.Background(), &state); got != tt.want {
+				t.Errorf("resourceVersionPrecondition() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/jmorris0x0/terraform-provider-k8sconnect/internal/k8sconnect/common/auth"
 	"github.com/jmorris0x0/terraform-provider-k8sconnect/internal/k8sconnect/common/factory"
 	"github.com/jmorris0x0/terraform-provider-k8sconnect/internal/k8sconnect/common/fieldmanagement"
 	"github.com/jmorris0x0/terraform-provider-k8sconnect/internal/k8sconnect/common/k8sclient"
@@ -47,6 +48,19 @@ func (r *objectResource) ModifyPlan(ctx context.Context, req resource.ModifyPlan
 		}
 	}
 
+	// replace_on_update: turn any remaining managed-field change into delete+create. Deferred
+	// so plans that end without a dry-run, with the projection unknown, are covered too.
+	defer func() {
+		if !resp.Diagnostics.HasError() {
+			r.checkReplaceOnUpdate(ctx, req, &plannedData, resp)
+		}
+	}()
+
+	// detect_drift = false: with unchanged configuration there is nothing to dry-run
+	if !req.State.Raw.IsNull() && r.planWithoutDriftCheck(ctx, req, &plannedData, resp) {
+		return
+	}
+
 	// Parse the desired YAML first (we need desiredObj for yaml fallback)
 	yamlStr := plannedData.YAMLBody.ValueString()
 
@@ -55,6 +69,12 @@ func (r *objectResource) ModifyPlan(ctx context.Context, req resource.ModifyPlan
 		// Mark computed fields as unknown
 		plannedData.ManagedStateProjection = types.MapUnknown(types.StringType)
 		plannedData.ManagedFields = types.MapUnknown(types.StringType)
+		plannedData.AppliedYAML = types.StringUnknown()
+		plannedData.Generation = types.Int64Unknown()
+		plannedData.ResourceVersion = types.StringUnknown()
+		plannedData.PodTemplateHash = types.StringUnknown()
+		plannedData.CurrentReplicas = types.Int64Unknown()
+		plannedData.Status = types.MapUnknown(types.StringType)
 
 		// Save the plan with unknown computed fields
 		diags = resp.Plan.Set(ctx, &plannedData)
@@ -70,6 +90,12 @@ func (r *objectResource) ModifyPlan(ctx context.Context, req resource.ModifyPlan
 			// Mark computed fields as unknown
 			plannedData.ManagedStateProjection = types.MapUnknown(types.StringType)
 			plannedData.ManagedFields = types.MapUnknown(types.StringType)
+			plannedData.AppliedYAML = types.StringUnknown()
+			plannedData.Generation = types.Int64Unknown()
+			plannedData.ResourceVersion = types.StringUnknown()
+			plannedData.PodTemplateHash = types.StringUnknown()
+			plannedData.CurrentReplicas = types.Int64Unknown()
+			plannedData.Status = types.MapUnknown(types.StringType)
 
 			// Save the plan with unknown computed fields
 			diags = resp.Plan.Set(ctx, &plannedData)
@@ -82,6 +108,41 @@ func (r *objectResource) ModifyPlan(ctx context.Context, req resource.ModifyPlan
 		return
 	}
 
+	// Merge labels/annotations attributes - they are part of what gets applied
+	if hasUnknownCommonMetadata(&plannedData) {
+		r.setProjectionUnknown(ctx, &plannedData, resp,
+			"labels, annotations or restart_on contain unknown values, projection will be calculated during apply")
+		return
+	}
+	if err := mergeCommonMetadata(ctx, desiredObj, &plannedData); err != nil {
+		resp.Diagnostics.AddError("Invalid Metadata", err.Error())
+		return
+	}
+	if err := applyReplacementStrategy(desiredObj, &plannedData); err != nil {
+		resp.Diagnostics.AddError("Invalid Replacement Strategy", err.Error())
+		return
+	}
+
+	// generateName: an existing object keeps the name recorded in state; a new one is
+	// named at apply, so there is nothing to dry-run against yet. An omitted namespace
+	// likewise stays the one recorded in state unless cluster.namespace changed.
+	if !isCreateOperation(req) {
+		var stateData objectResourceModel
+		if diags := req.State.Get(ctx, &stateData); !diags.HasError() {
+			resolveGeneratedName(ctx, desiredObj, stateData.ObjectRef)
+			if desiredObj.GetNamespace() == "" {
+				if namespace, kept := stateDefaultNamespace(ctx, &stateData, &plannedData); kept {
+					desiredObj.SetNamespace(namespace)
+				}
+			}
+		}
+	}
+	if usesGenerateName(desiredObj) {
+		r.setProjectionUnknown(ctx, &plannedData, resp,
+			"generateName: the object name is generated during apply, projection will be calculated during apply")
+		return
+	}
+
 	// Validate connection is ready for operations
 	connectionReady := r.isConnectionReady(plannedData.Cluster)
 
@@ -118,6 +179,11 @@ func (r *objectResource) ModifyPlan(ctx context.Context, req resource.ModifyPlan
 		return
 	}
 
+	// Same object as the last apply and no drift since: nothing to dry-run
+	if r.planFromAppliedHash(ctx, req, &plannedData, desiredObj, resp) {
+		return
+	}
+
 	// Execute dry-run and compute projection
 	ok, refreshedProjection := r.executeDryRunAndProjection(ctx, req, &plannedData, desiredObj, resp, plannedData.Cluster)
 	if !ok {
@@ -138,6 +204,9 @@ func (r *objectResource) ModifyPlan(ctx context.Context, req resource.ModifyPlan
 	// ADR-023: Pass refreshedProjection for accurate drift detection when Read returned stale state
 	r.checkDriftAndPreserveState(ctx, req, &plannedData, resp, refreshedProjection)
 
+	// reset_managed_fields: ownership after the reset is only known after apply
+	r.checkManagedFieldsReset(ctx, req, &plannedData)
+
 	// Save the modified plan
 	diags = resp.Plan.Set(ctx, &plannedData)
 	resp.Diagnostics.Append(diags...)
@@ -146,15 +215,130 @@ func (r *objectResource) ModifyPlan(ctx context.Context, req resource.ModifyPlan
 // setProjectionUnknown sets projection to unknown and saves plan
 //
 // When we can't perform dry-run to predict the result, we set
-// managed_state_projection and managed_fields to unknown.
+// managed_state_projection, managed_fields, applied_yaml, generation,
+// resource_version, pod_template_hash, current_replicas and status to unknown.
 func (r *objectResource) setProjectionUnknown(ctx context.Context, plannedData *objectResourceModel, resp *resource.ModifyPlanResponse, reason string) {
 	tflog.Debug(ctx, reason)
 	plannedData.ManagedStateProjection = types.MapUnknown(types.StringType)
 	plannedData.ManagedFields = types.MapUnknown(types.StringType)
+	plannedData.AppliedYAML = types.StringUnknown()
+	plannedData.Generation = types.Int64Unknown()
+	plannedData.ResourceVersion = types.StringUnknown()
+	plannedData.PodTemplateHash = types.StringUnknown()
+	plannedData.CurrentReplicas = types.Int64Unknown()
+	plannedData.Status = types.MapUnknown(types.StringType)
 	diags := resp.Plan.Set(ctx, plannedData)
 	resp.Diagnostics.Append(diags...)
 }
 
+// checkReplaceOnUpdate marks the resource for replacement when replace_on_update is set
+// and the planned projection still differs from state after drift preservation, i.e.
+// an in-place SSA update would otherwise happen. The projection path is used because
+// Terraform only honors RequiresReplace for attributes whose value actually changes, and
+// drift can change the projection without touching yaml_body. Without a dry-run the
+// changed configuration attributes are used instead, see replaceOnUpdatePaths.
+func (r *objectResource) checkReplaceOnUpdate(ctx context.Context, req resource.ModifyPlanRequest, plannedData *objectResourceModel, resp *resource.ModifyPlanResponse) {
+	if isCreateOperation(req) || !plannedData.ReplaceOnUpdate.ValueBool() {
+		return
+	}
+
+	var stateData objectResourceModel
+	diags := req.State.Get(ctx, &stateData)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	replacePaths := replaceOnUpdatePaths(plannedData, &stateData)
+	if len(replacePaths) == 0 {
+		return
+	}
+
+	tflog.Info(ctx, "Managed fields changed with replace_on_update, triggering replacement", map[string]interface{}{
+		"resource":      stateData.ID.ValueString(),
+		"replace_paths": replacePaths.String(),
+	})
+	resp.RequiresReplace = resp.RequiresReplace.Append(replacePaths...)
+}
+
+// replaceOnUpdatePaths returns the attributes whose change makes replace_on_update turn
+// this plan into a replacement, or nil when it stays an update. With a known projection
+// that is managed_state_projection. When the projection is unknown because the dry-run
+// couldn't run (interpolated values, a bootstrap connection, generateName), it is
+// whichever of yaml_body, labels and annotations changed, since those shape the object.
+func replaceOnUpdatePaths(plannedData, stateData *objectResourceModel) path.Paths {
+	if !plannedData.ReplaceOnUpdate.ValueBool() {
+		return nil
+	}
+	if !plannedData.ManagedStateProjection.IsUnknown() {
+		if plannedData.ManagedStateProjection.Equal(stateData.ManagedStateProjection) {
+			return nil
+		}
+		return path.Paths{path.Root("managed_state_projection")}
+	}
+
+	var paths path.Paths
+	if !plannedData.YAMLBody.Equal(stateData.YAMLBody) {
+		paths = append(paths, path.Root("yaml_body"))
+	}
+	if !plannedData.Labels.Equal(stateData.Labels) {
+		paths = append(paths, path.Root("labels"))
+	}
+	if !plannedData.Annotations.Equal(stateData.Annotations) {
+		paths = append(paths, path.Root("annotations"))
+	}
+	return paths
+}
+
+// driftDetectionDisabled reports whether detect_drift = false. State without a projection
+// (e.g. just imported) is always refreshed, since there is nothing to keep.
+func driftDetectionDisabled(data *objectResourceModel) bool {
+	return !data.DetectDrift.IsNull() && !data.DetectDrift.IsUnknown() && !data.DetectDrift.ValueBool() &&
+		!data.ManagedStateProjection.IsNull() && !data.ManagedStateProjection.IsUnknown()
+}
+
+// driftCheckSkippable reports whether an update plan can reuse state without a dry-run:
+// drift detection is disabled and nothing that shapes the applied object has changed
+func driftCheckSkippable(plannedData, stateData *objectResourceModel) bool {
+	return driftDetectionDisabled(stateData) && !plannedData.DetectDrift.IsNull() && !plannedData.DetectDrift.ValueBool() &&
+		plannedData.YAMLBody.Equal(stateData.YAMLBody) &&
+		plannedData.Labels.Equal(stateData.Labels) &&
+		plannedData.Annotations.Equal(stateData.Annotations) &&
+		plannedData.RestartOn.Equal(stateData.RestartOn) &&
+		plannedData.ReplacementStrategy.Equal(stateData.ReplacementStrategy) &&
+		projectionSettingsUnchanged(plannedData, stateData)
+}
+
+// planWithoutDriftCheck plans no change to the object for detect_drift = false when the
+// configuration is unchanged, preserving the computed attributes from state. Returns true
+// when the plan was set.
+func (r *objectResource) planWithoutDriftCheck(ctx context.Context, req resource.ModifyPlanRequest, plannedData *objectResourceModel, resp *resource.ModifyPlanResponse) bool {
+	if plannedData.DetectDrift.IsNull() || plannedData.DetectDrift.ValueBool() || checkPendingProjectionFlag(ctx, req.Private) {
+		return false
+	}
+
+	var stateData objectResourceModel
+	diags := req.State.Get(ctx, &stateData)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || !driftCheckSkippable(plannedData, &stateData) {
+		return false
+	}
+
+	tflog.Debug(ctx, "Drift detection disabled and configuration unchanged, skipping dry-run")
+	plannedData.ManagedStateProjection = stateData.ManagedStateProjection
+	plannedData.ManagedFields = stateData.ManagedFields
+	plannedData.ObjectRef = stateData.ObjectRef
+	plannedData.AppliedYAML = stateData.AppliedYAML
+	plannedData.Generation = stateData.Generation
+	plannedData.ResourceVersion = stateData.ResourceVersion
+	plannedData.PodTemplateHash = stateData.PodTemplateHash
+	plannedData.CurrentReplicas = stateData.CurrentReplicas
+	plannedData.Status = stateData.Status
+
+	diags = resp.Plan.Set(ctx, plannedData)
+	resp.Diagnostics.Append(diags...)
+	return true
+}
+
 // isCreateOperation checks if this is a create vs update
 func isCreateOperation(req resource.ModifyPlanRequest) bool {
 	return req.State.Raw.IsNull()
@@ -192,6 +376,15 @@ func (r *objectResource) checkDriftAndPreserveState(ctx context.Context, req res
 				// Preserve object_ref since resource identity hasn't changed
 				plannedData.ObjectRef = stateData.ObjectRef
 
+				// Preserve applied_yaml, generation, resource_version, pod_template_hash, current_replicas
+				// and status - nothing will be sent to the server
+				plannedData.AppliedYAML = stateData.AppliedYAML
+				plannedData.Generation = stateData.Generation
+				plannedData.ResourceVersion = stateData.ResourceVersion
+				plannedData.PodTemplateHash = stateData.PodTemplateHash
+				plannedData.CurrentReplicas = stateData.CurrentReplicas
+				plannedData.Status = stateData.Status
+
 				// Only preserve managed_fields if BOTH:
 				// 1. ignore_fields hasn't changed
 				// 2. managed_fields hasn't changed (no ownership transitions)
@@ -285,15 +478,19 @@ func (r *objectResource) computeRefreshedProjection(ctx context.Context, current
 	if ignoreFields := getIgnoreFields(ctx, data); ignoreFields != nil {
 		filteredPaths = filterIgnoredPaths(filteredPaths, ignoreFields, desiredObj.Object)
 	}
+	filteredPaths = filterManageScope(filteredPaths, getManageScope(ctx, data))
 
 	// Project field values from the current cluster object
-	projection, err := projectFields(currentObj.Object, filteredPaths)
+	projection, err := projectFields(alignListOrder(currentObj, desiredObj.Object), filteredPaths)
 	if err != nil {
 		tflog.Debug(ctx, "Failed to compute refreshed projection from current object", map[string]interface{}{
 			"error": err.Error(),
 		})
 		return nil
 	}
+	normalizeFinalizers(projection, desiredObj.Object)
+	normalizeBinaryData(projection)
+	normalizeIntOrString(projection)
 
 	// Convert to flat map and then types.Map
 	projectionMap := flattenProjectionToMap(projection, filteredPaths)
@@ -331,7 +528,7 @@ func (r *objectResource) calculateProjection(ctx context.Context, req resource.M
 
 		// For CREATE, project all fields from dry-run result (no existing ownership to filter by)
 		// The dry-run result contains all the fields we're setting plus K8s defaults
-		paths := extractOwnedPaths(ctx, dryRunResult.GetManagedFields(), desiredObj.Object)
+		paths := extractOwnedPaths(ctx, dryRunResult.GetManagedFields(), fieldManagerFor(plannedData), desiredObj.Object)
 
 		// Apply ignore_fields filtering if specified
 		if ignoreFields := getIgnoreFields(ctx, plannedData); ignoreFields != nil {
@@ -339,7 +536,7 @@ func (r *objectResource) calculateProjection(ctx context.Context, req resource.M
 		}
 
 		// Project the dry-run result to show what will be created
-		return r.applyProjection(ctx, dryRunResult, paths, plannedData, isCreate, resp), nil
+		return r.applyProjection(ctx, dryRunResult, desiredObj, paths, plannedData, isCreate, resp), nil
 	}
 
 	// UPDATE operations: Check for ownership transitions BEFORE dry-run
@@ -386,7 +583,7 @@ func (r *objectResource) calculateProjection(ctx context.Context, req resource.M
 
 	// Now continue with projection calculation using dry-run result
 	// Extract ownership from dry-run result (what ownership WILL BE after apply)
-	paths := extractOwnedPaths(ctx, dryRunResult.GetManagedFields(), desiredObj.Object)
+	paths := extractOwnedPaths(ctx, dryRunResult.GetManagedFields(), fieldManagerFor(plannedData), desiredObj.Object)
 
 	// ADR-023 Phase 3: Compute refreshed projection from current cluster state
 	// This enables drift detection even when Read returns stale state (expired token scenario).
@@ -397,13 +594,19 @@ func (r *objectResource) calculateProjection(ctx context.Context, req resource.M
 	}
 
 	// Apply projection from dry-run result
-	return r.applyProjection(ctx, dryRunResult, paths, plannedData, isCreate, resp), refreshedProjection
+	return r.applyProjection(ctx, dryRunResult, desiredObj, paths, plannedData, isCreate, resp), refreshedProjection
 }
 
 // performDryRun executes the dry-run against k8s
 func (r *objectResource) performDryRun(ctx context.Context, client k8sclient.K8sClient, desiredObj *unstructured.Unstructured, plannedData *objectResourceModel, resp *resource.ModifyPlanResponse) (*unstructured.Unstructured, error) {
 	// Filter ignored fields before dry-run to match what we'll actually apply
 	objToApply := desiredObj.DeepCopy()
+	if isCoreService(objToApply) {
+		// As in apply, the Service fields the server assigned are sent with the object
+		if gvr, err := client.GetGVR(ctx, objToApply); err == nil {
+			objToApply = withServiceAssignedFields(ctx, client, gvr, objToApply)
+		}
+	}
 	if ignoreFields := getIgnoreFields(ctx, plannedData); ignoreFields != nil {
 		objToApply = removeFieldsFromObject(objToApply, ignoreFields)
 		tflog.Debug(ctx, "Filtered ignore_fields before dry-run", map[string]interface{}{
@@ -412,11 +615,26 @@ func (r *objectResource) performDryRun(ctx context.Context, client k8sclient.K8s
 	}
 
 	dryRunResult, err := client.DryRunApply(ctx, objToApply, k8sclient.ApplyOptions{
-		FieldManager:    "k8sconnect",
+		FieldManager:    fieldManagerFor(plannedData),
 		Force:           true,
 		FieldValidation: "Strict", // ADR-017: Validate fields against OpenAPI schema during plan
+		UpdateOperation: recordsUpdateOperation(plannedData),
 	})
 
+	// x-kubernetes-preserve-unknown-fields: dry-run the merge patch apply would fall back to
+	if isTypedObjectError(err) {
+		if gvr, gvrErr := client.GetGVR(ctx, objToApply); gvrErr == nil {
+			if merged, ok := mergePatchFallback(ctx, client, gvr, objToApply, fieldManagerFor(plannedData), err, true); ok {
+				dryRunResult, err = merged, nil
+			}
+		}
+	}
+
+	// manage_scope includes status: dry-run the status subresource write apply follows up with
+	if err == nil && managesStatus(ctx, plannedData) {
+		dryRunResult, err = dryRunStatus(ctx, client, dryRunResult, objToApply, plannedData)
+	}
+
 	// Surface any API warnings from dry-run operation
 	k8sclient.SurfaceK8sWarningsWithIdentity(ctx, client, desiredObj, &resp.Diagnostics)
 
@@ -482,23 +700,31 @@ func (r *objectResource) performDryRun(ctx context.Context, client k8sclient.K8s
 }
 
 // applyProjection projects fields and updates plan
-func (r *objectResource) applyProjection(ctx context.Context, dryRunResult *unstructured.Unstructured, paths []string, plannedData *objectResourceModel, isCreate bool, resp *resource.ModifyPlanResponse) bool {
+func (r *objectResource) applyProjection(ctx context.Context, dryRunResult, desiredObj *unstructured.Unstructured, paths []string, plannedData *objectResourceModel, isCreate bool, resp *resource.ModifyPlanResponse) bool {
+	// paths address map and set list items by their position in yaml_body
+	aligned := alignListOrder(dryRunResult, desiredObj.Object)
+
 	// Apply ignore_fields filtering if specified
 	if ignoreFields := getIgnoreFields(ctx, plannedData); ignoreFields != nil {
-		paths = filterIgnoredPaths(paths, ignoreFields, dryRunResult.Object)
+		paths = filterIgnoredPaths(paths, ignoreFields, aligned)
 		tflog.Debug(ctx, "Applied ignore_fields filtering in plan modifier", map[string]interface{}{
 			"ignored_count":  len(ignoreFields),
 			"filtered_paths": len(paths),
 		})
 	}
+	manageScope := getManageScope(ctx, plannedData)
+	paths = filterManageScope(paths, manageScope)
 
 	// Project the dry-run result
-	projection, err := projectFields(dryRunResult.Object, paths)
+	projection, err := projectFields(aligned, paths)
 	if err != nil {
 		resp.Diagnostics.AddError("Projection Failed",
 			fmt.Sprintf("Failed to project fields for %s: %s", formatResource(dryRunResult), err))
 		return false
 	}
+	normalizeFinalizers(projection, desiredObj.Object)
+	normalizeBinaryData(projection)
+	normalizeIntOrString(projection)
 
 	// Convert projection to flat map for clean diff display
 	projectionMap := flattenProjectionToMap(projection, paths)
@@ -514,6 +740,17 @@ func (r *objectResource) applyProjection(ctx context.Context, dryRunResult *unst
 	// Update the plan with projection
 	plannedData.ManagedStateProjection = mapValue
 
+	// applied_yaml, generation, resource_version, current_replicas and status reflect the
+	// server's response and are only known after apply. checkDriftAndPreserveState restores
+	// the state values when nothing changes. pod_template_hash only depends on the template,
+	// which the dry-run already shows as the server will store it.
+	plannedData.AppliedYAML = types.StringUnknown()
+	plannedData.Generation = types.Int64Unknown()
+	plannedData.ResourceVersion = types.StringUnknown()
+	plannedData.CurrentReplicas = types.Int64Unknown()
+	plannedData.Status = types.MapUnknown(types.StringType)
+	updatePodTemplateHashData(plannedData, dryRunResult)
+
 	tflog.Debug(ctx, "Dry-run projection complete", map[string]interface{}{
 		"path_count": len(paths),
 		"map_size":   len(projectionMap),
@@ -535,8 +772,9 @@ func (r *objectResource) applyProjection(ctx context.Context, dryRunResult *unst
 		// (e.g., after import where kubectl owns everything, or ignore_fields modifications
 		// where external controllers took ownership). v0.1.7 used ExtractManagedFieldsMap
 		// which iterated over ALL managers, not just k8sconnect.
+		fieldManager := fieldManagerFor(plannedData)
 		allOwnership := fieldmanagement.ExtractAllManagedFields(dryRunResult)
-		ownershipMap := fieldmanagement.FlattenManagedFields(allOwnership)
+		ownershipMap := fieldmanagement.FlattenManagedFieldsFor(allOwnership, fieldManager)
 
 		// ADR-019: Override predicted ownership for fields we're applying with force=true
 		// Kubernetes dry-run doesn't predict force=true ownership takeover, so we must
@@ -578,13 +816,13 @@ func (r *objectResource) applyProjection(ctx context.Context, dryRunResult *unst
 		overrideCount := 0
 		for path, currentOwner := range ownershipMap {
 			// Only override if we're actually sending this field
-			if fieldsWeAreSending[path] && currentOwner != "k8sconnect" {
+			if fieldsWeAreSending[path] && currentOwner != fieldManager {
 				tflog.Debug(ctx, "Overriding ownership", map[string]interface{}{
 					"path": path,
 					"from": currentOwner,
-					"to":   "k8sconnect",
+					"to":   fieldManager,
 				})
-				ownershipMap[path] = "k8sconnect"
+				ownershipMap[path] = fieldManager
 				overrideCount++
 			}
 		}
@@ -598,16 +836,20 @@ func (r *objectResource) applyProjection(ctx context.Context, dryRunResult *unst
 			})
 		}
 
-		// Filter out status fields - they are not preserved during Apply operations
-		// Also filter out K8s system annotations that appear/change unpredictably
+		// Filter out status fields - they are not preserved during Apply operations unless
+		// manage_scope includes status. Also filter out K8s system annotations that appear/change unpredictably
 		for path := range ownershipMap {
-			if strings.HasPrefix(path, "status.") || path == "status" {
+			if isStatusPath(path) && !inManageScope(path, manageScope) {
 				delete(ownershipMap, path)
 			}
 			// Filter K8s system annotations to avoid plan/apply inconsistencies
 			if fieldmanagement.IsKubernetesSystemAnnotation(path) {
 				delete(ownershipMap, path)
 			}
+			// manage_scope: ownership outside the scope is not tracked, as in updateManagedFieldsData
+			if manageScope != nil && !inManageScope(path, manageScope) {
+				delete(ownershipMap, path)
+			}
 		}
 
 		// NOTE: We do NOT filter out ignore_fields from managed_fields
@@ -759,10 +1001,11 @@ func (r *objectResource) detectOwnershipConflicts(ctx context.Context, req resou
 	fieldsSendingMap := r.buildFieldsSendingMap(ctx, &plannedData)
 
 	// Flatten current ownership for comparison
-	currentOwnershipFlat := fieldmanagement.FlattenManagedFields(currentOwnership)
+	fieldManager := fieldManagerFor(&plannedData)
+	currentOwnershipFlat := fieldmanagement.FlattenManagedFieldsFor(currentOwnership, fieldManager)
 
 	// Classify all fields and collect conflicts
-	conflicts := r.classifyFieldConflicts(ctx, currentOwnershipFlat, baselineOwnership, fieldsSendingMap,
+	conflicts := r.classifyFieldConflicts(ctx, fieldManager, currentOwnershipFlat, baselineOwnership, fieldsSendingMap,
 		configChanged, stateObj, currentObj, desiredObj)
 
 	// Emit warnings (resource-level aggregation) with resource identity to prevent collapsing
@@ -842,9 +1085,15 @@ func (r *objectResource) buildFieldsSendingMap(ctx context.Context, plannedData
 	if err != nil {
 		return fieldsSendingMap
 	}
+	if err := mergeCommonMetadata(ctx, desiredObj, plannedData); err != nil {
+		return fieldsSendingMap
+	}
+	if err := applyReplacementStrategy(desiredObj, plannedData); err != nil {
+		return fieldsSendingMap
+	}
 
 	// Get all field paths from desired object
-	allPaths := extractOwnedPaths(ctx, []metav1.ManagedFieldsEntry{}, desiredObj.Object)
+	allPaths := extractOwnedPaths(ctx, []metav1.ManagedFieldsEntry{}, fieldManagerFor(plannedData), desiredObj.Object)
 
 	// Filter out ignore_fields
 	ignoreFields := getIgnoreFields(ctx, plannedData)
@@ -868,7 +1117,7 @@ func (r *objectResource) buildFieldsSendingMap(ctx context.Context, plannedData
 }
 
 // classifyFieldConflicts classifies all fields and builds conflict detector
-func (r *objectResource) classifyFieldConflicts(ctx context.Context,
+func (r *objectResource) classifyFieldConflicts(ctx context.Context, fieldManager string,
 	currentOwnershipFlat, baselineOwnership map[string]string,
 	fieldsSendingMap map[string]bool, configChanged bool,
 	stateObj, currentObj, desiredObj *unstructured.Unstructured) *ownership.ConflictDetection {
@@ -879,9 +1128,9 @@ func (r *objectResource) classifyFieldConflicts(ctx context.Context,
 		baselineManager, existedInBaseline := baselineOwnership[fieldPath]
 
 		// Calculate the 4 boolean dimensions
-		prevOwned := existedInBaseline && stringSliceContains([]string{baselineManager}, "k8sconnect")
-		nowOwned := fieldsSendingMap[fieldPath] || stringSliceContains([]string{currentManager}, "k8sconnect")
-		externalChanged := r.detectExternalChange(existedInBaseline, baselineManager, currentManager)
+		prevOwned := existedInBaseline && stringSliceContains([]string{baselineManager}, fieldManager)
+		nowOwned := fieldsSendingMap[fieldPath] || stringSliceContains([]string{currentManager}, fieldManager)
+		externalChanged := r.detectExternalChange(fieldManager, existedInBaseline, baselineManager, currentManager)
 
 		// Classify conflict type
 		conflictType := ownership.ClassifyConflict(prevOwned, nowOwned, configChanged, externalChanged)
@@ -902,7 +1151,7 @@ func (r *objectResource) classifyFieldConflicts(ctx context.Context,
 
 		// Add to conflict detector if not NoConflict
 		if conflictType != ownership.NoConflict {
-			fieldChange := r.createFieldChange(fieldPath, baselineManager, currentManager,
+			fieldChange := r.createFieldChange(fieldManager, fieldPath, baselineManager, currentManager,
 				stateObj, currentObj, desiredObj)
 			conflicts.AddField(conflictType, fieldChange)
 		}
@@ -912,27 +1161,27 @@ func (r *objectResource) classifyFieldConflicts(ctx context.Context,
 }
 
 // detectExternalChange determines if an external manager modified/owns a field
-func (r *objectResource) detectExternalChange(existedInBaseline bool, baselineManager, currentManager string) bool {
+func (r *objectResource) detectExternalChange(fieldManager string, existedInBaseline bool, baselineManager, currentManager string) bool {
 	// Case 1: Field was in baseline and manager changed to someone else
-	if existedInBaseline && baselineManager != currentManager && currentManager != "k8sconnect" {
+	if existedInBaseline && baselineManager != currentManager && currentManager != fieldManager {
 		return true
 	}
 	// Case 2: Field is NEW to us (not in baseline) but external already owns it
-	if !existedInBaseline && currentManager != "k8sconnect" && currentManager != "" {
+	if !existedInBaseline && currentManager != fieldManager && currentManager != "" {
 		return true
 	}
 	return false
 }
 
 // createFieldChange creates a FieldChange with values extracted from objects
-func (r *objectResource) createFieldChange(fieldPath, baselineManager, currentManager string,
+func (r *objectResource) createFieldChange(fieldManager, fieldPath, baselineManager, currentManager string,
 	stateObj, currentObj, desiredObj *unstructured.Unstructured) ownership.FieldChange {
 
 	fieldChange := ownership.FieldChange{
 		Path:            fieldPath,
 		PreviousManager: baselineManager,
 		CurrentManager:  currentManager,
-		PlannedManager:  "k8sconnect",
+		PlannedManager:  fieldManager,
 	}
 
 	// Extract field values if objects are available
@@ -1024,24 +1273,25 @@ func (r *objectResource) setObjectRefFromDesiredObj(ctx context.Context, obj *un
 	// 2. For unknown resources: Query cluster to determine scope (handles custom resources)
 	// 3. For namespace-scoped resources:
 	//    - If namespace explicitly set in YAML, use it
-	//    - If empty, default to "default" (matches K8s/k8sclient behavior)
+	//    - If empty, use the connection's default namespace (cluster.namespace, then the
+	//      kubeconfig context's namespace, then "default" - matches kubectl)
 	// 4. For cluster-scoped resources:
 	//    - Strip namespace from object (K8s ignores it anyway)
 	//    - Set object_ref.namespace to null (matches what K8s returns)
 
 	var isNamespaced bool
 
+	conn, err := r.convertObjectToConnectionModel(ctx, cluster)
+	if err != nil {
+		return fmt.Errorf("failed to convert connection for namespace detection: %w", err)
+	}
+
 	// Fast path: Use hardcoded list for common cluster-scoped resources
 	// This avoids discovery queries for standard Kubernetes resources and works during bootstrap
 	if k8sclient.IsClusterScopedResource(obj.GetAPIVersion(), obj.GetKind()) {
 		isNamespaced = false
 	} else {
 		// Unknown resource type - query the cluster
-		conn, err := r.convertObjectToConnectionModel(ctx, cluster)
-		if err != nil {
-			return fmt.Errorf("failed to convert connection for namespace detection: %w", err)
-		}
-
 		client, err := r.clientGetter(conn)
 		if err != nil {
 			return fmt.Errorf("failed to create client for namespace detection: %w", err)
@@ -1059,8 +1309,8 @@ func (r *objectResource) setObjectRefFromDesiredObj(ctx context.Context, obj *un
 		// Namespace-scoped resource
 		ns := obj.GetNamespace()
 		if ns == "" {
-			// No explicit namespace -> K8s defaults to "default"
-			ns = "default"
+			// No explicit namespace -> the connection's default namespace
+			ns = auth.DefaultNamespace(conn)
 			obj.SetNamespace(ns)
 		}
 		objRef.Namespace = types.StringValue(ns)
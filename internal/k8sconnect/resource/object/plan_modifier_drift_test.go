@@ -2,10 +2,13 @@ package object
 
 import (
 	"context"
+	"encoding/json"
 	"strings"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 )
 
@@ -266,3 +269,103 @@ func computeProjectionMap(t *testing.T, ctx context.Context, obj *unstructured.U
 	}
 	return mapValue
 }
+
+// TestIgnoreFieldsStatusSubtree verifies that ignoring status excludes the whole subtree,
+// so a controller rewriting status (placed in yaml_body by the CRD's schema) plans nothing.
+func TestIgnoreFieldsStatusSubtree(t *testing.T) {
+	ctx := context.Background()
+	r := &objectResource{}
+
+	desiredObj := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "example.com/v1",
+			"kind":       "Widget",
+			"metadata": map[string]interface{}{
+				"name":      "w",
+				"namespace": "default",
+			},
+			"spec": map[string]interface{}{"size": "small"},
+			"status": map[string]interface{}{
+				"config": map[string]interface{}{"mode": "auto"},
+				"phase":  "Pending",
+			},
+		},
+	}
+	paths := extractFieldPaths(desiredObj.Object, "")
+
+	for _, pattern := range []string{"status", "status.*"} {
+		t.Run(pattern, func(t *testing.T) {
+			ignoreList, _ := types.ListValueFrom(ctx, types.StringType, []string{pattern})
+			data := &objectResourceModel{IgnoreFields: ignoreList}
+
+			stateProjection := r.computeRefreshedProjection(ctx, desiredObj, desiredObj, paths, data)
+
+			// The controller rewrites status after the last apply
+			currentObj := desiredObj.DeepCopy()
+			_ = unstructured.SetNestedField(currentObj.Object, "manual", "status", "config", "mode")
+			_ = unstructured.SetNestedField(currentObj.Object, "Ready", "status", "phase")
+			_ = unstructured.SetNestedField(currentObj.Object, int64(3), "status", "observedGeneration")
+
+			refreshed := r.computeRefreshedProjection(ctx, currentObj, desiredObj, paths, data)
+			if refreshed == nil || stateProjection == nil {
+				t.Fatal("Expected non-nil projections")
+			}
+			if !refreshed.Equal(*stateProjection) {
+				t.Errorf("External status changes should not produce a diff\nstate:     %v\nrefreshed: %v", *stateProjection, *refreshed)
+			}
+			for key := range refreshed.Elements() {
+				if strings.HasPrefix(key, "status") {
+					t.Errorf("Status field %q should not be in the projection", key)
+				}
+			}
+			if _, ok := refreshed.Elements()["spec.size"]; !ok {
+				t.Error("spec.size should still be projected")
+			}
+
+			// status is also left out of the apply patch, so its fields aren't taken over
+			patch := removeFieldsFromObject(desiredObj, getIgnoreFields(ctx, data))
+			if _, found := patch.Object["status"]; found {
+				t.Errorf("status should be omitted from the apply patch: %v", patch.Object)
+			}
+		})
+	}
+}
+
+type recordingPrivateState map[string][]byte
+
+func (p recordingPrivateState) SetKey(_ context.Context, key string, value []byte) diag.Diagnostics {
+	p[key] = value
+	return nil
+}
+
+func (p recordingPrivateState) GetKey(_ context.Context, key string) ([]byte, diag.Diagnostics) {
+	return p[key], nil
+}
+
+// TestOwnershipBaselineSkipsIgnoredSubtree verifies that fields below an ignored parent
+// are left out of the ownership baseline used for drift detection
+func TestOwnershipBaselineSkipsIgnoredSubtree(t *testing.T) {
+	obj := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "example.com/v1",
+		"kind":       "Widget",
+		"metadata":   map[string]interface{}{"name": "w"},
+	}}
+	obj.SetManagedFields([]metav1.ManagedFieldsEntry{
+		{Manager: "k8sconnect", Operation: metav1.ManagedFieldsOperationApply, FieldsV1: &metav1.FieldsV1{Raw: []byte(`{"f:spec":{"f:size":{}}}`)}},
+		{Manager: "widget-controller", Operation: metav1.ManagedFieldsOperationUpdate, FieldsV1: &metav1.FieldsV1{Raw: []byte(`{"f:status":{"f:phase":{}}}`)}},
+	})
+
+	private := recordingPrivateState{}
+	saveOwnershipBaseline(context.Background(), private, obj, []string{"status"})
+
+	var baseline map[string]string
+	if err := json.Unmarshal(private["ownership_baseline"], &baseline); err != nil {
+		t.Fatalf("Failed to parse baseline: %v", err)
+	}
+	if _, ok := baseline["status.phase"]; ok {
+		t.Errorf("status.phase should be skipped when status is ignored: %v", baseline)
+	}
+	if baseline["spec.size"] != "k8sconnect" {
+		t.Errorf("spec.size should stay in the baseline: %v", baseline)
+	}
+}
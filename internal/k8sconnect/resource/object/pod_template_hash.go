@@ -0,0 +1,49 @@
+package object
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// podTemplateHashLength is the number of hex characters kept of the SHA-256 digest
+const podTemplateHashLength = 16
+
+// podTemplateHash returns a stable hash of spec.template for Deployments, StatefulSets
+// and DaemonSets. It is computed from the canonical JSON encoding (sorted keys), so it
+// only changes when the pod template itself changes. ok is false for other kinds and
+// for workloads without a template.
+func podTemplateHash(obj *unstructured.Unstructured) (string, bool) {
+	if obj.GroupVersionKind().Group != "apps" {
+		return "", false
+	}
+	switch obj.GetKind() {
+	case "Deployment", "StatefulSet", "DaemonSet":
+	default:
+		return "", false
+	}
+
+	template, found, err := unstructured.NestedMap(obj.Object, "spec", "template")
+	if err != nil || !found {
+		return "", false
+	}
+	encoded, err := json.Marshal(template)
+	if err != nil {
+		return "", false
+	}
+	sum := sha256.Sum256(encoded)
+	return hex.EncodeToString(sum[:])[:podTemplateHashLength], true
+}
+
+// updatePodTemplateHashData sets pod_template_hash from obj, or null for kinds without a
+// pod template
+func updatePodTemplateHashData(data *objectResourceModel, obj *unstructured.Unstructured) {
+	if hash, ok := podTemplateHash(obj); ok {
+		data.PodTemplateHash = types.StringValue(hash)
+	} else {
+		data.PodTemplateHash = types.StringNull()
+	}
+}
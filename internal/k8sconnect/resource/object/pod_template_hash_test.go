@@ -0,0 +1,64 @@
+package object
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func workloadWithTemplate(kind string, template map[string]interface{}) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "apps/v1",
+		"kind":       kind,
+		"metadata":   map[string]interface{}{"name": "web", "namespace": "default"},
+		"spec": map[string]interface{}{
+			"replicas": int64(3),
+			"template": template,
+		},
+	}}
+}
+
+func TestPodTemplateHash(t *testing.T) {
+	template := func(image string) map[string]interface{} {
+		return map[string]interface{}{
+			"metadata": map[string]interface{}{"labels": map[string]interface{}{"app": "web", "tier": "frontend"}},
+			"spec": map[string]interface{}{
+				"containers": []interface{}{map[string]interface{}{"name": "app", "image": image}},
+			},
+		}
+	}
+
+	base, ok := podTemplateHash(workloadWithTemplate("Deployment", template("nginx:1.27")))
+	if !ok || len(base) != podTemplateHashLength {
+		t.Fatalf("podTemplateHash() = %q, %v", base, ok)
+	}
+
+	// Changes outside the template don't change the hash
+	scaled := workloadWithTemplate("Deployment", template("nginx:1.27"))
+	scaled.Object["spec"].(map[string]interface{})["replicas"] = int64(5)
+	if hash, _ := podTemplateHash(scaled); hash != base {
+		t.Errorf("replicas change altered the hash: %s != %s", hash, base)
+	}
+
+	// A change deep in the template does
+	if hash, _ := podTemplateHash(workloadWithTemplate("Deployment", template("nginx:1.28"))); hash == base {
+		t.Error("image change did not alter the hash")
+	}
+
+	for _, kind := range []string{"StatefulSet", "DaemonSet"} {
+		if _, ok := podTemplateHash(workloadWithTemplate(kind, template("nginx:1.27"))); !ok {
+			t.Errorf("expected a hash for %s", kind)
+		}
+	}
+
+	// Other kinds have no pod template hash
+	job := workloadWithTemplate("Job", template("nginx:1.27"))
+	job.SetAPIVersion("batch/v1")
+	if _, ok := podTemplateHash(job); ok {
+		t.Error("expected no hash for a Job")
+	}
+	configMap := &unstructured.Unstructured{Object: map[string]interface{}{"apiVersion": "v1", "kind": "ConfigMap"}}
+	if _, ok := podTemplateHash(configMap); ok {
+		t.Error("expected no hash for a ConfigMap")
+	}
+}
@@ -0,0 +1,175 @@
+package object
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/util/jsonpath"
+
+	"github.com/jmorris0x0/terraform-provider-k8sconnect/internal/k8sconnect/common/k8serrors"
+)
+
+// preconditionAttrTypes are the attributes of precondition
+var preconditionAttrTypes = map[string]attr.Type{
+	"api_version": types.StringType,
+	"kind":        types.StringType,
+	"name":        types.StringType,
+	"namespace":   types.StringType,
+	"jsonpath":    types.StringType,
+	"expected":    types.StringType,
+}
+
+type preconditionModel struct {
+	APIVersion types.String `tfsdk:"api_version"`
+	Kind       types.String `tfsdk:"kind"`
+	Name       types.String `tfsdk:"name"`
+	Namespace  types.String `tfsdk:"namespace"`
+	JSONPath   types.String `tfsdk:"jsonpath"`
+	Expected   types.String `tfsdk:"expected"`
+}
+
+// checkPrecondition fails the apply when the precondition object doesn't satisfy it. It is a
+// single read, not a wait: use depends_on_ready to wait for objects this configuration creates.
+func (r *objectResource) checkPrecondition(ctx context.Context, rc *ResourceContext, data *objectResourceModel, diagnostics *diag.Diagnostics) error {
+	if data.Precondition.IsNull() || data.Precondition.IsUnknown() || rc.Client == nil {
+		return nil
+	}
+
+	var pc preconditionModel
+	if diags := data.Precondition.As(ctx, &pc, basetypes.ObjectAsOptions{}); diags.HasError() {
+		diagnostics.Append(diags...)
+		return fmt.Errorf("invalid precondition")
+	}
+
+	reason, err := preconditionNotMetReason(ctx, rc, pc)
+	if err != nil {
+		diagnostics.AddAttributeError(path.Root("precondition"), "Invalid Precondition", err.Error())
+		return err
+	}
+	if reason == "" {
+		tflog.Debug(ctx, "Precondition met", map[string]interface{}{
+			"resource":     formatResource(rc.Object),
+			"precondition": preconditionDisplayName(pc),
+		})
+		return nil
+	}
+
+	var msg strings.Builder
+	msg.WriteString(fmt.Sprintf("%s was not applied because its precondition is not met:\n\n", formatResource(rc.Object)))
+	msg.WriteString(fmt.Sprintf("  • %s: %s\n", preconditionDisplayName(pc), reason))
+	msg.WriteString("\nOptions:\n")
+	msg.WriteString("• Bring the cluster to the expected state, then run terraform apply again\n")
+	msg.WriteString(fmt.Sprintf("• Inspect the object: %s\n", preconditionKubectlGet(pc)))
+	msg.WriteString("• If the object is created by this configuration, use depends_on_ready to wait for it instead")
+
+	diagnostics.AddError(k8serrors.Summary(k8serrors.ErrorTypePreconditionFailed, "Precondition Not Met"), msg.String())
+	return fmt.Errorf("precondition not met")
+}
+
+// preconditionNotMetReason returns why pc isn't met, or "" when it is. Without jsonpath the
+// object only has to exist; without expected the value at jsonpath has to be non-empty.
+// An error means the precondition itself is broken, rather than unmet.
+func preconditionNotMetReason(ctx context.Context, rc *ResourceContext, pc preconditionModel) (string, error) {
+	var fieldPath *jsonpath.JSONPath
+	if expr := pc.JSONPath.ValueString(); expr != "" {
+		fieldPath = jsonpath.New("precondition")
+		if err := fieldPath.Parse(jsonPathTemplate(expr)); err != nil {
+			return "", fmt.Errorf("jsonpath %q is not valid: %w", expr, err)
+		}
+	}
+
+	ref := &unstructured.Unstructured{}
+	ref.SetAPIVersion(pc.APIVersion.ValueString())
+	ref.SetKind(pc.Kind.ValueString())
+
+	gvr, err := rc.Client.GetGVR(ctx, ref)
+	if err != nil {
+		return fmt.Sprintf("resource type not available (%v)", err), nil
+	}
+	live, err := rc.Client.Get(ctx, gvr, pc.Namespace.ValueString(), pc.Name.ValueString())
+	if errors.IsNotFound(err) {
+		return "not found", nil
+	}
+	if err != nil {
+		return err.Error(), nil
+	}
+	if fieldPath == nil {
+		return "", nil
+	}
+
+	value, found := preconditionValue(fieldPath, live)
+	switch {
+	case !found:
+		return fmt.Sprintf("%s is not set", pc.JSONPath.ValueString()), nil
+	case pc.Expected.IsNull():
+		return "", nil
+	case value != pc.Expected.ValueString():
+		return fmt.Sprintf("%s is %q, expected %q", pc.JSONPath.ValueString(), value, pc.Expected.ValueString()), nil
+	}
+	return "", nil
+}
+
+// preconditionValue returns the first value fieldPath finds in obj, formatted the way
+// k8sconnect_wait's field_value compares it: maps and lists are JSON-encoded. Empty
+// values count as not found.
+func preconditionValue(fieldPath *jsonpath.JSONPath, obj *unstructured.Unstructured) (string, bool) {
+	results, err := fieldPath.FindResults(obj.Object)
+	if err != nil || len(results) == 0 || len(results[0]) == 0 {
+		return "", false
+	}
+
+	switch value := results[0][0].Interface().(type) {
+	case nil:
+		return "", false
+	case string:
+		return value, value != ""
+	case map[string]interface{}, []interface{}:
+		encoded, err := json.Marshal(value)
+		if err != nil || string(encoded) == "{}" || string(encoded) == "[]" {
+			return "", false
+		}
+		return string(encoded), true
+	default:
+		return fmt.Sprintf("%v", value), true
+	}
+}
+
+// jsonPathTemplate accepts both kubectl's {.status.phase} and the bare status.phase form
+func jsonPathTemplate(expr string) string {
+	if strings.HasPrefix(expr, "{") {
+		return expr
+	}
+	return fmt.Sprintf("{.%s}", strings.TrimPrefix(expr, "."))
+}
+
+// preconditionDisplayName formats the precondition object as Kind namespace/name, or Kind name when cluster-scoped
+func preconditionDisplayName(pc preconditionModel) string {
+	return dependencyDisplayName(objectRefModel{
+		APIVersion: pc.APIVersion,
+		Kind:       pc.Kind,
+		Name:       pc.Name,
+		Namespace:  pc.Namespace,
+	})
+}
+
+// preconditionKubectlGet returns the kubectl command that shows what the precondition reads
+func preconditionKubectlGet(pc preconditionModel) string {
+	cmd := fmt.Sprintf("kubectl get %s %s", strings.ToLower(pc.Kind.ValueString()), pc.Name.ValueString())
+	if namespace := pc.Namespace.ValueString(); namespace != "" {
+		cmd += fmt.Sprintf(" -n %s", namespace)
+	}
+	if expr := pc.JSONPath.ValueString(); expr != "" {
+		return fmt.Sprintf("%s -o jsonpath='%s'", cmd, jsonPathTemplate(expr))
+	}
+	return cmd
+}
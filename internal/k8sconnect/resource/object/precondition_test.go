@@ -0,0 +1,97 @@
+package object
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/jmorris0x0/terraform-provider-k8sconnect/internal/k8sconnect/common/k8sclient"
+)
+
+func TestCheckPrecondition(t *testing.T) {
+	ctx := context.Background()
+	r := &objectResource{}
+
+	desired := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "ConfigMap",
+		"metadata":   map[string]interface{}{"name": "settings", "namespace": "team-a"},
+	}}
+	crd := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "apiextensions.k8s.io/v1",
+		"kind":       "CustomResourceDefinition",
+		"metadata":   map[string]interface{}{"name": "widgets.example.com"},
+		"spec": map[string]interface{}{
+			"versions": []interface{}{map[string]interface{}{"name": "v1beta1"}},
+		},
+	}}
+	precondition := func(jsonPath, expected types.String) *objectResourceModel {
+		return &objectResourceModel{Precondition: types.ObjectValueMust(preconditionAttrTypes, map[string]attr.Value{
+			"api_version": types.StringValue("apiextensions.k8s.io/v1"),
+			"kind":        types.StringValue("CustomResourceDefinition"),
+			"name":        types.StringValue("widgets.example.com"),
+			"namespace":   types.StringNull(),
+			"jsonpath":    jsonPath,
+			"expected":    expected,
+		})}
+	}
+
+	tests := []struct {
+		name     string
+		live     *unstructured.Unstructured
+		data     *objectResourceModel
+		wantErr  string
+		wantGets int
+	}{
+		{"object exists", crd, precondition(types.StringNull(), types.StringNull()), "", 1},
+		{"object missing", nil, precondition(types.StringNull(), types.StringNull()), "CustomResourceDefinition widgets.example.com: not found", 1},
+		{"value present", crd, precondition(types.StringValue("spec.versions[0].name"), types.StringNull()), "", 1},
+		{"value absent", crd, precondition(types.StringValue("status.acceptedNames.kind"), types.StringNull()), "status.acceptedNames.kind is not set", 1},
+		{"value matches", crd, precondition(types.StringValue("{.spec.versions[0].name}"), types.StringValue("v1beta1")), "", 1},
+		{"value differs", crd, precondition(types.StringValue("spec.versions[0].name"), types.StringValue("v1")), `is "v1beta1", expected "v1"`, 1},
+		{"no precondition", crd, &objectResourceModel{Precondition: types.ObjectNull(preconditionAttrTypes)}, "", 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &namespaceSequenceClient{K8sClient: k8sclient.NewStubK8sClient(), responses: []*unstructured.Unstructured{tt.live}}
+			var diags diag.Diagnostics
+
+			err := r.checkPrecondition(ctx, &ResourceContext{Client: client, Object: desired}, tt.data, &diags)
+
+			if client.gets != tt.wantGets {
+				t.Errorf("expected %d lookups, got %d", tt.wantGets, client.gets)
+			}
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %v\n%v", err, diags)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatal("expected the precondition to fail")
+			}
+			diag := diags.Errors()[0]
+			if diag.Summary() != "[PreconditionFailed] Precondition Not Met" || !strings.Contains(diag.Detail(), tt.wantErr) {
+				t.Errorf("unexpected diagnostic: %s\n%s", diag.Summary(), diag.Detail())
+			}
+		})
+	}
+}
+
+func TestPreconditionKubectlGet(t *testing.T) {
+	pc := preconditionModel{
+		Kind:      types.StringValue("ConfigMap"),
+		Name:      types.StringValue("cluster-info"),
+		Namespace: types.StringValue("kube-public"),
+		JSONPath:  types.StringValue("data.version"),
+	}
+	want := "kubectl get configmap cluster-info -n kube-public -o jsonpath='{.data.version}'"
+	if got := preconditionKubectlGet(pc); got != want {
+		t.Errorf("preconditionKubectlGet() = %q, want %q", got, want)
+	}
+}
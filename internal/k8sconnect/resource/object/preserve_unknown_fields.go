@@ -0,0 +1,132 @@
+package object
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	k8sschema "k8s.io/apimachinery/pkg/runtime/schema"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+
+	"github.com/jmorris0x0/terraform-provider-k8sconnect/internal/k8sconnect/common/k8sclient"
+)
+
+// preserveUnknownFieldsExtension marks a CRD schema node whose unknown fields the API
+// server keeps instead of pruning
+const preserveUnknownFieldsExtension = "x-kubernetes-preserve-unknown-fields"
+
+// isTypedObjectError reports whether server-side apply failed converting the object, or
+// the live object, to the type it derives from the schema. Happens under
+// x-kubernetes-preserve-unknown-fields when a value's shape doesn't match what the live
+// object or the managedFields recorded, e.g. a nested map that became a list. Unknown
+// fields outside such subtrees are typos, which field validation reports as before.
+func isTypedObjectError(err error) bool {
+	if err == nil {
+		return false
+	}
+	msg := err.Error()
+	if strings.Contains(msg, "field not declared in schema") {
+		return false
+	}
+	return strings.Contains(msg, "failed to create typed patch object") ||
+		strings.Contains(msg, "failed to create typed live object")
+}
+
+// crdPreservesUnknownFields reports whether the schema of version in a CRD sets
+// x-kubernetes-preserve-unknown-fields anywhere
+func crdPreservesUnknownFields(crd *unstructured.Unstructured, version string) bool {
+	versions, _, _ := unstructured.NestedSlice(crd.Object, "spec", "versions")
+	for _, v := range versions {
+		entry, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if name, _ := entry["name"].(string); name != version {
+			continue
+		}
+		schema, _, _ := unstructured.NestedMap(entry, "schema", "openAPIV3Schema")
+		return schemaPreservesUnknownFields(schema)
+	}
+	return false
+}
+
+// schemaPreservesUnknownFields walks an OpenAPI v3 schema node and its properties, items
+// and additionalProperties looking for x-kubernetes-preserve-unknown-fields: true
+func schemaPreservesUnknownFields(node map[string]interface{}) bool {
+	if node == nil {
+		return false
+	}
+	if preserve, _ := node[preserveUnknownFieldsExtension].(bool); preserve {
+		return true
+	}
+	if properties, ok := node["properties"].(map[string]interface{}); ok {
+		for _, p := range properties {
+			if child, ok := p.(map[string]interface{}); ok && schemaPreservesUnknownFields(child) {
+				return true
+			}
+		}
+	}
+	for _, key := range []string{"items", "additionalProperties"} {
+		if child, ok := node[key].(map[string]interface{}); ok && schemaPreservesUnknownFields(child) {
+			return true
+		}
+	}
+	return false
+}
+
+// preservesUnknownFields looks up the CRD of gvr and reports whether its schema preserves
+// unknown fields. Built-in resources and lookup failures report false.
+func preservesUnknownFields(ctx context.Context, client k8sclient.K8sClient, gvr k8sschema.GroupVersionResource) bool {
+	if gvr.Group == "" || gvr.Resource == "" {
+		return false
+	}
+	crd, err := client.Get(ctx, crdGVR, "", gvr.Resource+"."+gvr.Group)
+	if err != nil {
+		tflog.Debug(ctx, "Could not look up the CRD schema", map[string]interface{}{
+			"resource": gvr.GroupResource().String(),
+			"error":    err.Error(),
+		})
+		return false
+	}
+	return crdPreservesUnknownFields(crd, gvr.Version)
+}
+
+// mergePatchFallback retries a server-side apply that failed with a typed object error
+// as a JSON merge patch, when the CRD preserves unknown fields. The merge patch sends
+// yaml_body's nested maps and arrays as they are, without typing them against the
+// schema, and records k8sconnect's ownership as an Update entry in managedFields, which
+// the projection reads like an Apply entry. Only an existing object can be patched; the
+// caller reports the apply error otherwise. Returns the patched object and true when
+// the merge patch took over.
+func mergePatchFallback(ctx context.Context, client k8sclient.K8sClient, gvr k8sschema.GroupVersionResource, obj *unstructured.Unstructured, fieldManager string, applyErr error, dryRun bool) (*unstructured.Unstructured, bool) {
+	if !isTypedObjectError(applyErr) || !preservesUnknownFields(ctx, client, gvr) {
+		return nil, false
+	}
+
+	data, err := json.Marshal(obj.Object)
+	if err != nil {
+		return nil, false
+	}
+	opts := metav1.PatchOptions{FieldManager: fieldManager}
+	if dryRun {
+		opts.DryRun = []string{metav1.DryRunAll}
+	}
+	patched, err := client.Patch(ctx, gvr, obj.GetNamespace(), obj.GetName(), k8stypes.MergePatchType, data, opts)
+	if err != nil {
+		tflog.Debug(ctx, "Merge patch fallback failed", map[string]interface{}{
+			"resource": formatResource(obj),
+			"error":    err.Error(),
+		})
+		return nil, false
+	}
+
+	tflog.Info(ctx, "Server-side apply couldn't type the object, applied it as a merge patch", map[string]interface{}{
+		"resource":    formatResource(obj),
+		"dry_run":     dryRun,
+		"apply_error": applyErr.Error(),
+	})
+	return patched, true
+}
@@ -0,0 +1,156 @@
+package object
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	k8sschema "k8s.io/apimachinery/pkg/runtime/schema"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+
+	"github.com/jmorris0x0/terraform-provider-k8sconnect/internal/k8sconnect/common/k8sclient"
+)
+
+// untypedCRDClient serves a CRD from Get and records merge patches, each returning the
+// patch applied onto an empty object
+type untypedCRDClient struct {
+	k8sclient.K8sClient
+	crd     *unstructured.Unstructured
+	patches []metav1.PatchOptions
+	bodies  []map[string]interface{}
+}
+
+func (c *untypedCRDClient) Get(ctx context.Context, gvr k8sschema.GroupVersionResource, namespace, name string) (*unstructured.Unstructured, error) {
+	return c.crd, nil
+}
+
+func (c *untypedCRDClient) Patch(ctx context.Context, gvr k8sschema.GroupVersionResource, namespace, name string, patchType k8stypes.PatchType, data []byte, options metav1.PatchOptions, subresources ...string) (*unstructured.Unstructured, error) {
+	if patchType != k8stypes.MergePatchType {
+		return nil, fmt.Errorf("unexpected patch type %s", patchType)
+	}
+	var body map[string]interface{}
+	if err := json.Unmarshal(data, &body); err != nil {
+		return nil, err
+	}
+	c.patches = append(c.patches, options)
+	c.bodies = append(c.bodies, body)
+	return &unstructured.Unstructured{Object: body}, nil
+}
+
+func pipelineCRD(specSchema map[string]interface{}) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "apiextensions.k8s.io/v1",
+		"kind":       "CustomResourceDefinition",
+		"metadata":   map[string]interface{}{"name": "pipelines.example.com"},
+		"spec": map[string]interface{}{
+			"group": "example.com",
+			"names": map[string]interface{}{"kind": "Pipeline", "plural": "pipelines"},
+			"versions": []interface{}{
+				map[string]interface{}{
+					"name": "v1", "served": true, "storage": true,
+					"schema": map[string]interface{}{"openAPIV3Schema": map[string]interface{}{
+						"type":       "object",
+						"properties": map[string]interface{}{"spec": specSchema},
+					}},
+				},
+			},
+		},
+	}}
+}
+
+func TestCRDPreservesUnknownFields(t *testing.T) {
+	tests := []struct {
+		name   string
+		schema map[string]interface{}
+		want   bool
+	}{
+		{"spec", map[string]interface{}{"type": "object", preserveUnknownFieldsExtension: true}, true},
+		{"nested property", map[string]interface{}{"type": "object", "properties": map[string]interface{}{
+			"config": map[string]interface{}{"type": "object", preserveUnknownFieldsExtension: true},
+		}}, true},
+		{"array items", map[string]interface{}{"type": "object", "properties": map[string]interface{}{
+			"stages": map[string]interface{}{"type": "array", "items": map[string]interface{}{"type": "object", preserveUnknownFieldsExtension: true}},
+		}}, true},
+		{"structural", map[string]interface{}{"type": "object", "properties": map[string]interface{}{
+			"replicas": map[string]interface{}{"type": "integer"},
+		}}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			crd := pipelineCRD(tt.schema)
+			if got := crdPreservesUnknownFields(crd, "v1"); got != tt.want {
+				t.Errorf("crdPreservesUnknownFields = %v, want %v", got, tt.want)
+			}
+			if crdPreservesUnknownFields(crd, "v2") {
+				t.Error("a version the CRD doesn't serve has no schema")
+			}
+		})
+	}
+}
+
+func TestMergePatchFallback(t *testing.T) {
+	ctx := context.Background()
+	gvr := k8sschema.GroupVersionResource{Group: "example.com", Version: "v1", Resource: "pipelines"}
+	typedErr := fmt.Errorf(`failed to create typed patch object (default/build; example.com/v1, Kind=Pipeline): .spec.stages: expected map, got &{[...]}`)
+	obj := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "example.com/v1",
+		"kind":       "Pipeline",
+		"metadata":   map[string]interface{}{"name": "build", "namespace": "default"},
+		"spec": map[string]interface{}{
+			"config": map[string]interface{}{
+				"env":     map[string]interface{}{"CI": "true", "matrix": []interface{}{"linux", "darwin"}},
+				"retries": int64(3),
+			},
+			"stages": []interface{}{
+				map[string]interface{}{"name": "test", "steps": []interface{}{map[string]interface{}{"run": "go test ./..."}}},
+				map[string]interface{}{"name": "release", "needs": []interface{}{"test"}},
+			},
+		},
+	}}
+
+	t.Run("keeps nested maps and arrays", func(t *testing.T) {
+		client := &untypedCRDClient{K8sClient: k8sclient.NewStubK8sClient(), crd: pipelineCRD(map[string]interface{}{"type": "object", preserveUnknownFieldsExtension: true})}
+		patched, ok := mergePatchFallback(ctx, client, gvr, obj, "k8sconnect", typedErr, true)
+		if !ok {
+			t.Fatal("expected the merge patch to take over")
+		}
+		if len(client.patches) != 1 || client.patches[0].FieldManager != "k8sconnect" || !reflect.DeepEqual(client.patches[0].DryRun, []string{metav1.DryRunAll}) {
+			t.Fatalf("patch options = %+v, want one dry-run merge patch as k8sconnect", client.patches)
+		}
+
+		// The projection compares yaml_body against the result, so nothing may be reshaped
+		want, _ := json.Marshal(obj.Object)
+		got, _ := json.Marshal(patched.Object)
+		if string(got) != string(want) {
+			t.Errorf("merge patch changed the structure:\ngot  %s\nwant %s", got, want)
+		}
+		paths := extractAllFieldsFromYAML(obj.Object, "")
+		projection, err := projectFields(patched.Object, paths)
+		if err != nil {
+			t.Fatalf("projection failed: %v", err)
+		}
+		expected, _ := projectFields(obj.Object, paths)
+		if !reflect.DeepEqual(flattenProjectionToMap(projection, paths), flattenProjectionToMap(expected, paths)) {
+			t.Errorf("projection of the patched object differs from yaml_body: %v", projection)
+		}
+	})
+
+	t.Run("structural CRD reports the apply error", func(t *testing.T) {
+		client := &untypedCRDClient{K8sClient: k8sclient.NewStubK8sClient(), crd: pipelineCRD(map[string]interface{}{"type": "object"})}
+		if _, ok := mergePatchFallback(ctx, client, gvr, obj, "k8sconnect", typedErr, false); ok || len(client.patches) != 0 {
+			t.Error("expected no fallback without x-kubernetes-preserve-unknown-fields")
+		}
+	})
+
+	t.Run("typos still fail field validation", func(t *testing.T) {
+		client := &untypedCRDClient{K8sClient: k8sclient.NewStubK8sClient(), crd: pipelineCRD(map[string]interface{}{"type": "object", preserveUnknownFieldsExtension: true})}
+		typo := fmt.Errorf(`failed to create typed patch object (default/build; example.com/v1, Kind=Pipeline): .spec.stagse: field not declared in schema`)
+		if _, ok := mergePatchFallback(ctx, client, gvr, obj, "k8sconnect", typo, false); ok || len(client.patches) != 0 {
+			t.Error("expected no fallback for an undeclared field")
+		}
+	})
+}
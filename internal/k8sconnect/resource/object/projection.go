@@ -2,9 +2,11 @@ package object
 
 import (
 	"context"
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"regexp"
+	"sort"
 	"strings"
 
 	"github.com/jmorris0x0/terraform-provider-k8sconnect/internal/k8sconnect/common"
@@ -12,12 +14,13 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 )
 
-func extractOwnedPaths(ctx context.Context, managedFields []metav1.ManagedFieldsEntry, userJSON map[string]interface{}) []string {
-	// Collect ALL fields from ALL k8sconnect entries (both Apply and Update operations)
+func extractOwnedPaths(ctx context.Context, managedFields []metav1.ManagedFieldsEntry, fieldManager string, userJSON map[string]interface{}) []string {
+	// Collect ALL fields from ALL of fieldManager's entries (both Apply and Update operations).
+	// Other managers' fields, including another k8sconnect_object's field_manager, are not ours.
 	allOwnedFields := make(map[string]interface{})
 
 	for _, mf := range managedFields {
-		if mf.Manager == "k8sconnect" && mf.FieldsV1 != nil {
+		if mf.Manager == fieldManager && mf.FieldsV1 != nil {
 			// Parse this entry's fields
 			var fields map[string]interface{}
 			if err := json.Unmarshal(mf.FieldsV1.Raw, &fields); err != nil {
@@ -82,9 +85,95 @@ func extractOwnedPaths(ctx context.Context, managedFields []metav1.ManagedFields
 		}
 	}
 
+	// metadata.finalizers is a set: managedFields records its items as "v:" entries, which
+	// parseOwnedFields does not turn into paths, so track the declared list as a whole
+	if finalizers, _, _ := unstructured.NestedStringSlice(userJSON, "metadata", "finalizers"); len(finalizers) > 0 {
+		found := false
+		for _, p := range paths {
+			if p == finalizersPath {
+				found = true
+				break
+			}
+		}
+		if !found {
+			paths = append(paths, finalizersPath)
+		}
+	}
+
 	return paths
 }
 
+// finalizersPath is projected with set semantics, see normalizeFinalizers
+const finalizersPath = "metadata.finalizers"
+
+// normalizeFinalizers treats a projected metadata.finalizers as a set of the finalizers
+// declared in desired. Only declared finalizers still present are kept, sorted, so a
+// controller adding its own finalizers or reordering the list is not drift, while
+// removing a declared finalizer still is.
+func normalizeFinalizers(projection, desired map[string]interface{}) {
+	projected, found, _ := unstructured.NestedStringSlice(projection, "metadata", "finalizers")
+	if !found {
+		return
+	}
+
+	present := make(map[string]bool, len(projected))
+	for _, f := range projected {
+		present[f] = true
+	}
+
+	declared, _, _ := unstructured.NestedStringSlice(desired, "metadata", "finalizers")
+	sort.Strings(declared)
+
+	var kept []interface{}
+	for _, f := range declared {
+		if present[f] {
+			kept = append(kept, f)
+			delete(present, f) // tolerate duplicates in yaml_body
+		}
+	}
+
+	if len(kept) == 0 {
+		unstructured.RemoveNestedField(projection, "metadata", "finalizers")
+		return
+	}
+	_ = unstructured.SetNestedSlice(projection, kept, "metadata", "finalizers")
+}
+
+// normalizeBinaryData rewrites each value of a projected ConfigMap binaryData in canonical
+// base64 (standard alphabet, padded, no line breaks). binaryData holds bytes, so values
+// that encode the same bytes differently, e.g. wrapped or unpadded, are not drift.
+// Values that aren't valid base64 are left as they are.
+func normalizeBinaryData(projection map[string]interface{}) {
+	if kind, _ := projection["kind"].(string); kind != "ConfigMap" {
+		return
+	}
+	binaryData, ok := projection["binaryData"].(map[string]interface{})
+	if !ok {
+		return
+	}
+	for key, value := range binaryData {
+		encoded, ok := value.(string)
+		if !ok {
+			continue
+		}
+		if decoded, ok := decodeBase64(encoded); ok {
+			binaryData[key] = base64.StdEncoding.EncodeToString(decoded)
+		}
+	}
+}
+
+// decodeBase64 decodes s in any of the standard and URL-safe base64 variants, padded or
+// not, ignoring whitespace
+func decodeBase64(s string) ([]byte, bool) {
+	s = strings.Join(strings.Fields(s), "")
+	for _, encoding := range []*base64.Encoding{base64.StdEncoding, base64.RawStdEncoding, base64.URLEncoding, base64.RawURLEncoding} {
+		if decoded, err := encoding.DecodeString(s); err == nil {
+			return decoded, true
+		}
+	}
+	return nil, false
+}
+
 // extractAllFieldsFromYAML - used when no managedFields available
 func extractAllFieldsFromYAML(obj map[string]interface{}, prefix string) []string {
 	// Just call the full extractFieldPaths since tests expect that behavior
@@ -333,12 +422,20 @@ type PathSegment struct {
 	Selector *ArraySelector // nil for non-array fields
 }
 
-// parsePath converts "spec.containers[name=nginx].image" into segments
+// parsePath converts "spec.containers[name=nginx].image" into segments.
+// Label and annotation keys may contain dots (example.com/team), so everything after
+// metadata.labels or metadata.annotations is one segment: each key is its own field,
+// projected and owned per key like any other SSA map entry.
 func parsePath(path string) []PathSegment {
 	parts := strings.Split(path, ".")
 	segments := make([]PathSegment, 0, len(parts))
 
-	for _, part := range parts {
+	for i, part := range parts {
+		if isMetadataKeyMap(segments) {
+			segments = append(segments, PathSegment{Field: strings.Join(parts[i:], ".")})
+			break
+		}
+
 		segment := PathSegment{Field: part}
 
 		if idx := strings.Index(part, "["); idx >= 0 {
@@ -354,6 +451,16 @@ func parsePath(path string) []PathSegment {
 	return segments
 }
 
+// isMetadataKeyMap reports whether segments end at metadata.labels or metadata.annotations,
+// at the top level or nested, as in spec.template.metadata.labels
+func isMetadataKeyMap(segments []PathSegment) bool {
+	n := len(segments)
+	if n < 2 || segments[n-2].Field != "metadata" || segments[n-2].Selector != nil || segments[n-1].Selector != nil {
+		return false
+	}
+	return segments[n-1].Field == "labels" || segments[n-1].Field == "annotations"
+}
+
 // getFieldByPath retrieves a value from an object using dot notation
 func getFieldByPath(obj map[string]interface{}, path string) (interface{}, bool) {
 	segments := parsePath(path)
@@ -488,20 +595,23 @@ func filterIgnoredPaths(allPaths []string, ignoreFields []string, obj map[string
 
 	filtered := make([]string, 0, len(allPaths))
 	for _, path := range allPaths {
-		ignored := false
-		for _, ignorePattern := range ignoreFields {
-			if pathMatchesIgnorePattern(path, ignorePattern, obj) {
-				ignored = true
-				break
-			}
-		}
-		if !ignored {
+		if !isIgnoredPath(path, ignoreFields, obj) {
 			filtered = append(filtered, path)
 		}
 	}
 	return filtered
 }
 
+// isIgnoredPath reports whether path matches any of the ignore patterns
+func isIgnoredPath(path string, ignoreFields []string, obj map[string]interface{}) bool {
+	for _, ignorePattern := range ignoreFields {
+		if pathMatchesIgnorePattern(path, ignorePattern, obj) {
+			return true
+		}
+	}
+	return false
+}
+
 // resolveJSONPathPredicates converts JSONPath predicates to positional selectors
 // Example: containers[?(@.name=='nginx')].image -> containers[0].image
 func resolveJSONPathPredicates(pattern string, obj map[string]interface{}) string {
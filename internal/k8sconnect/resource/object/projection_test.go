@@ -1,12 +1,14 @@
 package object
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"reflect"
 	"sort"
 	"testing"
 
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 )
 
@@ -1171,3 +1173,266 @@ func toJSON(obj map[string]interface{}) (string, error) {
 	}
 	return string(bytes), nil
 }
+
+func TestNormalizeFinalizers(t *testing.T) {
+	desired := map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"finalizers": []interface{}{"example.com/b", "example.com/a"},
+		},
+	}
+
+	tests := []struct {
+		name string
+		live []interface{}
+		want map[string]string
+	}{
+		{
+			name: "reordered and extra finalizers are not drift",
+			live: []interface{}{"controller.io/cleanup", "example.com/a", "example.com/b"},
+			want: map[string]string{finalizersPath: `["example.com/a","example.com/b"]`},
+		},
+		{
+			name: "removed declared finalizer is drift",
+			live: []interface{}{"controller.io/cleanup", "example.com/b"},
+			want: map[string]string{finalizersPath: `["example.com/b"]`},
+		},
+		{
+			name: "no declared finalizer left",
+			live: []interface{}{"controller.io/cleanup"},
+			want: map[string]string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			source := map[string]interface{}{
+				"metadata": map[string]interface{}{"name": "app", "finalizers": tt.live},
+			}
+
+			projection, err := projectFields(source, []string{finalizersPath})
+			if err != nil {
+				t.Fatalf("projectFields() error: %v", err)
+			}
+			normalizeFinalizers(projection, desired)
+
+			got := flattenProjectionToMap(projection, []string{finalizersPath})
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("projection = %v, want %v", got, tt.want)
+			}
+			if len(source["metadata"].(map[string]interface{})["finalizers"].([]interface{})) != len(tt.live) {
+				t.Errorf("source object was modified")
+			}
+		})
+	}
+}
+
+func TestExtractOwnedPaths_IncludesDeclaredFinalizers(t *testing.T) {
+	managedFields := []metav1.ManagedFieldsEntry{{
+		Manager:  "k8sconnect",
+		FieldsV1: &metav1.FieldsV1{Raw: []byte(`{"f:metadata":{"f:finalizers":{".":{},"v:\"example.com/a\"":{}}},"f:data":{"f:key":{}}}`)},
+	}}
+	userJSON := map[string]interface{}{
+		"metadata": map[string]interface{}{"name": "app", "finalizers": []interface{}{"example.com/a"}},
+		"data":     map[string]interface{}{"key": "value"},
+	}
+
+	paths := extractOwnedPaths(context.Background(), managedFields, objectFieldManager, userJSON)
+
+	count := 0
+	for _, p := range paths {
+		if p == finalizersPath {
+			count++
+		}
+	}
+	if count != 1 {
+		t.Errorf("expected %s exactly once in %v", finalizersPath, paths)
+	}
+}
+
+func TestExtractOwnedPaths_SeparateFieldManagers(t *testing.T) {
+	// Two k8sconnect_object resources share a Deployment, each with its own field_manager
+	live := map[string]interface{}{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"metadata": map[string]interface{}{
+			"name":      "app",
+			"namespace": "default",
+			"labels":    map[string]interface{}{"team": "payments"},
+		},
+		"spec": map[string]interface{}{"replicas": int64(3)},
+	}
+	managedFields := []metav1.ManagedFieldsEntry{
+		{Manager: "team-labels", FieldsV1: &metav1.FieldsV1{Raw: []byte(`{"f:metadata":{"f:labels":{"f:team":{}}}}`)}},
+		{Manager: "team-spec", FieldsV1: &metav1.FieldsV1{Raw: []byte(`{"f:spec":{"f:replicas":{}}}`)}},
+	}
+	labelsJSON := map[string]interface{}{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"metadata": map[string]interface{}{
+			"name":      "app",
+			"namespace": "default",
+			"labels":    map[string]interface{}{"team": "payments"},
+		},
+	}
+
+	paths := extractOwnedPaths(context.Background(), managedFields, "team-labels", labelsJSON)
+	for _, p := range paths {
+		if p == "spec.replicas" {
+			t.Fatalf("team-labels should not own team-spec's fields, got %v", paths)
+		}
+	}
+	projection, err := projectFields(live, paths)
+	if err != nil {
+		t.Fatalf("projectFields: %v", err)
+	}
+	before := flattenProjectionToMap(projection, paths)
+	if before["metadata.labels.team"] != "payments" {
+		t.Errorf("expected the label in the projection, got %v", before)
+	}
+
+	// team-spec changing its field doesn't show as drift for team-labels
+	live["spec"].(map[string]interface{})["replicas"] = int64(5)
+	projection, err = projectFields(live, paths)
+	if err != nil {
+		t.Fatalf("projectFields: %v", err)
+	}
+	if after := flattenProjectionToMap(projection, paths); !reflect.DeepEqual(after, before) {
+		t.Errorf("projection changed from %v to %v", before, after)
+	}
+}
+
+func TestExtractOwnedPaths_MetadataKeysPerKey(t *testing.T) {
+	// A controller adds its own annotation next to the one in yaml_body
+	live := map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "Service",
+		"metadata": map[string]interface{}{
+			"name":      "api",
+			"namespace": "default",
+			"labels":    map[string]interface{}{"app.kubernetes.io/name": "api"},
+			"annotations": map[string]interface{}{
+				"example.com/team":     "payments",
+				"prometheus.io/scrape": "true",
+			},
+		},
+	}
+	managedFields := []metav1.ManagedFieldsEntry{
+		{Manager: "k8sconnect", FieldsV1: &metav1.FieldsV1{Raw: []byte(`{"f:metadata":{"f:labels":{"f:app.kubernetes.io/name":{}},"f:annotations":{"f:example.com/team":{}}}}`)}},
+		{Manager: "prometheus-operator", FieldsV1: &metav1.FieldsV1{Raw: []byte(`{"f:metadata":{"f:annotations":{"f:prometheus.io/scrape":{}}}}`)}},
+	}
+	userJSON := map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "Service",
+		"metadata": map[string]interface{}{
+			"name":        "api",
+			"namespace":   "default",
+			"labels":      map[string]interface{}{"app.kubernetes.io/name": "api"},
+			"annotations": map[string]interface{}{"example.com/team": "payments"},
+		},
+	}
+
+	paths := extractOwnedPaths(context.Background(), managedFields, "k8sconnect", userJSON)
+	projection, err := projectFields(live, paths)
+	if err != nil {
+		t.Fatalf("projectFields: %v", err)
+	}
+	before := flattenProjectionToMap(projection, paths)
+	if before["metadata.annotations.example.com/team"] != "payments" || before["metadata.labels.app.kubernetes.io/name"] != "api" {
+		t.Errorf("expected the declared keys in the projection, got %v", before)
+	}
+	if _, ok := before["metadata.annotations.prometheus.io/scrape"]; ok {
+		t.Errorf("the controller's annotation should not be projected, got %v", before)
+	}
+
+	// The controller changing or removing its annotation is not drift
+	annotations := live["metadata"].(map[string]interface{})["annotations"].(map[string]interface{})
+	annotations["prometheus.io/scrape"] = "false"
+	projection, err = projectFields(live, paths)
+	if err != nil {
+		t.Fatalf("projectFields: %v", err)
+	}
+	if after := flattenProjectionToMap(projection, paths); !reflect.DeepEqual(after, before) {
+		t.Errorf("projection changed from %v to %v", before, after)
+	}
+
+	// A change to a declared key still is
+	annotations["example.com/team"] = "checkout"
+	projection, err = projectFields(live, paths)
+	if err != nil {
+		t.Fatalf("projectFields: %v", err)
+	}
+	if after := flattenProjectionToMap(projection, paths); after["metadata.annotations.example.com/team"] != "checkout" {
+		t.Errorf("expected drift on the declared annotation, got %v", after)
+	}
+}
+
+func TestParsePath_MetadataKeys(t *testing.T) {
+	tests := []struct {
+		path string
+		want []string
+	}{
+		{"metadata.annotations.example.com/team", []string{"metadata", "annotations", "example.com/team"}},
+		{"spec.template.metadata.labels.app.kubernetes.io/name", []string{"spec", "template", "metadata", "labels", "app.kubernetes.io/name"}},
+		{"metadata.annotations", []string{"metadata", "annotations"}},
+		{"spec.selector.matchLabels.app", []string{"spec", "selector", "matchLabels", "app"}},
+		{"data.labels.a.b", []string{"data", "labels", "a", "b"}},
+	}
+	for _, tt := range tests {
+		var got []string
+		for _, seg := range parsePath(tt.path) {
+			got = append(got, seg.Field)
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("parsePath(%q) = %q, want %q", tt.path, got, tt.want)
+		}
+	}
+}
+
+func TestNormalizeBinaryData(t *testing.T) {
+	paths := []string{"kind", "binaryData.wrapped", "binaryData.unpadded", "binaryData.urlsafe", "binaryData.invalid"}
+	newSource := func(kind string) map[string]interface{} {
+		return map[string]interface{}{
+			"apiVersion": "v1",
+			"kind":       kind,
+			"metadata":   map[string]interface{}{"name": "blobs"},
+			"binaryData": map[string]interface{}{
+				"wrapped":  "AAEC\nAwQF\n",
+				"unpadded": "AAECAw",
+				"urlsafe":  "-_8=",
+				"invalid":  "not base64!",
+			},
+		}
+	}
+
+	source := newSource("ConfigMap")
+	projection, err := projectFields(source, paths)
+	if err != nil {
+		t.Fatalf("projectFields() error: %v", err)
+	}
+	normalizeBinaryData(projection)
+
+	got := flattenProjectionToMap(projection, paths)
+	want := map[string]string{
+		"kind":                "ConfigMap",
+		"binaryData.wrapped":  "AAECAwQF",
+		"binaryData.unpadded": "AAECAw==",
+		"binaryData.urlsafe":  "+/8=",
+		"binaryData.invalid":  "not base64!",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("projection = %v, want %v", got, want)
+	}
+	if source["binaryData"].(map[string]interface{})["wrapped"] != "AAEC\nAwQF\n" {
+		t.Errorf("source object was modified")
+	}
+
+	// Only ConfigMaps are normalized
+	other, err := projectFields(newSource("Widget"), paths)
+	if err != nil {
+		t.Fatalf("projectFields() error: %v", err)
+	}
+	normalizeBinaryData(other)
+	if got := flattenProjectionToMap(other, paths)["binaryData.unpadded"]; got != "AAECAw" {
+		t.Errorf("non-ConfigMap binaryData was normalized to %q", got)
+	}
+}
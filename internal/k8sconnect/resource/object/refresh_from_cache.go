@@ -0,0 +1,32 @@
+package object
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/jmorris0x0/terraform-provider-k8sconnect/internal/k8sconnect/common/k8sclient"
+)
+
+// readForRefresh reads the live object for Read. With refresh_from_cache the read is served
+// from the API server's watch cache, which can lag etcd. A NotFound from the cache is
+// confirmed with a quorum read before it removes the object from state, since the cache may
+// simply not have seen a recent create yet.
+func readForRefresh(ctx context.Context, rc *ResourceContext, data *objectResourceModel) (*unstructured.Unstructured, error) {
+	namespace, name := rc.Object.GetNamespace(), rc.Object.GetName()
+	if !data.RefreshFromCache.ValueBool() {
+		return rc.Client.Get(ctx, rc.GVR, namespace, name)
+	}
+
+	obj, err := rc.Client.Get(k8sclient.WithCachedReads(ctx), rc.GVR, namespace, name)
+	if !errors.IsNotFound(err) {
+		return obj, err
+	}
+
+	tflog.Debug(ctx, "Cached read found no object, confirming with a quorum read", map[string]interface{}{
+		"resource": formatResource(rc.Object),
+	})
+	return rc.Client.Get(ctx, rc.GVR, namespace, name)
+}
@@ -0,0 +1,58 @@
+package object
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/jmorris0x0/terraform-provider-k8sconnect/internal/k8sconnect/common/k8sclient"
+)
+
+func TestReadForRefresh(t *testing.T) {
+	ctx := context.Background()
+	desired := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "ConfigMap",
+		"metadata":   map[string]interface{}{"name": "settings", "namespace": "team-a"},
+	}}
+	live := desired.DeepCopy()
+	live.SetResourceVersion("7")
+
+	t.Run("a cached NotFound is confirmed with a quorum read", func(t *testing.T) {
+		client := &namespaceSequenceClient{K8sClient: k8sclient.NewStubK8sClient(), responses: []*unstructured.Unstructured{nil, live}}
+		data := &objectResourceModel{RefreshFromCache: types.BoolValue(true)}
+
+		obj, err := readForRefresh(ctx, &ResourceContext{Client: client, Object: desired}, data)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if obj.GetResourceVersion() != "7" || client.gets != 2 {
+			t.Errorf("expected the quorum read's object after 2 lookups, got resourceVersion %q after %d", obj.GetResourceVersion(), client.gets)
+		}
+	})
+
+	t.Run("a cached hit is used as is", func(t *testing.T) {
+		client := &namespaceSequenceClient{K8sClient: k8sclient.NewStubK8sClient(), responses: []*unstructured.Unstructured{live}}
+		data := &objectResourceModel{RefreshFromCache: types.BoolValue(true)}
+
+		if _, err := readForRefresh(ctx, &ResourceContext{Client: client, Object: desired}, data); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if client.gets != 1 {
+			t.Errorf("expected 1 lookup, got %d", client.gets)
+		}
+	})
+
+	t.Run("without refresh_from_cache NotFound is final", func(t *testing.T) {
+		client := &namespaceSequenceClient{K8sClient: k8sclient.NewStubK8sClient(), responses: []*unstructured.Unstructured{nil, live}}
+		data := &objectResourceModel{RefreshFromCache: types.BoolNull()}
+
+		_, err := readForRefresh(ctx, &ResourceContext{Client: client, Object: desired}, data)
+		if !errors.IsNotFound(err) || client.gets != 1 {
+			t.Errorf("expected NotFound after 1 lookup, got %v after %d", err, client.gets)
+		}
+	})
+}
@@ -0,0 +1,89 @@
+package object
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestReplaceOnUpdateRequired(t *testing.T) {
+	projection := func(value string) types.Map {
+		return types.MapValueMust(types.StringType, map[string]attr.Value{
+			"data.key": types.StringValue(value),
+		})
+	}
+
+	state := &objectResourceModel{
+		Labels:                 types.MapNull(types.StringType),
+		Annotations:            types.MapNull(types.StringType),
+		ManagedStateProjection: projection("old"),
+	}
+
+	tests := []struct {
+		name    string
+		planned objectResourceModel
+		want    bool
+	}{
+		{"disabled", objectResourceModel{ManagedStateProjection: projection("new")}, false},
+		{"explicitly false", objectResourceModel{ReplaceOnUpdate: types.BoolValue(false), ManagedStateProjection: projection("new")}, false},
+		{"enabled with change", objectResourceModel{ReplaceOnUpdate: types.BoolValue(true), ManagedStateProjection: projection("new")}, true},
+		{"enabled without change", objectResourceModel{ReplaceOnUpdate: types.BoolValue(true), ManagedStateProjection: projection("old")}, false},
+		{"enabled with unknown projection", objectResourceModel{
+			ReplaceOnUpdate:        types.BoolValue(true),
+			Labels:                 types.MapNull(types.StringType),
+			Annotations:            types.MapNull(types.StringType),
+			ManagedStateProjection: types.MapUnknown(types.StringType),
+		}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := len(replaceOnUpdatePaths(&tt.planned, state)) > 0; got != tt.want {
+				t.Errorf("replaceOnUpdatePaths() requires replacement = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestReplaceOnUpdatePathsUnknownProjection(t *testing.T) {
+	labels := func(value string) types.Map {
+		return types.MapValueMust(types.StringType, map[string]attr.Value{"team": types.StringValue(value)})
+	}
+	state := &objectResourceModel{
+		YAMLBody:               types.StringValue("kind: ConfigMap\ndata:\n  key: old\n"),
+		Labels:                 labels("payments"),
+		Annotations:            types.MapNull(types.StringType),
+		ManagedStateProjection: types.MapValueMust(types.StringType, map[string]attr.Value{"data.key": types.StringValue("old")}),
+	}
+	planned := func(yamlBody types.String, labels types.Map) *objectResourceModel {
+		return &objectResourceModel{
+			ReplaceOnUpdate:        types.BoolValue(true),
+			YAMLBody:               yamlBody,
+			Labels:                 labels,
+			Annotations:            types.MapNull(types.StringType),
+			ManagedStateProjection: types.MapUnknown(types.StringType),
+		}
+	}
+
+	tests := []struct {
+		name    string
+		planned *objectResourceModel
+		want    path.Paths
+	}{
+		{"unchanged", planned(state.YAMLBody, labels("payments")), nil},
+		{"yaml_body changed", planned(types.StringValue("kind: ConfigMap\ndata:\n  key: new\n"), labels("payments")), path.Paths{path.Root("yaml_body")}},
+		{"yaml_body interpolated", planned(types.StringUnknown(), labels("payments")), path.Paths{path.Root("yaml_body")}},
+		{"labels changed", planned(state.YAMLBody, labels("checkout")), path.Paths{path.Root("labels")}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := replaceOnUpdatePaths(tt.planned, state); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("replaceOnUpdatePaths() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,99 @@
+package object
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+const (
+	replacementStrategyRecreate  = "recreate"
+	replacementStrategyBlueGreen = "blue-green"
+
+	// blueGreenSuffixLength is the number of hex characters of the content hash
+	// appended to the yaml_body name in blue-green mode
+	blueGreenSuffixLength = 8
+
+	// maxObjectNameLength is the DNS subdomain limit most Kubernetes kinds enforce
+	maxObjectNameLength = 253
+)
+
+// isBlueGreen reports whether replacement_strategy selects blue-green replacement
+func isBlueGreen(data *objectResourceModel) bool {
+	return data.ReplacementStrategy.ValueString() == replacementStrategyBlueGreen
+}
+
+// applyReplacementStrategy rewrites the object's name for blue-green replacement.
+//
+// Kubernetes cannot hold two objects with the same name, so a replacement of a named
+// resource is normally delete-then-create. In blue-green mode the cluster name is the
+// yaml_body name plus a short hash of the desired object, so every change yields a new
+// name: combined with lifecycle.create_before_destroy, Terraform creates the new object,
+// updates dependents that reference object_ref.name (the cut-over), and only then deletes
+// the old one. Must run after mergeCommonMetadata so labels/annotations are part of the hash.
+// recreate_token is hashed too, so changing it also yields a new name.
+func applyReplacementStrategy(obj *unstructured.Unstructured, data *objectResourceModel) error {
+	if !isBlueGreen(data) {
+		return nil
+	}
+
+	baseName := obj.GetName()
+	if baseName == "" {
+		return fmt.Errorf("replacement_strategy = %q requires metadata.name in yaml_body", replacementStrategyBlueGreen)
+	}
+
+	suffix, err := blueGreenSuffix(obj, data.RecreateToken.ValueString())
+	if err != nil {
+		return err
+	}
+
+	name := baseName + "-" + suffix
+	if len(name) > maxObjectNameLength {
+		return fmt.Errorf("metadata.name %q is too long for replacement_strategy = %q: the generated name %q exceeds %d characters",
+			baseName, replacementStrategyBlueGreen, name, maxObjectNameLength)
+	}
+	obj.SetName(name)
+	return nil
+}
+
+// blueGreenSuffix hashes the desired object and recreate token. json.Marshal sorts map
+// keys, so the suffix depends only on content, not on YAML formatting or key order.
+// Without a token the hash is of the object alone, so existing names are unchanged.
+func blueGreenSuffix(obj *unstructured.Unstructured, recreateToken string) (string, error) {
+	content, err := json.Marshal(obj.Object)
+	if err != nil {
+		return "", fmt.Errorf("failed to hash object for blue-green name: %w", err)
+	}
+	if recreateToken != "" {
+		content = append(append(content, 0), recreateToken...)
+	}
+	sum := sha256.Sum256(content)
+	return hex.EncodeToString(sum[:])[:blueGreenSuffixLength], nil
+}
+
+// checkBlueGreenNameUnchanged guards Update against applying a blue-green object under a
+// new name, which would create a second object and orphan the one in state. ModifyPlan
+// plans a replacement whenever the name can change, so this only fires if that was bypassed.
+func (r *objectResource) checkBlueGreenNameUnchanged(ctx context.Context, state, plan *objectResourceModel, planned *unstructured.Unstructured) error {
+	if !isBlueGreen(state) && !isBlueGreen(plan) {
+		return nil
+	}
+
+	stateObj, err := r.parseYAML(state.YAMLBody.ValueString())
+	if err != nil {
+		return nil
+	}
+	if err := resolveAppliedName(ctx, stateObj, state); err != nil {
+		return nil
+	}
+
+	if stateObj.GetName() != planned.GetName() {
+		return fmt.Errorf("the object name would change from %q to %q, which requires replacement. "+
+			"Run terraform plan again so the replacement is planned", stateObj.GetName(), planned.GetName())
+	}
+	return nil
+}
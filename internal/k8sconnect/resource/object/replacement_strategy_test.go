@@ -0,0 +1,118 @@
+package object
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func pvcFixture(storage string) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "PersistentVolumeClaim",
+		"metadata":   map[string]interface{}{"name": "data", "namespace": "default"},
+		"spec": map[string]interface{}{
+			"accessModes": []interface{}{"ReadWriteOnce"},
+			"resources":   map[string]interface{}{"requests": map[string]interface{}{"storage": storage}},
+		},
+	}}
+}
+
+func TestApplyReplacementStrategy(t *testing.T) {
+	blueGreen := &objectResourceModel{ReplacementStrategy: types.StringValue(replacementStrategyBlueGreen)}
+
+	t.Run("recreate keeps name", func(t *testing.T) {
+		for _, data := range []*objectResourceModel{{}, {ReplacementStrategy: types.StringValue(replacementStrategyRecreate)}} {
+			obj := pvcFixture("1Gi")
+			if err := applyReplacementStrategy(obj, data); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if obj.GetName() != "data" {
+				t.Errorf("name = %q, want %q", obj.GetName(), "data")
+			}
+		}
+	})
+
+	t.Run("blue-green suffix is stable and content-derived", func(t *testing.T) {
+		first, second, resized := pvcFixture("1Gi"), pvcFixture("1Gi"), pvcFixture("2Gi")
+		for _, obj := range []*unstructured.Unstructured{first, second, resized} {
+			if err := applyReplacementStrategy(obj, blueGreen); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		}
+
+		if !strings.HasPrefix(first.GetName(), "data-") || len(first.GetName()) != len("data-")+blueGreenSuffixLength {
+			t.Errorf("name = %q, want data-<%d hex chars>", first.GetName(), blueGreenSuffixLength)
+		}
+		if first.GetName() != second.GetName() {
+			t.Errorf("identical objects got different names: %q vs %q", first.GetName(), second.GetName())
+		}
+		if first.GetName() == resized.GetName() {
+			t.Errorf("changed object kept name %q", first.GetName())
+		}
+	})
+
+	t.Run("blue-green suffix includes recreate_token", func(t *testing.T) {
+		withToken := func(token types.String) string {
+			obj := pvcFixture("1Gi")
+			data := &objectResourceModel{ReplacementStrategy: types.StringValue(replacementStrategyBlueGreen), RecreateToken: token}
+			if err := applyReplacementStrategy(obj, data); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			return obj.GetName()
+		}
+
+		untokened, first, again, second := withToken(types.StringNull()), withToken(types.StringValue("1")), withToken(types.StringValue("1")), withToken(types.StringValue("2"))
+		if plain := pvcFixture("1Gi"); applyReplacementStrategy(plain, blueGreen) != nil || plain.GetName() != untokened {
+			t.Errorf("a null recreate_token changed the name: %q vs %q", untokened, plain.GetName())
+		}
+		if first != again {
+			t.Errorf("same token got different names: %q vs %q", first, again)
+		}
+		if first == untokened || first == second {
+			t.Errorf("changing recreate_token kept the name: null=%q, 1=%q, 2=%q", untokened, first, second)
+		}
+	})
+
+	t.Run("blue-green requires a name", func(t *testing.T) {
+		obj := pvcFixture("1Gi")
+		obj.SetName("")
+		if err := applyReplacementStrategy(obj, blueGreen); err == nil {
+			t.Error("expected error for missing metadata.name")
+		}
+	})
+
+	t.Run("blue-green rejects names that become too long", func(t *testing.T) {
+		obj := pvcFixture("1Gi")
+		obj.SetName(strings.Repeat("a", maxObjectNameLength-blueGreenSuffixLength))
+		if err := applyReplacementStrategy(obj, blueGreen); err == nil {
+			t.Error("expected error for generated name over the length limit")
+		}
+	})
+}
+
+func TestResolveAppliedName_IncludesCommonMetadata(t *testing.T) {
+	ctx := context.Background()
+	withTeam := func(team string) *objectResourceModel {
+		return &objectResourceModel{
+			ReplacementStrategy: types.StringValue(replacementStrategyBlueGreen),
+			Labels:              types.MapValueMust(types.StringType, map[string]attr.Value{"team": types.StringValue(team)}),
+			Annotations:         types.MapNull(types.StringType),
+		}
+	}
+
+	a, b := pvcFixture("1Gi"), pvcFixture("1Gi")
+	if err := resolveAppliedName(ctx, a, withTeam("blue")); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := resolveAppliedName(ctx, b, withTeam("green")); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if a.GetName() == b.GetName() {
+		t.Errorf("label change did not change blue-green name %q", a.GetName())
+	}
+}
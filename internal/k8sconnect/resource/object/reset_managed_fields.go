@@ -0,0 +1,90 @@
+package object
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+)
+
+// resetManagedFieldsPatch replaces metadata.managedFields with a single empty entry,
+// which the API server treats as a request to strip them entirely
+const resetManagedFieldsPatch = `{"metadata":{"managedFields":[{}]}}`
+
+// resetsManagedFields reports whether reset_managed_fields is set
+func resetsManagedFields(data *objectResourceModel) bool {
+	return !data.ResetManagedFields.IsNull() && !data.ResetManagedFields.IsUnknown() && data.ResetManagedFields.ValueBool()
+}
+
+// managedFieldsResetPending reports whether this apply resets managedFields: on create,
+// and on the update that turns reset_managed_fields on. Leaving it on doesn't reset
+// again, so other managers keep the ownership they re-establish afterwards.
+func managedFieldsResetPending(plan, state *objectResourceModel) bool {
+	return resetsManagedFields(plan) && (state == nil || !resetsManagedFields(state))
+}
+
+// checkManagedFieldsReset marks managed_fields unknown when the apply resets them: the
+// dry-run can't predict which managers' ownership survives the reset
+func (r *objectResource) checkManagedFieldsReset(ctx context.Context, req resource.ModifyPlanRequest, plannedData *objectResourceModel) {
+	if isCreateOperation(req) || !resetsManagedFields(plannedData) {
+		return
+	}
+	var stateData objectResourceModel
+	if diags := req.State.Get(ctx, &stateData); diags.HasError() || !managedFieldsResetPending(plannedData, &stateData) {
+		return
+	}
+	plannedData.ManagedFields = types.MapUnknown(types.StringType)
+}
+
+// resetManagedFields clears metadata.managedFields of the live object before the apply,
+// so the forced apply that follows rebuilds ownership from scratch. A missing object has
+// nothing to reset. Used to recover from managedFields corrupted by a buggy controller
+// or a migration, where server-side apply otherwise behaves erratically.
+func (r *objectResource) resetManagedFields(ctx context.Context, rc *ResourceContext, diagnostics *diag.Diagnostics) error {
+	if rc.GVR.Empty() {
+		return nil
+	}
+	current, err := rc.Client.Get(ctx, rc.GVR, rc.Object.GetNamespace(), rc.Object.GetName())
+	if errors.IsNotFound(err) {
+		return nil
+	}
+	if err == nil {
+		current, err = rc.Client.Patch(ctx, rc.GVR, current.GetNamespace(), current.GetName(), k8stypes.MergePatchType,
+			[]byte(resetManagedFieldsPatch), metav1.PatchOptions{FieldManager: fieldManagerFor(rc.Data)})
+	}
+	if err != nil {
+		diagnostics.AddError(
+			"Managed Fields Reset Failed",
+			fmt.Sprintf("Failed to reset metadata.managedFields of %s (reset_managed_fields = true): %s\n\n"+
+				"Nothing was applied. The next terraform apply will try again.",
+				formatResource(rc.Object), err),
+		)
+		return err
+	}
+
+	// optimistic_concurrency: the reset is our own write, apply onto it
+	if rc.PreconditionResourceVersion != "" {
+		rc.PreconditionResourceVersion = current.GetResourceVersion()
+	}
+
+	tflog.Info(ctx, "Reset managedFields", map[string]interface{}{
+		"resource": formatResource(rc.Object),
+	})
+	diagnostics.AddWarning(
+		"Managed Fields Reset",
+		fmt.Sprintf("Cleared metadata.managedFields of %s before applying (reset_managed_fields = true).\n\n"+
+			"This removed the field ownership of every manager, not only k8sconnect. The fields in yaml_body "+
+			"are owned by k8sconnect again after this apply; controllers and other tools take ownership of "+
+			"their fields again on their next write. Until then, server-side apply from other managers "+
+			"won't report conflicts on those fields.\n\n"+
+			"The reset only runs on the apply that enables it. Remove reset_managed_fields once ownership is healthy.",
+			formatResource(rc.Object)),
+	)
+	return nil
+}
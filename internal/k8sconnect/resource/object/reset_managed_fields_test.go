@@ -0,0 +1,70 @@
+package object
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"k8s.io/apimachinery/pkg/api/errors"
+	k8sschema "k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/jmorris0x0/terraform-provider-k8sconnect/internal/k8sconnect/common/k8sclient"
+)
+
+func TestResetManagedFields(t *testing.T) {
+	ctx := context.Background()
+	r := &objectResource{}
+	gvr := k8sschema.GroupVersionResource{Version: "v1", Resource: "configmaps"}
+
+	t.Run("clears managedFields with a merge patch", func(t *testing.T) {
+		live := kubectlAppliedConfigMap(nil)
+		live.SetResourceVersion("42")
+		client := &patchRecordingClient{K8sClient: k8sclient.NewStubK8sClient(), live: live}
+		rc := &ResourceContext{Client: client, GVR: gvr, Object: live, Data: &objectResourceModel{}, PreconditionResourceVersion: "41"}
+		var diags diag.Diagnostics
+		if err := r.resetManagedFields(ctx, rc, &diags); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		want := `application/merge-patch+json {"metadata":{"managedFields":[{}]}}`
+		if len(client.patches) != 1 || client.patches[0] != want {
+			t.Fatalf("patches = %v, want [%s]", client.patches, want)
+		}
+		if len(diags) != 1 || !strings.Contains(diags[0].Detail(), "every manager") {
+			t.Errorf("expected a warning that all managers are affected, got %v", diags)
+		}
+		if rc.PreconditionResourceVersion != "42" {
+			t.Errorf("optimistic_concurrency should apply onto the reset, got resourceVersion %q", rc.PreconditionResourceVersion)
+		}
+	})
+
+	t.Run("object doesn't exist yet", func(t *testing.T) {
+		stub := k8sclient.NewStubK8sClient()
+		stub.GetError = errors.NewNotFound(gvr.GroupResource(), "settings")
+		rc := &ResourceContext{Client: stub, GVR: gvr, Object: kubectlAppliedConfigMap(nil), Data: &objectResourceModel{}}
+		var diags diag.Diagnostics
+		if err := r.resetManagedFields(ctx, rc, &diags); err != nil || len(diags) != 0 {
+			t.Errorf("expected nothing to reset, got %v and %v", err, diags)
+		}
+	})
+}
+
+func TestManagedFieldsResetPending(t *testing.T) {
+	on := &objectResourceModel{ResetManagedFields: types.BoolValue(true)}
+	off := &objectResourceModel{ResetManagedFields: types.BoolNull()}
+
+	if !managedFieldsResetPending(on, nil) {
+		t.Error("create with reset_managed_fields should reset")
+	}
+	if !managedFieldsResetPending(on, off) {
+		t.Error("the update that enables reset_managed_fields should reset")
+	}
+	if managedFieldsResetPending(on, on) {
+		t.Error("leaving reset_managed_fields on must not reset again")
+	}
+	if managedFieldsResetPending(off, on) {
+		t.Error("disabling reset_managed_fields must not reset")
+	}
+}
@@ -11,8 +11,52 @@ const (
 	CreatedAtAnnotation = "k8sconnect.terraform.io/created-at"
 )
 
-// setOwnershipAnnotation marks a Kubernetes resource as managed by this Terraform resource
-func (r *objectResource) setOwnershipAnnotation(obj *unstructured.Unstructured, terraformID string) {
+// objectFieldManager is the Server-Side Apply field manager used by k8sconnect_object
+// when field_manager is not set
+const objectFieldManager = "k8sconnect"
+
+// field_manager_operation values: how managedFields records k8sconnect's writes
+const (
+	fieldManagerOperationApply  = "Apply"
+	fieldManagerOperationUpdate = "Update"
+)
+
+// recordsUpdateOperation reports whether data's object is written with Update operations
+// (create or merge patch) instead of Server-Side Apply
+func recordsUpdateOperation(data *objectResourceModel) bool {
+	return data != nil && data.FieldManagerOperation.ValueString() == fieldManagerOperationUpdate
+}
+
+// fieldManagerFor returns the field manager data's object is applied with
+func fieldManagerFor(data *objectResourceModel) string {
+	if hasCustomFieldManager(data) {
+		return data.FieldManager.ValueString()
+	}
+	return objectFieldManager
+}
+
+// hasCustomFieldManager reports whether data sets its own field_manager
+func hasCustomFieldManager(data *objectResourceModel) bool {
+	return data != nil && !data.FieldManager.IsNull() && !data.FieldManager.IsUnknown() &&
+		data.FieldManager.ValueString() != "" && data.FieldManager.ValueString() != objectFieldManager
+}
+
+// usesOwnershipAnnotation reports whether data's object is tracked with the ownership
+// annotation. With its own field_manager the object is shared with other writers, each of
+// which would claim the annotation, so ownership is tracked by the field manager alone, as
+// with manage_ownership_annotation = false.
+func (r *objectResource) usesOwnershipAnnotation(data *objectResourceModel) bool {
+	return !r.skipOwnershipAnnotation && !hasCustomFieldManager(data)
+}
+
+// setOwnershipAnnotation marks a Kubernetes resource as managed by this Terraform resource.
+// Does nothing when the provider sets manage_ownership_annotation = false or data sets its
+// own field_manager.
+func (r *objectResource) setOwnershipAnnotation(obj *unstructured.Unstructured, data *objectResourceModel) {
+	if !r.usesOwnershipAnnotation(data) {
+		return
+	}
+	terraformID := data.ID.ValueString()
 	annotations := obj.GetAnnotations()
 	if annotations == nil {
 		annotations = make(map[string]string)
@@ -22,11 +66,29 @@ func (r *objectResource) setOwnershipAnnotation(obj *unstructured.Unstructured,
 	obj.SetAnnotations(annotations)
 }
 
-// getOwnershipID extracts the Terraform resource ID from Kubernetes annotations
-func (r *objectResource) getOwnershipID(obj *unstructured.Unstructured) string {
+// getOwnershipID extracts the Terraform resource ID from Kubernetes annotations.
+// Returns "" when the annotation is disabled for data, so leftovers from earlier applies
+// (or another writer's annotation) are ignored. data is nil on import, before there is any
+// configuration.
+func (r *objectResource) getOwnershipID(obj *unstructured.Unstructured, data *objectResourceModel) string {
+	if !r.usesOwnershipAnnotation(data) {
+		return ""
+	}
 	annotations := obj.GetAnnotations()
 	if annotations == nil {
 		return ""
 	}
 	return annotations[OwnershipAnnotation]
 }
+
+// isManagedByFieldManager reports whether fieldManager owns any field of obj. Used for
+// ownership decisions when the ownership annotation is disabled; it cannot tell which
+// k8sconnect_object (or Terraform state) applied the object.
+func isManagedByFieldManager(obj *unstructured.Unstructured, fieldManager string) bool {
+	for _, mf := range obj.GetManagedFields() {
+		if mf.Manager == fieldManager {
+			return true
+		}
+	}
+	return false
+}
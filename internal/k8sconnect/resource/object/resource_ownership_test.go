@@ -0,0 +1,120 @@
+package object
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func ownershipTestObject() *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{}
+	obj.SetAPIVersion("v1")
+	obj.SetKind("ConfigMap")
+	obj.SetName("app")
+	obj.SetNamespace("default")
+	return obj
+}
+
+func TestOwnershipAnnotationRoundTrip(t *testing.T) {
+	r := &objectResource{}
+	obj := ownershipTestObject()
+
+	r.setOwnershipAnnotation(obj, &objectResourceModel{ID: types.StringValue("abc123")})
+
+	if got := r.getOwnershipID(obj, nil); got != "abc123" {
+		t.Errorf("getOwnershipID() = %q, want %q", got, "abc123")
+	}
+	if _, ok := obj.GetAnnotations()[CreatedAtAnnotation]; !ok {
+		t.Errorf("expected %s annotation to be set", CreatedAtAnnotation)
+	}
+}
+
+func TestOwnershipAnnotationDisabled(t *testing.T) {
+	r := NewObjectResourceWithClientGetter(nil, ProviderSettings{ManageOwnershipAnnotation: false}).(*objectResource)
+	obj := ownershipTestObject()
+
+	r.setOwnershipAnnotation(obj, &objectResourceModel{ID: types.StringValue("abc123")})
+	if annotations := obj.GetAnnotations(); len(annotations) != 0 {
+		t.Errorf("expected no annotations when disabled, got %v", annotations)
+	}
+
+	// Leftover annotations from an earlier apply are not read either
+	obj.SetAnnotations(map[string]string{OwnershipAnnotation: "old-id"})
+	if got := r.getOwnershipID(obj, nil); got != "" {
+		t.Errorf("getOwnershipID() = %q, want empty when disabled", got)
+	}
+}
+
+func TestOwnershipAnnotationCustomFieldManager(t *testing.T) {
+	r := &objectResource{}
+	obj := ownershipTestObject()
+	data := &objectResourceModel{ID: types.StringValue("abc123"), FieldManager: types.StringValue("team-labels")}
+
+	r.setOwnershipAnnotation(obj, data)
+	if annotations := obj.GetAnnotations(); len(annotations) != 0 {
+		t.Errorf("expected no annotations with a custom field manager, got %v", annotations)
+	}
+
+	// Another writer's annotation is not ours to compare against
+	obj.SetAnnotations(map[string]string{OwnershipAnnotation: "other-id"})
+	if got := r.getOwnershipID(obj, data); got != "" {
+		t.Errorf("getOwnershipID() = %q, want empty with a custom field manager", got)
+	}
+}
+
+func TestFieldManagerFor(t *testing.T) {
+	tests := []struct {
+		name       string
+		data       *objectResourceModel
+		want       string
+		annotation bool
+	}{
+		{"import", nil, objectFieldManager, true},
+		{"not set", &objectResourceModel{FieldManager: types.StringNull()}, objectFieldManager, true},
+		{"unknown", &objectResourceModel{FieldManager: types.StringUnknown()}, objectFieldManager, true},
+		{"default spelled out", &objectResourceModel{FieldManager: types.StringValue("k8sconnect")}, objectFieldManager, true},
+		{"custom", &objectResourceModel{FieldManager: types.StringValue("team-labels")}, "team-labels", false},
+	}
+
+	r := &objectResource{}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := fieldManagerFor(tt.data); got != tt.want {
+				t.Errorf("fieldManagerFor() = %q, want %q", got, tt.want)
+			}
+			if got := r.usesOwnershipAnnotation(tt.data); got != tt.annotation {
+				t.Errorf("usesOwnershipAnnotation() = %v, want %v", got, tt.annotation)
+			}
+		})
+	}
+}
+
+func TestIsManagedByFieldManager(t *testing.T) {
+	tests := []struct {
+		name     string
+		managers []string
+		want     bool
+	}{
+		{"no managed fields", nil, false},
+		{"other managers only", []string{"kubectl-client-side-apply", "k8sconnect-patch-abc"}, false},
+		{"object field manager", []string{"kube-controller-manager", "k8sconnect"}, true},
+		{"another object's field manager", []string{"team-labels"}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			obj := ownershipTestObject()
+			var entries []metav1.ManagedFieldsEntry
+			for _, m := range tt.managers {
+				entries = append(entries, metav1.ManagedFieldsEntry{Manager: m, Operation: metav1.ManagedFieldsOperationApply})
+			}
+			obj.SetManagedFields(entries)
+
+			if got := isManagedByFieldManager(obj, objectFieldManager); got != tt.want {
+				t.Errorf("isManagedByFieldManager() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
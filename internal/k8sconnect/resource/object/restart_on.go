@@ -0,0 +1,38 @@
+package object
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// restartedAtAnnotation is the pod template annotation kubectl rollout restart sets
+const restartedAtAnnotation = "kubectl.kubernetes.io/restartedAt"
+
+// applyRestartOn writes restart_on to the restartedAt annotation of the pod template, so a
+// new token rolls the pods the way kubectl rollout restart does. The annotation is sent
+// with the object, so it is projected and drift-checked like any other field, and it
+// changes pod_template_hash. A value already in yaml_body wins, as with annotations.
+func applyRestartOn(obj *unstructured.Unstructured, data *objectResourceModel) error {
+	if data.RestartOn.IsNull() || data.RestartOn.IsUnknown() {
+		return nil
+	}
+
+	if _, ok := podTemplateHash(obj); !ok {
+		return fmt.Errorf("restart_on requires a Deployment, StatefulSet, or DaemonSet with spec.template, got %s %s",
+			obj.GetKind(), obj.GetName())
+	}
+
+	annotations, _, err := unstructured.NestedStringMap(obj.Object, "spec", "template", "metadata", "annotations")
+	if err != nil {
+		return fmt.Errorf("invalid spec.template.metadata.annotations: %w", err)
+	}
+	if _, set := annotations[restartedAtAnnotation]; set {
+		return nil
+	}
+	if annotations == nil {
+		annotations = make(map[string]string, 1)
+	}
+	annotations[restartedAtAnnotation] = data.RestartOn.ValueString()
+	return unstructured.SetNestedStringMap(obj.Object, annotations, "spec", "template", "metadata", "annotations")
+}
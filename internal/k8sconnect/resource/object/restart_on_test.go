@@ -0,0 +1,75 @@
+package object
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestApplyRestartOn(t *testing.T) {
+	ctx := context.Background()
+	deployment := func() *unstructured.Unstructured {
+		return workloadWithTemplate("Deployment", map[string]interface{}{
+			"metadata": map[string]interface{}{"labels": map[string]interface{}{"app": "web"}},
+			"spec": map[string]interface{}{
+				"containers": []interface{}{map[string]interface{}{"name": "app", "image": "nginx:1.27"}},
+			},
+		})
+	}
+	restartedAt := func(obj *unstructured.Unstructured) string {
+		value, _, _ := unstructured.NestedString(obj.Object, "spec", "template", "metadata", "annotations", restartedAtAnnotation)
+		return value
+	}
+
+	t.Run("stamps the pod template and changes pod_template_hash", func(t *testing.T) {
+		before, _ := podTemplateHash(deployment())
+		obj := deployment()
+		if err := mergeCommonMetadata(ctx, obj, &objectResourceModel{RestartOn: types.StringValue("v1")}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got := restartedAt(obj); got != "v1" {
+			t.Errorf("%s = %q, want v1", restartedAtAnnotation, got)
+		}
+		if after, _ := podTemplateHash(obj); after == before {
+			t.Error("expected pod_template_hash to change")
+		}
+		if len(obj.GetAnnotations()) != 0 {
+			t.Errorf("expected the object's own annotations untouched, got %v", obj.GetAnnotations())
+		}
+	})
+
+	t.Run("yaml_body wins", func(t *testing.T) {
+		obj := deployment()
+		_ = unstructured.SetNestedField(obj.Object, map[string]interface{}{restartedAtAnnotation: "from-yaml"},
+			"spec", "template", "metadata", "annotations")
+		if err := applyRestartOn(obj, &objectResourceModel{RestartOn: types.StringValue("v1")}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got := restartedAt(obj); got != "from-yaml" {
+			t.Errorf("%s = %q, want from-yaml", restartedAtAnnotation, got)
+		}
+	})
+
+	t.Run("unset", func(t *testing.T) {
+		obj := deployment()
+		if err := applyRestartOn(obj, &objectResourceModel{RestartOn: types.StringNull()}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got := restartedAt(obj); got != "" {
+			t.Errorf("expected no annotation, got %q", got)
+		}
+	})
+
+	t.Run("kinds without a pod template", func(t *testing.T) {
+		obj := &unstructured.Unstructured{Object: map[string]interface{}{
+			"apiVersion": "v1",
+			"kind":       "ConfigMap",
+			"metadata":   map[string]interface{}{"name": "settings"},
+		}}
+		if err := applyRestartOn(obj, &objectResourceModel{RestartOn: types.StringValue("v1")}); err == nil {
+			t.Error("expected an error for a ConfigMap")
+		}
+	})
+}
@@ -0,0 +1,134 @@
+package object
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	k8sschema "k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/jmorris0x0/terraform-provider-k8sconnect/internal/k8sconnect/common/k8sclient"
+)
+
+// isCoreService reports whether obj is a core/v1 Service
+func isCoreService(obj *unstructured.Unstructured) bool {
+	return obj.GetKind() == "Service" && obj.GroupVersionKind().Group == ""
+}
+
+// serviceType returns spec.type, defaulting to ClusterIP like the API server
+func serviceType(obj *unstructured.Unstructured) string {
+	if t, _, _ := unstructured.NestedString(obj.Object, "spec", "type"); t != "" {
+		return t
+	}
+	return "ClusterIP"
+}
+
+// servicePortKey identifies a Service port the way the ports list is merged: by port and
+// protocol, which defaults to TCP
+func servicePortKey(port map[string]interface{}) string {
+	protocol, _ := port["protocol"].(string)
+	if protocol == "" {
+		protocol = "TCP"
+	}
+	return fmt.Sprintf("%v/%s", port["port"], protocol)
+}
+
+// adoptServiceAssignedFields copies the fields the API server assigns to a Service when
+// yaml_body omits them (spec.clusterIP and spec.clusterIPs, spec.ports[].nodePort and
+// spec.healthCheckNodePort) from live into obj, so every apply after the first one sends
+// the values the server chose. Server-side apply then keeps owning them instead of
+// removing a value it sent before, and projection and ownership stay the same from one
+// apply to the next. Only fields the type declared in obj allows are adopted, so changing
+// the type still releases them: no cluster IP for ExternalName, node ports for NodePort
+// and LoadBalancer, the health check port for a LoadBalancer with
+// externalTrafficPolicy Local. Changing ipFamilyPolicy lets the server reassign cluster IPs.
+// Returns the names of the adopted fields.
+func adoptServiceAssignedFields(obj, live *unstructured.Unstructured) []string {
+	var adopted []string
+	desiredType := serviceType(obj)
+	if desiredType == "ExternalName" || serviceType(live) == "ExternalName" {
+		return nil
+	}
+
+	_, hasClusterIP, _ := unstructured.NestedFieldNoCopy(obj.Object, "spec", "clusterIP")
+	_, hasClusterIPs, _ := unstructured.NestedFieldNoCopy(obj.Object, "spec", "clusterIPs")
+	desiredPolicy, _, _ := unstructured.NestedString(obj.Object, "spec", "ipFamilyPolicy")
+	livePolicy, _, _ := unstructured.NestedString(live.Object, "spec", "ipFamilyPolicy")
+	if !hasClusterIP && !hasClusterIPs && (desiredPolicy == "" || desiredPolicy == livePolicy) {
+		if clusterIP, _, _ := unstructured.NestedString(live.Object, "spec", "clusterIP"); clusterIP != "" {
+			_ = unstructured.SetNestedField(obj.Object, clusterIP, "spec", "clusterIP")
+			adopted = append(adopted, "spec.clusterIP")
+			if clusterIPs, found, _ := unstructured.NestedStringSlice(live.Object, "spec", "clusterIPs"); found && len(clusterIPs) > 0 {
+				_ = unstructured.SetNestedStringSlice(obj.Object, clusterIPs, "spec", "clusterIPs")
+				adopted = append(adopted, "spec.clusterIPs")
+			}
+		}
+	}
+
+	allocateNodePorts := true
+	if allocate, found, _ := unstructured.NestedBool(obj.Object, "spec", "allocateLoadBalancerNodePorts"); found && !allocate {
+		allocateNodePorts = false
+	}
+	if desiredType == "NodePort" || (desiredType == "LoadBalancer" && allocateNodePorts) {
+		liveNodePorts := make(map[string]interface{})
+		livePorts, _, _ := unstructured.NestedSlice(live.Object, "spec", "ports")
+		for _, p := range livePorts {
+			if port, ok := p.(map[string]interface{}); ok && port["nodePort"] != nil {
+				liveNodePorts[servicePortKey(port)] = port["nodePort"]
+			}
+		}
+
+		ports, _, _ := unstructured.NestedSlice(obj.Object, "spec", "ports")
+		changed := false
+		for i, p := range ports {
+			port, ok := p.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			if _, set := port["nodePort"]; set {
+				continue
+			}
+			if nodePort, ok := liveNodePorts[servicePortKey(port)]; ok {
+				port["nodePort"] = nodePort
+				adopted = append(adopted, fmt.Sprintf("spec.ports[%d].nodePort", i))
+				changed = true
+			}
+		}
+		if changed {
+			_ = unstructured.SetNestedSlice(obj.Object, ports, "spec", "ports")
+		}
+	}
+
+	trafficPolicy, _, _ := unstructured.NestedString(obj.Object, "spec", "externalTrafficPolicy")
+	if _, set, _ := unstructured.NestedFieldNoCopy(obj.Object, "spec", "healthCheckNodePort"); !set && desiredType == "LoadBalancer" && trafficPolicy == "Local" {
+		if port, found, _ := unstructured.NestedInt64(live.Object, "spec", "healthCheckNodePort"); found && port != 0 {
+			_ = unstructured.SetNestedField(obj.Object, port, "spec", "healthCheckNodePort")
+			adopted = append(adopted, "spec.healthCheckNodePort")
+		}
+	}
+	return adopted
+}
+
+// withServiceAssignedFields returns a copy of obj with the server-assigned fields it
+// omits adopted from the live Service, see adoptServiceAssignedFields. Other kinds,
+// Services that don't exist yet, and failed reads return obj unchanged.
+func withServiceAssignedFields(ctx context.Context, client k8sclient.K8sClient, gvr k8sschema.GroupVersionResource, obj *unstructured.Unstructured) *unstructured.Unstructured {
+	if !isCoreService(obj) {
+		return obj
+	}
+	live, err := client.Get(ctx, gvr, obj.GetNamespace(), obj.GetName())
+	if err != nil || live == nil {
+		return obj
+	}
+
+	adopting := obj.DeepCopy()
+	if adopted := adoptServiceAssignedFields(adopting, live); len(adopted) > 0 {
+		tflog.Debug(ctx, "Adopted server-assigned Service fields", map[string]interface{}{
+			"resource": formatResource(obj),
+			"fields":   adopted,
+		})
+		return adopting
+	}
+	return obj
+}
@@ -0,0 +1,215 @@
+package object
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	k8sschema "k8s.io/apimachinery/pkg/runtime/schema"
+	sigsyaml "sigs.k8s.io/yaml"
+
+	"github.com/jmorris0x0/terraform-provider-k8sconnect/internal/k8sconnect/common/k8sclient"
+)
+
+func serviceFromYAML(t *testing.T, body string) *unstructured.Unstructured {
+	t.Helper()
+	data, err := sigsyaml.YAMLToJSON([]byte(body))
+	if err != nil {
+		t.Fatalf("invalid Service YAML: %v", err)
+	}
+	// Decoded like the dynamic client does, with integers as int64
+	obj := &unstructured.Unstructured{}
+	if err := obj.UnmarshalJSON(data); err != nil {
+		t.Fatalf("invalid Service: %v", err)
+	}
+	return obj
+}
+
+// liveService is a NodePort Service as the API server returns it after assigning its
+// cluster IP and node ports
+const liveService = `
+apiVersion: v1
+kind: Service
+metadata:
+  name: web
+  namespace: default
+spec:
+  type: NodePort
+  clusterIP: 10.96.14.7
+  clusterIPs: [10.96.14.7]
+  ipFamilyPolicy: SingleStack
+  ports:
+  - name: http
+    port: 80
+    protocol: TCP
+    targetPort: 8080
+    nodePort: 30080
+  - name: metrics
+    port: 9090
+    protocol: TCP
+    nodePort: 31090
+`
+
+func TestAdoptServiceAssignedFields(t *testing.T) {
+	tests := []struct {
+		name        string
+		desired     string
+		wantAdopted []string
+		wantIP      string
+		wantPorts   []interface{}
+	}{
+		{
+			name: "ClusterIP keeps the cluster IP, node ports are released",
+			desired: `
+apiVersion: v1
+kind: Service
+metadata: {name: web, namespace: default}
+spec:
+  ports:
+  - {name: http, port: 80, targetPort: 8080}
+`,
+			wantAdopted: []string{"spec.clusterIP", "spec.clusterIPs"},
+			wantIP:      "10.96.14.7",
+			wantPorts:   []interface{}{nil},
+		},
+		{
+			name: "NodePort keeps the cluster IP and the node ports of matching ports",
+			desired: `
+apiVersion: v1
+kind: Service
+metadata: {name: web, namespace: default}
+spec:
+  type: NodePort
+  ports:
+  - {name: http, port: 80, targetPort: 8080}
+  - {name: metrics, port: 9090, nodePort: 32000}
+  - {name: dns, port: 53, protocol: UDP}
+`,
+			wantAdopted: []string{"spec.clusterIP", "spec.clusterIPs", "spec.ports[0].nodePort"},
+			wantIP:      "10.96.14.7",
+			wantPorts:   []interface{}{int64(30080), int64(32000), nil},
+		},
+		{
+			name: "a declared cluster IP is left as it is",
+			desired: `
+apiVersion: v1
+kind: Service
+metadata: {name: web, namespace: default}
+spec:
+  type: NodePort
+  clusterIP: None
+  ports:
+  - {name: http, port: 80}
+`,
+			wantAdopted: []string{"spec.ports[0].nodePort"},
+			wantIP:      "None",
+			wantPorts:   []interface{}{int64(30080)},
+		},
+		{
+			name: "changing ipFamilyPolicy lets the server reassign cluster IPs",
+			desired: `
+apiVersion: v1
+kind: Service
+metadata: {name: web, namespace: default}
+spec:
+  ipFamilyPolicy: PreferDualStack
+  ports:
+  - {name: http, port: 80}
+`,
+			wantPorts: []interface{}{nil},
+		},
+		{
+			name: "ExternalName adopts nothing",
+			desired: `
+apiVersion: v1
+kind: Service
+metadata: {name: web, namespace: default}
+spec:
+  type: ExternalName
+  externalName: web.example.com
+`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			obj := serviceFromYAML(t, tt.desired)
+			adopted := adoptServiceAssignedFields(obj, serviceFromYAML(t, liveService))
+			if !reflect.DeepEqual(adopted, tt.wantAdopted) {
+				t.Errorf("adopted = %v, want %v", adopted, tt.wantAdopted)
+			}
+			if clusterIP, _, _ := unstructured.NestedString(obj.Object, "spec", "clusterIP"); clusterIP != tt.wantIP {
+				t.Errorf("spec.clusterIP = %q, want %q", clusterIP, tt.wantIP)
+			}
+			ports, _, _ := unstructured.NestedSlice(obj.Object, "spec", "ports")
+			if len(ports) != len(tt.wantPorts) {
+				t.Fatalf("spec.ports = %v, want %d ports", ports, len(tt.wantPorts))
+			}
+			for i, want := range tt.wantPorts {
+				if got := ports[i].(map[string]interface{})["nodePort"]; got != want {
+					t.Errorf("spec.ports[%d].nodePort = %v, want %v", i, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestAdoptServiceHealthCheckNodePort(t *testing.T) {
+	live := serviceFromYAML(t, liveService)
+	_ = unstructured.SetNestedField(live.Object, "LoadBalancer", "spec", "type")
+	_ = unstructured.SetNestedField(live.Object, "Local", "spec", "externalTrafficPolicy")
+	_ = unstructured.SetNestedField(live.Object, int64(32500), "spec", "healthCheckNodePort")
+
+	for _, tt := range []struct {
+		trafficPolicy string
+		want          interface{}
+	}{
+		{"Local", int64(32500)},
+		{"Cluster", nil},
+	} {
+		obj := serviceFromYAML(t, `
+apiVersion: v1
+kind: Service
+metadata: {name: web, namespace: default}
+spec:
+  type: LoadBalancer
+  ports:
+  - {name: http, port: 80}
+`)
+		_ = unstructured.SetNestedField(obj.Object, tt.trafficPolicy, "spec", "externalTrafficPolicy")
+		adoptServiceAssignedFields(obj, live)
+		if got, _, _ := unstructured.NestedFieldNoCopy(obj.Object, "spec", "healthCheckNodePort"); got != tt.want {
+			t.Errorf("externalTrafficPolicy %s: spec.healthCheckNodePort = %v, want %v", tt.trafficPolicy, got, tt.want)
+		}
+	}
+}
+
+func TestWithServiceAssignedFields(t *testing.T) {
+	ctx := context.Background()
+	gvr := k8sschema.GroupVersionResource{Version: "v1", Resource: "services"}
+	desired := serviceFromYAML(t, `
+apiVersion: v1
+kind: Service
+metadata: {name: web, namespace: default}
+spec:
+  type: NodePort
+  ports:
+  - {name: http, port: 80}
+`)
+
+	stub := k8sclient.NewStubK8sClient()
+	stub.GetResponse = serviceFromYAML(t, liveService)
+	adopted := withServiceAssignedFields(ctx, stub, gvr, desired)
+	if nodePort, _, _ := unstructured.NestedSlice(adopted.Object, "spec", "ports"); nodePort[0].(map[string]interface{})["nodePort"] != int64(30080) {
+		t.Errorf("expected the live node port to be adopted, got %v", adopted.Object["spec"])
+	}
+	if _, found, _ := unstructured.NestedString(desired.Object, "spec", "clusterIP"); found {
+		t.Error("the desired object must not be modified")
+	}
+
+	// Not created yet: nothing to adopt
+	if got := withServiceAssignedFields(ctx, k8sclient.NewStubK8sClient(), gvr, desired); got != desired {
+		t.Errorf("expected the object unchanged without a live Service, got %v", got.Object)
+	}
+}
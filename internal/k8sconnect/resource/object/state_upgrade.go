@@ -2,13 +2,17 @@ package object
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/jmorris0x0/terraform-provider-k8sconnect/internal/k8sconnect/common/auth"
@@ -20,10 +24,10 @@ var _ resource.ResourceWithUpgradeState = (*objectResource)(nil)
 // UpgradeState implements resource.ResourceWithUpgradeState
 func (r *objectResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
 	return map[int64]resource.StateUpgrader{
-		// State upgrade from v0 to v2 - add managed_fields
+		// State upgrade from v0 to v2 - add managed_fields, rename cluster_connection
 		0: {
-			PriorSchema:   getObjectSchemaV1(),
-			StateUpgrader: upgradeObjectState,
+			PriorSchema:   getLegacyObjectSchema(),
+			StateUpgrader: upgradeLegacyObjectState,
 		},
 		// State upgrade from v1 to v2 - add managed_fields
 		1: {
@@ -99,40 +103,133 @@ func getObjectSchemaV1() *schema.Schema {
 	}
 }
 
-// upgradeObjectState upgrades state from v0/v1 to v2 by adding managed_fields
-func upgradeObjectState(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
-	// Define a struct matching v1 schema (without managed_fields)
-	type objectResourceModelV1 struct {
-		ID                     types.String `tfsdk:"id"`
-		YAMLBody               types.String `tfsdk:"yaml_body"`
-		Cluster                types.Object `tfsdk:"cluster"`
-		DeleteProtection       types.Bool   `tfsdk:"delete_protection"`
-		DeleteTimeout          types.String `tfsdk:"delete_timeout"`
-		ForceDestroy           types.Bool   `tfsdk:"force_destroy"`
-		IgnoreFields           types.List   `tfsdk:"ignore_fields"`
-		ManagedStateProjection types.Map    `tfsdk:"managed_state_projection"`
-		ObjectRef              types.Object `tfsdk:"object_ref"`
+// getLegacyObjectSchema returns the v1 schema with the pre-0.2.0 cluster_connection
+// attribute beside cluster. Version 0 state may use either name, and so may the state
+// of the legacy k8sconnect_manifest and k8sinline_manifest resources (see move_state.go).
+func getLegacyObjectSchema() *schema.Schema {
+	legacy := getObjectSchemaV1()
+	legacy.Version = 0
+	legacy.Attributes["cluster"] = schema.SingleNestedAttribute{
+		Optional:   true,
+		Attributes: auth.GetConnectionSchemaForResource(),
+	}
+	legacy.Attributes["cluster_connection"] = schema.SingleNestedAttribute{
+		Optional:   true,
+		Attributes: auth.GetConnectionSchemaForResource(),
 	}
+	return legacy
+}
+
+// objectResourceModelV1 matches the v1 schema (without managed_fields)
+type objectResourceModelV1 struct {
+	ID                     types.String `tfsdk:"id"`
+	YAMLBody               types.String `tfsdk:"yaml_body"`
+	Cluster                types.Object `tfsdk:"cluster"`
+	DeleteProtection       types.Bool   `tfsdk:"delete_protection"`
+	DeleteTimeout          types.String `tfsdk:"delete_timeout"`
+	ForceDestroy           types.Bool   `tfsdk:"force_destroy"`
+	IgnoreFields           types.List   `tfsdk:"ignore_fields"`
+	ManagedStateProjection types.Map    `tfsdk:"managed_state_projection"`
+	ObjectRef              types.Object `tfsdk:"object_ref"`
+}
 
+// legacyObjectResourceModel matches getLegacyObjectSchema
+type legacyObjectResourceModel struct {
+	objectResourceModelV1
+	ClusterConnection types.Object `tfsdk:"cluster_connection"`
+}
+
+// readLegacyObjectState reads state in the legacy schema as v1, taking the connection
+// from cluster_connection when cluster is not set
+func readLegacyObjectState(ctx context.Context, state *tfsdk.State) (objectResourceModelV1, diag.Diagnostics) {
+	var legacy legacyObjectResourceModel
+	diags := state.Get(ctx, &legacy)
+	if diags.HasError() {
+		return objectResourceModelV1{}, diags
+	}
+
+	dataV1 := legacy.objectResourceModelV1
+	if dataV1.Cluster.IsNull() {
+		dataV1.Cluster = legacy.ClusterConnection
+	}
+	if dataV1.Cluster.IsNull() {
+		diags.AddAttributeError(
+			path.Root("cluster"),
+			"Cannot Upgrade State Without a Cluster Connection",
+			fmt.Sprintf("The prior state of %s sets neither cluster nor cluster_connection, "+
+				"so it cannot be migrated to the current k8sconnect_object schema.\n\n"+
+				"Options:\n"+
+				"• Remove it from state (terraform state rm) and import the object again",
+				dataV1.ID.ValueString()),
+		)
+		return objectResourceModelV1{}, diags
+	}
+	return dataV1, diags
+}
+
+// upgradeLegacyObjectState upgrades v0 state, which may still use cluster_connection, to v2
+func upgradeLegacyObjectState(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+	dataV1, diags := readLegacyObjectState(ctx, req.State)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, objectModelFromV1(dataV1))...)
+}
+
+// upgradeObjectState upgrades state from v1 to v2 by adding managed_fields
+func upgradeObjectState(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
 	var dataV1 objectResourceModelV1
 	resp.Diagnostics.Append(req.State.Get(ctx, &dataV1)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	// Create upgraded state with managed_fields added
-	upgradedData := objectResourceModel{
-		ID:                     dataV1.ID,
-		YAMLBody:               dataV1.YAMLBody,
-		Cluster:                dataV1.Cluster,
-		DeleteProtection:       dataV1.DeleteProtection,
-		DeleteTimeout:          dataV1.DeleteTimeout,
-		ForceDestroy:           dataV1.ForceDestroy,
-		IgnoreFields:           dataV1.IgnoreFields,
-		ManagedStateProjection: dataV1.ManagedStateProjection,
-		ObjectRef:              dataV1.ObjectRef,
-		ManagedFields:          types.MapNull(types.StringType), // Add managed_fields as null
-	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, objectModelFromV1(dataV1))...)
+}
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, upgradedData)...)
+// objectModelFromV1 converts v1 state to the current model; attributes added since v1 are null
+func objectModelFromV1(dataV1 objectResourceModelV1) objectResourceModel {
+	return objectResourceModel{
+		ID:                          dataV1.ID,
+		YAMLBody:                    dataV1.YAMLBody,
+		Cluster:                     dataV1.Cluster,
+		DeleteProtection:            dataV1.DeleteProtection,
+		DeleteTimeout:               dataV1.DeleteTimeout,
+		DeleteGracePeriod:           types.Int64Null(),
+		DeleteCascade:               types.StringNull(),
+		ForceDestroy:                dataV1.ForceDestroy,
+		WaitForDeletion:             types.BoolNull(),
+		WaitForNamespaceTermination: types.BoolNull(),
+		DependsOnReady:              types.ListNull(types.ObjectType{AttrTypes: dependencyRefAttrTypes}),
+		DependsOnReadyTimeout:       types.StringNull(),
+		Precondition:                types.ObjectNull(preconditionAttrTypes),
+		AllowStatus:                 types.BoolNull(),
+		DetectDrift:                 types.BoolNull(),
+		RefreshFromCache:            types.BoolNull(),
+		FieldManager:                types.StringNull(),
+		FieldManagerOperation:       types.StringNull(),
+		IgnoreFields:                dataV1.IgnoreFields,
+		ManageScope:                 types.ListNull(types.StringType),
+		ReplaceOnUpdate:             types.BoolNull(),
+		ReplacementStrategy:         types.StringNull(),
+		RecreateToken:               types.StringNull(),
+		RestartOn:                   types.StringNull(),
+		OptimisticConcurrency:       types.BoolNull(),
+		ApplyPriority:               types.Int64Null(),
+		StripLastApplied:            types.BoolNull(),
+		ResetManagedFields:          types.BoolNull(),
+		Labels:                      types.MapNull(types.StringType),
+		Annotations:                 types.MapNull(types.StringType),
+		ManagedStateProjection:      dataV1.ManagedStateProjection,
+		ObjectRef:                   dataV1.ObjectRef,
+		ManagedFields:               types.MapNull(types.StringType), // Add managed_fields as null
+		AppliedYAML:                 types.StringNull(),
+		Generation:                  types.Int64Null(),
+		PodTemplateHash:             types.StringNull(),
+		CurrentReplicas:             types.Int64Null(),
+		Status:                      types.MapNull(types.StringType),
+		ResourceVersion:             types.StringNull(),
+	}
 }
@@ -29,10 +29,16 @@ func TestUpgradeObjectStateV0toV2(t *testing.T) {
 						"cluster_ca_certificate": tftypes.String,
 						"token":                  tftypes.String,
 
-						"insecure":   tftypes.Bool,
-						"kubeconfig": tftypes.String,
-						"context":    tftypes.String,
-						"proxy_url":  tftypes.String,
+						"insecure":        tftypes.Bool,
+						"kubeconfig":      tftypes.String,
+						"context":         tftypes.String,
+						"proxy_url":       tftypes.String,
+						"use_env":         tftypes.Bool,
+						"request_timeout": tftypes.String,
+						"namespace":       tftypes.String,
+						"eks":             tftypes.Object{AttributeTypes: map[string]tftypes.Type{"cluster_name": tftypes.String, "region": tftypes.String, "profile": tftypes.String, "role_arn": tftypes.String}},
+						"gke":             tftypes.Object{AttributeTypes: map[string]tftypes.Type{"use_application_default_credentials": tftypes.Bool}},
+						"aks":             tftypes.Object{AttributeTypes: map[string]tftypes.Type{"login": tftypes.String, "server_id": tftypes.String, "tenant_id": tftypes.String, "client_id": tftypes.String}},
 						"exec": tftypes.Object{
 							AttributeTypes: map[string]tftypes.Type{
 								"api_version": tftypes.String,
@@ -49,11 +55,17 @@ func TestUpgradeObjectStateV0toV2(t *testing.T) {
 					"cluster_ca_certificate": tftypes.NewValue(tftypes.String, nil),
 					"token":                  tftypes.NewValue(tftypes.String, "test-token"),
 
-					"insecure":   tftypes.NewValue(tftypes.Bool, nil),
-					"kubeconfig": tftypes.NewValue(tftypes.String, nil),
-					"context":    tftypes.NewValue(tftypes.String, nil),
-					"proxy_url":  tftypes.NewValue(tftypes.String, nil),
-					"exec":       tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{"api_version": tftypes.String, "command": tftypes.String, "args": tftypes.List{ElementType: tftypes.String}, "env": tftypes.Map{ElementType: tftypes.String}}}, nil),
+					"insecure":        tftypes.NewValue(tftypes.Bool, nil),
+					"kubeconfig":      tftypes.NewValue(tftypes.String, nil),
+					"context":         tftypes.NewValue(tftypes.String, nil),
+					"proxy_url":       tftypes.NewValue(tftypes.String, nil),
+					"use_env":         tftypes.NewValue(tftypes.Bool, nil),
+					"request_timeout": tftypes.NewValue(tftypes.String, nil),
+					"namespace":       tftypes.NewValue(tftypes.String, nil),
+					"eks":             tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{"cluster_name": tftypes.String, "region": tftypes.String, "profile": tftypes.String, "role_arn": tftypes.String}}, nil),
+					"gke":             tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{"use_application_default_credentials": tftypes.Bool}}, nil),
+					"aks":             tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{"login": tftypes.String, "server_id": tftypes.String, "tenant_id": tftypes.String, "client_id": tftypes.String}}, nil),
+					"exec":            tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{"api_version": tftypes.String, "command": tftypes.String, "args": tftypes.List{ElementType: tftypes.String}, "env": tftypes.Map{ElementType: tftypes.String}}}, nil),
 				}),
 				"delete_protection": tftypes.NewValue(tftypes.Bool, nil),
 				"delete_timeout":    tftypes.NewValue(tftypes.String, nil),
@@ -102,10 +114,16 @@ func TestUpgradeObjectStateV0toV2(t *testing.T) {
 						"cluster_ca_certificate": tftypes.String,
 						"token":                  tftypes.String,
 
-						"insecure":   tftypes.Bool,
-						"kubeconfig": tftypes.String,
-						"context":    tftypes.String,
-						"proxy_url":  tftypes.String,
+						"insecure":        tftypes.Bool,
+						"kubeconfig":      tftypes.String,
+						"context":         tftypes.String,
+						"proxy_url":       tftypes.String,
+						"use_env":         tftypes.Bool,
+						"request_timeout": tftypes.String,
+						"namespace":       tftypes.String,
+						"eks":             tftypes.Object{AttributeTypes: map[string]tftypes.Type{"cluster_name": tftypes.String, "region": tftypes.String, "profile": tftypes.String, "role_arn": tftypes.String}},
+						"gke":             tftypes.Object{AttributeTypes: map[string]tftypes.Type{"use_application_default_credentials": tftypes.Bool}},
+						"aks":             tftypes.Object{AttributeTypes: map[string]tftypes.Type{"login": tftypes.String, "server_id": tftypes.String, "tenant_id": tftypes.String, "client_id": tftypes.String}},
 						"exec": tftypes.Object{
 							AttributeTypes: map[string]tftypes.Type{
 								"api_version": tftypes.String,
@@ -122,11 +140,17 @@ func TestUpgradeObjectStateV0toV2(t *testing.T) {
 					"cluster_ca_certificate": tftypes.NewValue(tftypes.String, nil),
 					"token":                  tftypes.NewValue(tftypes.String, nil),
 
-					"insecure":   tftypes.NewValue(tftypes.Bool, nil),
-					"kubeconfig": tftypes.NewValue(tftypes.String, nil),
-					"context":    tftypes.NewValue(tftypes.String, nil),
-					"proxy_url":  tftypes.NewValue(tftypes.String, nil),
-					"exec":       tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{"api_version": tftypes.String, "command": tftypes.String, "args": tftypes.List{ElementType: tftypes.String}, "env": tftypes.Map{ElementType: tftypes.String}}}, nil),
+					"insecure":        tftypes.NewValue(tftypes.Bool, nil),
+					"kubeconfig":      tftypes.NewValue(tftypes.String, nil),
+					"context":         tftypes.NewValue(tftypes.String, nil),
+					"proxy_url":       tftypes.NewValue(tftypes.String, nil),
+					"use_env":         tftypes.NewValue(tftypes.Bool, nil),
+					"request_timeout": tftypes.NewValue(tftypes.String, nil),
+					"namespace":       tftypes.NewValue(tftypes.String, nil),
+					"eks":             tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{"cluster_name": tftypes.String, "region": tftypes.String, "profile": tftypes.String, "role_arn": tftypes.String}}, nil),
+					"gke":             tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{"use_application_default_credentials": tftypes.Bool}}, nil),
+					"aks":             tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{"login": tftypes.String, "server_id": tftypes.String, "tenant_id": tftypes.String, "client_id": tftypes.String}}, nil),
+					"exec":            tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{"api_version": tftypes.String, "command": tftypes.String, "args": tftypes.List{ElementType: tftypes.String}, "env": tftypes.Map{ElementType: tftypes.String}}}, nil),
 				}),
 				"delete_protection":        tftypes.NewValue(tftypes.Bool, nil),
 				"delete_timeout":           tftypes.NewValue(tftypes.String, nil),
@@ -243,6 +267,12 @@ func TestUpgradeObjectStateV1toV2(t *testing.T) {
 					"kubeconfig":             tftypes.String,
 					"context":                tftypes.String,
 					"proxy_url":              tftypes.String,
+					"use_env":                tftypes.Bool,
+					"request_timeout":        tftypes.String,
+					"namespace":              tftypes.String,
+					"eks":                    tftypes.Object{AttributeTypes: map[string]tftypes.Type{"cluster_name": tftypes.String, "region": tftypes.String, "profile": tftypes.String, "role_arn": tftypes.String}},
+					"gke":                    tftypes.Object{AttributeTypes: map[string]tftypes.Type{"use_application_default_credentials": tftypes.Bool}},
+					"aks":                    tftypes.Object{AttributeTypes: map[string]tftypes.Type{"login": tftypes.String, "server_id": tftypes.String, "tenant_id": tftypes.String, "client_id": tftypes.String}},
 					"exec": tftypes.Object{
 						AttributeTypes: map[string]tftypes.Type{
 							"api_version": tftypes.String,
@@ -262,6 +292,12 @@ func TestUpgradeObjectStateV1toV2(t *testing.T) {
 				"kubeconfig":             tftypes.NewValue(tftypes.String, nil),
 				"context":                tftypes.NewValue(tftypes.String, nil),
 				"proxy_url":              tftypes.NewValue(tftypes.String, nil),
+				"use_env":                tftypes.NewValue(tftypes.Bool, nil),
+				"request_timeout":        tftypes.NewValue(tftypes.String, nil),
+				"namespace":              tftypes.NewValue(tftypes.String, nil),
+				"eks":                    tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{"cluster_name": tftypes.String, "region": tftypes.String, "profile": tftypes.String, "role_arn": tftypes.String}}, nil),
+				"gke":                    tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{"use_application_default_credentials": tftypes.Bool}}, nil),
+				"aks":                    tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{"login": tftypes.String, "server_id": tftypes.String, "tenant_id": tftypes.String, "client_id": tftypes.String}}, nil),
 				"exec":                   tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{"api_version": tftypes.String, "command": tftypes.String, "args": tftypes.List{ElementType: tftypes.String}, "env": tftypes.Map{ElementType: tftypes.String}}}, nil),
 			}),
 			"delete_protection":        tftypes.NewValue(tftypes.Bool, nil),
@@ -333,3 +369,33 @@ func TestUpgradeObjectStateV1toV2(t *testing.T) {
 		}
 	})
 }
+
+func TestUpgradeLegacyObjectStateRenamesClusterConnection(t *testing.T) {
+	ctx := context.Background()
+	req := resource.UpgradeStateRequest{State: legacyState(t, legacyManifestStateJSON)}
+	resp := &resource.UpgradeStateResponse{State: currentObjectState(t)}
+
+	upgradeLegacyObjectState(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	var upgraded objectResourceModel
+	if diags := resp.State.Get(ctx, &upgraded); diags.HasError() {
+		t.Fatalf("failed to read upgraded state: %v", diags)
+	}
+	if upgraded.Cluster.IsNull() {
+		t.Fatal("expected cluster from cluster_connection")
+	}
+	if token := upgraded.Cluster.Attributes()["token"]; token.String() != `"secret-token"` {
+		t.Errorf("unexpected cluster: %v", upgraded.Cluster)
+	}
+
+	// Neither connection attribute set: the upgrade fails instead of writing a state without cluster
+	empty := legacyState(t, `{"id": "abc", "yaml_body": "apiVersion: v1\nkind: Namespace\nmetadata:\n  name: x\n"}`)
+	resp = &resource.UpgradeStateResponse{State: currentObjectState(t)}
+	upgradeLegacyObjectState(ctx, resource.UpgradeStateRequest{State: empty}, resp)
+	if !resp.Diagnostics.HasError() {
+		t.Error("expected an error for state without a cluster connection")
+	}
+}
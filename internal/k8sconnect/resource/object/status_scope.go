@@ -0,0 +1,139 @@
+package object
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	k8sschema "k8s.io/apimachinery/pkg/runtime/schema"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+
+	"github.com/jmorris0x0/terraform-provider-k8sconnect/internal/k8sconnect/common/k8sclient"
+)
+
+// statusSubresource is the subresource the API server accepts status writes on for kinds
+// that have one, and the manage_scope entry that opts into managing status
+const statusSubresource = "status"
+
+// managesStatus reports whether manage_scope includes status
+func managesStatus(ctx context.Context, data *objectResourceModel) bool {
+	for _, field := range getManageScope(ctx, data) {
+		if field == statusSubresource {
+			return true
+		}
+	}
+	return false
+}
+
+// isStatusPath reports whether a field path lies under the top-level status
+func isStatusPath(path string) bool {
+	return inManageScope(path, []string{statusSubresource})
+}
+
+// statusPatchObject returns the status subresource patch for obj: its identity and its
+// status, or nil when obj has no status
+func statusPatchObject(obj *unstructured.Unstructured) *unstructured.Unstructured {
+	status, found, _ := unstructured.NestedMap(obj.Object, statusSubresource)
+	if !found || len(status) == 0 {
+		return nil
+	}
+	patch := &unstructured.Unstructured{Object: map[string]interface{}{statusSubresource: status}}
+	patch.SetAPIVersion(obj.GetAPIVersion())
+	patch.SetKind(obj.GetKind())
+	patch.SetName(obj.GetName())
+	patch.SetNamespace(obj.GetNamespace())
+	return patch
+}
+
+// applyStatus writes obj's status through the status subresource, since the API server
+// drops status sent to the main resource of kinds that have one. It is a server-side
+// apply as fieldManager, or a merge patch for field_manager_operation = "update". Returns
+// nil without error when obj has no status, or when the server answers NotFound: the
+// object doesn't exist yet (plan of a create), or the kind has no status subresource and
+// status is a regular field the main apply already wrote.
+func applyStatus(ctx context.Context, client k8sclient.K8sClient, gvr k8sschema.GroupVersionResource, obj *unstructured.Unstructured, fieldManager string, updateOperation, dryRun bool) (*unstructured.Unstructured, error) {
+	patch := statusPatchObject(obj)
+	if patch == nil {
+		return nil, nil
+	}
+	data, err := json.Marshal(patch.Object)
+	if err != nil {
+		return nil, err
+	}
+
+	patchType := k8stypes.ApplyPatchType
+	opts := metav1.PatchOptions{FieldManager: fieldManager, FieldValidation: "Strict"}
+	if updateOperation {
+		patchType = k8stypes.MergePatchType
+	} else {
+		force := true
+		opts.Force = &force
+	}
+	if dryRun {
+		opts.DryRun = []string{metav1.DryRunAll}
+	}
+
+	result, err := client.Patch(ctx, gvr, obj.GetNamespace(), obj.GetName(), patchType, data, opts, statusSubresource)
+	if errors.IsNotFound(err) {
+		tflog.Debug(ctx, "No status subresource to write, status goes with the object", map[string]interface{}{
+			"resource": formatResource(obj),
+			"dry_run":  dryRun,
+		})
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// overlayStatus combines the dry-run of the main apply with the status write it is
+// followed by. statusResult, dry-run against the live object, contributes status and the
+// status subresource's managedFields entries. Without it the object is being created, or
+// status is a regular field; desired's status is what the write leaves unless the main
+// dry-run already carries one.
+func overlayStatus(dryRunResult, statusResult, desired *unstructured.Unstructured) *unstructured.Unstructured {
+	combined := dryRunResult.DeepCopy()
+	if statusResult == nil {
+		if _, found := combined.Object[statusSubresource]; !found {
+			if status, ok := desired.Object[statusSubresource]; ok {
+				combined.Object[statusSubresource] = status
+			}
+		}
+		return combined
+	}
+
+	if status, ok := statusResult.Object[statusSubresource]; ok {
+		combined.Object[statusSubresource] = status
+	}
+	var entries []metav1.ManagedFieldsEntry
+	for _, entry := range dryRunResult.GetManagedFields() {
+		if entry.Subresource != statusSubresource {
+			entries = append(entries, entry)
+		}
+	}
+	for _, entry := range statusResult.GetManagedFields() {
+		if entry.Subresource == statusSubresource {
+			entries = append(entries, entry)
+		}
+	}
+	combined.SetManagedFields(entries)
+	return combined
+}
+
+// dryRunStatus dry-runs the status write apply makes when manage_scope includes status,
+// and returns the main dry-run result with its outcome overlaid
+func dryRunStatus(ctx context.Context, client k8sclient.K8sClient, dryRunResult, obj *unstructured.Unstructured, data *objectResourceModel) (*unstructured.Unstructured, error) {
+	gvr, err := client.GetGVR(ctx, obj)
+	if err != nil {
+		return nil, err
+	}
+	statusResult, err := applyStatus(ctx, client, gvr, obj, fieldManagerFor(data), recordsUpdateOperation(data), true)
+	if err != nil {
+		return nil, err
+	}
+	return overlayStatus(dryRunResult, statusResult, obj), nil
+}
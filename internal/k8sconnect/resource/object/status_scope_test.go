@@ -0,0 +1,174 @@
+package object
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	k8sschema "k8s.io/apimachinery/pkg/runtime/schema"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+
+	"github.com/jmorris0x0/terraform-provider-k8sconnect/internal/k8sconnect/common/k8sclient"
+)
+
+// statusPatchClient records patches and answers them with result, or with NotFound when
+// result is nil
+type statusPatchClient struct {
+	k8sclient.K8sClient
+	result       *unstructured.Unstructured
+	patchTypes   []k8stypes.PatchType
+	options      []metav1.PatchOptions
+	subresources [][]string
+	bodies       []map[string]interface{}
+}
+
+func (c *statusPatchClient) Patch(ctx context.Context, gvr k8sschema.GroupVersionResource, namespace, name string, patchType k8stypes.PatchType, data []byte, options metav1.PatchOptions, subresources ...string) (*unstructured.Unstructured, error) {
+	var body map[string]interface{}
+	if err := json.Unmarshal(data, &body); err != nil {
+		return nil, err
+	}
+	c.patchTypes = append(c.patchTypes, patchType)
+	c.options = append(c.options, options)
+	c.subresources = append(c.subresources, subresources)
+	c.bodies = append(c.bodies, body)
+	if c.result == nil {
+		return nil, errors.NewNotFound(gvr.GroupResource(), name)
+	}
+	return c.result, nil
+}
+
+func sensorObject() *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "example.com/v1",
+		"kind":       "Sensor",
+		"metadata":   map[string]interface{}{"name": "probe", "namespace": "default", "labels": map[string]interface{}{"team": "edge"}},
+		"spec":       map[string]interface{}{"interval": "30s"},
+		"status":     map[string]interface{}{"phase": "Provisioning", "calibrated": false},
+	}}
+}
+
+func TestApplyStatus(t *testing.T) {
+	ctx := context.Background()
+	gvr := k8sschema.GroupVersionResource{Group: "example.com", Version: "v1", Resource: "sensors"}
+
+	t.Run("server-side apply of the status subresource", func(t *testing.T) {
+		client := &statusPatchClient{K8sClient: k8sclient.NewStubK8sClient(), result: sensorObject()}
+		if _, err := applyStatus(ctx, client, gvr, sensorObject(), "k8sconnect", false, true); err != nil {
+			t.Fatalf("applyStatus failed: %v", err)
+		}
+		if len(client.patchTypes) != 1 || client.patchTypes[0] != k8stypes.ApplyPatchType {
+			t.Fatalf("patch types = %v, want one apply patch", client.patchTypes)
+		}
+		if !reflect.DeepEqual(client.subresources[0], []string{"status"}) {
+			t.Errorf("subresources = %v, want [status]", client.subresources[0])
+		}
+		opts := client.options[0]
+		if opts.FieldManager != "k8sconnect" || opts.Force == nil || !*opts.Force || !reflect.DeepEqual(opts.DryRun, []string{metav1.DryRunAll}) {
+			t.Errorf("patch options = %+v, want a forced dry-run apply as k8sconnect", opts)
+		}
+		// Only the identity and status go to the subresource
+		if _, found := client.bodies[0]["spec"]; found {
+			t.Errorf("status patch carries spec: %v", client.bodies[0])
+		}
+		if labels, _, _ := unstructured.NestedMap(client.bodies[0], "metadata", "labels"); labels != nil {
+			t.Errorf("status patch carries labels: %v", client.bodies[0])
+		}
+		if phase, _, _ := unstructured.NestedString(client.bodies[0], "status", "phase"); phase != "Provisioning" {
+			t.Errorf("status patch = %v, want status.phase from yaml_body", client.bodies[0])
+		}
+	})
+
+	t.Run("update operation sends a merge patch", func(t *testing.T) {
+		client := &statusPatchClient{K8sClient: k8sclient.NewStubK8sClient(), result: sensorObject()}
+		if _, err := applyStatus(ctx, client, gvr, sensorObject(), "k8sconnect", true, false); err != nil {
+			t.Fatalf("applyStatus failed: %v", err)
+		}
+		if client.patchTypes[0] != k8stypes.MergePatchType || client.options[0].Force != nil || client.options[0].DryRun != nil {
+			t.Errorf("got %s with %+v, want a merge patch without force or dry-run", client.patchTypes[0], client.options[0])
+		}
+	})
+
+	t.Run("no status subresource", func(t *testing.T) {
+		client := &statusPatchClient{K8sClient: k8sclient.NewStubK8sClient()}
+		result, err := applyStatus(ctx, client, gvr, sensorObject(), "k8sconnect", false, false)
+		if err != nil || result != nil {
+			t.Errorf("NotFound should leave status to the main apply, got %v, %v", result, err)
+		}
+	})
+
+	t.Run("yaml_body without status", func(t *testing.T) {
+		client := &statusPatchClient{K8sClient: k8sclient.NewStubK8sClient(), result: sensorObject()}
+		obj := sensorObject()
+		delete(obj.Object, "status")
+		if result, err := applyStatus(ctx, client, gvr, obj, "k8sconnect", false, false); err != nil || result != nil || len(client.patchTypes) != 0 {
+			t.Errorf("expected no status write, got %d patches", len(client.patchTypes))
+		}
+	})
+}
+
+func TestOverlayStatus(t *testing.T) {
+	desired := sensorObject()
+	dryRun := sensorObject()
+	dryRun.Object["status"] = map[string]interface{}{"phase": "Pending"}
+	dryRun.SetManagedFields([]metav1.ManagedFieldsEntry{
+		{Manager: "k8sconnect", Operation: metav1.ManagedFieldsOperationApply, FieldsV1: &metav1.FieldsV1{Raw: []byte(`{"f:spec":{"f:interval":{}}}`)}},
+		{Manager: "k8sconnect", Operation: metav1.ManagedFieldsOperationApply, Subresource: "status", FieldsV1: &metav1.FieldsV1{Raw: []byte(`{"f:status":{"f:phase":{}}}`)}},
+	})
+	statusResult := sensorObject()
+	statusResult.SetManagedFields([]metav1.ManagedFieldsEntry{
+		{Manager: "k8sconnect", Operation: metav1.ManagedFieldsOperationApply, FieldsV1: &metav1.FieldsV1{Raw: []byte(`{"f:spec":{}}`)}},
+		{Manager: "k8sconnect", Operation: metav1.ManagedFieldsOperationApply, Subresource: "status", FieldsV1: &metav1.FieldsV1{Raw: []byte(`{"f:status":{"f:calibrated":{},"f:phase":{}}}`)}},
+	})
+
+	combined := overlayStatus(dryRun, statusResult, desired)
+	if phase, _, _ := unstructured.NestedString(combined.Object, "status", "phase"); phase != "Provisioning" {
+		t.Errorf("status.phase = %q, want the status write's result", phase)
+	}
+	entries := combined.GetManagedFields()
+	if len(entries) != 2 || string(entries[0].FieldsV1.Raw) != `{"f:spec":{"f:interval":{}}}` ||
+		string(entries[1].FieldsV1.Raw) != `{"f:status":{"f:calibrated":{},"f:phase":{}}}` {
+		t.Errorf("managedFields = %+v, want the main entry from the dry-run and the status entry from the status write", entries)
+	}
+
+	// Plan of a create: status is dropped on create and the status write can only follow it
+	created := sensorObject()
+	delete(created.Object, "status")
+	combined = overlayStatus(created, nil, desired)
+	if calibrated, found, _ := unstructured.NestedBool(combined.Object, "status", "calibrated"); !found || calibrated {
+		t.Errorf("status = %v, want yaml_body's status", combined.Object["status"])
+	}
+}
+
+func TestUpdateManagedFieldsData_StatusInManageScope(t *testing.T) {
+	ctx := context.Background()
+	obj := sensorObject()
+	obj.SetManagedFields([]metav1.ManagedFieldsEntry{
+		{Manager: "k8sconnect", Operation: metav1.ManagedFieldsOperationApply, FieldsV1: &metav1.FieldsV1{Raw: []byte(`{"f:spec":{"f:interval":{}}}`)}},
+		{Manager: "k8sconnect", Operation: metav1.ManagedFieldsOperationApply, Subresource: "status", FieldsV1: &metav1.FieldsV1{Raw: []byte(`{"f:status":{"f:phase":{}}}`)}},
+	})
+
+	for _, tt := range []struct {
+		scope []string
+		want  map[string]string
+	}{
+		{nil, map[string]string{"spec.interval": "k8sconnect"}},
+		{[]string{"spec", "status"}, map[string]string{"spec.interval": "k8sconnect", "status.phase": "k8sconnect"}},
+	} {
+		data := &objectResourceModel{ManageScope: types.ListNull(types.StringType)}
+		if tt.scope != nil {
+			data.ManageScope, _ = types.ListValueFrom(ctx, types.StringType, tt.scope)
+		}
+		updateManagedFieldsData(ctx, data, obj)
+
+		var managedFields map[string]string
+		data.ManagedFields.ElementsAs(ctx, &managedFields, false)
+		if !reflect.DeepEqual(managedFields, tt.want) {
+			t.Errorf("manage_scope %v: managed_fields = %v, want %v", tt.scope, managedFields, tt.want)
+		}
+	}
+}
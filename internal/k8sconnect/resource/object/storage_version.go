@@ -0,0 +1,87 @@
+package object
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	k8sschema "k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/jmorris0x0/terraform-provider-k8sconnect/internal/k8sconnect/common/k8sclient"
+)
+
+var crdGVR = k8sschema.GroupVersionResource{Group: "apiextensions.k8s.io", Version: "v1", Resource: "customresourcedefinitions"}
+
+// warnOnAPIVersionConversion warns when yaml_body uses an apiVersion other than a CRD's
+// storage version or, for built-in resources, the group's preferred version. The API
+// server converts the object on every request, and fields that are dropped or defaulted
+// by the conversion show up as drift. The check is best-effort: lookup failures are only
+// logged.
+func warnOnAPIVersionConversion(ctx context.Context, client k8sclient.K8sClient, gvr k8sschema.GroupVersionResource, obj *unstructured.Unstructured, diagnostics *diag.Diagnostics) {
+	// The core group only has v1
+	if gvr.Group == "" {
+		return
+	}
+
+	version, stored, err := resourceTargetVersion(ctx, client, gvr)
+	if err != nil {
+		tflog.Debug(ctx, "Could not determine storage or preferred version", map[string]interface{}{
+			"resource": gvr.GroupResource().String(),
+			"error":    err.Error(),
+		})
+		return
+	}
+	if version == "" || version == gvr.Version {
+		return
+	}
+
+	targetAPIVersion := k8sschema.GroupVersion{Group: gvr.Group, Version: version}.String()
+	clusterVersion := fmt.Sprintf("the cluster stores %s objects as %s", obj.GetKind(), targetAPIVersion)
+	if !stored {
+		clusterVersion = fmt.Sprintf("the cluster's preferred version for %s is %s", obj.GetKind(), targetAPIVersion)
+	}
+	diagnostics.AddWarning(
+		fmt.Sprintf("API Version Converted (%s/%s)", obj.GetKind(), obj.GetName()),
+		fmt.Sprintf("yaml_body uses apiVersion %s, but %s. "+
+			"The API server converts the object between versions on every request, so fields "+
+			"that are renamed, dropped, or defaulted by the conversion can show up as drift.\n\n"+
+			"Update apiVersion in yaml_body to %s.",
+			obj.GetAPIVersion(), clusterVersion, targetAPIVersion),
+	)
+}
+
+// resourceTargetVersion returns the version yaml_body should use for gvr's resource: the
+// CRD's storage version for custom resources (stored is true), otherwise the group's
+// preferred version from discovery. Returns "" when it can't be told.
+func resourceTargetVersion(ctx context.Context, client k8sclient.K8sClient, gvr k8sschema.GroupVersionResource) (version string, stored bool, err error) {
+	crd, err := client.Get(ctx, crdGVR, "", gvr.Resource+"."+gvr.Group)
+	if err == nil {
+		return crdStorageVersion(crd), true, nil
+	}
+	if !errors.IsNotFound(err) {
+		return "", false, err
+	}
+
+	// Built-in resource: discovery doesn't expose the storage version, only the preferred one
+	version, err = client.ServerPreferredVersion(gvr.Group)
+	return version, false, err
+}
+
+// crdStorageVersion returns the version with storage: true in a CRD's spec.versions
+func crdStorageVersion(crd *unstructured.Unstructured) string {
+	versions, _, _ := unstructured.NestedSlice(crd.Object, "spec", "versions")
+	for _, v := range versions {
+		version, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if storage, _ := version["storage"].(bool); storage {
+			name, _ := version["name"].(string)
+			return name
+		}
+	}
+	return ""
+}
@@ -0,0 +1,95 @@
+package object
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	k8sschema "k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/jmorris0x0/terraform-provider-k8sconnect/internal/k8sconnect/common/k8sclient"
+)
+
+func TestWarnOnAPIVersionConversion(t *testing.T) {
+	ctx := context.Background()
+
+	// A CRD serving v1alpha1 and v1, stored as v1
+	client := k8sclient.NewStubK8sClient()
+	client.GetResponse = &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "apiextensions.k8s.io/v1",
+		"kind":       "CustomResourceDefinition",
+		"metadata":   map[string]interface{}{"name": "widgets.example.com"},
+		"spec": map[string]interface{}{
+			"group": "example.com",
+			"versions": []interface{}{
+				map[string]interface{}{"name": "v1alpha1", "served": true, "storage": false},
+				map[string]interface{}{"name": "v1", "served": true, "storage": true},
+			},
+		},
+	}}
+
+	widget := func(apiVersion string) *unstructured.Unstructured {
+		return &unstructured.Unstructured{Object: map[string]interface{}{
+			"apiVersion": apiVersion,
+			"kind":       "Widget",
+			"metadata":   map[string]interface{}{"name": "test"},
+		}}
+	}
+
+	var diags diag.Diagnostics
+	gvr := k8sschema.GroupVersionResource{Group: "example.com", Version: "v1alpha1", Resource: "widgets"}
+	warnOnAPIVersionConversion(ctx, client, gvr, widget("example.com/v1alpha1"), &diags)
+	if len(diags.Warnings()) != 1 {
+		t.Fatalf("expected a conversion warning for v1alpha1, got %v", diags)
+	}
+	if detail := diags.Warnings()[0].Detail(); !strings.Contains(detail, "stores Widget objects as example.com/v1") {
+		t.Errorf("expected the storage version in the warning, got %q", detail)
+	}
+	if lookup := client.GetCalls[0]; lookup.GVR.Resource != "customresourcedefinitions" || lookup.Name != "widgets.example.com" {
+		t.Errorf("expected the CRD to be looked up, got %+v", lookup)
+	}
+
+	// The storage version itself is not converted
+	diags = nil
+	gvr.Version = "v1"
+	warnOnAPIVersionConversion(ctx, client, gvr, widget("example.com/v1"), &diags)
+	if len(diags) != 0 {
+		t.Errorf("expected no warning for the storage version, got %v", diags)
+	}
+
+	// Core resources only have v1 and are never looked up
+	calls := len(client.GetCalls)
+	warnOnAPIVersionConversion(ctx, client, k8sschema.GroupVersionResource{Version: "v1", Resource: "configmaps"}, widget("v1"), &diags)
+	if len(client.GetCalls) != calls || len(diags) != 0 {
+		t.Errorf("expected core resources to be skipped")
+	}
+}
+
+func TestWarnOnAPIVersionConversionBuiltIn(t *testing.T) {
+	ctx := context.Background()
+
+	// No CRD: a built-in group whose preferred version is v2, behind the dry_run wrapper
+	stub := k8sclient.NewStubK8sClient()
+	stub.GetError = apierrors.NewNotFound(crdGVR.GroupResource(), "horizontalpodautoscalers.autoscaling")
+	stub.PreferredVersions = map[string]string{"autoscaling": "v2"}
+	client := k8sclient.NewDryRunClient(stub)
+
+	hpa := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "autoscaling/v1",
+		"kind":       "HorizontalPodAutoscaler",
+		"metadata":   map[string]interface{}{"name": "web"},
+	}}
+	gvr := k8sschema.GroupVersionResource{Group: "autoscaling", Version: "v1", Resource: "horizontalpodautoscalers"}
+
+	var diags diag.Diagnostics
+	warnOnAPIVersionConversion(ctx, client, gvr, hpa, &diags)
+	if len(diags.Warnings()) != 1 {
+		t.Fatalf("expected a conversion warning for autoscaling/v1, got %v", diags)
+	}
+	if detail := diags.Warnings()[0].Detail(); !strings.Contains(detail, "preferred version for HorizontalPodAutoscaler is autoscaling/v2") {
+		t.Errorf("expected the preferred version in the warning, got %q", detail)
+	}
+}
@@ -0,0 +1,86 @@
+package object
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/jmorris0x0/terraform-provider-k8sconnect/internal/k8sconnect/common/k8sclient"
+)
+
+// workloadSubresources reports which subresources current_replicas and status are read
+// from for obj. Only workload kinds are read, so other objects cost no extra requests.
+func workloadSubresources(obj *unstructured.Unstructured) (scale, status bool) {
+	if obj.GroupVersionKind().Group != "apps" {
+		return false, false
+	}
+	switch obj.GetKind() {
+	case "Deployment", "StatefulSet", "ReplicaSet":
+		return true, true
+	case "DaemonSet":
+		// DaemonSets run one pod per node and have no scale subresource
+		return false, true
+	default:
+		return false, false
+	}
+}
+
+// updateSubresourceData sets current_replicas from the scale subresource and status from
+// the status subresource, or null for kinds without them. The reads are best-effort: on
+// failure the previous value is kept (null if there is none) so an unreachable
+// subresource never fails an apply or refresh.
+func updateSubresourceData(ctx context.Context, data *objectResourceModel, client k8sclient.K8sClient, gvr schema.GroupVersionResource, obj *unstructured.Unstructured) {
+	readScale, readStatus := workloadSubresources(obj)
+
+	if !readScale {
+		data.CurrentReplicas = types.Int64Null()
+	} else if scale, err := client.GetSubresource(ctx, gvr, obj.GetNamespace(), obj.GetName(), "scale"); err != nil {
+		logSubresourceFailure(ctx, obj, "scale", err)
+		if data.CurrentReplicas.IsUnknown() {
+			data.CurrentReplicas = types.Int64Null()
+		}
+	} else {
+		replicas, _, _ := unstructured.NestedInt64(scale.Object, "status", "replicas")
+		data.CurrentReplicas = types.Int64Value(replicas)
+	}
+
+	if !readStatus {
+		data.Status = types.MapNull(types.StringType)
+	} else if statusObj, err := client.GetSubresource(ctx, gvr, obj.GetNamespace(), obj.GetName(), "status"); err != nil {
+		logSubresourceFailure(ctx, obj, "status", err)
+		if data.Status.IsUnknown() {
+			data.Status = types.MapNull(types.StringType)
+		}
+	} else {
+		data.Status = flattenStatus(ctx, statusObj)
+	}
+}
+
+// flattenStatus converts the status of obj to a flat map with dotted paths, formatted
+// like managed_state_projection
+func flattenStatus(ctx context.Context, obj *unstructured.Unstructured) types.Map {
+	status, found, err := unstructured.NestedMap(obj.Object, "status")
+	if err != nil || !found {
+		emptyMap, _ := types.MapValueFrom(ctx, types.StringType, map[string]string{})
+		return emptyMap
+	}
+	flat := flattenProjectionToMap(status, extractFieldPaths(status, ""))
+	mapValue, diags := types.MapValueFrom(ctx, types.StringType, flat)
+	if diags.HasError() {
+		return types.MapNull(types.StringType)
+	}
+	return mapValue
+}
+
+func logSubresourceFailure(ctx context.Context, obj *unstructured.Unstructured, subresource string, err error) {
+	tflog.Warn(ctx, "Failed to read subresource, keeping previous value", map[string]interface{}{
+		"kind":        obj.GetKind(),
+		"name":        obj.GetName(),
+		"namespace":   obj.GetNamespace(),
+		"subresource": subresource,
+		"error":       err.Error(),
+	})
+}
@@ -0,0 +1,92 @@
+package object
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/jmorris0x0/terraform-provider-k8sconnect/internal/k8sconnect/common/k8sclient"
+)
+
+func TestUpdateSubresourceData(t *testing.T) {
+	ctx := context.Background()
+	gvr := schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "deployments"}
+	deployment := workloadWithTemplate("Deployment", map[string]interface{}{})
+
+	client := k8sclient.NewStubK8sClient()
+	client.SubresourceResponses = map[string]*unstructured.Unstructured{
+		"scale": {Object: map[string]interface{}{
+			"apiVersion": "autoscaling/v1",
+			"kind":       "Scale",
+			"spec":       map[string]interface{}{"replicas": int64(5)},
+			"status":     map[string]interface{}{"replicas": int64(4), "selector": "app=web"},
+		}},
+		"status": {Object: map[string]interface{}{
+			"apiVersion": "apps/v1",
+			"kind":       "Deployment",
+			"status": map[string]interface{}{
+				"readyReplicas":      int64(3),
+				"observedGeneration": int64(2),
+			},
+		}},
+	}
+
+	data := objectResourceModel{CurrentReplicas: types.Int64Unknown(), Status: types.MapUnknown(types.StringType)}
+	updateSubresourceData(ctx, &data, client, gvr, deployment)
+	if data.CurrentReplicas.ValueInt64() != 4 {
+		t.Errorf("current_replicas = %v, want the scale status (4)", data.CurrentReplicas)
+	}
+	status := map[string]string{}
+	data.Status.ElementsAs(ctx, &status, false)
+	if status["readyReplicas"] != "3" || status["observedGeneration"] != "2" || len(status) != 2 {
+		t.Errorf("status = %v", status)
+	}
+
+	// A failed read keeps the previous value
+	client.SubresourceResponses = nil
+	updateSubresourceData(ctx, &data, client, gvr, deployment)
+	if data.CurrentReplicas.ValueInt64() != 4 || len(data.Status.Elements()) != 2 {
+		t.Errorf("expected previous values after a failed read, got %v, %v", data.CurrentReplicas, data.Status)
+	}
+
+	// ...or sets null when there is none, so an apply never leaves them unknown
+	data = objectResourceModel{CurrentReplicas: types.Int64Unknown(), Status: types.MapUnknown(types.StringType)}
+	updateSubresourceData(ctx, &data, client, gvr, deployment)
+	if !data.CurrentReplicas.IsNull() || !data.Status.IsNull() {
+		t.Errorf("expected null after a failed read without a previous value, got %v, %v", data.CurrentReplicas, data.Status)
+	}
+}
+
+func TestWorkloadSubresources(t *testing.T) {
+	tests := []struct {
+		apiVersion, kind      string
+		wantScale, wantStatus bool
+	}{
+		{"apps/v1", "Deployment", true, true},
+		{"apps/v1", "StatefulSet", true, true},
+		{"apps/v1", "ReplicaSet", true, true},
+		{"apps/v1", "DaemonSet", false, true},
+		{"batch/v1", "Job", false, false},
+		{"v1", "ConfigMap", false, false},
+	}
+
+	for _, tt := range tests {
+		obj := &unstructured.Unstructured{Object: map[string]interface{}{"apiVersion": tt.apiVersion, "kind": tt.kind}}
+		scale, status := workloadSubresources(obj)
+		if scale != tt.wantScale || status != tt.wantStatus {
+			t.Errorf("workloadSubresources(%s) = %v, %v, want %v, %v", tt.kind, scale, status, tt.wantScale, tt.wantStatus)
+		}
+	}
+
+	// Kinds without subresources never cost a request
+	client := k8sclient.NewStubK8sClient()
+	data := objectResourceModel{CurrentReplicas: types.Int64Unknown(), Status: types.MapUnknown(types.StringType)}
+	configMap := &unstructured.Unstructured{Object: map[string]interface{}{"apiVersion": "v1", "kind": "ConfigMap"}}
+	updateSubresourceData(context.Background(), &data, client, schema.GroupVersionResource{Version: "v1", Resource: "configmaps"}, configMap)
+	if !data.CurrentReplicas.IsNull() || !data.Status.IsNull() {
+		t.Errorf("expected null for a ConfigMap, got %v, %v", data.CurrentReplicas, data.Status)
+	}
+}
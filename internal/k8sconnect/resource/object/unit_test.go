@@ -189,6 +189,9 @@ func TestConvertObjectToConnectionModel(t *testing.T) {
 		"client_key":         types.StringType,
 		"insecure":           types.BoolType,
 		"proxy_url":          types.StringType,
+		"use_env":            types.BoolType,
+		"request_timeout":    types.StringType,
+		"namespace":          types.StringType,
 		"exec":               execType,
 	}
 
@@ -203,6 +206,9 @@ func TestConvertObjectToConnectionModel(t *testing.T) {
 		"client_key":         types.StringNull(),
 		"insecure":           types.BoolValue(false),
 		"proxy_url":          types.StringNull(),
+		"use_env":            types.BoolNull(),
+		"request_timeout":    types.StringNull(),
+		"namespace":          types.StringNull(),
 		"exec":               types.ObjectNull(execType.AttrTypes),
 	}
 
@@ -24,6 +24,7 @@ func (r *objectResource) ConfigValidators(ctx context.Context) []resource.Config
 		&validators.ExecAuth{},
 		&conflictingAttributesValidator{},
 		&requiredFieldsValidator{},
+		&statusFieldValidator{},
 	}
 }
 
@@ -172,6 +173,7 @@ func isClusterEmpty(conn types.Object) bool {
 		connModel.ClientCertificate.IsNull() &&
 		connModel.ClientKey.IsNull() &&
 		connModel.ProxyURL.IsNull() &&
+		connModel.UseEnv.IsNull() &&
 		connModel.Exec == nil
 }
 
@@ -227,6 +229,36 @@ func (v ignoreFieldsValidator) ValidateString(ctx context.Context, req validator
 	}
 }
 
+// =============================================================================
+// commonAnnotationKeyValidator blocks provider internal annotations in annotations
+// =============================================================================
+
+type commonAnnotationKeyValidator struct{}
+
+func (v commonAnnotationKeyValidator) Description(ctx context.Context) string {
+	return "validates that annotations does not include provider internal annotations"
+}
+
+func (v commonAnnotationKeyValidator) MarkdownDescription(ctx context.Context) string {
+	return "validates that `annotations` does not include provider internal annotations"
+}
+
+func (v commonAnnotationKeyValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	key := req.ConfigValue.ValueString()
+	if strings.HasPrefix(key, validation.ProviderAnnotationPrefix) {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Cannot set provider internal annotations",
+			fmt.Sprintf("Annotation '%s' is used internally for resource tracking and is set automatically.\n\n"+
+				"Remove it from annotations to proceed.", key),
+		)
+	}
+}
+
 // =============================================================================
 // serverManagedFieldsValidator blocks server-managed fields and provider internal annotations
 // =============================================================================
@@ -291,18 +323,68 @@ func (v serverManagedFieldsValidator) ValidateString(ctx context.Context, req va
 				validation.CopyPasteHintYAML+
 				"Please remove server-managed fields from your YAML.", field),
 		)
+	}
+
+	// status is checked by statusFieldValidator, which can honor allow_status
+}
+
+// =============================================================================
+// statusFieldValidator rejects a top-level status in yaml_body unless allow_status is set
+// or manage_scope includes status
+// =============================================================================
+
+type statusFieldValidator struct{}
+
+func (v *statusFieldValidator) Description(ctx context.Context) string {
+	return "Ensures yaml_body has no top-level status unless allow_status is set"
+}
+
+func (v *statusFieldValidator) MarkdownDescription(ctx context.Context) string {
+	return "Ensures `yaml_body` has no top-level `status` unless `allow_status` is set"
+}
+
+func (v *statusFieldValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data objectResourceModel
+
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.YAMLBody.IsNull() || data.YAMLBody.IsUnknown() || data.AllowStatus.IsUnknown() || data.AllowStatus.ValueBool() {
+		return
+	}
+	if data.ManageScope.IsUnknown() || managesStatus(ctx, &data) {
+		return
+	}
+
+	yamlStr := data.YAMLBody.ValueString()
+
+	// Skip validation if YAML contains interpolations (will be resolved during apply)
+	if validation.ContainsInterpolation(yamlStr) {
+		return
+	}
+
+	obj := &unstructured.Unstructured{}
+	if err := sigsyaml.Unmarshal([]byte(yamlStr), obj); err != nil {
+		// Don't validate if we can't parse - that's handled by other validators
 		return
 	}
 
-	// Check for status field
 	if validation.HasStatusField(obj) {
 		resp.Diagnostics.AddAttributeError(
-			req.Path,
+			path.Root("yaml_body"),
 			"Server-managed fields not allowed in yaml_body",
-			"The 'status' field is a read-only subresource managed by the Kubernetes API server and should not be included in yaml_body.\n\n"+
-				"The status field is automatically populated by controllers and should only be read via the 'status' computed attribute.\n\n"+
-				validation.CopyPasteHintYAML+
-				"Please remove the status field from your YAML.",
+			"The 'status' field is owned by the Kubernetes API server and controllers. For kinds with a status subresource "+
+				"(most built-in kinds and many CRDs), applying it through yaml_body has no effect, and the mismatch "+
+				"between yaml_body and the live object makes drift harder to read.\n\n"+
+				strings.TrimSpace(validation.CopyPasteHintYAML)+"\n\n"+
+				"Solutions:\n"+
+				"• Remove the status field from yaml_body; read live status from applied_yaml or wait on it with k8sconnect_wait\n"+
+				"• If this kind has no status subresource and status is a regular field, set allow_status = true\n"+
+				"• If this custom resource's status is user-writable, add \"status\" to manage_scope to write it "+
+				"through the status subresource and track it for drift",
 		)
 	}
 }
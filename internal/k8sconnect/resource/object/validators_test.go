@@ -3,9 +3,13 @@ package object
 import (
 	"context"
 	"reflect"
+	"strings"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 
 	"github.com/jmorris0x0/terraform-provider-k8sconnect/internal/k8sconnect/common/auth"
 )
@@ -96,8 +100,8 @@ func TestDeleteProtectionConflict(t *testing.T) {
 func TestConfigValidatorsSlice(t *testing.T) {
 	r := &objectResource{}
 	validatorList := r.ConfigValidators(nil)
-	if len(validatorList) != 4 {
-		t.Fatalf("expected 4 validators, got %d", len(validatorList))
+	if len(validatorList) != 5 {
+		t.Fatalf("expected 5 validators, got %d", len(validatorList))
 	}
 
 	typeNames := map[string]bool{}
@@ -112,9 +116,11 @@ func TestConfigValidatorsSlice(t *testing.T) {
 			typeNames["conflict"] = true
 		case typeName == "*object.requiredFieldsValidator":
 			typeNames["required"] = true
+		case typeName == "*object.statusFieldValidator":
+			typeNames["status"] = true
 		}
 	}
-	for _, k := range []string{"cluster", "exec", "conflict", "required"} {
+	for _, k := range []string{"cluster", "exec", "conflict", "required", "status"} {
 		if !typeNames[k] {
 			t.Errorf("validator %q missing", k)
 		}
@@ -150,3 +156,70 @@ func execMissingFields(e *auth.ExecAuthModel) []string {
 	// Remove the entire check for Args
 	return m
 }
+
+// objectConfig builds a resource config with yaml_body, allow_status and manage_scope set
+// and every other attribute null
+func objectConfig(t *testing.T, yamlBody string, allowStatus *bool, manageScope []string) tfsdk.Config {
+	t.Helper()
+	ctx := context.Background()
+
+	r := &objectResource{}
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	objType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	values := make(map[string]tftypes.Value, len(objType.AttributeTypes))
+	for name, attrType := range objType.AttributeTypes {
+		values[name] = tftypes.NewValue(attrType, nil)
+	}
+	values["yaml_body"] = tftypes.NewValue(tftypes.String, yamlBody)
+	if allowStatus != nil {
+		values["allow_status"] = tftypes.NewValue(tftypes.Bool, *allowStatus)
+	}
+	if manageScope != nil {
+		fields := make([]tftypes.Value, 0, len(manageScope))
+		for _, field := range manageScope {
+			fields = append(fields, tftypes.NewValue(tftypes.String, field))
+		}
+		values["manage_scope"] = tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, fields)
+	}
+
+	return tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objType, values)}
+}
+
+func TestStatusFieldValidator(t *testing.T) {
+	withStatus := "apiVersion: example.com/v1\nkind: Widget\nmetadata:\n  name: w\nstatus:\n  phase: Ready\n"
+	withoutStatus := "apiVersion: example.com/v1\nkind: Widget\nmetadata:\n  name: w\nspec:\n  size: 1\n"
+	allow, deny := true, false
+
+	tests := []struct {
+		name        string
+		yamlBody    string
+		allowStatus *bool
+		manageScope []string
+		wantErr     bool
+	}{
+		{"status rejected by default", withStatus, nil, nil, true},
+		{"status rejected with allow_status = false", withStatus, &deny, nil, true},
+		{"status allowed with allow_status = true", withStatus, &allow, nil, false},
+		{"status rejected outside manage_scope", withStatus, nil, []string{"spec"}, true},
+		{"status allowed in manage_scope", withStatus, nil, []string{"spec", "status"}, false},
+		{"no status", withoutStatus, nil, nil, false},
+		{"interpolated yaml skipped", withStatus + "data: ${var.x}\n", nil, nil, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := resource.ValidateConfigRequest{Config: objectConfig(t, tt.yamlBody, tt.allowStatus, tt.manageScope)}
+			resp := &resource.ValidateConfigResponse{}
+			(&statusFieldValidator{}).ValidateResource(context.Background(), req, resp)
+
+			if got := resp.Diagnostics.HasError(); got != tt.wantErr {
+				t.Fatalf("HasError() = %v, want %v: %v", got, tt.wantErr, resp.Diagnostics)
+			}
+			if tt.wantErr && !strings.Contains(resp.Diagnostics.Errors()[0].Detail(), "allow_status = true") {
+				t.Errorf("error should mention the allow_status opt-out: %s", resp.Diagnostics.Errors()[0].Detail())
+			}
+		})
+	}
+}
@@ -1,10 +1,13 @@
 package object
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/util/yaml"
 	sigsyaml "sigs.k8s.io/yaml"
@@ -90,6 +93,12 @@ func (r *objectResource) parseYAML(yamlStr string) (*unstructured.Unstructured,
 		return nil, fmt.Errorf("failed to unmarshal YAML: %w", err)
 	}
 
+	// List wrappers (kind: List from kubectl get -o yaml) are not objects the API server can apply
+	if strings.HasSuffix(obj.GetKind(), "List") && obj.IsList() {
+		return nil, fmt.Errorf("kind %s wraps multiple objects in 'items', but k8sconnect_object manages a single object. "+
+			"Use the k8sconnect_yaml_split or k8sconnect_yaml_scoped data source to expand the items into individual manifests", obj.GetKind())
+	}
+
 	// Validate required fields
 	if obj.GetAPIVersion() == "" {
 		return nil, fmt.Errorf("apiVersion is required")
@@ -98,8 +107,8 @@ func (r *objectResource) parseYAML(yamlStr string) (*unstructured.Unstructured,
 	if obj.GetKind() == "" {
 		return nil, fmt.Errorf("kind is required")
 	}
-	if obj.GetName() == "" {
-		return nil, fmt.Errorf("metadata.name is required")
+	if obj.GetName() == "" && obj.GetGenerateName() == "" {
+		return nil, fmt.Errorf("metadata.name or metadata.generateName is required")
 	}
 
 	// Validate containers have names (critical for strategic merge)
@@ -159,31 +168,96 @@ func (r *objectResource) cleanObjectForImport(obj *unstructured.Unstructured) *u
 		}
 	}
 
-	// Clean nested pod template metadata (for Deployments, StatefulSets, DaemonSets, Jobs, CronJobs)
-	if spec, ok := cleaned.Object["spec"].(map[string]interface{}); ok {
-		r.cleanPodTemplateMetadata(spec)
-	}
+	// Nested templates carry creationTimestamp: null and status: {} placeholders
+	removeEmptyArtifacts(cleaned.Object)
 
 	return cleaned
 }
 
-// cleanPodTemplateMetadata removes server-added fields from pod template metadata
-func (r *objectResource) cleanPodTemplateMetadata(spec map[string]interface{}) {
-	// Handle Deployment, StatefulSet, DaemonSet, ReplicaSet
-	if template, ok := spec["template"].(map[string]interface{}); ok {
-		if templateMeta, ok := template["metadata"].(map[string]interface{}); ok {
-			delete(templateMeta, "creationTimestamp")
-		}
+// removeEmptyArtifacts strips the placeholders serialization leaves in an object - an
+// empty top-level status: {}, creationTimestamp: null in pod template metadata, and both
+// in volumeClaimTemplates - so YAML rendered from the cluster matches what users write.
+// Only those locations are cleaned: an empty map elsewhere can be meaningful, such as a
+// CRD's subresources.status: {}. Shared by import and applied_yaml; it modifies obj in place.
+func removeEmptyArtifacts(obj map[string]interface{}) {
+	removeEmptyStatus(obj)
+
+	spec, _ := obj["spec"].(map[string]interface{})
+	if spec == nil {
+		return
 	}
+	removeNullCreationTimestamp(spec, "template", "metadata")
+	removeNullCreationTimestamp(spec, "jobTemplate", "spec", "template", "metadata")
 
-	// Handle Job
-	if jobTemplate, ok := spec["jobTemplate"].(map[string]interface{}); ok {
-		if jobSpec, ok := jobTemplate["spec"].(map[string]interface{}); ok {
-			if template, ok := jobSpec["template"].(map[string]interface{}); ok {
-				if templateMeta, ok := template["metadata"].(map[string]interface{}); ok {
-					delete(templateMeta, "creationTimestamp")
-				}
-			}
+	claims, _ := spec["volumeClaimTemplates"].([]interface{})
+	for _, claim := range claims {
+		if claimMap, ok := claim.(map[string]interface{}); ok {
+			removeNullCreationTimestamp(claimMap, "metadata")
+			removeEmptyStatus(claimMap)
 		}
 	}
 }
+
+// removeNullCreationTimestamp deletes creationTimestamp: null from the metadata map at path
+func removeNullCreationTimestamp(obj map[string]interface{}, path ...string) {
+	metadata, found, err := unstructured.NestedMap(obj, path...)
+	if err != nil || !found {
+		return
+	}
+	if timestamp, ok := metadata["creationTimestamp"]; ok && timestamp == nil {
+		unstructured.RemoveNestedField(obj, append(path, "creationTimestamp")...)
+	}
+}
+
+// removeEmptyStatus deletes an empty status: {} from obj
+func removeEmptyStatus(obj map[string]interface{}) {
+	if isEmptyMap(obj["status"]) {
+		delete(obj, "status")
+	}
+}
+
+func isEmptyMap(value interface{}) bool {
+	m, ok := value.(map[string]interface{})
+	return ok && len(m) == 0
+}
+
+// appliedObjectToYAML renders the live object as returned by the API server
+// (post-defaulting, including server-populated fields) for the applied_yaml
+// attribute. Only metadata.managedFields - noisy, changes on every apply, and
+// already surfaced through managed_fields - and empty serialization artifacts are
+// stripped.
+func appliedObjectToYAML(obj *unstructured.Unstructured) (string, error) {
+	applied := obj.DeepCopy()
+	unstructured.RemoveNestedField(applied.Object, "metadata", "managedFields")
+	removeEmptyArtifacts(applied.Object)
+
+	yamlBytes, err := sigsyaml.Marshal(applied.Object)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal applied object to YAML: %w", err)
+	}
+
+	return string(yamlBytes), nil
+}
+
+// updateAppliedYAMLData sets applied_yaml from the live object. On marshal failure
+// the attribute is set to an empty string so state always receives a known value.
+func updateAppliedYAMLData(ctx context.Context, data *objectResourceModel, obj *unstructured.Unstructured) {
+	appliedYAML, err := appliedObjectToYAML(obj)
+	if err != nil {
+		tflog.Warn(ctx, "Failed to render applied_yaml", map[string]interface{}{
+			"error": err.Error(),
+		})
+	}
+	data.AppliedYAML = types.StringValue(appliedYAML)
+}
+
+// updateObjectVersionData sets generation and resource_version from the live object.
+// Generation is null for kinds that don't set it (the API server reports 0).
+func updateObjectVersionData(data *objectResourceModel, obj *unstructured.Unstructured) {
+	if generation := obj.GetGeneration(); generation > 0 {
+		data.Generation = types.Int64Value(generation)
+	} else {
+		data.Generation = types.Int64Null()
+	}
+	data.ResourceVersion = types.StringValue(obj.GetResourceVersion())
+}
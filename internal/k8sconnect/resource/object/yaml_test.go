@@ -108,3 +108,195 @@ metadata:
 		})
 	}
 }
+
+// TestParseYAML_ListKind verifies that List wrappers are rejected with guidance
+// to expand them with the yaml data sources, instead of failing later on a
+// nonexistent "lists" resource.
+func TestParseYAML_ListKind(t *testing.T) {
+	r := &objectResource{}
+
+	_, err := r.parseYAML(`apiVersion: v1
+kind: List
+items:
+- apiVersion: v1
+  kind: ConfigMap
+  metadata:
+    name: one
+`)
+	if err == nil {
+		t.Fatal("Expected error for kind: List")
+	}
+	for _, want := range []string{"kind List", "k8sconnect_yaml_split", "k8sconnect_yaml_scoped"} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("Error %q should contain %q", err.Error(), want)
+		}
+	}
+}
+
+// TestAppliedObjectToYAML verifies applied_yaml keeps server-populated fields
+// but drops metadata.managedFields (already surfaced via managed_fields).
+func TestAppliedObjectToYAML(t *testing.T) {
+	r := &objectResource{}
+	obj, err := r.parseYAML(`apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: test
+  namespace: default
+  resourceVersion: "12345"
+  uid: abc-123
+  managedFields:
+  - manager: k8sconnect
+    operation: Apply
+data:
+  key: value
+`)
+	if err != nil {
+		t.Fatalf("parseYAML failed: %v", err)
+	}
+
+	got, err := appliedObjectToYAML(obj)
+	if err != nil {
+		t.Fatalf("appliedObjectToYAML failed: %v", err)
+	}
+
+	for _, want := range []string{"resourceVersion: \"12345\"", "uid: abc-123", "key: value"} {
+		if !strings.Contains(got, want) {
+			t.Errorf("expected applied_yaml to contain %q, got:\n%s", want, got)
+		}
+	}
+	if strings.Contains(got, "managedFields") {
+		t.Errorf("expected managedFields to be stripped, got:\n%s", got)
+	}
+	if _, exists := obj.Object["metadata"].(map[string]interface{})["managedFields"]; !exists {
+		t.Error("appliedObjectToYAML must not mutate the input object")
+	}
+}
+
+// TestRemoveEmptyArtifacts verifies import and applied_yaml drop the null and empty
+// placeholders the API server serializes into nested templates.
+func TestRemoveEmptyArtifacts(t *testing.T) {
+	r := &objectResource{}
+	obj, err := r.parseYAML(`apiVersion: apps/v1
+kind: StatefulSet
+metadata:
+  name: db
+  namespace: default
+  creationTimestamp: "2024-01-01T00:00:00Z"
+  generation: 2
+  uid: abc-123
+spec:
+  template:
+    metadata:
+      creationTimestamp: null
+      labels:
+        app: db
+  volumeClaimTemplates:
+  - metadata:
+      name: data
+      creationTimestamp: null
+    spec:
+      accessModes: ["ReadWriteOnce"]
+    status: {}
+status:
+  replicas: 1
+`)
+	if err != nil {
+		t.Fatalf("parseYAML failed: %v", err)
+	}
+
+	imported, err := r.objectToYAML(obj)
+	if err != nil {
+		t.Fatalf("objectToYAML failed: %v", err)
+	}
+	for _, unwanted := range []string{"creationTimestamp", "status", "generation", "uid"} {
+		if strings.Contains(string(imported), unwanted) {
+			t.Errorf("expected imported yaml_body without %s, got:\n%s", unwanted, imported)
+		}
+	}
+
+	applied, err := appliedObjectToYAML(obj)
+	if err != nil {
+		t.Fatalf("appliedObjectToYAML failed: %v", err)
+	}
+	if strings.Count(applied, "creationTimestamp") != 1 || strings.Contains(applied, "status: {}") {
+		t.Errorf("expected applied_yaml to keep only the real creationTimestamp and status, got:\n%s", applied)
+	}
+	if !strings.Contains(applied, "replicas: 1") {
+		t.Errorf("expected applied_yaml to keep the live status, got:\n%s", applied)
+	}
+}
+
+func TestRemoveEmptyArtifactsKeepsMeaningfulEmptyStatus(t *testing.T) {
+	r := &objectResource{}
+	crd, err := r.parseYAML(`apiVersion: apiextensions.k8s.io/v1
+kind: CustomResourceDefinition
+metadata:
+  name: widgets.example.com
+spec:
+  group: example.com
+  names:
+    kind: Widget
+    plural: widgets
+  scope: Namespaced
+  versions:
+  - name: v1
+    served: true
+    storage: true
+    subresources:
+      status: {}
+`)
+	if err != nil {
+		t.Fatalf("parseYAML failed: %v", err)
+	}
+
+	// The status subresource is turned on by an empty map; dropping it would disable it
+	imported, err := r.objectToYAML(crd)
+	if err != nil {
+		t.Fatalf("objectToYAML failed: %v", err)
+	}
+	if !strings.Contains(string(imported), "status: {}") {
+		t.Errorf("expected the CRD's subresources.status to be kept, got:\n%s", imported)
+	}
+}
+
+func TestUpdateObjectVersionData(t *testing.T) {
+	r := &objectResource{}
+	deployment, err := r.parseYAML(`apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: web
+  namespace: default
+  generation: 3
+  resourceVersion: "12345"
+`)
+	if err != nil {
+		t.Fatalf("parseYAML failed: %v", err)
+	}
+
+	var data objectResourceModel
+	updateObjectVersionData(&data, deployment)
+	if data.Generation.ValueInt64() != 3 {
+		t.Errorf("generation = %v, want 3", data.Generation)
+	}
+	if data.ResourceVersion.ValueString() != "12345" {
+		t.Errorf("resource_version = %v, want \"12345\"", data.ResourceVersion)
+	}
+
+	// ConfigMaps don't track generation
+	configMap, err := r.parseYAML(`apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: test
+  resourceVersion: "7"
+`)
+	if err != nil {
+		t.Fatalf("parseYAML failed: %v", err)
+	}
+	updateObjectVersionData(&data, configMap)
+	if !data.Generation.IsNull() {
+		t.Errorf("expected null generation for ConfigMap, got %v", data.Generation)
+	}
+	if data.ResourceVersion.ValueString() != "7" {
+		t.Errorf("resource_version = %v, want \"7\"", data.ResourceVersion)
+	}
+}
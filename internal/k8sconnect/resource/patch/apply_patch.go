@@ -0,0 +1,160 @@
+package patch
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/yaml"
+
+	"github.com/jmorris0x0/terraform-provider-k8sconnect/internal/k8sconnect/common/k8sclient"
+	"github.com/jmorris0x0/terraform-provider-k8sconnect/internal/k8sconnect/common/k8serrors"
+)
+
+const applyPatchType = "application/apply-patch+yaml"
+
+// parseApplyPatch parses apply_patch content into a partial object
+func parseApplyPatch(patchContent string) (map[string]interface{}, error) {
+	var patchData map[string]interface{}
+	if err := yaml.Unmarshal([]byte(patchContent), &patchData); err != nil {
+		return nil, fmt.Errorf("failed to parse apply_patch: %w", err)
+	}
+	if patchData == nil {
+		return nil, fmt.Errorf("apply_patch must be an object")
+	}
+	return patchData, nil
+}
+
+// applyPatchFields returns the fields of an apply patch without the identity fields
+// (apiVersion, kind, metadata.name, metadata.namespace), which come from target and are
+// not owned by the patch. Used for field path extraction and drift detection.
+func applyPatchFields(patchContent string) (map[string]interface{}, error) {
+	patchData, err := parseApplyPatch(patchContent)
+	if err != nil {
+		return nil, err
+	}
+
+	delete(patchData, "apiVersion")
+	delete(patchData, "kind")
+	if metadata, ok := patchData["metadata"].(map[string]interface{}); ok {
+		delete(metadata, "name")
+		delete(metadata, "namespace")
+		if len(metadata) == 0 {
+			delete(patchData, "metadata")
+		}
+	}
+	return patchData, nil
+}
+
+// buildApplyPatchObject builds the object sent to Server-Side Apply. Identity fields may be
+// omitted from apply_patch; if present they must match the target so the patch cannot
+// silently apply to a different resource.
+func buildApplyPatchObject(targetObj *unstructured.Unstructured, patchContent string) (*unstructured.Unstructured, error) {
+	patchData, err := parseApplyPatch(patchContent)
+	if err != nil {
+		return nil, err
+	}
+	patchObj := &unstructured.Unstructured{Object: patchData}
+
+	mismatches := []struct {
+		field, got, want string
+	}{
+		{"apiVersion", patchObj.GetAPIVersion(), targetObj.GetAPIVersion()},
+		{"kind", patchObj.GetKind(), targetObj.GetKind()},
+		{"metadata.name", patchObj.GetName(), targetObj.GetName()},
+		{"metadata.namespace", patchObj.GetNamespace(), targetObj.GetNamespace()},
+	}
+	for _, m := range mismatches {
+		if m.got != "" && m.got != m.want {
+			return nil, fmt.Errorf("apply_patch sets %s to %q but the target has %q. "+
+				"Remove %s from apply_patch or change target to match", m.field, m.got, m.want, m.field)
+		}
+	}
+
+	patchObj.SetAPIVersion(targetObj.GetAPIVersion())
+	patchObj.SetKind(targetObj.GetKind())
+	patchObj.SetName(targetObj.GetName())
+	patchObj.SetNamespace(targetObj.GetNamespace())
+	return patchObj, nil
+}
+
+// applyApplyPatch applies an apply_patch using Server-Side Apply without Force, so
+// fields owned by another field manager surface as conflicts instead of being taken over
+func (r *patchResource) applyApplyPatch(ctx context.Context, client k8sclient.K8sClient, targetObj *unstructured.Unstructured, patchContent string, fieldManager string, gvr schema.GroupVersionResource) (*unstructured.Unstructured, error) {
+	patchObj, err := buildApplyPatchObject(targetObj, patchContent)
+	if err != nil {
+		return nil, err
+	}
+
+	err = client.Apply(ctx, patchObj, k8sclient.ApplyOptions{
+		FieldManager: fieldManager,
+		Force:        false,
+	})
+	if err != nil {
+		if errors.IsConflict(err) {
+			return nil, fmt.Errorf("%s", formatApplyPatchConflict(err, describeObject(targetObj)))
+		}
+		if k8serrors.IsImmutableFieldError(err) {
+			immutableFields := k8serrors.ExtractImmutableFields(err)
+			return nil, fmt.Errorf("cannot patch immutable field(s): %v on %s/%s in namespace %s\n\n"+
+				"The target resource has immutable fields that cannot be changed after creation.\n\n"+
+				"Options:\n"+
+				"1. Remove the immutable field from your patch\n"+
+				"2. If the field MUST change, recreate the target resource manually or use k8sconnect_object",
+				immutableFields, targetObj.GetKind(), targetObj.GetName(), targetObj.GetNamespace())
+		}
+		return nil, fmt.Errorf("failed to apply patch: %w", err)
+	}
+
+	result, err := client.Get(ctx, gvr, targetObj.GetNamespace(), targetObj.GetName())
+	if err != nil {
+		return nil, fmt.Errorf("failed to read patched resource: %w", err)
+	}
+
+	return result, nil
+}
+
+// dryRunApplyPatch predicts the result and field ownership of an apply_patch
+func (r *patchResource) dryRunApplyPatch(ctx context.Context, client k8sclient.K8sClient, currentObj *unstructured.Unstructured, patchContent string, fieldManager string) (*unstructured.Unstructured, error) {
+	patchObj, err := buildApplyPatchObject(currentObj, patchContent)
+	if err != nil {
+		return nil, err
+	}
+
+	return client.DryRunApply(ctx, patchObj, k8sclient.ApplyOptions{
+		FieldManager:    fieldManager,
+		Force:           false,
+		FieldValidation: "Strict", // ADR-017: Validate fields against OpenAPI schema during plan
+	})
+}
+
+// formatApplyPatchConflict explains an SSA conflict on an apply_patch. The generic conflict
+// diagnostic suggests ignore_fields, which k8sconnect_patch does not have.
+// The conflicts are also listed as JSON, so automation can decide whether to switch to patch.
+func formatApplyPatchConflict(err error, targetDesc string) string {
+	conflictDetails := k8serrors.ExtractConflictDetails(err)
+	conflictsJSON := ""
+	if line := k8serrors.FormatFieldConflictsJSON(k8serrors.ExtractFieldConflicts(err)); line != "" {
+		conflictsJSON = line + "\n\n"
+	}
+	return fmt.Sprintf("apply_patch sets fields owned by another field manager:\n%s\n\n"+
+		"apply_patch never forces ownership, so these fields were not changed.\n\n"+
+		"Options:\n"+
+		"1. Remove the conflicting fields from apply_patch\n"+
+		"2. Stop the other manager from setting these fields\n"+
+		"3. Use 'patch' instead, which forces ownership of every field it sets\n\n"+
+		"Target: %s\n\n"+
+		"%s"+
+		"Details: %v",
+		conflictDetails, targetDesc, conflictsJSON, err)
+}
+
+// describeObject returns a human-readable string for a live object, matching formatTarget
+func describeObject(obj *unstructured.Unstructured) string {
+	if obj.GetNamespace() == "" {
+		return fmt.Sprintf("%s %s/%s", obj.GetAPIVersion(), obj.GetKind(), obj.GetName())
+	}
+	return fmt.Sprintf("%s %s/%s (namespace: %s)", obj.GetAPIVersion(), obj.GetKind(), obj.GetName(), obj.GetNamespace())
+}
@@ -0,0 +1,138 @@
+package patch
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func applyPatchTarget() *unstructured.Unstructured {
+	return &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "apps/v1",
+			"kind":       "Deployment",
+			"metadata":   map[string]interface{}{"name": "web", "namespace": "default"},
+			"spec":       map[string]interface{}{"replicas": int64(3)},
+		},
+	}
+}
+
+func TestBuildApplyPatchObject(t *testing.T) {
+	tests := []struct {
+		name        string
+		content     string
+		wantErr     string
+		wantLabels  map[string]string
+		wantReplica int64
+	}{
+		{
+			name:        "partial object gets identity from target",
+			content:     `{"metadata":{"labels":{"team":"platform"}},"spec":{"replicas":5}}`,
+			wantLabels:  map[string]string{"team": "platform"},
+			wantReplica: 5,
+		},
+		{
+			name: "matching identity fields are accepted",
+			content: `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: web
+  namespace: default
+spec:
+  replicas: 2`,
+			wantReplica: 2,
+		},
+		{
+			name:    "kind mismatch",
+			content: `{"kind":"StatefulSet","spec":{"replicas":2}}`,
+			wantErr: `apply_patch sets kind to "StatefulSet"`,
+		},
+		{
+			name:    "name mismatch",
+			content: `{"metadata":{"name":"other"}}`,
+			wantErr: `apply_patch sets metadata.name to "other"`,
+		},
+		{
+			name:    "not an object",
+			content: `- a`,
+			wantErr: "failed to parse apply_patch",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			obj, err := buildApplyPatchObject(applyPatchTarget(), tt.content)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("expected error containing %q, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if obj.GetAPIVersion() != "apps/v1" || obj.GetKind() != "Deployment" ||
+				obj.GetName() != "web" || obj.GetNamespace() != "default" {
+				t.Errorf("identity not taken from target: %s %s %s/%s",
+					obj.GetAPIVersion(), obj.GetKind(), obj.GetNamespace(), obj.GetName())
+			}
+			if tt.wantLabels != nil && obj.GetLabels()["team"] != tt.wantLabels["team"] {
+				t.Errorf("labels = %v, want %v", obj.GetLabels(), tt.wantLabels)
+			}
+			replicas, _, _ := unstructured.NestedFieldNoCopy(obj.Object, "spec", "replicas")
+			if replicas != tt.wantReplica && replicas != float64(tt.wantReplica) {
+				t.Errorf("spec.replicas = %v, want %d", replicas, tt.wantReplica)
+			}
+		})
+	}
+}
+
+func TestApplyPatchFieldPathsExcludeIdentity(t *testing.T) {
+	r := &patchResource{}
+	content := `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: web
+  labels:
+    team: platform
+spec:
+  replicas: 2`
+
+	paths, err := r.extractPatchFieldPaths(context.Background(), content, applyPatchType)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	sort.Strings(paths)
+
+	want := []string{"metadata.labels.team", "spec.replicas"}
+	if strings.Join(paths, ",") != strings.Join(want, ",") {
+		t.Errorf("paths = %v, want %v", paths, want)
+	}
+}
+
+func TestDetectValueDrift_ApplyPatch(t *testing.T) {
+	r := &patchResource{}
+	data := patchResourceModel{
+		Patch:      types.StringNull(),
+		JSONPatch:  types.StringNull(),
+		MergePatch: types.StringNull(),
+		ApplyPatch: types.StringValue(`{"kind":"Deployment","metadata":{"name":"web"},"spec":{"replicas":5}}`),
+	}
+
+	if got := r.determinePatchType(data); got != applyPatchType {
+		t.Fatalf("determinePatchType() = %q, want %q", got, applyPatchType)
+	}
+
+	drift, fields, err := r.detectValueDrift(context.Background(), applyPatchTarget(), data)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !drift || len(fields) != 1 || fields[0] != "spec.replicas" {
+		t.Errorf("expected drift on spec.replicas only, got drift=%v fields=%v", drift, fields)
+	}
+}
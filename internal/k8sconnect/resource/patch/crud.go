@@ -88,6 +88,9 @@ func (r *patchResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
+	// 8. Record the pre-patch values so restore_on_destroy can be enabled at any time
+	r.saveOriginalValues(ctx, targetObj, data, fieldManager, nil, resp.Private, &resp.Diagnostics)
+
 	// Surface any API warnings from patch operation
 	k8sclient.SurfaceK8sWarnings(ctx, client, &resp.Diagnostics)
 
@@ -99,6 +102,9 @@ func (r *patchResource) Create(ctx context.Context, req resource.CreateRequest,
 	// 9. Update managed_fields attribute in state
 	updateManagedFieldsData(ctx, &data, patchedObj, fieldManager)
 
+	// 10. dry_run: the patch was only simulated
+	k8sclient.SurfaceDryRunWarning(ctx, client, "Patch", formatTarget(target), &resp.Diagnostics)
+
 	// 12. Save state
 	diags = resp.State.Set(ctx, &data)
 	resp.Diagnostics.Append(diags...)
@@ -194,15 +200,24 @@ func (r *patchResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		}
 		kubectlCmd += " -o yaml"
 
+		correction := "will be forcefully corrected"
+		reapplyNote := "The patch has been re-applied with force=true to restore your values."
+		if patchType == applyPatchType {
+			correction = "will be re-applied"
+			reapplyNote = "The patch has been re-applied without force; fields now owned by another manager are reported as conflicts instead of being overwritten."
+		}
+
 		resp.Diagnostics.AddWarning(
 			"Field Ownership Conflict - Controllers Fighting",
-			fmt.Sprintf("Other controllers modified fields we manage and will be forcefully corrected:\n%s\n\n"+
-				"The patch has been re-applied with force=true to restore your values. This indicates controllers are fighting over these fields.\n\n"+
+			fmt.Sprintf("Other controllers modified fields we manage and %s:\n%s\n\n"+
+				"%s This indicates controllers are fighting over these fields.\n\n"+
 				"If another controller keeps modifying these fields, consider:\n"+
 				"• Removing this patch to allow the other controller to manage these fields\n"+
 				"• Reconfiguring or disabling the other controller to avoid conflicts\n\n"+
 				"To investigate: %s",
+				correction,
 				strings.Join(fieldDetails, "\n"),
+				reapplyNote,
 				kubectlCmd),
 		)
 
@@ -297,6 +312,9 @@ func (r *patchResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
+	// 7a. Record pre-patch values for fields this update patches for the first time
+	r.saveOriginalValues(ctx, currentObj, plan, fieldManager, req.Private, resp.Private, &resp.Diagnostics)
+
 	// Surface any API warnings from patch operation
 	k8sclient.SurfaceK8sWarnings(ctx, client, &resp.Diagnostics)
 
@@ -320,6 +338,9 @@ func (r *patchResource) Update(ctx context.Context, req resource.UpdateRequest,
 	// 8b. Update managed_fields attribute in state
 	updateManagedFieldsData(ctx, &plan, patchedObj, fieldManager)
 
+	// 9. dry_run: the patch update was only simulated
+	k8sclient.SurfaceDryRunWarning(ctx, client, "Patch update", formatTarget(target), &resp.Diagnostics)
+
 	// 11. Save updated state
 	diags = resp.State.Set(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -351,7 +372,7 @@ func (r *patchResource) Delete(ctx context.Context, req resource.DeleteRequest,
 	}
 
 	// 4. Check if target still exists
-	_, _, err = r.getTargetResource(ctx, client, target)
+	gvr, targetObj, err := r.getTargetResource(ctx, client, target)
 	if err != nil {
 		if errors.IsNotFound(err) {
 			// Target already deleted - nothing to do
@@ -366,9 +387,33 @@ func (r *patchResource) Delete(ctx context.Context, req resource.DeleteRequest,
 	// Surface any API warnings from get operation
 	k8sclient.SurfaceK8sWarnings(ctx, client, &resp.Diagnostics)
 
-	// That's it - state removed automatically by framework
-	// The patched values stay on the resource
-	// Note: Previous owner tracking and transfer-back logic was removed per ADR-020
+	// 5. Optionally write the original values back
+	if data.RestoreOnDestroy.ValueBool() {
+		r.restoreOriginalValues(ctx, client, gvr, targetObj, data, req.Private, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		// The restore patches changed the target; release against the current version
+		targetObj, err = client.Get(ctx, gvr, targetObj.GetNamespace(), targetObj.GetName())
+		if err != nil {
+			if !errors.IsNotFound(err) {
+				tflog.Warn(ctx, "Failed to re-read target after restore", map[string]interface{}{"error": err.Error()})
+			}
+			return
+		}
+	}
+
+	// 6. Release ownership and verify the field manager is gone from managedFields.
+	// The patched values stay on the resource; previous owners are not handed their
+	// fields back explicitly (ADR-020), they can reclaim them on their next apply.
+	fieldManager := fmt.Sprintf("k8sconnect-patch-%s", data.ID.ValueString())
+	releaseOwnership(ctx, client, gvr, targetObj, fieldManager, &resp.Diagnostics)
+
+	// 7. dry_run: the restore and release were only simulated, keep the patch in state
+	k8sclient.SurfaceDryRunDelete(ctx, client, "Destroy", formatTarget(target), &resp.Diagnostics)
+
+	// State removed automatically by framework unless an error was added
 }
 
 func (r *patchResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
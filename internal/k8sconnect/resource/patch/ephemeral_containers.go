@@ -0,0 +1,182 @@
+package patch
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/yaml"
+
+	"github.com/jmorris0x0/terraform-provider-k8sconnect/internal/k8sconnect/common/k8sclient"
+)
+
+// ephemeralContainersSubresource is the Pod subresource that accepts ephemeral container
+// changes. The main Pod endpoint rejects any modification to spec.ephemeralContainers.
+const ephemeralContainersSubresource = "ephemeralcontainers"
+
+// ephemeralContainersPath is the field path (dot notation) of a Pod's ephemeral containers
+const ephemeralContainersPath = "spec.ephemeralContainers"
+
+// isEphemeralContainersPatch reports whether a patch on the target must be routed to the
+// pods/ephemeralcontainers subresource: the target is a core/v1 Pod and the patch touches
+// spec.ephemeralContainers.
+func isEphemeralContainersPatch(apiVersion, kind string, fieldPaths []string) bool {
+	if apiVersion != "v1" || kind != "Pod" {
+		return false
+	}
+	for _, p := range fieldPaths {
+		if isEphemeralContainersFieldPath(p) {
+			return true
+		}
+	}
+	return false
+}
+
+// isEphemeralContainersFieldPath checks if a field path is spec.ephemeralContainers or nested below it
+func isEphemeralContainersFieldPath(fieldPath string) bool {
+	if fieldPath == ephemeralContainersPath {
+		return true
+	}
+	return strings.HasPrefix(fieldPath, ephemeralContainersPath+".") ||
+		strings.HasPrefix(fieldPath, ephemeralContainersPath+"[")
+}
+
+// validateEphemeralContainersPatch ensures the patch only touches spec.ephemeralContainers.
+// The ephemeralcontainers subresource silently drops every other field, so mixing them
+// in one patch would report success without applying the rest.
+func validateEphemeralContainersPatch(fieldPaths []string, targetDesc string) error {
+	var otherPaths []string
+	for _, p := range fieldPaths {
+		if !isEphemeralContainersFieldPath(p) {
+			otherPaths = append(otherPaths, p)
+		}
+	}
+	if len(otherPaths) == 0 {
+		return nil
+	}
+
+	return fmt.Errorf("patch modifies spec.ephemeralContainers together with other fields: %s\n\n"+
+		"Ephemeral containers can only be changed through the pods/ephemeralcontainers subresource, "+
+		"which ignores all other fields.\n\n"+
+		"Solutions:\n"+
+		"• Move the other fields into a separate k8sconnect_patch resource\n"+
+		"• Keep only spec.ephemeralContainers in this patch\n\n"+
+		"Target: %s",
+		strings.Join(otherPaths, ", "), targetDesc)
+}
+
+// applyEphemeralContainersPatch sends the patch to the pods/ephemeralcontainers subresource.
+// The patch attribute is sent as a strategic merge rather than through Server-Side Apply
+// (merge key: name), matching how kubectl debug attaches containers. JSON and merge patches
+// are forwarded as-is; apply patches take their identity fields from the target.
+func (r *patchResource) applyEphemeralContainersPatch(ctx context.Context, client k8sclient.K8sClient, targetObj *unstructured.Unstructured, patchContent string, patchType types.PatchType, fieldManager string, gvr schema.GroupVersionResource) (*unstructured.Unstructured, error) {
+	patchBytes := []byte(patchContent)
+	switch patchType {
+	case types.StrategicMergePatchType:
+		// The patch attribute accepts YAML, the API expects JSON
+		jsonBytes, err := yaml.YAMLToJSON(patchBytes)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse patch content: %w", err)
+		}
+		patchBytes = jsonBytes
+	case types.ApplyPatchType:
+		patchObj, err := buildApplyPatchObject(targetObj, patchContent)
+		if err != nil {
+			return nil, err
+		}
+		jsonBytes, err := json.Marshal(patchObj.Object)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode apply_patch: %w", err)
+		}
+		patchBytes = jsonBytes
+	}
+
+	result, err := client.Patch(ctx, gvr, targetObj.GetNamespace(), targetObj.GetName(), patchType, patchBytes,
+		metav1.PatchOptions{FieldManager: fieldManager}, ephemeralContainersSubresource)
+	if err != nil {
+		return nil, fmt.Errorf("failed to patch %s subresource: %w", ephemeralContainersSubresource, err)
+	}
+
+	return result, nil
+}
+
+// detectEphemeralContainersDrift checks that every ephemeral container named in the patch
+// still exists on the Pod. Ephemeral containers are immutable once added and the server
+// fills in defaults, so a value-by-value comparison would report drift on every refresh.
+func detectEphemeralContainersDrift(currentObj *unstructured.Unstructured, patchContent string, patchType string) (bool, []string, error) {
+	desired, err := desiredEphemeralContainerNames(patchContent, patchType)
+	if err != nil {
+		return false, nil, err
+	}
+
+	current := make(map[string]bool)
+	containers, _, _ := unstructured.NestedSlice(currentObj.Object, "spec", "ephemeralContainers")
+	for _, c := range containers {
+		if cm, ok := c.(map[string]interface{}); ok {
+			if name, ok := cm["name"].(string); ok {
+				current[name] = true
+			}
+		}
+	}
+
+	var drifted []string
+	for _, name := range desired {
+		if !current[name] {
+			drifted = append(drifted, fmt.Sprintf("%s[name=%s]", ephemeralContainersPath, name))
+		}
+	}
+
+	return len(drifted) > 0, drifted, nil
+}
+
+// desiredEphemeralContainerNames extracts the names of ephemeral containers added by a patch
+func desiredEphemeralContainerNames(patchContent string, patchType string) ([]string, error) {
+	var values []interface{}
+
+	switch patchType {
+	case "application/json-patch+json":
+		var operations []map[string]interface{}
+		if err := json.Unmarshal([]byte(patchContent), &operations); err != nil {
+			return nil, fmt.Errorf("failed to parse JSON patch: %w", err)
+		}
+		for _, op := range operations {
+			opType, _ := op["op"].(string)
+			if opType != "add" && opType != "replace" {
+				continue
+			}
+			pathStr, _ := op["path"].(string)
+			fieldPath := strings.ReplaceAll(strings.TrimPrefix(pathStr, "/"), "/", ".")
+			if !isEphemeralContainersFieldPath(fieldPath) {
+				continue
+			}
+			// Either the whole list (/spec/ephemeralContainers) or a single entry (/spec/ephemeralContainers/-)
+			if list, ok := op["value"].([]interface{}); ok {
+				values = append(values, list...)
+			} else {
+				values = append(values, op["value"])
+			}
+		}
+	default:
+		var patchData map[string]interface{}
+		if err := yaml.Unmarshal([]byte(patchContent), &patchData); err != nil {
+			return nil, fmt.Errorf("failed to parse patch: %w", err)
+		}
+		list, _, _ := unstructured.NestedSlice(patchData, "spec", "ephemeralContainers")
+		values = list
+	}
+
+	var names []string
+	for _, v := range values {
+		if cm, ok := v.(map[string]interface{}); ok {
+			if name, ok := cm["name"].(string); ok && name != "" {
+				names = append(names, name)
+			}
+		}
+	}
+	return names, nil
+}
@@ -0,0 +1,104 @@
+package patch
+
+import (
+	"reflect"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestIsEphemeralContainersPatch(t *testing.T) {
+	tests := []struct {
+		name       string
+		apiVersion string
+		kind       string
+		paths      []string
+		want       bool
+	}{
+		{"pod with ephemeral containers list", "v1", "Pod", []string{"spec.ephemeralContainers", "spec.ephemeralContainers[0].name"}, true},
+		{"pod json patch append", "v1", "Pod", []string{"spec.ephemeralContainers.-"}, true},
+		{"pod containers only", "v1", "Pod", []string{"spec.containers[0].image"}, false},
+		{"similar prefix is not a match", "v1", "Pod", []string{"spec.ephemeralContainersExtra"}, false},
+		{"non-pod kind", "apps/v1", "Deployment", []string{"spec.ephemeralContainers"}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isEphemeralContainersPatch(tt.apiVersion, tt.kind, tt.paths); got != tt.want {
+				t.Errorf("isEphemeralContainersPatch() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateEphemeralContainersPatch(t *testing.T) {
+	if err := validateEphemeralContainersPatch([]string{"spec.ephemeralContainers", "spec.ephemeralContainers[0].image"}, "Pod test"); err != nil {
+		t.Errorf("expected no error for ephemeral-only patch, got: %v", err)
+	}
+
+	if err := validateEphemeralContainersPatch([]string{"spec.ephemeralContainers", "metadata.labels.debug"}, "Pod test"); err == nil {
+		t.Error("expected error when patch mixes ephemeral containers with other fields")
+	}
+}
+
+func TestDetectEphemeralContainersDrift(t *testing.T) {
+	currentObj := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "v1",
+			"kind":       "Pod",
+			"metadata":   map[string]interface{}{"name": "app", "namespace": "default"},
+			"spec": map[string]interface{}{
+				"ephemeralContainers": []interface{}{
+					map[string]interface{}{
+						"name":                     "debugger",
+						"image":                    "busybox",
+						"terminationMessagePath":   "/dev/termination-log",
+						"terminationMessagePolicy": "File",
+					},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name      string
+		content   string
+		patchType string
+		wantDrift []string
+	}{
+		{
+			name: "strategic patch present with server defaults",
+			content: `spec:
+  ephemeralContainers:
+  - name: debugger
+    image: busybox`,
+			patchType: "application/strategic-merge-patch+json",
+		},
+		{
+			name: "strategic patch container missing",
+			content: `spec:
+  ephemeralContainers:
+  - name: other
+    image: busybox`,
+			patchType: "application/strategic-merge-patch+json",
+			wantDrift: []string{"spec.ephemeralContainers[name=other]"},
+		},
+		{
+			name:      "json patch append present",
+			content:   `[{"op":"add","path":"/spec/ephemeralContainers/-","value":{"name":"debugger","image":"busybox"}}]`,
+			patchType: "application/json-patch+json",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hasDrift, drifted, err := detectEphemeralContainersDrift(currentObj, tt.content, tt.patchType)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if hasDrift != (len(tt.wantDrift) > 0) || !reflect.DeepEqual(drifted, tt.wantDrift) {
+				t.Errorf("detectEphemeralContainersDrift() = %v %v, want %v", hasDrift, drifted, tt.wantDrift)
+			}
+		})
+	}
+}
@@ -80,6 +80,13 @@ func (r *patchResource) extractPatchFieldPaths(ctx context.Context, patchContent
 			return nil, fmt.Errorf("failed to parse patch: %w", err)
 		}
 		return extractFieldPathsFromMap(patchData, ""), nil
+	case applyPatchType:
+		// Apply patches are partial objects; identity fields come from target
+		patchData, err := applyPatchFields(patchContent)
+		if err != nil {
+			return nil, err
+		}
+		return extractFieldPathsFromMap(patchData, ""), nil
 	default:
 		return nil, fmt.Errorf("unsupported patch type: %s", patchType)
 	}
@@ -168,6 +175,17 @@ func (r *patchResource) applyPatch(ctx context.Context, client k8sclient.K8sClie
 	}
 
 	patchTypeStr := r.determinePatchType(data)
+	defer k8sclient.TrackPhase(ctx, k8sclient.PhaseApply, describeObject(targetObj))()
+
+	// Ephemeral containers can only be added through the pods/ephemeralcontainers subresource
+	fieldPaths, err := r.extractPatchFieldPaths(ctx, patchContent, patchTypeStr)
+	if err == nil && isEphemeralContainersPatch(targetObj.GetAPIVersion(), targetObj.GetKind(), fieldPaths) {
+		targetDesc := fmt.Sprintf("Pod %s (namespace: %s)", targetObj.GetName(), targetObj.GetNamespace())
+		if err := validateEphemeralContainersPatch(fieldPaths, targetDesc); err != nil {
+			return nil, err
+		}
+		return r.applyEphemeralContainersPatch(ctx, client, targetObj, patchContent, types.PatchType(patchTypeStr), fieldManager, gvr)
+	}
 
 	// Handle different patch types
 	switch patchTypeStr {
@@ -176,7 +194,9 @@ func (r *patchResource) applyPatch(ctx context.Context, client k8sclient.K8sClie
 	case "application/merge-patch+json":
 		return r.applyJSONOrMergePatch(ctx, client, targetObj, patchContent, types.MergePatchType, gvr, fieldManager)
 	case "application/strategic-merge-patch+json":
-		return r.applyStrategicMergePatch(ctx, client, targetObj, patchContent, fieldManager, gvr)
+		return r.applyStrategicMergePatch(ctx, client, targetObj, patchContent, mergeKeysFromModel(data.MergeKeys), fieldManager, gvr)
+	case applyPatchType:
+		return r.applyApplyPatch(ctx, client, targetObj, patchContent, fieldManager, gvr)
 	default:
 		return nil, fmt.Errorf("unsupported patch type: %s", patchTypeStr)
 	}
@@ -203,8 +223,7 @@ func (r *patchResource) applyJSONOrMergePatch(ctx context.Context, client k8scli
 				"Options:\n"+
 				"1. Remove the immutable field from your patch\n"+
 				"2. If the field MUST change, recreate the target resource manually or use k8sconnect_object\n"+
-				"3. k8sconnect_object manages full resource lifecycle and can trigger automatic replacement\n\n"+
-				"Note: JSON Patch and Merge Patch cannot detect immutable fields during plan - errors only appear during apply",
+				"3. k8sconnect_object manages full resource lifecycle and can trigger automatic replacement",
 				immutableFields, targetObj.GetKind(), targetObj.GetName(), targetObj.GetNamespace())
 		}
 		return nil, fmt.Errorf("failed to apply patch: %w", err)
@@ -214,12 +233,15 @@ func (r *patchResource) applyJSONOrMergePatch(ctx context.Context, client k8scli
 }
 
 // applyStrategicMergePatch applies a strategic merge patch using Server-Side Apply
-func (r *patchResource) applyStrategicMergePatch(ctx context.Context, client k8sclient.K8sClient, targetObj *unstructured.Unstructured, patchContent string, fieldManager string, gvr schema.GroupVersionResource) (*unstructured.Unstructured, error) {
+func (r *patchResource) applyStrategicMergePatch(ctx context.Context, client k8sclient.K8sClient, targetObj *unstructured.Unstructured, patchContent string, mergeKeys map[string]string, fieldManager string, gvr schema.GroupVersionResource) (*unstructured.Unstructured, error) {
 	// Parse patch content into unstructured format
 	var patchData map[string]interface{}
 	if err := yaml.Unmarshal([]byte(patchContent), &patchData); err != nil {
 		return nil, fmt.Errorf("failed to parse patch content: %w", err)
 	}
+	if err := mergeListsByKey(patchData, targetObj.Object, mergeKeys); err != nil {
+		return nil, err
+	}
 
 	// Create a new object that combines target metadata with patch data
 	patchObj := &unstructured.Unstructured{Object: make(map[string]interface{})}
@@ -291,25 +313,37 @@ func (r *patchResource) detectValueDrift(ctx context.Context, currentObj *unstru
 
 	patchType := r.determinePatchType(data)
 
+	if fieldPaths, err := r.extractPatchFieldPaths(ctx, patchContent, patchType); err == nil &&
+		isEphemeralContainersPatch(currentObj.GetAPIVersion(), currentObj.GetKind(), fieldPaths) {
+		return detectEphemeralContainersDrift(currentObj, patchContent, patchType)
+	}
+
 	switch patchType {
 	case "application/strategic-merge-patch+json":
-		return r.detectStrategicMergeDrift(currentObj, patchContent)
+		return r.detectStrategicMergeDrift(currentObj, patchContent, mergeKeysFromModel(data.MergeKeys))
 	case "application/json-patch+json":
 		return r.detectJSONPatchDrift(currentObj, patchContent)
 	case "application/merge-patch+json":
 		return r.detectMergePatchDrift(currentObj, patchContent)
+	case applyPatchType:
+		return r.detectApplyPatchDrift(currentObj, patchContent)
 	default:
 		return false, nil, fmt.Errorf("unsupported patch type: %s", patchType)
 	}
 }
 
 // detectStrategicMergeDrift checks if strategic merge patch values have drifted
-func (r *patchResource) detectStrategicMergeDrift(currentObj *unstructured.Unstructured, patchContent string) (bool, []string, error) {
+func (r *patchResource) detectStrategicMergeDrift(currentObj *unstructured.Unstructured, patchContent string, mergeKeys map[string]string) (bool, []string, error) {
 	// Parse the patch to get desired values
 	var patchData map[string]interface{}
 	if err := yaml.Unmarshal([]byte(patchContent), &patchData); err != nil {
 		return false, nil, fmt.Errorf("failed to parse patch: %w", err)
 	}
+	// Lists merged by key are compared as the merged list, so the elements the patch
+	// doesn't mention aren't reported as drift
+	if err := mergeListsByKey(patchData, currentObj.Object, mergeKeys); err != nil {
+		return false, nil, err
+	}
 
 	// Recursively compare desired values with current values and collect drifted paths
 	driftedPaths := collectValueDrift(currentObj.Object, patchData, "")
@@ -319,7 +353,18 @@ func (r *patchResource) detectStrategicMergeDrift(currentObj *unstructured.Unstr
 // detectMergePatchDrift checks if merge patch values have drifted
 func (r *patchResource) detectMergePatchDrift(currentObj *unstructured.Unstructured, patchContent string) (bool, []string, error) {
 	// Merge patch has same semantics as strategic merge for value comparison
-	return r.detectStrategicMergeDrift(currentObj, patchContent)
+	return r.detectStrategicMergeDrift(currentObj, patchContent, nil)
+}
+
+// detectApplyPatchDrift checks if apply patch values have drifted
+func (r *patchResource) detectApplyPatchDrift(currentObj *unstructured.Unstructured, patchContent string) (bool, []string, error) {
+	patchData, err := applyPatchFields(patchContent)
+	if err != nil {
+		return false, nil, err
+	}
+
+	driftedPaths := collectValueDrift(currentObj.Object, patchData, "")
+	return len(driftedPaths) > 0, driftedPaths, nil
 }
 
 // detectJSONPatchDrift checks if JSON patch values have drifted
@@ -0,0 +1,76 @@
+package patch
+
+import (
+	"context"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestUpdateManagedFieldsData_NestedPaths(t *testing.T) {
+	ctx := context.Background()
+
+	obj := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"metadata":   map[string]interface{}{"name": "web", "namespace": "default"},
+		"spec": map[string]interface{}{
+			"replicas": int64(1),
+			"template": map[string]interface{}{
+				"spec": map[string]interface{}{
+					"containers": []interface{}{
+						map[string]interface{}{
+							"name":  "app",
+							"image": "nginx:1.14.2",
+							"env": []interface{}{
+								map[string]interface{}{"name": "DEEP_VAR", "value": "patched"},
+							},
+						},
+					},
+				},
+			},
+		},
+		"status": map[string]interface{}{"replicas": int64(1)},
+	}}
+	obj.SetManagedFields([]metav1.ManagedFieldsEntry{
+		{
+			Manager:   "kubectl",
+			Operation: metav1.ManagedFieldsOperationApply,
+			FieldsV1: &metav1.FieldsV1{Raw: []byte(`{"f:spec":{"f:replicas":{},"f:template":{"f:spec":{"f:containers":{` +
+				`"k:{\"name\":\"app\"}":{".":{},"f:image":{},"f:name":{}}}}}}}`)},
+		},
+		{
+			Manager:   "k8sconnect-patch-abc123",
+			Operation: metav1.ManagedFieldsOperationApply,
+			FieldsV1: &metav1.FieldsV1{Raw: []byte(`{"f:spec":{"f:template":{"f:spec":{"f:containers":{` +
+				`"k:{\"name\":\"app\"}":{"f:env":{"k:{\"name\":\"DEEP_VAR\"}":{".":{},"f:name":{},"f:value":{}}}}}}}}}`)},
+		},
+		{
+			Manager:   "kube-controller-manager",
+			Operation: metav1.ManagedFieldsOperationUpdate,
+			FieldsV1:  &metav1.FieldsV1{Raw: []byte(`{"f:status":{"f:replicas":{}}}`)},
+		},
+	})
+
+	var data patchResourceModel
+	updateManagedFieldsData(ctx, &data, obj, "k8sconnect-patch-abc123")
+
+	got := make(map[string]string)
+	if diags := data.ManagedFields.ElementsAs(ctx, &got, false); diags.HasError() {
+		t.Fatalf("failed to read managed_fields: %v", diags)
+	}
+
+	want := map[string]string{
+		"spec.template.spec.containers[0].env[0].name":  "k8sconnect-patch",
+		"spec.template.spec.containers[0].env[0].value": "k8sconnect-patch",
+	}
+	if len(got) != len(want) {
+		t.Fatalf("managed_fields = %v, want %v", got, want)
+	}
+	for path, manager := range want {
+		if got[path] != manager {
+			t.Errorf("managed_fields[%q] = %q, want %q (got %v)", path, got[path], manager, got)
+		}
+	}
+}
@@ -0,0 +1,129 @@
+package patch
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// mergeKeysFromModel returns merge_keys as list path -> merge key field,
+// or nil when it isn't set (or not yet known during plan)
+func mergeKeysFromModel(mergeKeys types.Map) map[string]string {
+	if mergeKeys.IsNull() || mergeKeys.IsUnknown() {
+		return nil
+	}
+
+	keys := make(map[string]string, len(mergeKeys.Elements()))
+	for listPath, value := range mergeKeys.Elements() {
+		if key, ok := value.(types.String); ok && !key.IsNull() && !key.IsUnknown() {
+			keys[listPath] = key.ValueString()
+		}
+	}
+	return keys
+}
+
+// mergeListsByKey rewrites each list in patchData named by mergeKeys into the full
+// live list with the patch's elements merged in by key: an element whose key matches a
+// live element is deep-merged into it, and any other element is appended. Custom
+// resource lists are atomic unless their schema declares a list-map, so sending only
+// the patched elements would replace the whole list.
+func mergeListsByKey(patchData, live map[string]interface{}, mergeKeys map[string]string) error {
+	listPaths := make([]string, 0, len(mergeKeys))
+	for listPath := range mergeKeys {
+		listPaths = append(listPaths, listPath)
+	}
+	sort.Strings(listPaths)
+
+	for _, listPath := range listPaths {
+		mergeKey := mergeKeys[listPath]
+		fields := strings.Split(listPath, ".")
+
+		patchList, found := nestedList(patchData, fields)
+		if !found {
+			continue
+		}
+		liveList, _ := nestedList(live, fields)
+
+		merged := make([]interface{}, 0, len(liveList)+len(patchList))
+		for _, item := range liveList {
+			if itemMap, ok := item.(map[string]interface{}); ok {
+				merged = append(merged, deepCopyMap(itemMap))
+			} else {
+				merged = append(merged, item)
+			}
+		}
+
+		for i, item := range patchList {
+			patchItem, ok := item.(map[string]interface{})
+			if !ok {
+				return fmt.Errorf("merge_keys: %s[%d] is not an object, so it can't be merged by %q", listPath, i, mergeKey)
+			}
+			keyValue, ok := patchItem[mergeKey]
+			if !ok {
+				return fmt.Errorf("merge_keys: %s[%d] has no %q field to merge by", listPath, i, mergeKey)
+			}
+
+			if existing := findListElement(merged, mergeKey, keyValue); existing != nil {
+				mergeMaps(existing, patchItem)
+			} else {
+				merged = append(merged, patchItem)
+			}
+		}
+
+		setNestedList(patchData, fields, merged)
+	}
+	return nil
+}
+
+// nestedList returns the list at fields, following nested maps only
+func nestedList(obj map[string]interface{}, fields []string) ([]interface{}, bool) {
+	current := obj
+	for _, field := range fields[:len(fields)-1] {
+		next, ok := current[field].(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+		current = next
+	}
+	list, ok := current[fields[len(fields)-1]].([]interface{})
+	return list, ok
+}
+
+// setNestedList replaces the list at fields; nestedList already found the parents
+func setNestedList(obj map[string]interface{}, fields []string, list []interface{}) {
+	current := obj
+	for _, field := range fields[:len(fields)-1] {
+		current = current[field].(map[string]interface{})
+	}
+	current[fields[len(fields)-1]] = list
+}
+
+// findListElement returns the element of list whose mergeKey field equals keyValue
+func findListElement(list []interface{}, mergeKey string, keyValue interface{}) map[string]interface{} {
+	for _, item := range list {
+		itemMap, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if value, ok := itemMap[mergeKey]; ok && valuesEqual(value, keyValue) {
+			return itemMap
+		}
+	}
+	return nil
+}
+
+// deepCopyMap copies nested maps so merging into a live list element doesn't
+// modify the live object
+func deepCopyMap(src map[string]interface{}) map[string]interface{} {
+	dst := make(map[string]interface{}, len(src))
+	for key, value := range src {
+		if nested, ok := value.(map[string]interface{}); ok {
+			dst[key] = deepCopyMap(nested)
+		} else {
+			dst[key] = value
+		}
+	}
+	return dst
+}
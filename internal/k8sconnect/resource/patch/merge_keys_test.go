@@ -0,0 +1,86 @@
+package patch
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func routeTarget() *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "example.com/v1",
+		"kind":       "Route",
+		"metadata":   map[string]interface{}{"name": "web", "namespace": "default"},
+		"spec": map[string]interface{}{
+			"rules": []interface{}{
+				map[string]interface{}{"host": "a.example.com", "backend": map[string]interface{}{"service": "a", "port": int64(80)}},
+				map[string]interface{}{"host": "b.example.com", "backend": map[string]interface{}{"service": "b", "port": int64(80)}},
+			},
+		},
+	}}
+}
+
+func TestMergeListsByKey(t *testing.T) {
+	live := routeTarget()
+	patchData := map[string]interface{}{
+		"spec": map[string]interface{}{
+			"rules": []interface{}{
+				map[string]interface{}{"host": "b.example.com", "backend": map[string]interface{}{"port": int64(8080)}},
+				map[string]interface{}{"host": "c.example.com", "backend": map[string]interface{}{"service": "c", "port": int64(80)}},
+			},
+		},
+	}
+
+	if err := mergeListsByKey(patchData, live.Object, map[string]string{"spec.rules": "host"}); err != nil {
+		t.Fatalf("mergeListsByKey: %v", err)
+	}
+
+	want := []interface{}{
+		map[string]interface{}{"host": "a.example.com", "backend": map[string]interface{}{"service": "a", "port": int64(80)}},
+		map[string]interface{}{"host": "b.example.com", "backend": map[string]interface{}{"service": "b", "port": int64(8080)}},
+		map[string]interface{}{"host": "c.example.com", "backend": map[string]interface{}{"service": "c", "port": int64(80)}},
+	}
+	if got := patchData["spec"].(map[string]interface{})["rules"]; !reflect.DeepEqual(got, want) {
+		t.Errorf("merged rules = %v, want %v", got, want)
+	}
+
+	// The live object is left untouched
+	if !reflect.DeepEqual(live, routeTarget()) {
+		t.Error("mergeListsByKey must not modify the live object")
+	}
+
+	// Elements without the merge key can't be matched
+	patchData = map[string]interface{}{"spec": map[string]interface{}{"rules": []interface{}{map[string]interface{}{"backend": "x"}}}}
+	err := mergeListsByKey(patchData, live.Object, map[string]string{"spec.rules": "host"})
+	if err == nil || !strings.Contains(err.Error(), `spec.rules[0] has no "host" field`) {
+		t.Errorf("expected a missing merge key error, got %v", err)
+	}
+}
+
+func TestMergeKeysDrift(t *testing.T) {
+	r := &patchResource{}
+	data := patchResourceModel{
+		Patch: types.StringValue("spec:\n  rules:\n  - host: b.example.com\n    backend:\n      port: 80\n"),
+		MergeKeys: types.MapValueMust(types.StringType, map[string]attr.Value{
+			"spec.rules": types.StringValue("host"),
+		}),
+	}
+
+	// The other rules in the live list are not drift
+	drift, fields, err := r.detectStrategicMergeDrift(routeTarget(), data.Patch.ValueString(), mergeKeysFromModel(data.MergeKeys))
+	if err != nil || drift {
+		t.Errorf("expected no drift, got %v %v %v", drift, fields, err)
+	}
+
+	// A changed value in the patched element is
+	target := routeTarget()
+	target.Object["spec"].(map[string]interface{})["rules"].([]interface{})[1].(map[string]interface{})["backend"].(map[string]interface{})["port"] = int64(9090)
+	drift, _, err = r.detectStrategicMergeDrift(target, data.Patch.ValueString(), mergeKeysFromModel(data.MergeKeys))
+	if err != nil || !drift {
+		t.Errorf("expected drift for the patched element, got %v %v", drift, err)
+	}
+}
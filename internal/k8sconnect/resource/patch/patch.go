@@ -6,6 +6,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -39,8 +40,12 @@ type patchResourceModel struct {
 	Patch      types.String `tfsdk:"patch"`
 	JSONPatch  types.String `tfsdk:"json_patch"`
 	MergePatch types.String `tfsdk:"merge_patch"`
+	ApplyPatch types.String `tfsdk:"apply_patch"`
+	MergeKeys  types.Map    `tfsdk:"merge_keys"`
 	Cluster    types.Object `tfsdk:"cluster"`
 
+	RestoreOnDestroy types.Bool `tfsdk:"restore_on_destroy"`
+
 	// Computed fields
 
 	ManagedStateProjection types.Map `tfsdk:"managed_state_projection"`
@@ -95,7 +100,7 @@ func (r *patchResource) Schema(ctx context.Context, req resource.SchemaRequest,
 		Version: 2,
 		MarkdownDescription: `Applies targeted patches to existing Kubernetes resources using Server-Side Apply.
 
-**IMPORTANT:** This resource forcefully takes ownership of fields from other controllers.
+**IMPORTANT:** This resource forcefully takes ownership of fields from other controllers (except apply_patch, which fails on conflicts instead).
 
 **Appropriate use cases:**
 - Cloud provider system resources (AWS EKS, GCP GKE, Azure AKS defaults)
@@ -109,7 +114,8 @@ func (r *patchResource) Schema(ctx context.Context, req resource.SchemaRequest,
 - Resources you could manage with k8sconnect_object instead
 
 **Destroy behavior:**
-When you destroy a patch resource, ownership is released but patched values remain on the target resource. Values are not reverted to their original state.`,
+When you destroy a patch resource, ownership is released but patched values remain on the target resource. Values are not reverted to their original state
+unless restore_on_destroy is set.`,
 
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -163,11 +169,13 @@ When you destroy a patch resource, ownership is released but patched values rema
 					stringvalidator.ConflictsWith(
 						path.MatchRoot("json_patch"),
 						path.MatchRoot("merge_patch"),
+						path.MatchRoot("apply_patch"),
 					),
 					stringvalidator.AtLeastOneOf(
 						path.MatchRoot("patch"),
 						path.MatchRoot("json_patch"),
 						path.MatchRoot("merge_patch"),
+						path.MatchRoot("apply_patch"),
 					),
 					validators.StrategicMergePatch{},
 				},
@@ -182,11 +190,13 @@ When you destroy a patch resource, ownership is released but patched values rema
 					stringvalidator.ConflictsWith(
 						path.MatchRoot("patch"),
 						path.MatchRoot("merge_patch"),
+						path.MatchRoot("apply_patch"),
 					),
 					stringvalidator.AtLeastOneOf(
 						path.MatchRoot("patch"),
 						path.MatchRoot("json_patch"),
 						path.MatchRoot("merge_patch"),
+						path.MatchRoot("apply_patch"),
 					),
 					validators.JSONPatchValidator{},
 				},
@@ -201,16 +211,51 @@ When you destroy a patch resource, ownership is released but patched values rema
 					stringvalidator.ConflictsWith(
 						path.MatchRoot("patch"),
 						path.MatchRoot("json_patch"),
+						path.MatchRoot("apply_patch"),
 					),
 					stringvalidator.AtLeastOneOf(
 						path.MatchRoot("patch"),
 						path.MatchRoot("json_patch"),
 						path.MatchRoot("merge_patch"),
+						path.MatchRoot("apply_patch"),
 					),
 					validators.MergePatchValidator{},
 				},
 			},
 
+			"apply_patch": schema.StringAttribute{
+				Optional: true,
+				MarkdownDescription: "Server-Side Apply patch content (YAML or JSON): a partial object holding only the fields this patch owns, " +
+					"typically written with `yamlencode()`. apiVersion, kind, and metadata.name/namespace are taken from `target` and may be omitted. " +
+					"Unlike `patch`, the apply is not forced: fields owned by another field manager fail with a conflict instead of being taken over.",
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(
+						path.MatchRoot("patch"),
+						path.MatchRoot("json_patch"),
+						path.MatchRoot("merge_patch"),
+					),
+					stringvalidator.AtLeastOneOf(
+						path.MatchRoot("patch"),
+						path.MatchRoot("json_patch"),
+						path.MatchRoot("merge_patch"),
+						path.MatchRoot("apply_patch"),
+					),
+					validators.ApplyPatchValidator{},
+				},
+			},
+
+			"merge_keys": schema.MapAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				MarkdownDescription: "Lists to merge by key in `patch`, as a map of dotted list path to the field identifying an element, " +
+					"e.g. `{ \"spec.rules\" = \"host\" }`. Custom resource lists without a merge key in their schema are replaced as a whole; " +
+					"with merge_keys, each element in the patch is merged into the live element with the same key, or appended if there is none, " +
+					"and the other elements are kept. The patch then owns the whole list. Only supported with `patch`.",
+				Validators: []validator.Map{
+					mapvalidator.AlsoRequires(path.MatchRoot("patch")),
+				},
+			},
+
 			"cluster": schema.SingleNestedAttribute{
 				Required: true,
 				Description: "Kubernetes cluster connection for this specific patch. Can be different per-resource, enabling multi-cluster " +
@@ -218,21 +263,30 @@ When you destroy a patch resource, ownership is released but patched values rema
 				Attributes: auth.GetConnectionSchemaForResource(),
 			},
 
+			"restore_on_destroy": schema.BoolAttribute{
+				Optional: true,
+				MarkdownDescription: "Write the original pre-patch values back when this patch is destroyed, instead of leaving the patched values in place. " +
+					"Supported for patch and apply_patch. Original values are recorded when the patch is first applied. Only fields set through nested maps " +
+					"are restored (lists are left as they are), and a field is skipped if its value changed since the patch set it. " +
+					"Restored fields are handed back to the field manager that owned them before the patch. Defaults to false.",
+			},
+
 			// Computed fields
 			"managed_state_projection": schema.MapAttribute{
 				Computed:    true,
 				ElementType: types.StringType,
 				Description: "Filtered Kubernetes state containing only fields owned by k8sconnect (determined via managedFields parsing). " +
-					"Used for drift detection. Only available for strategic merge patches (SSA). " +
+					"Used for drift detection. Only available for Server-Side Apply patches (patch, apply_patch). " +
 					"Non-SSA patches (json_patch, merge_patch) do not track field ownership.",
 			},
 
 			"managed_fields": schema.MapAttribute{
 				Computed:    true,
 				ElementType: types.StringType,
-				Description: "Tracks which field manager owns each field path in the patched resource. " +
-					"Shows 'k8sconnect' for fields managed by this provider, or external manager names (e.g., 'kubectl', 'hpa-controller') for fields managed by other systems. " +
-					"When ownership changes appear in diffs, it indicates another system has taken control of those fields.",
+				Description: "Field paths owned by this patch's field manager on the target resource, keyed by dotted path " +
+					"(e.g., 'spec.template.spec.containers[0].env[0].value') with the value 'k8sconnect-patch'. " +
+					"Paths use array indices resolved from merge keys and exclude status fields. " +
+					"A path disappearing indicates another system has taken control of that field.",
 			},
 		},
 	}
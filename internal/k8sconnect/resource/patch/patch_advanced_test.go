@@ -444,7 +444,12 @@ func TestAccPatchResource_DeepNestedFieldExtraction(t *testing.T) {
 					"raw": config.StringVariable(raw),
 				},
 				Check: resource.ComposeTestCheckFunc(
-				// Verify managed_fields is populated with nested structure
+					// Verify managed_fields records ownership of the patched env var by path
+					resource.TestCheckResourceAttr("k8sconnect_patch.test",
+						"managed_fields.spec.template.spec.containers[0].env[0].value", "k8sconnect-patch"),
+					// The image is still owned by kubectl, so it is not part of this patch's fields
+					resource.TestCheckNoResourceAttr("k8sconnect_patch.test",
+						"managed_fields.spec.template.spec.containers[0].image"),
 				),
 			},
 		},
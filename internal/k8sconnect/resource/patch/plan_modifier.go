@@ -12,7 +12,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	k8stypes "k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/yaml"
 
 	"github.com/jmorris0x0/terraform-provider-k8sconnect/internal/k8sconnect/common/auth"
@@ -111,7 +113,7 @@ func (r *patchResource) checkDriftAndPreserveState(ctx context.Context, req reso
 
 // hasPatchChanged determines if the patch has actually changed
 func (r *patchResource) hasPatchChanged(ctx context.Context, stateData *patchResourceModel, plannedData *patchResourceModel) bool {
-	// For SSA patches (strategic merge), compare projections
+	// For SSA patches (strategic merge, apply), compare projections
 	if !plannedData.ManagedStateProjection.IsNull() && !stateData.ManagedStateProjection.IsNull() {
 		return !stateData.ManagedStateProjection.Equal(plannedData.ManagedStateProjection)
 	}
@@ -130,6 +132,7 @@ func (r *patchResource) preservePatchInputAndState(ctx context.Context, stateDat
 	plannedData.Patch = stateData.Patch
 	plannedData.JSONPatch = stateData.JSONPatch
 	plannedData.MergePatch = stateData.MergePatch
+	plannedData.ApplyPatch = stateData.ApplyPatch
 
 	// Preserve computed attributes
 	plannedData.ManagedStateProjection = stateData.ManagedStateProjection
@@ -180,6 +183,11 @@ func (r *patchResource) executeDryRunPatch(ctx context.Context, req resource.Mod
 	// Generate our field manager name
 	fieldManager := r.generateFieldManager(*plannedData)
 
+	// JSON Patch and Merge Patch have no ownership to predict, but are still validated
+	if !r.validateNonSSAPatch(ctx, req, client, currentObj, plannedData, target, patchContent, fieldManager, resp) {
+		return false
+	}
+
 	// Execute dry-run patch
 	patchedObj, ok := r.executePatchDryRun(ctx, client, currentObj, plannedData, target, patchContent, fieldManager, resp)
 	if !ok {
@@ -202,7 +210,7 @@ func (r *patchResource) setupDryRunClient(ctx context.Context, plannedData *patc
 	return client, nil
 }
 
-func (r *patchResource) dryRunStrategicMergePatch(ctx context.Context, client k8sclient.K8sClient, currentObj *unstructured.Unstructured, patchContent string, fieldManager string) (*unstructured.Unstructured, error) {
+func (r *patchResource) dryRunStrategicMergePatch(ctx context.Context, client k8sclient.K8sClient, currentObj *unstructured.Unstructured, patchContent string, mergeKeys map[string]string, fieldManager string) (*unstructured.Unstructured, error) {
 	// Parse patch content
 	var patchData map[string]interface{}
 	if err := json.Unmarshal([]byte(patchContent), &patchData); err != nil {
@@ -211,6 +219,9 @@ func (r *patchResource) dryRunStrategicMergePatch(ctx context.Context, client k8
 			return nil, fmt.Errorf("failed to parse patch content: %w", err)
 		}
 	}
+	if err := mergeListsByKey(patchData, currentObj.Object, mergeKeys); err != nil {
+		return nil, err
+	}
 
 	// Create a new object that combines target metadata with patch data
 	patchObj := &unstructured.Unstructured{Object: make(map[string]interface{})}
@@ -356,7 +367,7 @@ func (r *patchResource) validatePatchTarget(
 	return currentObj, true
 }
 
-// executePatchDryRun executes a dry-run patch for strategic merge patches
+// executePatchDryRun executes a dry-run patch for SSA patches (strategic merge and apply)
 // Returns patchedObj and true if successful, or nil and false on error
 // For non-SSA patches (JSON/Merge), returns nil and true (no dry-run available)
 func (r *patchResource) executePatchDryRun(
@@ -371,37 +382,47 @@ func (r *patchResource) executePatchDryRun(
 ) (*unstructured.Unstructured, bool) {
 	patchType := r.determinePatchType(*plannedData)
 
-	// JSON Patch and Merge Patch don't use SSA field management
-	if patchType != "application/strategic-merge-patch+json" {
+	// JSON Patch and Merge Patch don't use SSA field management (validateNonSSAPatch dry-runs them)
+	if patchType != "application/strategic-merge-patch+json" && patchType != applyPatchType {
 		tflog.Debug(ctx, "JSON/Merge patch detected, skipping dry-run (no SSA field management)")
 		return nil, true // No patchedObj, but not an error
 	}
 
-	// Strategic merge patch uses SSA - can do dry-run to predict field ownership
-	patchedObj, err := r.dryRunStrategicMergePatch(ctx, client, currentObj, patchContent, fieldManager)
+	// Ephemeral container patches go through the pods/ephemeralcontainers subresource
+	// rather than SSA, so there is no field ownership to predict
+	if fieldPaths, err := r.extractPatchFieldPaths(ctx, patchContent, patchType); err == nil &&
+		isEphemeralContainersPatch(currentObj.GetAPIVersion(), currentObj.GetKind(), fieldPaths) {
+		if err := validateEphemeralContainersPatch(fieldPaths, formatTarget(target)); err != nil {
+			resp.Diagnostics.AddError("Invalid Ephemeral Containers Patch", err.Error())
+			return nil, false
+		}
+		tflog.Debug(ctx, "Ephemeral containers patch detected, skipping SSA dry-run")
+		return nil, true
+	}
+
+	// Strategic merge and apply patches use SSA - can do dry-run to predict field ownership
+	var patchedObj *unstructured.Unstructured
+	var err error
+	if patchType == applyPatchType {
+		patchedObj, err = r.dryRunApplyPatch(ctx, client, currentObj, patchContent, fieldManager)
+	} else {
+		patchedObj, err = r.dryRunStrategicMergePatch(ctx, client, currentObj, patchContent, mergeKeysFromModel(plannedData.MergeKeys), fieldManager)
+	}
 
 	// Surface any warnings from Patch operation
 	k8sclient.SurfaceK8sWarnings(ctx, client, &resp.Diagnostics)
 
 	if err != nil {
-		// Check for immutable field errors
-		if k8serrors.IsImmutableFieldError(err) {
-			immutableFields := k8serrors.ExtractImmutableFields(err)
+		// apply_patch does not force ownership, so conflicts are expected and actionable
+		if errors.IsConflict(err) {
 			resp.Diagnostics.AddError(
-				"Immutable Field in Patch",
-				fmt.Sprintf("Cannot patch immutable field(s): %v on %s\n\n"+
-					"The target resource has immutable fields that cannot be changed after creation.\n\n"+
-					"Options:\n"+
-					"1. Remove the immutable field from your patch\n"+
-					"2. If the field MUST change, recreate the target resource manually or use k8sconnect_object\n"+
-					"3. k8sconnect_object manages full resource lifecycle and can trigger automatic replacement",
-					immutableFields, formatTarget(target)),
+				k8serrors.Summary(k8serrors.ErrorTypeConflict, "Apply Patch Field Conflict"),
+				formatApplyPatchConflict(err, formatTarget(target)),
 			)
 			return nil, false
 		}
 
-		// Other errors
-		k8serrors.AddClassifiedError(&resp.Diagnostics, err, "Dry-run Patch", formatTarget(target), currentObj.GetAPIVersion())
+		addDryRunPatchError(err, currentObj, target, resp)
 		return nil, false
 	}
 
@@ -409,6 +430,86 @@ func (r *patchResource) executePatchDryRun(
 	return patchedObj, true
 }
 
+// addDryRunPatchError reports a failed dry-run patch, explaining immutable field errors
+func addDryRunPatchError(err error, currentObj *unstructured.Unstructured, target patchTargetModel, resp *resource.ModifyPlanResponse) {
+	if k8serrors.IsImmutableFieldError(err) {
+		immutableFields := k8serrors.ExtractImmutableFields(err)
+		resp.Diagnostics.AddError(
+			"Immutable Field in Patch",
+			fmt.Sprintf("Cannot patch immutable field(s): %v on %s\n\n"+
+				"The target resource has immutable fields that cannot be changed after creation.\n\n"+
+				"Options:\n"+
+				"1. Remove the immutable field from your patch\n"+
+				"2. If the field MUST change, recreate the target resource manually or use k8sconnect_object\n"+
+				"3. k8sconnect_object manages full resource lifecycle and can trigger automatic replacement",
+				immutableFields, formatTarget(target)),
+		)
+		return
+	}
+
+	k8serrors.AddClassifiedError(&resp.Diagnostics, err, "Dry-run Patch", formatTarget(target), currentObj.GetAPIVersion())
+}
+
+// validateNonSSAPatch dry-runs a JSON Patch or Merge Patch that is about to be applied, so a
+// patch the API server would reject (an invalid resulting object, a missing path, an
+// immutable field) fails the plan instead of the apply. Field validation warnings, e.g. for
+// unknown fields, are surfaced as warnings. Unchanged patches are not dry-run: a JSON Patch
+// such as "remove" can fail against the already patched object although it won't be re-sent.
+func (r *patchResource) validateNonSSAPatch(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	client k8sclient.K8sClient,
+	currentObj *unstructured.Unstructured,
+	plannedData *patchResourceModel,
+	target patchTargetModel,
+	patchContent string,
+	fieldManager string,
+	resp *resource.ModifyPlanResponse,
+) bool {
+	patchType := r.determinePatchType(*plannedData)
+	if patchType != "application/json-patch+json" && patchType != "application/merge-patch+json" {
+		return true
+	}
+
+	// Ephemeral container patches are checked by executePatchDryRun
+	if fieldPaths, err := r.extractPatchFieldPaths(ctx, patchContent, patchType); err == nil &&
+		isEphemeralContainersPatch(currentObj.GetAPIVersion(), currentObj.GetKind(), fieldPaths) {
+		return true
+	}
+
+	if !req.State.Raw.IsNull() {
+		var stateData patchResourceModel
+		if diags := req.State.Get(ctx, &stateData); !diags.HasError() &&
+			r.patchContentEqual(r.getPatchContent(stateData), patchContent, patchType) {
+			return true
+		}
+	}
+
+	gvr, err := client.GetGVR(ctx, currentObj)
+	if err != nil {
+		tflog.Debug(ctx, "Skipping patch validation, resource type not discovered", map[string]interface{}{"error": err.Error()})
+		return true
+	}
+
+	_, err = client.Patch(ctx, gvr, currentObj.GetNamespace(), currentObj.GetName(), k8stypes.PatchType(patchType), []byte(patchContent),
+		metav1.PatchOptions{
+			FieldManager:    fieldManager,
+			DryRun:          []string{metav1.DryRunAll},
+			FieldValidation: "Warn",
+		})
+
+	// Surface any warnings from Patch operation
+	k8sclient.SurfaceK8sWarnings(ctx, client, &resp.Diagnostics)
+
+	if err != nil {
+		addDryRunPatchError(err, currentObj, target, resp)
+		return false
+	}
+
+	tflog.Debug(ctx, "Dry-run validation of patch successful", map[string]interface{}{"patch_type": patchType})
+	return true
+}
+
 // calculatePatchProjection handles projection calculation and state management
 // based on whether it's a strategic merge patch or JSON/Merge patch
 func (r *patchResource) calculatePatchProjection(
@@ -7,6 +7,14 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+
+	"github.com/jmorris0x0/terraform-provider-k8sconnect/internal/k8sconnect/common/k8sclient"
 )
 
 // Test 2.1-2.7: patchContentEqual semantic comparison
@@ -361,3 +369,76 @@ func TestCheckOwnershipTransitions_FieldRemovalLogging(t *testing.T) {
 
 	// Note: This requires mocking private state, better tested in acceptance tests
 }
+
+// dryRunPatchClient records Patch calls and fails them with patchErr
+type dryRunPatchClient struct {
+	k8sclient.K8sClient
+	patchErr error
+	calls    []metav1.PatchOptions
+}
+
+func (c *dryRunPatchClient) Patch(_ context.Context, _ schema.GroupVersionResource, _, _ string, _ k8stypes.PatchType, _ []byte, options metav1.PatchOptions, _ ...string) (*unstructured.Unstructured, error) {
+	c.calls = append(c.calls, options)
+	return nil, c.patchErr
+}
+
+func TestValidateNonSSAPatch(t *testing.T) {
+	r := &patchResource{}
+	ctx := context.Background()
+	target := patchTargetModel{
+		APIVersion: types.StringValue("apps/v1"),
+		Kind:       types.StringValue("Deployment"),
+		Name:       types.StringValue("web"),
+		Namespace:  types.StringValue("default"),
+	}
+	currentObj := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"metadata":   map[string]interface{}{"name": "web", "namespace": "default"},
+	}}
+	jsonPatch := `[{"op":"replace","path":"/spec/replicas","value":"three"}]`
+
+	t.Run("rejected patch fails the plan", func(t *testing.T) {
+		client := &dryRunPatchClient{
+			K8sClient: k8sclient.NewStubK8sClient(),
+			patchErr:  apierrors.NewBadRequest(`Deployment.apps "web" is invalid: spec.replicas: Invalid value: "string"`),
+		}
+		data := &patchResourceModel{JSONPatch: types.StringValue(jsonPatch)}
+		resp := &resource.ModifyPlanResponse{}
+
+		if r.validateNonSSAPatch(ctx, resource.ModifyPlanRequest{}, client, currentObj, data, target, jsonPatch, "k8sconnect-patch-temp", resp) {
+			t.Fatal("expected validation to fail")
+		}
+		if !resp.Diagnostics.HasError() {
+			t.Error("expected an error diagnostic")
+		}
+		if len(client.calls) != 1 || len(client.calls[0].DryRun) != 1 || client.calls[0].DryRun[0] != metav1.DryRunAll {
+			t.Errorf("expected a single dry-run patch, got %+v", client.calls)
+		}
+	})
+
+	t.Run("accepted patch passes", func(t *testing.T) {
+		client := &dryRunPatchClient{K8sClient: k8sclient.NewStubK8sClient()}
+		data := &patchResourceModel{MergePatch: types.StringValue(`{"spec":{"replicas":3}}`)}
+		resp := &resource.ModifyPlanResponse{}
+
+		if !r.validateNonSSAPatch(ctx, resource.ModifyPlanRequest{}, client, currentObj, data, target, `{"spec":{"replicas":3}}`, "k8sconnect-patch-temp", resp) {
+			t.Fatalf("expected validation to pass: %v", resp.Diagnostics)
+		}
+		if len(client.calls) != 1 {
+			t.Errorf("expected a dry-run patch, got %d calls", len(client.calls))
+		}
+	})
+
+	t.Run("strategic merge patches are left to executePatchDryRun", func(t *testing.T) {
+		client := &dryRunPatchClient{K8sClient: k8sclient.NewStubK8sClient()}
+		data := &patchResourceModel{Patch: types.StringValue("spec:\n  replicas: 3")}
+
+		if !r.validateNonSSAPatch(ctx, resource.ModifyPlanRequest{}, client, currentObj, data, target, "spec:\n  replicas: 3", "k8sconnect-patch-temp", &resource.ModifyPlanResponse{}) {
+			t.Fatal("expected validation to be skipped")
+		}
+		if len(client.calls) != 0 {
+			t.Errorf("expected no patch calls, got %d", len(client.calls))
+		}
+	})
+}
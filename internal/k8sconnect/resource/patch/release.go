@@ -0,0 +1,179 @@
+package patch
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+
+	"github.com/jmorris0x0/terraform-provider-k8sconnect/internal/k8sconnect/common/fieldmanagement"
+	"github.com/jmorris0x0/terraform-provider-k8sconnect/internal/k8sconnect/common/k8sclient"
+)
+
+// releaseVerifyTimeout bounds releasing and verifying the patch's ownership on destroy
+var releaseVerifyTimeout = 30 * time.Second
+
+// releasePollInterval is how long destroy waits before retrying a release that didn't take
+var releasePollInterval = time.Second
+
+// releaseOwnership removes fieldManager's entries from the target's managedFields on destroy,
+// so the patched values stay but the patch no longer owns them. Fields another manager also
+// owns stay with that manager; the rest become unowned and free for any controller to take.
+// The release is re-read from the server until the manager owns nothing, within
+// releaseVerifyTimeout. Destroy never fails here: a release that can't be confirmed is
+// reported as a warning, since the patch is gone from state either way.
+func releaseOwnership(ctx context.Context, client k8sclient.K8sClient, gvr schema.GroupVersionResource,
+	current *unstructured.Unstructured, fieldManager string, diagnostics *diag.Diagnostics) {
+
+	targetDesc := describeObject(current)
+	deadline := time.Now().Add(releaseVerifyTimeout)
+	attempted := false
+
+	for {
+		if !hasManagerEntry(current, fieldManager) {
+			if attempted {
+				tflog.Info(ctx, "Verified patch field ownership was released", map[string]interface{}{
+					"target":        targetDesc,
+					"field_manager": fieldManager,
+				})
+			}
+			return
+		}
+
+		owned := ownedPaths(current, fieldManager)
+		if attempted && time.Now().After(deadline) {
+			if len(owned) == 0 {
+				owned = []string{"(an entry that owns no fields)"}
+			}
+			diagnostics.AddWarning(
+				"Field Ownership Not Released",
+				fmt.Sprintf("Field manager %s still owns these fields on %s after %v:\n  • %s\n\n"+
+					"The patched values are unaffected, but another patch of the same fields may report "+
+					"a conflict with this manager. Remove its entry from metadata.managedFields to release them.",
+					fieldManager, targetDesc, releaseVerifyTimeout, strings.Join(owned, "\n  • ")),
+			)
+			return
+		}
+		if attempted {
+			select {
+			case <-ctx.Done():
+				diagnostics.AddWarning("Field Ownership Not Verified",
+					fmt.Sprintf("Destroy was interrupted before the release of %s's fields on %s was confirmed: %s",
+						fieldManager, targetDesc, ctx.Err()))
+				return
+			case <-time.After(releasePollInterval):
+			}
+		}
+		attempted = true
+
+		patchBytes, err := managedFieldsReleasePatch(current, fieldManager)
+		if err != nil {
+			diagnostics.AddWarning("Field Ownership Not Released",
+				fmt.Sprintf("Failed to encode the managedFields of %s: %s", targetDesc, err))
+			return
+		}
+
+		tflog.Info(ctx, "Releasing patch field ownership on destroy", map[string]interface{}{
+			"target":        targetDesc,
+			"field_manager": fieldManager,
+			"fields":        len(owned),
+		})
+
+		_, err = client.Patch(ctx, gvr, current.GetNamespace(), current.GetName(), k8stypes.MergePatchType, patchBytes,
+			metav1.PatchOptions{FieldManager: fieldManager})
+		if err != nil && !errors.IsConflict(err) {
+			if errors.IsNotFound(err) {
+				addTargetGoneWarning(diagnostics, fieldManager, targetDesc)
+				return
+			}
+			diagnostics.AddWarning(
+				"Field Ownership Not Released",
+				fmt.Sprintf("Could not release %s's fields on %s: %s\n\n"+
+					"The patched values are unaffected. Remove the manager's entry from metadata.managedFields "+
+					"to release them.", fieldManager, targetDesc, err),
+			)
+			return
+		}
+
+		// Verify against a fresh read; a conflict means the target changed, so retry against it
+		current, err = client.Get(ctx, gvr, current.GetNamespace(), current.GetName())
+		if err != nil {
+			if errors.IsNotFound(err) {
+				addTargetGoneWarning(diagnostics, fieldManager, targetDesc)
+			} else {
+				diagnostics.AddWarning("Field Ownership Not Verified",
+					fmt.Sprintf("Could not re-read %s to confirm %s released its fields: %s", targetDesc, fieldManager, err))
+			}
+			return
+		}
+	}
+}
+
+// addTargetGoneWarning reports a target deleted while its ownership was being released
+func addTargetGoneWarning(diagnostics *diag.Diagnostics, fieldManager, targetDesc string) {
+	diagnostics.AddWarning(
+		"Field Ownership Not Verified",
+		fmt.Sprintf("%s was deleted while %s's fields were being released, so the release could not be confirmed. "+
+			"No cleanup is needed if the target stays deleted.", targetDesc, fieldManager),
+	)
+}
+
+// managedFieldsReleasePatch builds a merge patch that writes back the target's managedFields
+// without fieldManager's entries. The API server takes managedFields from the request as
+// they are, and resourceVersion makes the patch fail with a conflict if the target changed
+// since it was read. An empty list would be ignored, so when nothing would be left, a
+// single empty entry is sent, which clears managedFields.
+func managedFieldsReleasePatch(obj *unstructured.Unstructured, fieldManager string) ([]byte, error) {
+	remaining := make([]interface{}, 0)
+	for _, entry := range obj.GetManagedFields() {
+		if entry.Manager != fieldManager {
+			remaining = append(remaining, entry)
+		}
+	}
+	if len(remaining) == 0 {
+		remaining = append(remaining, map[string]interface{}{})
+	}
+
+	return json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"managedFields":   remaining,
+			"resourceVersion": obj.GetResourceVersion(),
+		},
+	})
+}
+
+// ownedPaths returns the paths fieldManager owns on obj, sorted
+func ownedPaths(obj *unstructured.Unstructured, fieldManager string) []string {
+	var owned []string
+	for path, managers := range fieldmanagement.ExtractAllManagedFields(obj) {
+		for _, m := range managers {
+			if m == fieldManager {
+				owned = append(owned, path)
+				break
+			}
+		}
+	}
+	sort.Strings(owned)
+	return owned
+}
+
+// hasManagerEntry reports whether obj still has a managedFields entry for fieldManager.
+// An entry that owns no fields still counts, since it shows the release didn't happen.
+func hasManagerEntry(obj *unstructured.Unstructured, fieldManager string) bool {
+	for _, entry := range obj.GetManagedFields() {
+		if entry.Manager == fieldManager {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,168 @@
+package patch
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+
+	"github.com/jmorris0x0/terraform-provider-k8sconnect/internal/k8sconnect/common/k8sclient"
+)
+
+// managedFieldsClient acts like the API server for managedFields merge patches: the
+// managedFields sent replace the target's, and a single empty entry clears them
+type managedFieldsClient struct {
+	k8sclient.K8sClient
+	obj         *unstructured.Unstructured
+	ignorePatch bool
+	deleted     bool
+	patches     int
+}
+
+func (c *managedFieldsClient) Patch(ctx context.Context, gvr schema.GroupVersionResource, namespace, name string, patchType k8stypes.PatchType, data []byte, options metav1.PatchOptions, subresources ...string) (*unstructured.Unstructured, error) {
+	c.patches++
+	if c.deleted {
+		return nil, errors.NewNotFound(gvr.GroupResource(), name)
+	}
+	if c.ignorePatch {
+		return c.obj.DeepCopy(), nil
+	}
+
+	var patch struct {
+		Metadata struct {
+			ManagedFields []metav1.ManagedFieldsEntry `json:"managedFields"`
+		} `json:"metadata"`
+	}
+	if err := json.Unmarshal(data, &patch); err != nil {
+		return nil, err
+	}
+	entries := patch.Metadata.ManagedFields
+	if len(entries) == 1 && entries[0].Manager == "" {
+		entries = nil
+	}
+	c.obj.SetManagedFields(entries)
+	return c.obj.DeepCopy(), nil
+}
+
+func (c *managedFieldsClient) Get(ctx context.Context, gvr schema.GroupVersionResource, namespace, name string) (*unstructured.Unstructured, error) {
+	if c.deleted {
+		return nil, errors.NewNotFound(gvr.GroupResource(), name)
+	}
+	return c.obj.DeepCopy(), nil
+}
+
+func patchedTarget() *unstructured.Unstructured {
+	obj := restoreTarget()
+	obj.SetResourceVersion("42")
+	obj.SetManagedFields(append(obj.GetManagedFields(), metav1.ManagedFieldsEntry{
+		Manager:  "k8sconnect-patch-abc",
+		FieldsV1: &metav1.FieldsV1{Raw: []byte(`{"f:spec":{"f:replicas":{}}}`)},
+	}))
+	return obj
+}
+
+func TestReleaseOwnership(t *testing.T) {
+	ctx := context.Background()
+	gvr := schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "deployments"}
+	defer func(timeout, interval time.Duration) {
+		releaseVerifyTimeout, releasePollInterval = timeout, interval
+	}(releaseVerifyTimeout, releasePollInterval)
+	releaseVerifyTimeout, releasePollInterval = 50*time.Millisecond, 10*time.Millisecond
+
+	t.Run("removes the manager and verifies it is gone", func(t *testing.T) {
+		target := patchedTarget()
+		client := &managedFieldsClient{K8sClient: k8sclient.NewStubK8sClient(), obj: target.DeepCopy()}
+		var diags diag.Diagnostics
+
+		releaseOwnership(ctx, client, gvr, target, "k8sconnect-patch-abc", &diags)
+
+		if diags.WarningsCount() != 0 {
+			t.Errorf("unexpected warnings: %v", diags)
+		}
+		if hasManagerEntry(client.obj, "k8sconnect-patch-abc") {
+			t.Error("expected the patch's field manager to be removed")
+		}
+		if !hasManagerEntry(client.obj, "helm") {
+			t.Error("expected the other managers to be kept")
+		}
+		if client.obj.Object["spec"].(map[string]interface{})["replicas"] != int64(2) {
+			t.Error("expected the values to be left in place")
+		}
+	})
+
+	t.Run("nothing to release", func(t *testing.T) {
+		client := &managedFieldsClient{K8sClient: k8sclient.NewStubK8sClient(), obj: restoreTarget()}
+		var diags diag.Diagnostics
+
+		releaseOwnership(ctx, client, gvr, restoreTarget(), "k8sconnect-patch-abc", &diags)
+
+		if client.patches != 0 || diags.WarningsCount() != 0 {
+			t.Errorf("expected no patch and no warning, got %d patches and %v", client.patches, diags)
+		}
+	})
+
+	t.Run("warns when the release doesn't take within the timeout", func(t *testing.T) {
+		target := patchedTarget()
+		client := &managedFieldsClient{K8sClient: k8sclient.NewStubK8sClient(), obj: target.DeepCopy(), ignorePatch: true}
+		var diags diag.Diagnostics
+
+		releaseOwnership(ctx, client, gvr, target, "k8sconnect-patch-abc", &diags)
+
+		if diags.HasError() || diags.WarningsCount() != 1 {
+			t.Fatalf("expected a single warning, got %v", diags)
+		}
+		warning := diags.Warnings()[0]
+		if warning.Summary() != "Field Ownership Not Released" || !strings.Contains(warning.Detail(), "spec.replicas") {
+			t.Errorf("unexpected warning: %s\n%s", warning.Summary(), warning.Detail())
+		}
+		if client.patches < 2 {
+			t.Errorf("expected the release to be retried, got %d patches", client.patches)
+		}
+	})
+
+	t.Run("warns when the target is deleted during the release", func(t *testing.T) {
+		target := patchedTarget()
+		client := &managedFieldsClient{K8sClient: k8sclient.NewStubK8sClient(), obj: target.DeepCopy(), deleted: true}
+		var diags diag.Diagnostics
+
+		releaseOwnership(ctx, client, gvr, target, "k8sconnect-patch-abc", &diags)
+
+		if diags.HasError() || diags.WarningsCount() != 1 || diags.Warnings()[0].Summary() != "Field Ownership Not Verified" {
+			t.Errorf("expected a Field Ownership Not Verified warning, got %v", diags)
+		}
+	})
+}
+
+func TestManagedFieldsReleasePatch(t *testing.T) {
+	patchBytes, err := managedFieldsReleasePatch(patchedTarget(), "k8sconnect-patch-abc")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var patch map[string]map[string]interface{}
+	if err := json.Unmarshal(patchBytes, &patch); err != nil {
+		t.Fatalf("invalid patch: %v", err)
+	}
+	if rv := patch["metadata"]["resourceVersion"]; rv != "42" {
+		t.Errorf("resourceVersion = %v, want 42", rv)
+	}
+	entries := patch["metadata"]["managedFields"].([]interface{})
+	if len(entries) != 1 || entries[0].(map[string]interface{})["manager"] != "helm" {
+		t.Errorf("managedFields = %v, want only helm's entry", entries)
+	}
+
+	// The last manager leaves a single empty entry, which clears managedFields
+	obj := patchedTarget()
+	obj.SetManagedFields(obj.GetManagedFields()[1:])
+	patchBytes, _ = managedFieldsReleasePatch(obj, "k8sconnect-patch-abc")
+	if !strings.Contains(string(patchBytes), `"managedFields":[{}]`) {
+		t.Errorf("expected a single empty entry, got %s", patchBytes)
+	}
+}
@@ -0,0 +1,346 @@
+package patch
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/yaml"
+
+	"github.com/jmorris0x0/terraform-provider-k8sconnect/internal/k8sconnect/common/k8sclient"
+)
+
+// originalValuesKey is the private state key holding the pre-patch values of patched fields
+const originalValuesKey = "original_values"
+
+// originalValue is the value of one patched field before the patch first set it, and the
+// field manager that owned it then. Path holds map keys, so keys containing dots
+// (e.g. app.kubernetes.io/name) stay unambiguous.
+type originalValue struct {
+	Path    []string    `json:"path"`
+	Present bool        `json:"present"`
+	Value   interface{} `json:"value,omitempty"`
+	Owner   string      `json:"owner,omitempty"`
+}
+
+// privateStateGetter and privateStateSetter cover the Private fields of the CRUD
+// requests and responses
+type privateStateGetter interface {
+	GetKey(ctx context.Context, key string) ([]byte, diag.Diagnostics)
+}
+
+type privateStateSetter interface {
+	SetKey(ctx context.Context, key string, value []byte) diag.Diagnostics
+}
+
+// restorablePatchData returns the patch as a map for patch types whose original values
+// can be restored (strategic merge and apply patches), or ok=false for the others
+func (r *patchResource) restorablePatchData(data patchResourceModel) (map[string]interface{}, bool, error) {
+	patchContent := r.getPatchContent(data)
+	switch r.determinePatchType(data) {
+	case "application/strategic-merge-patch+json":
+		var patchData map[string]interface{}
+		if err := yaml.Unmarshal([]byte(patchContent), &patchData); err != nil {
+			return nil, true, fmt.Errorf("failed to parse patch: %w", err)
+		}
+		return patchData, true, nil
+	case applyPatchType:
+		patchData, err := applyPatchFields(patchContent)
+		return patchData, true, err
+	default:
+		return nil, false, nil
+	}
+}
+
+// restorableLeaves walks the patch through nested maps and returns the paths it sets
+// directly. Values inside lists are not restorable: lists are merged by key or replaced
+// whole, so there is no single field to write back. Those paths are returned separately.
+func restorableLeaves(patchData map[string]interface{}, prefix []string) (leaves, unrestorable [][]string) {
+	for key, value := range patchData {
+		// Strategic merge directives ($patch, $retainKeys, $setElementOrder/...) are not fields
+		if strings.HasPrefix(key, "$") {
+			continue
+		}
+		path := append(append([]string{}, prefix...), key)
+
+		switch v := value.(type) {
+		case map[string]interface{}:
+			nestedLeaves, nestedUnrestorable := restorableLeaves(v, path)
+			leaves = append(leaves, nestedLeaves...)
+			unrestorable = append(unrestorable, nestedUnrestorable...)
+		case []interface{}:
+			unrestorable = append(unrestorable, path)
+		default:
+			leaves = append(leaves, path)
+		}
+	}
+	sortPaths(leaves)
+	sortPaths(unrestorable)
+	return leaves, unrestorable
+}
+
+// recordOriginalValues captures obj's value and owner at each restorable patch path that
+// isn't already in existing. obj must be the target as it was before this patch touched it.
+func recordOriginalValues(obj *unstructured.Unstructured, patchData map[string]interface{}, fieldManager string, existing []originalValue) []originalValue {
+	recorded := make(map[string]bool, len(existing))
+	for _, ov := range existing {
+		recorded[joinPath(ov.Path)] = true
+	}
+
+	records := append([]originalValue{}, existing...)
+	leaves, _ := restorableLeaves(patchData, nil)
+	for _, path := range leaves {
+		if recorded[joinPath(path)] {
+			continue
+		}
+		value, present, _ := unstructured.NestedFieldCopy(obj.Object, path...)
+		records = append(records, originalValue{
+			Path:    path,
+			Present: present,
+			Value:   value,
+			Owner:   previousFieldOwner(obj, path, fieldManager),
+		})
+	}
+	return records
+}
+
+// previousFieldOwner returns the first field manager other than fieldManager that owns path
+func previousFieldOwner(obj *unstructured.Unstructured, path []string, fieldManager string) string {
+	for _, entry := range obj.GetManagedFields() {
+		if entry.Manager == fieldManager || entry.FieldsV1 == nil {
+			continue
+		}
+		var fields map[string]interface{}
+		if err := json.Unmarshal(entry.FieldsV1.Raw, &fields); err != nil {
+			continue
+		}
+		if fieldsV1Contains(fields, path) {
+			return entry.Manager
+		}
+	}
+	return ""
+}
+
+func fieldsV1Contains(fields map[string]interface{}, path []string) bool {
+	current := fields
+	for _, key := range path {
+		next, ok := current["f:"+key].(map[string]interface{})
+		if !ok {
+			return false
+		}
+		current = next
+	}
+	return true
+}
+
+// buildRestorePatches groups the recorded values into one JSON merge patch per field
+// manager. A field is only restored while it still holds the value this patch set;
+// anything changed since belongs to someone else and is returned in skipped.
+// Fields that didn't exist originally are removed (merge patch null).
+func buildRestorePatches(current *unstructured.Unstructured, patchData map[string]interface{}, records []originalValue, fieldManager string) (map[string]map[string]interface{}, []string) {
+	byPath := make(map[string]originalValue, len(records))
+	for _, ov := range records {
+		byPath[joinPath(ov.Path)] = ov
+	}
+
+	patches := make(map[string]map[string]interface{})
+	var skipped []string
+	leaves, _ := restorableLeaves(patchData, nil)
+	for _, path := range leaves {
+		key := joinPath(path)
+		ov, ok := byPath[key]
+		if !ok {
+			skipped = append(skipped, key+" (no original value recorded)")
+			continue
+		}
+
+		patchValue, _, _ := unstructured.NestedFieldNoCopy(patchData, path...)
+		currentValue, exists, _ := unstructured.NestedFieldNoCopy(current.Object, path...)
+		stillPatched := exists && valuesEqual(currentValue, patchValue)
+		if patchValue == nil {
+			// The patch removed this field
+			stillPatched = !exists
+		}
+		if !stillPatched {
+			skipped = append(skipped, key+" (changed since the patch was applied)")
+			continue
+		}
+
+		if ov.Present && valuesEqual(ov.Value, patchValue) {
+			continue // Nothing to restore
+		}
+		if !ov.Present && !exists {
+			continue
+		}
+
+		// Removing a field leaves no owner; restored values go back to their previous owner
+		manager := fieldManager
+		if ov.Present && ov.Owner != "" {
+			manager = ov.Owner
+		}
+		if patches[manager] == nil {
+			patches[manager] = make(map[string]interface{})
+		}
+		var value interface{}
+		if ov.Present {
+			value = ov.Value
+		}
+		setNestedValue(patches[manager], path, value)
+	}
+
+	return patches, skipped
+}
+
+// restoreOriginalValues writes the recorded pre-patch values back to the target on destroy.
+// Fields that can't be restored safely are left in place and reported as a warning.
+func (r *patchResource) restoreOriginalValues(ctx context.Context, client k8sclient.K8sClient, gvr schema.GroupVersionResource,
+	current *unstructured.Unstructured, data patchResourceModel, private privateStateGetter, diagnostics *diag.Diagnostics) {
+
+	targetDesc := describeObject(current)
+	fieldManager := fmt.Sprintf("k8sconnect-patch-%s", data.ID.ValueString())
+
+	patchData, restorable, err := r.restorablePatchData(data)
+	if err != nil || !restorable {
+		diagnostics.AddWarning(
+			"Original Values Not Restored",
+			fmt.Sprintf("restore_on_destroy only supports patch and apply_patch. The patched values remain on %s.", targetDesc),
+		)
+		return
+	}
+
+	records, diags := loadOriginalValues(ctx, private)
+	diagnostics.Append(diags...)
+	if records == nil {
+		diagnostics.AddWarning(
+			"Original Values Not Restored",
+			fmt.Sprintf("No original values were recorded for this patch, so the patched values remain on %s.\n\n"+
+				"Original values are recorded when a patch is first applied; patches created before "+
+				"restore_on_destroy was available have none.", targetDesc),
+		)
+		return
+	}
+
+	patches, skipped := buildRestorePatches(current, patchData, records, fieldManager)
+	_, unrestorable := restorableLeaves(patchData, nil)
+	for _, path := range unrestorable {
+		skipped = append(skipped, joinPath(path)+" (list values can't be restored)")
+	}
+
+	managers := make([]string, 0, len(patches))
+	for manager := range patches {
+		managers = append(managers, manager)
+	}
+	sort.Strings(managers)
+
+	for _, manager := range managers {
+		patchBytes, err := json.Marshal(patches[manager])
+		if err != nil {
+			diagnostics.AddError("Failed to Restore Original Values",
+				fmt.Sprintf("Failed to encode restore patch for %s: %s", targetDesc, err))
+			return
+		}
+
+		tflog.Info(ctx, "Restoring original values on destroy", map[string]interface{}{
+			"target":        targetDesc,
+			"field_manager": manager,
+		})
+
+		_, err = client.Patch(ctx, gvr, current.GetNamespace(), current.GetName(), k8stypes.MergePatchType, patchBytes,
+			metav1.PatchOptions{FieldManager: manager})
+		if err != nil {
+			diagnostics.AddError(
+				"Failed to Restore Original Values",
+				fmt.Sprintf("Could not restore the original values on %s: %s\n\n"+
+					"Options:\n"+
+					"• Fix the error and run terraform destroy again\n"+
+					"• Set restore_on_destroy = false to leave the patched values in place",
+					targetDesc, err),
+			)
+			return
+		}
+	}
+
+	if len(skipped) > 0 {
+		diagnostics.AddWarning(
+			"Some Original Values Not Restored",
+			fmt.Sprintf("These fields on %s were left as they are:\n  • %s",
+				targetDesc, strings.Join(skipped, "\n  • ")),
+		)
+	}
+}
+
+// loadOriginalValues reads the recorded values from private state; nil if none were recorded
+func loadOriginalValues(ctx context.Context, private privateStateGetter) ([]originalValue, diag.Diagnostics) {
+	raw, diags := private.GetKey(ctx, originalValuesKey)
+	if diags.HasError() || len(raw) == 0 {
+		return nil, diags
+	}
+	var records []originalValue
+	if err := json.Unmarshal(raw, &records); err != nil {
+		tflog.Warn(ctx, "Failed to decode recorded original values", map[string]interface{}{"error": err.Error()})
+		return nil, diags
+	}
+	return records, diags
+}
+
+// saveOriginalValues records the pre-patch values of the paths this patch sets. Values
+// already recorded are kept, so they always describe the target before the first apply.
+// prior is nil on create. On update, a patch with nothing recorded predates
+// restore_on_destroy: its target already holds patched values, so nothing is recorded.
+func (r *patchResource) saveOriginalValues(ctx context.Context, original *unstructured.Unstructured, data patchResourceModel,
+	fieldManager string, prior privateStateGetter, private privateStateSetter, diagnostics *diag.Diagnostics) {
+
+	patchData, restorable, err := r.restorablePatchData(data)
+	if err != nil || !restorable {
+		return
+	}
+
+	var existing []originalValue
+	if prior != nil {
+		var diags diag.Diagnostics
+		existing, diags = loadOriginalValues(ctx, prior)
+		diagnostics.Append(diags...)
+		if existing == nil {
+			return
+		}
+	}
+
+	records := recordOriginalValues(original, patchData, fieldManager, existing)
+	raw, err := json.Marshal(records)
+	if err != nil {
+		tflog.Warn(ctx, "Failed to encode original values", map[string]interface{}{"error": err.Error()})
+		return
+	}
+	diagnostics.Append(private.SetKey(ctx, originalValuesKey, raw)...)
+}
+
+func setNestedValue(obj map[string]interface{}, path []string, value interface{}) {
+	current := obj
+	for _, key := range path[:len(path)-1] {
+		next, ok := current[key].(map[string]interface{})
+		if !ok {
+			next = make(map[string]interface{})
+			current[key] = next
+		}
+		current = next
+	}
+	current[path[len(path)-1]] = value
+}
+
+func joinPath(path []string) string {
+	return strings.Join(path, ".")
+}
+
+func sortPaths(paths [][]string) {
+	sort.Slice(paths, func(i, j int) bool {
+		return joinPath(paths[i]) < joinPath(paths[j])
+	})
+}
@@ -0,0 +1,132 @@
+package patch
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func restoreTarget() *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"metadata": map[string]interface{}{
+			"name":      "web",
+			"namespace": "default",
+			"labels":    map[string]interface{}{"app.kubernetes.io/name": "web"},
+		},
+		"spec": map[string]interface{}{"replicas": int64(2)},
+	}}
+	obj.SetManagedFields([]metav1.ManagedFieldsEntry{
+		{
+			Manager:  "helm",
+			FieldsV1: &metav1.FieldsV1{Raw: []byte(`{"f:metadata":{"f:labels":{"f:app.kubernetes.io/name":{}}},"f:spec":{"f:replicas":{}}}`)},
+		},
+	})
+	return obj
+}
+
+func TestRestorableLeaves(t *testing.T) {
+	patchData := map[string]interface{}{
+		"$patch": "merge",
+		"metadata": map[string]interface{}{
+			"labels": map[string]interface{}{"team": "core"},
+		},
+		"spec": map[string]interface{}{
+			"replicas": int64(5),
+			"template": map[string]interface{}{
+				"spec": map[string]interface{}{
+					"containers": []interface{}{map[string]interface{}{"name": "web"}},
+				},
+			},
+		},
+	}
+
+	leaves, unrestorable := restorableLeaves(patchData, nil)
+
+	wantLeaves := [][]string{{"metadata", "labels", "team"}, {"spec", "replicas"}}
+	if !reflect.DeepEqual(leaves, wantLeaves) {
+		t.Errorf("leaves = %v, want %v", leaves, wantLeaves)
+	}
+	wantUnrestorable := [][]string{{"spec", "template", "spec", "containers"}}
+	if !reflect.DeepEqual(unrestorable, wantUnrestorable) {
+		t.Errorf("unrestorable = %v, want %v", unrestorable, wantUnrestorable)
+	}
+}
+
+func TestRecordOriginalValues(t *testing.T) {
+	obj := restoreTarget()
+	patchData := map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"labels": map[string]interface{}{"app.kubernetes.io/name": "api", "team": "core"},
+		},
+		"spec": map[string]interface{}{"replicas": int64(5)},
+	}
+
+	records := recordOriginalValues(obj, patchData, "k8sconnect-patch-abc", nil)
+
+	want := []originalValue{
+		{Path: []string{"metadata", "labels", "app.kubernetes.io/name"}, Present: true, Value: "web", Owner: "helm"},
+		{Path: []string{"metadata", "labels", "team"}, Present: false},
+		{Path: []string{"spec", "replicas"}, Present: true, Value: int64(2), Owner: "helm"},
+	}
+	if !reflect.DeepEqual(records, want) {
+		t.Errorf("records = %+v\nwant %+v", records, want)
+	}
+
+	// Paths already recorded keep their original value
+	obj.Object["spec"] = map[string]interface{}{"replicas": int64(5)}
+	again := recordOriginalValues(obj, patchData, "k8sconnect-patch-abc", records)
+	if !reflect.DeepEqual(again, want) {
+		t.Errorf("re-recording changed existing values: %+v", again)
+	}
+}
+
+func TestBuildRestorePatches(t *testing.T) {
+	patchData := map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"labels": map[string]interface{}{"team": "core", "tier": "frontend"},
+		},
+		"spec": map[string]interface{}{"replicas": int64(5), "paused": true},
+	}
+	records := []originalValue{
+		{Path: []string{"metadata", "labels", "team"}, Present: false},
+		{Path: []string{"metadata", "labels", "tier"}, Present: true, Value: "backend", Owner: "helm"},
+		{Path: []string{"spec", "replicas"}, Present: true, Value: int64(2), Owner: "helm"},
+	}
+
+	current := &unstructured.Unstructured{Object: map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"labels": map[string]interface{}{"team": "core", "tier": "frontend"},
+		},
+		// An autoscaler changed replicas after the patch was applied
+		"spec": map[string]interface{}{"replicas": int64(7), "paused": true},
+	}}
+
+	patches, skipped := buildRestorePatches(current, patchData, records, "k8sconnect-patch-abc")
+
+	want := map[string]map[string]interface{}{
+		"k8sconnect-patch-abc": {
+			"metadata": map[string]interface{}{"labels": map[string]interface{}{"team": nil}},
+		},
+		"helm": {
+			"metadata": map[string]interface{}{"labels": map[string]interface{}{"tier": "backend"}},
+		},
+	}
+	if !reflect.DeepEqual(patches, want) {
+		t.Errorf("patches = %v\nwant %v", patches, want)
+	}
+
+	joined := strings.Join(skipped, "\n")
+	for _, wantSkipped := range []string{
+		"spec.paused (no original value recorded)",
+		"spec.replicas (changed since the patch was applied)",
+	} {
+		if !strings.Contains(joined, wantSkipped) {
+			t.Errorf("skipped missing %q: %v", wantSkipped, skipped)
+		}
+	}
+}
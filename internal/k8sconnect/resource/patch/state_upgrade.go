@@ -149,7 +149,10 @@ func upgradePatchStateV0(ctx context.Context, req resource.UpgradeStateRequest,
 		Patch:                  dataV0.Patch,
 		JSONPatch:              dataV0.JSONPatch,
 		MergePatch:             dataV0.MergePatch,
+		ApplyPatch:             types.StringNull(),
+		MergeKeys:              types.MapNull(types.StringType),
 		Cluster:                dataV0.Cluster,
+		RestoreOnDestroy:       types.BoolNull(),
 		ManagedStateProjection: dataV0.ManagedStateProjection,
 		ManagedFields:          types.MapNull(types.StringType), // Add as null Map
 	}
@@ -185,7 +188,10 @@ func upgradePatchStateV1(ctx context.Context, req resource.UpgradeStateRequest,
 		Patch:                  dataV1.Patch,
 		JSONPatch:              dataV1.JSONPatch,
 		MergePatch:             dataV1.MergePatch,
+		ApplyPatch:             types.StringNull(),
+		MergeKeys:              types.MapNull(types.StringType),
 		Cluster:                dataV1.Cluster,
+		RestoreOnDestroy:       types.BoolNull(),
 		ManagedStateProjection: dataV1.ManagedStateProjection,
 		ManagedFields:          types.MapNull(types.StringType), // Convert from String to null Map
 	}
@@ -44,10 +44,16 @@ func TestUpgradePatchStateV0toV2(t *testing.T) {
 						"cluster_ca_certificate": tftypes.String,
 						"token":                  tftypes.String,
 
-						"insecure":   tftypes.Bool,
-						"kubeconfig": tftypes.String,
-						"context":    tftypes.String,
-						"proxy_url":  tftypes.String,
+						"insecure":        tftypes.Bool,
+						"kubeconfig":      tftypes.String,
+						"context":         tftypes.String,
+						"proxy_url":       tftypes.String,
+						"use_env":         tftypes.Bool,
+						"request_timeout": tftypes.String,
+						"namespace":       tftypes.String,
+						"eks":             tftypes.Object{AttributeTypes: map[string]tftypes.Type{"cluster_name": tftypes.String, "region": tftypes.String, "profile": tftypes.String, "role_arn": tftypes.String}},
+						"gke":             tftypes.Object{AttributeTypes: map[string]tftypes.Type{"use_application_default_credentials": tftypes.Bool}},
+						"aks":             tftypes.Object{AttributeTypes: map[string]tftypes.Type{"login": tftypes.String, "server_id": tftypes.String, "tenant_id": tftypes.String, "client_id": tftypes.String}},
 						"exec": tftypes.Object{
 							AttributeTypes: map[string]tftypes.Type{
 								"api_version": tftypes.String,
@@ -64,11 +70,17 @@ func TestUpgradePatchStateV0toV2(t *testing.T) {
 					"cluster_ca_certificate": tftypes.NewValue(tftypes.String, nil),
 					"token":                  tftypes.NewValue(tftypes.String, "test-token"),
 
-					"insecure":   tftypes.NewValue(tftypes.Bool, nil),
-					"kubeconfig": tftypes.NewValue(tftypes.String, nil),
-					"context":    tftypes.NewValue(tftypes.String, nil),
-					"proxy_url":  tftypes.NewValue(tftypes.String, nil),
-					"exec":       tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{"api_version": tftypes.String, "command": tftypes.String, "args": tftypes.List{ElementType: tftypes.String}, "env": tftypes.Map{ElementType: tftypes.String}}}, nil),
+					"insecure":        tftypes.NewValue(tftypes.Bool, nil),
+					"kubeconfig":      tftypes.NewValue(tftypes.String, nil),
+					"context":         tftypes.NewValue(tftypes.String, nil),
+					"proxy_url":       tftypes.NewValue(tftypes.String, nil),
+					"use_env":         tftypes.NewValue(tftypes.Bool, nil),
+					"request_timeout": tftypes.NewValue(tftypes.String, nil),
+					"namespace":       tftypes.NewValue(tftypes.String, nil),
+					"eks":             tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{"cluster_name": tftypes.String, "region": tftypes.String, "profile": tftypes.String, "role_arn": tftypes.String}}, nil),
+					"gke":             tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{"use_application_default_credentials": tftypes.Bool}}, nil),
+					"aks":             tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{"login": tftypes.String, "server_id": tftypes.String, "tenant_id": tftypes.String, "client_id": tftypes.String}}, nil),
+					"exec":            tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{"api_version": tftypes.String, "command": tftypes.String, "args": tftypes.List{ElementType: tftypes.String}, "env": tftypes.Map{ElementType: tftypes.String}}}, nil),
 				}),
 				"managed_state_projection": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{
 					"data.foo": tftypes.NewValue(tftypes.String, "bar"),
@@ -81,6 +93,9 @@ func TestUpgradePatchStateV0toV2(t *testing.T) {
 				if state.ManagedFields.IsNull() != true {
 					t.Errorf("Expected ManagedFields to be null Map, got %v", state.ManagedFields)
 				}
+				if !state.RestoreOnDestroy.IsNull() {
+					t.Errorf("Expected RestoreOnDestroy to be null, got %v", state.RestoreOnDestroy)
+				}
 			},
 		},
 		{
@@ -111,10 +126,16 @@ func TestUpgradePatchStateV0toV2(t *testing.T) {
 						"cluster_ca_certificate": tftypes.String,
 						"token":                  tftypes.String,
 
-						"insecure":   tftypes.Bool,
-						"kubeconfig": tftypes.String,
-						"context":    tftypes.String,
-						"proxy_url":  tftypes.String,
+						"insecure":        tftypes.Bool,
+						"kubeconfig":      tftypes.String,
+						"context":         tftypes.String,
+						"proxy_url":       tftypes.String,
+						"use_env":         tftypes.Bool,
+						"request_timeout": tftypes.String,
+						"namespace":       tftypes.String,
+						"eks":             tftypes.Object{AttributeTypes: map[string]tftypes.Type{"cluster_name": tftypes.String, "region": tftypes.String, "profile": tftypes.String, "role_arn": tftypes.String}},
+						"gke":             tftypes.Object{AttributeTypes: map[string]tftypes.Type{"use_application_default_credentials": tftypes.Bool}},
+						"aks":             tftypes.Object{AttributeTypes: map[string]tftypes.Type{"login": tftypes.String, "server_id": tftypes.String, "tenant_id": tftypes.String, "client_id": tftypes.String}},
 						"exec": tftypes.Object{
 							AttributeTypes: map[string]tftypes.Type{
 								"api_version": tftypes.String,
@@ -131,11 +152,17 @@ func TestUpgradePatchStateV0toV2(t *testing.T) {
 					"cluster_ca_certificate": tftypes.NewValue(tftypes.String, nil),
 					"token":                  tftypes.NewValue(tftypes.String, nil),
 
-					"insecure":   tftypes.NewValue(tftypes.Bool, nil),
-					"kubeconfig": tftypes.NewValue(tftypes.String, nil),
-					"context":    tftypes.NewValue(tftypes.String, nil),
-					"proxy_url":  tftypes.NewValue(tftypes.String, nil),
-					"exec":       tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{"api_version": tftypes.String, "command": tftypes.String, "args": tftypes.List{ElementType: tftypes.String}, "env": tftypes.Map{ElementType: tftypes.String}}}, nil),
+					"insecure":        tftypes.NewValue(tftypes.Bool, nil),
+					"kubeconfig":      tftypes.NewValue(tftypes.String, nil),
+					"context":         tftypes.NewValue(tftypes.String, nil),
+					"proxy_url":       tftypes.NewValue(tftypes.String, nil),
+					"use_env":         tftypes.NewValue(tftypes.Bool, nil),
+					"request_timeout": tftypes.NewValue(tftypes.String, nil),
+					"namespace":       tftypes.NewValue(tftypes.String, nil),
+					"eks":             tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{"cluster_name": tftypes.String, "region": tftypes.String, "profile": tftypes.String, "role_arn": tftypes.String}}, nil),
+					"gke":             tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{"use_application_default_credentials": tftypes.Bool}}, nil),
+					"aks":             tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{"login": tftypes.String, "server_id": tftypes.String, "tenant_id": tftypes.String, "client_id": tftypes.String}}, nil),
+					"exec":            tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{"api_version": tftypes.String, "command": tftypes.String, "args": tftypes.List{ElementType: tftypes.String}, "env": tftypes.Map{ElementType: tftypes.String}}}, nil),
 				}),
 				"managed_state_projection": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
 			},
@@ -248,10 +275,16 @@ func TestUpgradePatchStateV1toV2(t *testing.T) {
 						"cluster_ca_certificate": tftypes.String,
 						"token":                  tftypes.String,
 
-						"insecure":   tftypes.Bool,
-						"kubeconfig": tftypes.String,
-						"context":    tftypes.String,
-						"proxy_url":  tftypes.String,
+						"insecure":        tftypes.Bool,
+						"kubeconfig":      tftypes.String,
+						"context":         tftypes.String,
+						"proxy_url":       tftypes.String,
+						"use_env":         tftypes.Bool,
+						"request_timeout": tftypes.String,
+						"namespace":       tftypes.String,
+						"eks":             tftypes.Object{AttributeTypes: map[string]tftypes.Type{"cluster_name": tftypes.String, "region": tftypes.String, "profile": tftypes.String, "role_arn": tftypes.String}},
+						"gke":             tftypes.Object{AttributeTypes: map[string]tftypes.Type{"use_application_default_credentials": tftypes.Bool}},
+						"aks":             tftypes.Object{AttributeTypes: map[string]tftypes.Type{"login": tftypes.String, "server_id": tftypes.String, "tenant_id": tftypes.String, "client_id": tftypes.String}},
 						"exec": tftypes.Object{
 							AttributeTypes: map[string]tftypes.Type{
 								"api_version": tftypes.String,
@@ -268,11 +301,17 @@ func TestUpgradePatchStateV1toV2(t *testing.T) {
 					"cluster_ca_certificate": tftypes.NewValue(tftypes.String, nil),
 					"token":                  tftypes.NewValue(tftypes.String, "test-token"),
 
-					"insecure":   tftypes.NewValue(tftypes.Bool, nil),
-					"kubeconfig": tftypes.NewValue(tftypes.String, nil),
-					"context":    tftypes.NewValue(tftypes.String, nil),
-					"proxy_url":  tftypes.NewValue(tftypes.String, nil),
-					"exec":       tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{"api_version": tftypes.String, "command": tftypes.String, "args": tftypes.List{ElementType: tftypes.String}, "env": tftypes.Map{ElementType: tftypes.String}}}, nil),
+					"insecure":        tftypes.NewValue(tftypes.Bool, nil),
+					"kubeconfig":      tftypes.NewValue(tftypes.String, nil),
+					"context":         tftypes.NewValue(tftypes.String, nil),
+					"proxy_url":       tftypes.NewValue(tftypes.String, nil),
+					"use_env":         tftypes.NewValue(tftypes.Bool, nil),
+					"request_timeout": tftypes.NewValue(tftypes.String, nil),
+					"namespace":       tftypes.NewValue(tftypes.String, nil),
+					"eks":             tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{"cluster_name": tftypes.String, "region": tftypes.String, "profile": tftypes.String, "role_arn": tftypes.String}}, nil),
+					"gke":             tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{"use_application_default_credentials": tftypes.Bool}}, nil),
+					"aks":             tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{"login": tftypes.String, "server_id": tftypes.String, "tenant_id": tftypes.String, "client_id": tftypes.String}}, nil),
+					"exec":            tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{"api_version": tftypes.String, "command": tftypes.String, "args": tftypes.List{ElementType: tftypes.String}, "env": tftypes.Map{ElementType: tftypes.String}}}, nil),
 				}),
 				"managed_state_projection": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{
 					"data.cache.enabled": tftypes.NewValue(tftypes.String, "true"),
@@ -318,10 +357,16 @@ func TestUpgradePatchStateV1toV2(t *testing.T) {
 						"cluster_ca_certificate": tftypes.String,
 						"token":                  tftypes.String,
 
-						"insecure":   tftypes.Bool,
-						"kubeconfig": tftypes.String,
-						"context":    tftypes.String,
-						"proxy_url":  tftypes.String,
+						"insecure":        tftypes.Bool,
+						"kubeconfig":      tftypes.String,
+						"context":         tftypes.String,
+						"proxy_url":       tftypes.String,
+						"use_env":         tftypes.Bool,
+						"request_timeout": tftypes.String,
+						"namespace":       tftypes.String,
+						"eks":             tftypes.Object{AttributeTypes: map[string]tftypes.Type{"cluster_name": tftypes.String, "region": tftypes.String, "profile": tftypes.String, "role_arn": tftypes.String}},
+						"gke":             tftypes.Object{AttributeTypes: map[string]tftypes.Type{"use_application_default_credentials": tftypes.Bool}},
+						"aks":             tftypes.Object{AttributeTypes: map[string]tftypes.Type{"login": tftypes.String, "server_id": tftypes.String, "tenant_id": tftypes.String, "client_id": tftypes.String}},
 						"exec": tftypes.Object{
 							AttributeTypes: map[string]tftypes.Type{
 								"api_version": tftypes.String,
@@ -338,11 +383,17 @@ func TestUpgradePatchStateV1toV2(t *testing.T) {
 					"cluster_ca_certificate": tftypes.NewValue(tftypes.String, nil),
 					"token":                  tftypes.NewValue(tftypes.String, nil),
 
-					"insecure":   tftypes.NewValue(tftypes.Bool, nil),
-					"kubeconfig": tftypes.NewValue(tftypes.String, "~/.kube/config"),
-					"context":    tftypes.NewValue(tftypes.String, "prod"),
-					"proxy_url":  tftypes.NewValue(tftypes.String, nil),
-					"exec":       tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{"api_version": tftypes.String, "command": tftypes.String, "args": tftypes.List{ElementType: tftypes.String}, "env": tftypes.Map{ElementType: tftypes.String}}}, nil),
+					"insecure":        tftypes.NewValue(tftypes.Bool, nil),
+					"kubeconfig":      tftypes.NewValue(tftypes.String, "~/.kube/config"),
+					"context":         tftypes.NewValue(tftypes.String, "prod"),
+					"proxy_url":       tftypes.NewValue(tftypes.String, nil),
+					"use_env":         tftypes.NewValue(tftypes.Bool, nil),
+					"request_timeout": tftypes.NewValue(tftypes.String, nil),
+					"namespace":       tftypes.NewValue(tftypes.String, nil),
+					"eks":             tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{"cluster_name": tftypes.String, "region": tftypes.String, "profile": tftypes.String, "role_arn": tftypes.String}}, nil),
+					"gke":             tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{"use_application_default_credentials": tftypes.Bool}}, nil),
+					"aks":             tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{"login": tftypes.String, "server_id": tftypes.String, "tenant_id": tftypes.String, "client_id": tftypes.String}}, nil),
+					"exec":            tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{"api_version": tftypes.String, "command": tftypes.String, "args": tftypes.List{ElementType: tftypes.String}, "env": tftypes.Map{ElementType: tftypes.String}}}, nil),
 				}),
 				"managed_state_projection": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
 				"managed_fields":           tftypes.NewValue(tftypes.String, nil), // Null in v1
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
@@ -19,9 +20,53 @@ func (r *patchResource) ConfigValidators(ctx context.Context) []resource.ConfigV
 	return []resource.ConfigValidator{
 		&validators.Cluster{},
 		&validators.ExecAuth{},
+		&restoreOnDestroyValidator{},
 	}
 }
 
+// restoreOnDestroyValidator rejects restore_on_destroy for json_patch and merge_patch,
+// whose edits can't be mapped back to recorded field values
+type restoreOnDestroyValidator struct{}
+
+func (v restoreOnDestroyValidator) Description(ctx context.Context) string {
+	return "validates that restore_on_destroy is only used with patch or apply_patch"
+}
+
+func (v restoreOnDestroyValidator) MarkdownDescription(ctx context.Context) string {
+	return "validates that `restore_on_destroy` is only used with `patch` or `apply_patch`"
+}
+
+func (v restoreOnDestroyValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data patchResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.RestoreOnDestroy.IsUnknown() || !data.RestoreOnDestroy.ValueBool() {
+		return
+	}
+
+	var patchAttr string
+	switch {
+	case !data.JSONPatch.IsNull():
+		patchAttr = "json_patch"
+	case !data.MergePatch.IsNull():
+		patchAttr = "merge_patch"
+	default:
+		return
+	}
+
+	resp.Diagnostics.AddAttributeError(
+		path.Root("restore_on_destroy"),
+		"Restore On Destroy Not Supported",
+		fmt.Sprintf("restore_on_destroy is not supported with %s: its edits can't be mapped back to the original field values.\n\n"+
+			"Solutions:\n"+
+			"• Rewrite the patch as patch (strategic merge) or apply_patch\n"+
+			"• Remove restore_on_destroy", patchAttr),
+	)
+}
+
 // isManagedByThisState checks if a resource is managed by k8sconnect_object
 // This is the critical safety mechanism to prevent self-patching
 func (r *patchResource) isManagedByThisState(ctx context.Context, obj *unstructured.Unstructured) bool {
@@ -69,6 +114,9 @@ func (r *patchResource) determinePatchType(data patchResourceModel) string {
 	if !data.MergePatch.IsNull() && data.MergePatch.ValueString() != "" {
 		return "application/merge-patch+json"
 	}
+	if !data.ApplyPatch.IsNull() && data.ApplyPatch.ValueString() != "" {
+		return "application/apply-patch+yaml"
+	}
 	return "application/strategic-merge-patch+json" // Default
 }
 
@@ -83,6 +131,9 @@ func (r *patchResource) getPatchContent(data patchResourceModel) string {
 	if !data.MergePatch.IsNull() && data.MergePatch.ValueString() != "" {
 		return data.MergePatch.ValueString()
 	}
+	if !data.ApplyPatch.IsNull() && data.ApplyPatch.ValueString() != "" {
+		return data.ApplyPatch.ValueString()
+	}
 	return ""
 }
 
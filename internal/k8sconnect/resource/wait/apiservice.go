@@ -0,0 +1,113 @@
+package wait
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+const (
+	// apiServiceWaitType names apiservice_available waits in logs and selects their timeout error
+	apiServiceWaitType = "apiservice available"
+
+	// apiServiceAvailableCondition is the condition kube-aggregator sets once it can reach
+	// the API server behind an APIService; it populates results for apiservice_available waits
+	apiServiceAvailableCondition = "Available"
+)
+
+// checkAPIServiceAvailable reports whether an APIService's Available condition is True
+func checkAPIServiceAvailable(obj *unstructured.Unstructured) (bool, string) {
+	cond, found := readyCondition(obj, apiServiceAvailableCondition)
+	if !found {
+		return false, "Available condition not reported yet"
+	}
+	if cond.status == "True" {
+		return true, ""
+	}
+	return false, cond.describe()
+}
+
+// buildAPIServiceTimeoutError creates the timeout error for apiservice_available waits.
+// kube-aggregator explains in the Available condition's message why it can't reach the
+// extension API server (a TLS error, missing endpoints, a failed discovery check), so
+// that message leads, followed by the Service it points at.
+func buildAPIServiceTimeoutError(current, original *unstructured.Unstructured, timeout time.Duration) error {
+	obj := current
+	if obj == nil {
+		obj = original
+	}
+
+	name := obj.GetName()
+
+	errMsg := "Wait Timeout\n\n"
+	errMsg += fmt.Sprintf("APIService %q did not become Available within %v\n\n", name, timeout)
+
+	cond, found := readyCondition(obj, apiServiceAvailableCondition)
+	if found {
+		errMsg += fmt.Sprintf("Available: %s", cond.status)
+		if cond.reason != "" {
+			errMsg += fmt.Sprintf(" (reason: %s)", cond.reason)
+		}
+		errMsg += "\n"
+		if cond.message != "" {
+			errMsg += fmt.Sprintf("Message: %s\n", cond.message)
+		}
+	} else {
+		errMsg += "Available: <not reported>\n"
+	}
+
+	serviceNamespace, _, _ := unstructured.NestedString(obj.Object, "spec", "service", "namespace")
+	serviceName, _, _ := unstructured.NestedString(obj.Object, "spec", "service", "name")
+	if serviceName != "" {
+		errMsg += fmt.Sprintf("Service: %s/%s", serviceNamespace, serviceName)
+		if port, found, _ := unstructured.NestedInt64(obj.Object, "spec", "service", "port"); found {
+			errMsg += fmt.Sprintf(" (port %d)", port)
+		}
+		errMsg += "\n"
+	}
+	errMsg += "\n"
+
+	if cause := apiServiceCause(obj, cond.reason, cond.message); cause != "" {
+		errMsg += fmt.Sprintf("Likely cause: %s\n\n", cause)
+	}
+
+	errMsg += "Troubleshooting:\n"
+	errMsg += "• Inspect the APIService status:\n"
+	errMsg += fmt.Sprintf("    kubectl get apiservice %s -o yaml\n", name)
+	if serviceName != "" {
+		errMsg += "• Check that the extension API server has ready endpoints:\n"
+		errMsg += fmt.Sprintf("    kubectl get endpointslices -n %s -l kubernetes.io/service-name=%s\n", serviceNamespace, serviceName)
+		errMsg += "• Check the extension API server's logs:\n"
+		errMsg += fmt.Sprintf("    kubectl logs -n %s service/%s\n", serviceNamespace, serviceName)
+	}
+	errMsg += "• Increase timeout if the server is still starting:\n"
+	errMsg += "    wait_for = { apiservice_available = true, timeout = \"5m\" }\n"
+
+	return &waitTimeoutError{message: errMsg, lastObserved: current}
+}
+
+// apiServiceCause explains the usual fix for the reasons kube-aggregator reports on the
+// Available condition, or "" when the reason says nothing more than the message
+func apiServiceCause(obj *unstructured.Unstructured, reason, message string) string {
+	switch reason {
+	case "ServiceNotFound":
+		return "spec.service names a Service that does not exist. Create it before the APIService, or fix spec.service."
+	case "MissingEndpoints", "EndpointsNotFound":
+		return "the Service has no ready endpoints, so the extension API server's pods are not running or not Ready."
+	case "ServicePortError":
+		return "spec.service.port is not a port of the Service."
+	case "FailedDiscoveryCheck":
+		if strings.Contains(message, "x509") || strings.Contains(message, "certificate") {
+			caBundle, _, _ := unstructured.NestedString(obj.Object, "spec", "caBundle")
+			skipVerify, _, _ := unstructured.NestedBool(obj.Object, "spec", "insecureSkipTLSVerify")
+			if caBundle == "" && !skipVerify {
+				return "the server's certificate can't be verified and spec.caBundle is empty. Set spec.caBundle to the CA that signed it."
+			}
+			return "the server's certificate can't be verified with spec.caBundle. Check that the serving certificate and caBundle match."
+		}
+		return "the kube-apiserver reached the Service but the extension API server did not answer discovery requests."
+	}
+	return ""
+}
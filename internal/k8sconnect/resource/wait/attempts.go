@@ -0,0 +1,90 @@
+package wait
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/watch"
+
+	"github.com/jmorris0x0/terraform-provider-k8sconnect/internal/k8sconnect/common/k8sclient"
+)
+
+// attemptCountingClient counts how often a wait checked the waited-for object: every Get
+// of it, including while polling for it to exist, and every watch event delivered for it.
+// performWait runs the wait helpers on one so wait_attempts needs no bookkeeping in each.
+type attemptCountingClient struct {
+	k8sclient.K8sClient
+	gvr       schema.GroupVersionResource
+	namespace string
+	name      string
+	attempts  atomic.Int64
+}
+
+func newAttemptCountingClient(wc *waitContext) *attemptCountingClient {
+	return &attemptCountingClient{
+		K8sClient: wc.Client,
+		gvr:       wc.GVR,
+		namespace: wc.ObjectRef.Namespace.ValueString(),
+		name:      wc.ObjectRef.Name.ValueString(),
+	}
+}
+
+func (c *attemptCountingClient) Get(ctx context.Context, gvr schema.GroupVersionResource, namespace, name string) (*unstructured.Unstructured, error) {
+	if gvr == c.gvr && namespace == c.namespace && name == c.name {
+		c.attempts.Add(1)
+	}
+	return c.K8sClient.Get(ctx, gvr, namespace, name)
+}
+
+func (c *attemptCountingClient) Watch(ctx context.Context, gvr schema.GroupVersionResource, namespace string, opts metav1.ListOptions) (watch.Interface, error) {
+	w, err := c.K8sClient.Watch(ctx, gvr, namespace, opts)
+	if err != nil || gvr != c.gvr {
+		return w, err
+	}
+	return newCountingWatch(w, &c.attempts), nil
+}
+
+// countingWatch forwards the events of a watch, counting those that carry the object
+type countingWatch struct {
+	watch.Interface
+	events chan watch.Event
+}
+
+func newCountingWatch(w watch.Interface, attempts *atomic.Int64) *countingWatch {
+	cw := &countingWatch{Interface: w, events: make(chan watch.Event)}
+	go func() {
+		defer close(cw.events)
+		for event := range w.ResultChan() {
+			if event.Type != watch.Bookmark && event.Type != watch.Error {
+				attempts.Add(1)
+			}
+			cw.events <- event
+		}
+	}()
+	return cw
+}
+
+func (w *countingWatch) ResultChan() <-chan watch.Event {
+	return w.events
+}
+
+// Stop stops the underlying watch and drains what it still delivers, so the forwarding
+// goroutine exits even when nobody reads the events anymore
+func (w *countingWatch) Stop() {
+	w.Interface.Stop()
+	go func() {
+		for range w.events {
+		}
+	}()
+}
+
+// setWaitMetrics records how long a successful wait took and how many checks it made
+func setWaitMetrics(data *waitResourceModel, elapsed time.Duration, attempts int64) {
+	data.WaitDuration = types.StringValue(elapsed.Round(time.Millisecond).String())
+	data.WaitAttempts = types.Int64Value(attempts)
+}
@@ -0,0 +1,185 @@
+package wait
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/jmorris0x0/terraform-provider-k8sconnect/internal/k8sconnect/common/k8sclient"
+)
+
+const (
+	// certManagerGroup is the API group of cert-manager's Certificate, CertificateRequest,
+	// Issuer and ClusterIssuer kinds
+	certManagerGroup = "cert-manager.io"
+
+	// certificateRevisionAnnotation is set on a CertificateRequest to the issuance
+	// revision of the Certificate it was created for
+	certificateRevisionAnnotation = "cert-manager.io/certificate-revision"
+)
+
+var (
+	certificateRequestGVR = schema.GroupVersionResource{Group: certManagerGroup, Version: "v1", Resource: "certificaterequests"}
+	acmeOrderGVR          = schema.GroupVersionResource{Group: "acme.cert-manager.io", Version: "v1", Resource: "orders"}
+	issuerGVR             = schema.GroupVersionResource{Group: certManagerGroup, Version: "v1", Resource: "issuers"}
+	clusterIssuerGVR      = schema.GroupVersionResource{Group: certManagerGroup, Version: "v1", Resource: "clusterissuers"}
+)
+
+// isCertManagerCertificate reports whether obj is a cert-manager Certificate
+func isCertManagerCertificate(obj *unstructured.Unstructured) bool {
+	return obj.GetKind() == "Certificate" && obj.GroupVersionKind().Group == certManagerGroup
+}
+
+// certificateIssuanceDetails explains why a cert-manager Certificate isn't Ready. The
+// Certificate's own conditions usually only say that issuance is in progress; the cause
+// is on the objects cert-manager creates for it: the latest CertificateRequest, the ACME
+// Order behind it, or the issuer it references not being Ready. Status fields such as
+// notAfter and failedIssuanceAttempts are already in the status dump. Lookups are
+// best-effort, objects that can't be read are left out.
+func certificateIssuanceDetails(ctx context.Context, client k8sclient.K8sClient, cert *unstructured.Unstructured) []string {
+	var details []string
+
+	if detail := certificateIssuerDetail(ctx, client, cert); detail != "" {
+		details = append(details, detail)
+	}
+
+	if client == nil {
+		return details
+	}
+	request := latestCertificateRequest(ctx, client, cert)
+	if request == nil {
+		return details
+	}
+	details = append(details, describeCertManagerObject("CertificateRequest", request, "Denied", "InvalidRequest", "Ready"))
+	if failureTime, found, _ := unstructured.NestedString(request.Object, "status", "failureTime"); found {
+		details = append(details, fmt.Sprintf("CertificateRequest %s failed at %s", request.GetName(), failureTime))
+	}
+
+	if order := ownedObject(ctx, client, acmeOrderGVR, request); order != nil {
+		state, _, _ := unstructured.NestedString(order.Object, "status", "state")
+		if state == "" {
+			state = "pending"
+		}
+		detail := fmt.Sprintf("Order %s: state %s", order.GetName(), state)
+		if reason, _, _ := unstructured.NestedString(order.Object, "status", "reason"); reason != "" {
+			detail += fmt.Sprintf(". %s", reason)
+		}
+		details = append(details, detail)
+	}
+	return details
+}
+
+// certificateIssuerDetail describes spec.issuerRef and, for cert-manager's own issuer
+// kinds, the issuer's Ready condition when it isn't True
+func certificateIssuerDetail(ctx context.Context, client k8sclient.K8sClient, cert *unstructured.Unstructured) string {
+	name, _, _ := unstructured.NestedString(cert.Object, "spec", "issuerRef", "name")
+	if name == "" {
+		return ""
+	}
+	kind, _, _ := unstructured.NestedString(cert.Object, "spec", "issuerRef", "kind")
+	if kind == "" {
+		kind = "Issuer"
+	}
+	group, _, _ := unstructured.NestedString(cert.Object, "spec", "issuerRef", "group")
+
+	detail := fmt.Sprintf("Issuer: %s/%s", kind, name)
+	if group != "" && group != certManagerGroup {
+		// External issuer (e.g. AWS PCA, Google CAS): its status format isn't known
+		return detail + fmt.Sprintf(" (%s)", group)
+	}
+	if client == nil {
+		return detail
+	}
+
+	gvr, namespace := issuerGVR, cert.GetNamespace()
+	if kind == "ClusterIssuer" {
+		gvr, namespace = clusterIssuerGVR, ""
+	}
+	issuer, err := client.Get(ctx, gvr, namespace, name)
+	if err != nil {
+		return detail + fmt.Sprintf(" (could not be read: %v)", err)
+	}
+	if cond, found := readyCondition(issuer, "Ready"); !found {
+		detail += " (Ready not reported)"
+	} else if cond.status != "True" {
+		detail += fmt.Sprintf(" is not Ready: %s", cond.describe())
+	}
+	return detail
+}
+
+// latestCertificateRequest returns the CertificateRequest of cert's most recent issuance
+// attempt: the one it owns with the highest certificate-revision annotation
+func latestCertificateRequest(ctx context.Context, client k8sclient.K8sClient, cert *unstructured.Unstructured) *unstructured.Unstructured {
+	list, err := client.List(ctx, certificateRequestGVR, cert.GetNamespace(), metav1.ListOptions{})
+	if err != nil {
+		tflog.Debug(ctx, "Could not list CertificateRequests", map[string]interface{}{"error": err.Error()})
+		return nil
+	}
+
+	var latest *unstructured.Unstructured
+	latestRevision := -1
+	for i := range list.Items {
+		request := &list.Items[i]
+		if !isOwnedBy(request, cert) {
+			continue
+		}
+		revision, err := strconv.Atoi(request.GetAnnotations()[certificateRevisionAnnotation])
+		if err != nil {
+			revision = 0
+		}
+		if revision > latestRevision {
+			latest, latestRevision = request, revision
+		}
+	}
+	return latest
+}
+
+// ownedObject returns the first gvr object in owner's namespace that owner owns, or nil
+func ownedObject(ctx context.Context, client k8sclient.K8sClient, gvr schema.GroupVersionResource, owner *unstructured.Unstructured) *unstructured.Unstructured {
+	list, err := client.List(ctx, gvr, owner.GetNamespace(), metav1.ListOptions{})
+	if err != nil {
+		// Not an ACME issuer, or the ACME CRDs aren't installed
+		return nil
+	}
+	for i := range list.Items {
+		if isOwnedBy(&list.Items[i], owner) {
+			return &list.Items[i]
+		}
+	}
+	return nil
+}
+
+// isOwnedBy reports whether obj lists owner in its ownerReferences
+func isOwnedBy(obj, owner *unstructured.Unstructured) bool {
+	for _, ref := range obj.GetOwnerReferences() {
+		if ref.UID == owner.GetUID() || (owner.GetUID() == "" && ref.Kind == owner.GetKind() && ref.Name == owner.GetName()) {
+			return true
+		}
+	}
+	return false
+}
+
+// describeCertManagerObject summarizes a cert-manager object by the first of
+// conditionTypes it reports, in order of precedence
+func describeCertManagerObject(kind string, obj *unstructured.Unstructured, conditionTypes ...string) string {
+	for _, conditionType := range conditionTypes {
+		cond, found := readyCondition(obj, conditionType)
+		if !found || (conditionType != "Ready" && cond.status != "True") {
+			continue
+		}
+		detail := fmt.Sprintf("%s %s: %s = %s", kind, obj.GetName(), conditionType, cond.status)
+		if cond.reason != "" {
+			detail += fmt.Sprintf(" (reason: %s)", cond.reason)
+		}
+		if cond.message != "" {
+			detail += fmt.Sprintf(". %s", cond.message)
+		}
+		return detail
+	}
+	return fmt.Sprintf("%s %s: no conditions reported yet", kind, obj.GetName())
+}
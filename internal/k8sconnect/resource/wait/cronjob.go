@@ -0,0 +1,85 @@
+package wait
+
+import (
+	"fmt"
+	"time"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+const (
+	// cronJobWaitType names cronjob_scheduled waits in logs and selects their timeout error
+	cronJobWaitType = "cronjob scheduled"
+
+	// cronJobLastScheduleField and cronJobActiveField are the status fields that show a
+	// CronJob has scheduled a Job; they populate results for cronjob_scheduled waits
+	cronJobLastScheduleField = "status.lastScheduleTime"
+	cronJobActiveField       = "status.active"
+)
+
+// checkCronJobScheduled reports whether a CronJob has scheduled a Job: it has a
+// status.lastScheduleTime, or a Job listed in status.active
+func checkCronJobScheduled(obj *unstructured.Unstructured) (bool, string) {
+	if lastSchedule, _, _ := unstructured.NestedString(obj.Object, "status", "lastScheduleTime"); lastSchedule != "" {
+		return true, ""
+	}
+	if active, _, _ := unstructured.NestedSlice(obj.Object, "status", "active"); len(active) > 0 {
+		return true, ""
+	}
+	if cronJobSuspended(obj) {
+		return false, "spec.suspend is true, so no Job will be scheduled"
+	}
+	return false, fmt.Sprintf("no Job scheduled yet (%s not set, no active Jobs)", cronJobLastScheduleField)
+}
+
+func cronJobSuspended(obj *unstructured.Unstructured) bool {
+	suspend, _, _ := unstructured.NestedBool(obj.Object, "spec", "suspend")
+	return suspend
+}
+
+// buildCronJobTimeoutError creates the timeout error for cronjob_scheduled waits. A
+// suspended CronJob never schedules, so that is called out before anything else.
+func buildCronJobTimeoutError(current, original *unstructured.Unstructured, timeout time.Duration) error {
+	obj := current
+	if obj == nil {
+		obj = original
+	}
+
+	name := obj.GetName()
+	namespace := obj.GetNamespace()
+
+	errMsg := "Wait Timeout\n\n"
+	errMsg += fmt.Sprintf("CronJob %q in namespace %q did not schedule a Job within %v (%s not set, no active Jobs)\n\n",
+		name, namespace, timeout, cronJobLastScheduleField)
+
+	if cronJobSuspended(obj) {
+		errMsg += "Warning: spec.suspend is true, so the CronJob controller does not schedule any Jobs. " +
+			"Set spec.suspend to false in yaml_body to resume scheduling.\n\n"
+	}
+
+	if schedule, _, _ := unstructured.NestedString(obj.Object, "spec", "schedule"); schedule != "" {
+		errMsg += fmt.Sprintf("Schedule: %s", schedule)
+		if timeZone, _, _ := unstructured.NestedString(obj.Object, "spec", "timeZone"); timeZone != "" {
+			errMsg += fmt.Sprintf(" (%s)", timeZone)
+		}
+		errMsg += "\n"
+	}
+	if deadline, found, _ := unstructured.NestedInt64(obj.Object, "spec", "startingDeadlineSeconds"); found {
+		errMsg += fmt.Sprintf("Starting deadline: %ds\n", deadline)
+	}
+	errMsg += "\n"
+
+	errMsg += "Common causes:\n"
+	errMsg += "• The next scheduled time is later than the timeout; the first Job only starts at the next match of spec.schedule\n"
+	errMsg += "• spec.startingDeadlineSeconds is too short, so scheduled runs are skipped as missed\n\n"
+
+	errMsg += "Troubleshooting:\n"
+	errMsg += "• Increase timeout to cover the next scheduled time:\n"
+	errMsg += "    wait_for = { cronjob_scheduled = true, timeout = \"15m\" }\n"
+	errMsg += "• Inspect the CronJob and its events:\n"
+	errMsg += fmt.Sprintf("    kubectl describe cronjob %s -n %s\n", name, namespace)
+	errMsg += "• Start a Job now instead of waiting for the schedule:\n"
+	errMsg += fmt.Sprintf("    kubectl create job --from=cronjob/%s %s-manual -n %s\n", name, name, namespace)
+
+	return &waitTimeoutError{message: errMsg, lastObserved: current}
+}
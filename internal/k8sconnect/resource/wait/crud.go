@@ -2,6 +2,7 @@ package wait
 
 import (
 	"context"
+	stderrors "errors"
 	"fmt"
 	"time"
 
@@ -28,6 +29,13 @@ type waitContext struct {
 	ObjectRef  objectRefModel
 	WaitConfig waitForModel
 	Cluster    auth.ClusterModel
+
+	// Steps holds wait_for.steps expanded into per-step configs; empty for single-mode waits
+	Steps []waitForModel
+
+	// Results collects the values observed by completed waits, keyed by field path or
+	// condition type
+	Results map[string]string
 }
 
 func (r *waitResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -56,16 +64,25 @@ func (r *waitResource) Create(ctx context.Context, req resource.CreateRequest, r
 		"namespace":   wc.ObjectRef.Namespace.ValueString(),
 	})
 
+	// dry_run: writes are only simulated, so there is nothing to wait for
+	if k8sclient.IsDryRun(wc.Client) {
+		skipWaitForDryRun(ctx, wc, &resp.Diagnostics)
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		return
+	}
+
 	// Perform the wait operation
+	started := time.Now()
 	if err := r.performWait(ctx, wc); err != nil {
 		resp.Diagnostics.AddError(
-			"Wait Operation Failed",
-			err.Error(),
+			waitFailureSummary(err),
+			waitFailureDetail(err, wc.WaitConfig),
 		)
 		return
 	}
 
 	tflog.Info(ctx, "Wait operation completed successfully")
+	r.reportWarningEvents(ctx, wc, started, &resp.Diagnostics)
 
 	// Populate result if configured in wait_for (following ADR-008)
 	if err := r.updateStatus(ctx, wc); err != nil {
@@ -73,6 +90,10 @@ func (r *waitResource) Create(ctx context.Context, req resource.CreateRequest, r
 		// Don't fail the entire operation - result is optional
 	}
 
+	results, diags := resultsValue(ctx, wc.Results)
+	resp.Diagnostics.Append(diags...)
+	data.Results = results
+
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -124,7 +145,7 @@ func (r *waitResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	// For field waits, refresh result from current state (drift detection)
 	// Condition/rollout waits have null result per ADR-008
 	// Only refresh if connection is ready (all values known, not during bootstrap)
-	if !wc.WaitConfig.Field.IsNull() && wc.WaitConfig.Field.ValueString() != "" {
+	if fields := resultFields(wc); len(fields) > 0 {
 		if r.isConnectionReady(data.Cluster) {
 			if err := r.updateStatus(ctx, wc); err != nil {
 				tflog.Warn(ctx, "Failed to update result during Read", map[string]interface{}{
@@ -133,7 +154,7 @@ func (r *waitResource) Read(ctx context.Context, req resource.ReadRequest, resp
 				// Don't fail - keep existing result on transient errors
 			}
 			tflog.Debug(ctx, "Refreshed result for field wait", map[string]interface{}{
-				"field": wc.WaitConfig.Field.ValueString(),
+				"fields": fields,
 			})
 		} else {
 			tflog.Debug(ctx, "Skipping result refresh - connection has unknown values (bootstrap)")
@@ -160,18 +181,26 @@ func (r *waitResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		return
 	}
 
+	if k8sclient.IsDryRun(wc.Client) {
+		skipWaitForDryRun(ctx, wc, &resp.Diagnostics)
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		return
+	}
+
 	tflog.Info(ctx, "Re-performing wait operation after configuration change")
 
 	// Re-perform the wait operation with new configuration
+	started := time.Now()
 	if err := r.performWait(ctx, wc); err != nil {
 		resp.Diagnostics.AddError(
-			"Wait Operation Failed",
-			err.Error(),
+			waitFailureSummary(err),
+			waitFailureDetail(err, wc.WaitConfig),
 		)
 		return
 	}
 
 	tflog.Info(ctx, "Wait operation completed successfully")
+	r.reportWarningEvents(ctx, wc, started, &resp.Diagnostics)
 
 	// Populate result if configured in wait_for (following ADR-008)
 	if err := r.updateStatus(ctx, wc); err != nil {
@@ -179,6 +208,10 @@ func (r *waitResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		// Don't fail the entire operation - result is optional
 	}
 
+	results, diags := resultsValue(ctx, wc.Results)
+	resp.Diagnostics.Append(diags...)
+	data.Results = results
+
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -221,6 +254,12 @@ func (r *waitResource) buildWaitContext(ctx context.Context, data *waitResourceM
 		return nil, diags
 	}
 
+	steps, diagsSteps := expandWaitSteps(ctx, waitConfig)
+	diags.Append(diagsSteps...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
 	// Construct GVR from object_ref using discovery
 	gvr, err := r.constructGVR(ctx, client, objRef)
 	if err != nil {
@@ -239,6 +278,7 @@ func (r *waitResource) buildWaitContext(ctx context.Context, data *waitResourceM
 		ObjectRef:  objRef,
 		WaitConfig: waitConfig,
 		Cluster:    connModel,
+		Steps:      steps,
 	}, diags
 }
 
@@ -263,14 +303,37 @@ func (r *waitResource) constructGVR(ctx context.Context, client k8sclient.K8sCli
 // waiting on resources that are created lazily by operators (e.g., Stackgres
 // creating Services, cert-manager creating Secrets, ALB controller creating
 // ALBs). See issue #171.
+//
+// On success it sets wait_duration and wait_attempts; reading the observed values
+// afterwards doesn't count as an attempt.
 func (r *waitResource) performWait(ctx context.Context, wc *waitContext) error {
-	obj, err := r.waitForExistence(ctx, wc)
+	defer k8sclient.TrackPhase(ctx, k8sclient.PhaseWait, formatObjectRef(wc.ObjectRef))()
+
+	started := time.Now()
+	counted := newAttemptCountingClient(wc)
+
+	if len(wc.Steps) > 0 {
+		if err := r.performWaitSteps(ctx, wc, counted); err != nil {
+			return err
+		}
+		setWaitMetrics(wc.Data, time.Since(started), counted.attempts.Load())
+		return nil
+	}
+
+	countedWC := *wc
+	countedWC.Client = counted
+	obj, err := r.waitForExistence(ctx, &countedWC)
 	if err != nil {
 		return err
 	}
 
 	// Execute wait logic based on wait_for configuration
-	return r.waitForResource(ctx, wc.Client, wc.GVR, obj, wc.WaitConfig)
+	if err := r.waitForResource(ctx, counted, wc.GVR, obj, wc.WaitConfig); err != nil {
+		return err
+	}
+	setWaitMetrics(wc.Data, time.Since(started), counted.attempts.Load())
+	r.recordResults(ctx, wc, wc.WaitConfig)
+	return nil
 }
 
 // waitForExistence polls for the configured object to exist, bounded by the
@@ -303,8 +366,7 @@ func (r *waitResource) waitForExistence(ctx context.Context, wc *waitContext) (*
 	pollCtx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
-	const pollInterval = 2 * time.Second
-	ticker := time.NewTicker(pollInterval)
+	ticker := time.NewTicker(parsePollSettings(ctx, wc.WaitConfig).interval)
 	defer ticker.Stop()
 
 	for {
@@ -318,13 +380,13 @@ func (r *waitResource) waitForExistence(ctx context.Context, wc *waitContext) (*
 			if namespace != "" {
 				resourceDesc = fmt.Sprintf("%s (namespace: %q)", resourceDesc, namespace)
 			}
-			return nil, fmt.Errorf("%s did not appear within %s.\n\n"+
+			return nil, &waitTimeoutError{message: fmt.Sprintf("%s did not appear within %s.\n\n"+
 				"k8sconnect_wait polls for the referenced resource to be created, but it never appeared.\n\n"+
 				"Possible causes:\n"+
 				"1. The resource was never created (typo in name/namespace, or the operator that creates it never ran)\n"+
 				"2. The resource creation is slow; increase wait_for.timeout\n"+
 				"3. The cluster connection or permissions are wrong",
-				resourceDesc, timeout)
+				resourceDesc, timeout)}
 
 		case <-ticker.C:
 			obj, err := wc.Client.Get(ctx, wc.GVR, namespace, name)
@@ -366,3 +428,43 @@ func parseTimeout(t types.String) time.Duration {
 func (r *waitResource) isConnectionReady(obj types.Object) bool {
 	return auth.IsConnectionReady(obj)
 }
+
+// skipWaitForDryRun reports that the wait was skipped because the provider's dry_run
+// setting simulates writes - the object never reaches the waited-for state
+func skipWaitForDryRun(ctx context.Context, wc *waitContext, diagnostics *diag.Diagnostics) {
+	wc.Data.Result = types.DynamicNull()
+	wc.Data.Results = types.MapNull(types.StringType)
+	wc.Data.WaitDuration = types.StringNull()
+	wc.Data.WaitAttempts = types.Int64Null()
+	diagnostics.AddWarning(
+		"Dry Run: Wait Skipped",
+		fmt.Sprintf("The wait for %s was skipped because the provider is configured with dry_run = true. "+
+			"Changes are only simulated, so the object would never reach the waited-for state.", formatObjectRef(wc.ObjectRef)),
+	)
+	tflog.Warn(ctx, "Dry run: wait skipped", map[string]interface{}{
+		"resource": formatObjectRef(wc.ObjectRef),
+	})
+}
+
+// waitTimeoutError is returned when a wait doesn't complete within wait_for.timeout.
+// The message is already formatted for users; the type lets callers classify it.
+type waitTimeoutError struct {
+	message string
+
+	// lastObserved is the object state the wait gave up on, nil if none was seen
+	lastObserved *unstructured.Unstructured
+}
+
+func (e *waitTimeoutError) Error() string {
+	return e.message
+}
+
+// waitFailureSummary returns the diagnostic summary for a failed wait, tagged with a
+// stable error type so timeouts can be told apart from other failures
+func waitFailureSummary(err error) string {
+	var timeoutErr *waitTimeoutError
+	if stderrors.As(err, &timeoutErr) || stderrors.Is(err, context.DeadlineExceeded) {
+		return k8serrors.Summary(k8serrors.ErrorTypeWaitTimeout, "Wait Operation Failed")
+	}
+	return k8serrors.Summary(k8serrors.ErrorTypeWaitFailed, "Wait Operation Failed")
+}
@@ -0,0 +1,194 @@
+package wait
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// maxWarningEvents caps the distinct Warning events listed after a successful wait
+const maxWarningEvents = 10
+
+// warningEvent is a distinct Warning event recorded during a wait
+type warningEvent struct {
+	object   string // e.g. "Pod/web-7d4b9c8f5-x2kqp"
+	reason   string
+	message  string
+	count    int64
+	lastSeen time.Time
+}
+
+// reportWarningEvents adds a warning diagnostic summarizing the Warning events recorded
+// for the waited-on object since the wait started, when wait_for.report_warning_events
+// is set. The wait already succeeded, so they don't fail it: they are reported so that
+// intermittent problems during a rollout (e.g. a crash-looping pod that recovered) are
+// visible. Listing events is best effort; failures are only logged.
+func (r *waitResource) reportWarningEvents(ctx context.Context, wc *waitContext, since time.Time, diags *diag.Diagnostics) {
+	if !wc.WaitConfig.ReportWarningEvents.ValueBool() {
+		return
+	}
+
+	namespace := wc.ObjectRef.Namespace.ValueString()
+	list, err := wc.Client.List(ctx, eventGVR, namespace, metav1.ListOptions{
+		FieldSelector: "type=Warning",
+	})
+	if err != nil {
+		tflog.Warn(ctx, "Failed to list Warning events after wait", map[string]interface{}{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	events := collectWarningEvents(list.Items, wc.ObjectRef.Kind.ValueString(), wc.ObjectRef.Name.ValueString(), since)
+	if len(events) == 0 {
+		return
+	}
+	diags.AddWarning("Warning Events During Wait", formatWarningEvents(formatObjectRef(wc.ObjectRef), namespace, events))
+}
+
+// collectWarningEvents returns the Warning events about the kind/name object, or about the
+// pods and ReplicaSets of a workload, last seen at or after since. Events of the same
+// object with the same reason and message are merged, and the result is ordered by when
+// each was last seen.
+func collectWarningEvents(items []unstructured.Unstructured, kind, name string, since time.Time) []warningEvent {
+	// Event timestamps have second precision
+	since = since.Truncate(time.Second)
+
+	merged := make(map[string]*warningEvent)
+	for i := range items {
+		event := items[i].Object
+		if eventType, _, _ := unstructured.NestedString(event, "type"); eventType != "Warning" {
+			continue
+		}
+		involvedKind, _, _ := unstructured.NestedString(event, "involvedObject", "kind")
+		involvedName, _, _ := unstructured.NestedString(event, "involvedObject", "name")
+		if !involvesObject(kind, name, involvedKind, involvedName) {
+			continue
+		}
+		lastSeen := eventLastSeen(event)
+		if lastSeen.Before(since) {
+			continue
+		}
+
+		reason, _, _ := unstructured.NestedString(event, "reason")
+		message, _, _ := unstructured.NestedString(event, "message")
+		object := involvedKind + "/" + involvedName
+		key := object + "\x00" + reason + "\x00" + message
+		if existing, ok := merged[key]; ok {
+			existing.count += eventCount(event)
+			if lastSeen.After(existing.lastSeen) {
+				existing.lastSeen = lastSeen
+			}
+			continue
+		}
+		merged[key] = &warningEvent{
+			object:   object,
+			reason:   reason,
+			message:  strings.TrimSpace(message),
+			count:    eventCount(event),
+			lastSeen: lastSeen,
+		}
+	}
+
+	events := make([]warningEvent, 0, len(merged))
+	for _, event := range merged {
+		events = append(events, *event)
+	}
+	sort.Slice(events, func(i, j int) bool {
+		if !events[i].lastSeen.Equal(events[j].lastSeen) {
+			return events[i].lastSeen.Before(events[j].lastSeen)
+		}
+		return events[i].object+events[i].reason < events[j].object+events[j].reason
+	})
+	return events
+}
+
+// involvesObject reports whether an event about involvedKind/involvedName concerns the
+// waited-on kind/name object. For workloads this includes the ReplicaSets and pods they
+// create, matched by their generated names, so events of pods that were replaced during
+// the rollout are still found.
+func involvesObject(kind, name, involvedKind, involvedName string) bool {
+	if involvedKind == kind && involvedName == name {
+		return true
+	}
+
+	suffix, ok := strings.CutPrefix(involvedName, name+"-")
+	if !ok || suffix == "" {
+		return false
+	}
+	// Number of name segments the controller appends: <deployment>-<hash> for
+	// ReplicaSets, <deployment>-<hash>-<random> for their pods, <name>-<random or
+	// ordinal> for pods of the other workloads
+	segments := strings.Count(suffix, "-") + 1
+	switch kind {
+	case "Deployment":
+		return (involvedKind == "ReplicaSet" && segments == 1) || (involvedKind == "Pod" && segments == 2)
+	case "StatefulSet", "DaemonSet", "ReplicaSet", "Job":
+		return involvedKind == "Pod" && segments == 1
+	}
+	return false
+}
+
+// eventLastSeen returns when an event last occurred. core/v1 events set lastTimestamp,
+// while events recorded through events.k8s.io only set eventTime and series.
+func eventLastSeen(event map[string]interface{}) time.Time {
+	var latest time.Time
+	for _, fields := range [][]string{
+		{"lastTimestamp"},
+		{"series", "lastObservedTime"},
+		{"eventTime"},
+		{"firstTimestamp"},
+	} {
+		value, _, _ := unstructured.NestedString(event, fields...)
+		if value == "" {
+			continue
+		}
+		if parsed, err := time.Parse(time.RFC3339, value); err == nil && parsed.After(latest) {
+			latest = parsed
+		}
+	}
+	return latest
+}
+
+// eventCount returns how often an event occurred, from count or series.count
+func eventCount(event map[string]interface{}) int64 {
+	if count, found, _ := unstructured.NestedInt64(event, "count"); found && count > 0 {
+		return count
+	}
+	if count, found, _ := unstructured.NestedInt64(event, "series", "count"); found && count > 0 {
+		return count
+	}
+	return 1
+}
+
+// formatWarningEvents renders the warning diagnostic detail for events
+func formatWarningEvents(resourceRef, namespace string, events []warningEvent) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "The wait for %s succeeded, but %d distinct Warning event(s) were recorded while waiting:\n\n", resourceRef, len(events))
+	for i, event := range events {
+		if i == maxWarningEvents {
+			fmt.Fprintf(&b, "• ... and %d more\n", len(events)-maxWarningEvents)
+			break
+		}
+		fmt.Fprintf(&b, "• %s %s", event.object, event.reason)
+		if event.count > 1 {
+			fmt.Fprintf(&b, " (x%d)", event.count)
+		}
+		fmt.Fprintf(&b, ": %s\n", event.message)
+	}
+
+	b.WriteString("\nThese may point to intermittent problems during the rollout. To see them:\n")
+	if namespace != "" {
+		fmt.Fprintf(&b, "  kubectl get events -n %s --field-selector type=Warning", namespace)
+	} else {
+		b.WriteString("  kubectl get events -A --field-selector type=Warning")
+	}
+	return b.String()
+}
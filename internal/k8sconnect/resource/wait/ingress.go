@@ -0,0 +1,170 @@
+package wait
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/jmorris0x0/terraform-provider-k8sconnect/internal/k8sconnect/common/k8sclient"
+)
+
+const (
+	// ingressWaitType names ingress_ready waits in logs and selects their timeout error
+	ingressWaitType = "ingress address"
+
+	// ingressAddressField is where ingress controllers publish the assigned address;
+	// it populates result for ingress_ready waits
+	ingressAddressField = "status.loadBalancer.ingress"
+
+	defaultIngressClassAnnotation = "ingressclass.kubernetes.io/is-default-class"
+	legacyIngressClassAnnotation  = "kubernetes.io/ingress.class"
+)
+
+var (
+	ingressClassGVR = schema.GroupVersionResource{Group: "networking.k8s.io", Version: "v1", Resource: "ingressclasses"}
+	eventGVR        = schema.GroupVersionResource{Version: "v1", Resource: "events"}
+)
+
+// checkIngressReady reports whether an Ingress has been assigned an address, i.e. an
+// entry of status.loadBalancer.ingress has an ip or hostname
+func checkIngressReady(obj *unstructured.Unstructured) (bool, string) {
+	if len(ingressAddresses(obj)) > 0 {
+		return true, ""
+	}
+	return false, "no address in " + ingressAddressField
+}
+
+// ingressAddresses returns the ips and hostnames published in status.loadBalancer.ingress
+func ingressAddresses(obj *unstructured.Unstructured) []string {
+	entries, _, _ := unstructured.NestedSlice(obj.Object, "status", "loadBalancer", "ingress")
+	var addresses []string
+	for _, entry := range entries {
+		entryMap, ok := entry.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		for _, key := range []string{"ip", "hostname"} {
+			if value, _ := entryMap[key].(string); value != "" {
+				addresses = append(addresses, value)
+			}
+		}
+	}
+	return addresses
+}
+
+// buildIngressTimeoutError creates the timeout error for ingress_ready waits. An address
+// that never appears usually means no controller is processing the Ingress, so the
+// IngressClass setup and the Ingress's events are checked for a likely cause.
+func (r *waitResource) buildIngressTimeoutError(ctx context.Context, client k8sclient.K8sClient, current, original *unstructured.Unstructured, timeout time.Duration) error {
+	obj := current
+	if obj == nil {
+		obj = original
+	}
+
+	name := obj.GetName()
+	namespace := obj.GetNamespace()
+
+	errMsg := "Wait Timeout\n\n"
+	errMsg += fmt.Sprintf("Ingress %q in namespace %q was not assigned an address in %s within %v\n\n", name, namespace, ingressAddressField, timeout)
+
+	if issues := r.ingressControllerIssues(ctx, client, obj); len(issues) > 0 {
+		errMsg += "Warning: no ingress controller appears to be processing this Ingress:\n"
+		for _, issue := range issues {
+			errMsg += fmt.Sprintf("• %s\n", issue)
+		}
+		errMsg += "\n"
+	}
+
+	errMsg += "Common causes:\n"
+	errMsg += "• No ingress controller is installed or running for the Ingress's class\n"
+	errMsg += "• The controller is still provisioning a cloud load balancer\n"
+	errMsg += "• The controller does not publish addresses (e.g., bare-metal setups without a LoadBalancer Service)\n\n"
+
+	errMsg += "Troubleshooting:\n"
+	errMsg += "• Increase timeout if the load balancer is legitimately slow to provision:\n"
+	errMsg += "    wait_for = { ingress_ready = true, timeout = \"15m\" }\n"
+	errMsg += "• Inspect the Ingress and its events:\n"
+	errMsg += fmt.Sprintf("    kubectl describe ingress %s -n %s\n", name, namespace)
+	errMsg += "• Check the available IngressClasses and their controllers:\n"
+	errMsg += "    kubectl get ingressclass\n"
+
+	return &waitTimeoutError{message: errMsg, lastObserved: current}
+}
+
+// ingressControllerIssues returns reasons no controller appears to be processing the
+// Ingress. Lookup failures are logged and skipped: the checks only add context.
+func (r *waitResource) ingressControllerIssues(ctx context.Context, client k8sclient.K8sClient, obj *unstructured.Unstructured) []string {
+	className, _, _ := unstructured.NestedString(obj.Object, "spec", "ingressClassName")
+	legacyClass := obj.GetAnnotations()[legacyIngressClassAnnotation]
+
+	classes, err := client.List(ctx, ingressClassGVR, "", metav1.ListOptions{})
+	if err != nil {
+		tflog.Warn(ctx, "Failed to list IngressClasses for timeout diagnostics", map[string]interface{}{
+			"error": err.Error(),
+		})
+	} else if issues := ingressClassIssues(className, legacyClass, classes.Items); len(issues) > 0 {
+		return issues
+	}
+
+	// Controllers record events (e.g. Sync) on the Ingresses they process
+	events, err := client.List(ctx, eventGVR, obj.GetNamespace(), metav1.ListOptions{
+		FieldSelector: fmt.Sprintf("involvedObject.kind=Ingress,involvedObject.name=%s", obj.GetName()),
+		Limit:         1,
+	})
+	if err != nil {
+		tflog.Warn(ctx, "Failed to list Ingress events for timeout diagnostics", map[string]interface{}{
+			"error": err.Error(),
+		})
+		return nil
+	}
+	if len(events.Items) == 0 {
+		return []string{"No events have been recorded for the Ingress, so no controller appears to be watching it"}
+	}
+	return nil
+}
+
+// ingressClassIssues checks that some IngressClass claims the Ingress: the class named
+// in spec.ingressClassName must exist, and without one a default class must be marked.
+// Ingresses using the legacy kubernetes.io/ingress.class annotation are matched by
+// the controller directly, so they are not checked against IngressClasses.
+func ingressClassIssues(className, legacyClass string, classes []unstructured.Unstructured) []string {
+	if className == "" && legacyClass != "" {
+		return nil
+	}
+
+	var names, defaults []string
+	for _, class := range classes {
+		names = append(names, class.GetName())
+		if class.GetAnnotations()[defaultIngressClassAnnotation] == "true" {
+			defaults = append(defaults, class.GetName())
+		}
+	}
+	sort.Strings(names)
+
+	if len(names) == 0 {
+		return []string{"No IngressClass exists in the cluster, so no ingress controller appears to be installed"}
+	}
+
+	if className != "" {
+		for _, name := range names {
+			if name == className {
+				return nil
+			}
+		}
+		return []string{fmt.Sprintf("IngressClass %q from spec.ingressClassName does not exist (available: %s)",
+			className, strings.Join(names, ", "))}
+	}
+
+	if len(defaults) == 0 {
+		return []string{fmt.Sprintf("spec.ingressClassName is not set and no IngressClass is marked as default (%s=true), "+
+			"so no controller may claim the Ingress (available: %s)", defaultIngressClassAnnotation, strings.Join(names, ", "))}
+	}
+	return nil
+}
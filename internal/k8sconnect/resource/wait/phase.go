@@ -0,0 +1,106 @@
+package wait
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+const (
+	// phaseWaitType names phase waits in logs and timeout errors
+	phaseWaitType = "phase"
+
+	// phaseField is the field a phase wait observes
+	phaseField = "status.phase"
+)
+
+// phaseWait waits for status.phase to reach phase. Phases such as Failed or Lost are
+// terminal, so the wait ends as soon as the object enters one of failPhases instead of
+// running to its timeout the way a field_value wait would.
+type phaseWait struct {
+	phase      string
+	failPhases []string
+}
+
+// newPhaseWait reads wait_for.phase and wait_for.fail_phases
+func newPhaseWait(ctx context.Context, waitConfig waitForModel) (phaseWait, error) {
+	pw := phaseWait{phase: waitConfig.Phase.ValueString()}
+	if !waitConfig.FailPhases.IsNull() && !waitConfig.FailPhases.IsUnknown() {
+		if diags := waitConfig.FailPhases.ElementsAs(ctx, &pw.failPhases, false); diags.HasError() {
+			return pw, fmt.Errorf("invalid fail_phases: %v", diags)
+		}
+	}
+	return pw, nil
+}
+
+// check reports whether obj reached the target phase, for waitWithFailCheck
+func (pw phaseWait) check(obj *unstructured.Unstructured) (bool, string) {
+	phase, _, _ := unstructured.NestedString(obj.Object, "status", "phase")
+	if phase == pw.phase {
+		return true, ""
+	}
+	if phase == "" {
+		return false, fmt.Sprintf("no phase reported yet, waiting for %s", pw.phase)
+	}
+	return false, fmt.Sprintf("phase is %s, waiting for %s", phase, pw.phase)
+}
+
+// failedError returns an error when obj is in one of fail_phases
+func (pw phaseWait) failedError(obj *unstructured.Unstructured) error {
+	phase, _, _ := unstructured.NestedString(obj.Object, "status", "phase")
+	if !pw.isFailPhase(phase) {
+		return nil
+	}
+
+	errMsg := fmt.Sprintf("Phase Failed: %s\n\n", readyResourceRef(obj))
+	errMsg += fmt.Sprintf("%s entered phase %s, which is listed in fail_phases, so it will not reach %s.\n\n", obj.GetKind(), phase, pw.phase)
+	if reason, _, _ := unstructured.NestedString(obj.Object, "status", "reason"); reason != "" {
+		errMsg += fmt.Sprintf("Reason: %s\n", reason)
+	}
+	if message, _, _ := unstructured.NestedString(obj.Object, "status", "message"); message != "" {
+		errMsg += fmt.Sprintf("Message: %s\n", message)
+	}
+	errMsg += "\n" + statusSection(obj)
+	return fmt.Errorf("%s", strings.TrimRight(errMsg, "\n"))
+}
+
+func (pw phaseWait) isFailPhase(phase string) bool {
+	if phase == "" {
+		return false
+	}
+	for _, failPhase := range pw.failPhases {
+		if phase == failPhase {
+			return true
+		}
+	}
+	return false
+}
+
+// buildPhaseTimeoutError creates the timeout error for phase waits
+func buildPhaseTimeoutError(current, original *unstructured.Unstructured,
+	checkFunc func(*unstructured.Unstructured) (bool, string), timeout time.Duration) error {
+	obj := current
+	if obj == nil {
+		obj = original
+	}
+	_, reason := checkFunc(obj)
+
+	errMsg := fmt.Sprintf("Wait Timeout: %s\n\n", readyResourceRef(obj))
+	errMsg += fmt.Sprintf("%s did not reach the expected phase within %v: %s\n\n", obj.GetKind(), timeout, reason)
+	errMsg += statusSection(obj)
+
+	errMsg += "Troubleshooting:\n"
+	errMsg += "• Increase timeout if the resource is legitimately slow:\n"
+	errMsg += "    wait_for = { phase = \"Running\", timeout = \"15m\" }\n"
+	if namespace := obj.GetNamespace(); namespace != "" {
+		errMsg += fmt.Sprintf("• Inspect the resource: kubectl describe %s %s -n %s\n", obj.GetKind(), obj.GetName(), namespace)
+	} else {
+		errMsg += fmt.Sprintf("• Inspect the resource: kubectl describe %s %s\n", obj.GetKind(), obj.GetName())
+	}
+	errMsg += "• List the phases the resource can't recover from in fail_phases to fail as soon as it enters one"
+
+	return &waitTimeoutError{message: errMsg, lastObserved: obj}
+}
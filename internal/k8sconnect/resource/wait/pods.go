@@ -0,0 +1,286 @@
+package wait
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/jmorris0x0/terraform-provider-k8sconnect/internal/k8sconnect/common/k8sclient"
+)
+
+// podsReadyWaitType names pods_ready waits in logs
+const podsReadyWaitType = "pods ready"
+
+// podsReadyKinds are the workloads pods_ready supports: those that select their pods with
+// spec.selector and say how many they want
+var podsReadyKinds = map[string]bool{
+	"Deployment":  true,
+	"StatefulSet": true,
+	"DaemonSet":   true,
+	"ReplicaSet":  true,
+}
+
+// podsReadyStatus is what a pods_ready check found for a workload
+type podsReadyStatus struct {
+	expected int64
+	ready    []string
+	notReady []string // "name: reason"
+	pending  string   // why the pods couldn't be checked, e.g. no desired count yet
+}
+
+// done reports whether the expected number of pods exist and all of them are Ready
+func (s podsReadyStatus) done() bool {
+	return s.pending == "" && len(s.notReady) == 0 && int64(len(s.ready)) == s.expected
+}
+
+// describe summarizes the status for logs and timeout errors
+func (s podsReadyStatus) describe() string {
+	if s.pending != "" {
+		return s.pending
+	}
+	return fmt.Sprintf("%d/%d pods Ready (%d not Ready)", len(s.ready), s.expected, len(s.notReady))
+}
+
+// waitForPodsReady polls a workload's pods until the expected number exist and every one
+// is Ready. Pods change without the workload changing, so this always polls, starting
+// with an immediate check.
+func (r *waitResource) waitForPodsReady(ctx context.Context, client k8sclient.K8sClient,
+	gvr schema.GroupVersionResource, obj *unstructured.Unstructured, timeout time.Duration, ps pollSettings) error {
+
+	ticker := time.NewTicker(ps.interval)
+	defer ticker.Stop()
+	next := pollSettings{pollOnly: true}.firstTick(ticker.C)
+
+	deadline := time.Now().Add(timeout)
+	var current *unstructured.Unstructured
+	var last podsReadyStatus
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-next:
+			next = ticker.C
+
+			workload, err := client.Get(ctx, gvr, obj.GetNamespace(), obj.GetName())
+			if err != nil {
+				logWaitGetError(ctx, err, podsReadyWaitType)
+			} else {
+				current = workload
+				status, err := checkPodsReady(ctx, client, workload)
+				if err != nil {
+					tflog.Warn(ctx, "Failed to list pods for pods_ready wait", map[string]interface{}{
+						"error": err.Error(),
+					})
+				} else {
+					last = status
+					if status.done() {
+						tflog.Info(ctx, "Pods ready", map[string]interface{}{
+							"resource": fmt.Sprintf("%s/%s", obj.GetKind(), obj.GetName()),
+							"pods":     len(status.ready),
+						})
+						return nil
+					}
+					tflog.Debug(ctx, "Pods not ready yet", map[string]interface{}{
+						"type":   podsReadyWaitType,
+						"reason": status.describe(),
+					})
+				}
+			}
+
+			if time.Now().After(deadline) {
+				return r.buildPodsReadyTimeoutError(ctx, client, current, obj, last, timeout)
+			}
+		}
+	}
+}
+
+// checkPodsReady lists the pods selected by workload and sorts them into Ready and not
+// Ready ones. Terminating pods are skipped: they are on their way out and don't count
+// toward the expected number. Until the controller has rolled out the current spec, the
+// selector still matches the previous revision's pods, so the check stays pending.
+func checkPodsReady(ctx context.Context, client k8sclient.K8sClient, workload *unstructured.Unstructured) (podsReadyStatus, error) {
+	var status podsReadyStatus
+
+	expected, pending := expectedPodCount(workload)
+	if pending == "" {
+		pending = podsRevisionPending(workload, expected)
+	}
+	if pending != "" {
+		status.pending = pending
+		return status, nil
+	}
+	status.expected = expected
+
+	selector, err := podSelector(workload)
+	if err != nil {
+		status.pending = err.Error()
+		return status, nil
+	}
+
+	podGVR := schema.GroupVersionResource{Version: "v1", Resource: "pods"}
+	pods, err := client.List(ctx, podGVR, workload.GetNamespace(), metav1.ListOptions{LabelSelector: selector})
+	if err != nil {
+		return status, err
+	}
+
+	for _, pod := range pods.Items {
+		if pod.GetDeletionTimestamp() != nil {
+			continue
+		}
+		if reason := podNotReadyReason(&pod); reason != "" {
+			status.notReady = append(status.notReady, fmt.Sprintf("%s: %s", pod.GetName(), reason))
+		} else {
+			status.ready = append(status.ready, pod.GetName())
+		}
+	}
+	sort.Strings(status.ready)
+	sort.Strings(status.notReady)
+	return status, nil
+}
+
+// expectedPodCount returns how many pods workload wants: spec.replicas (default 1), or a
+// DaemonSet's status.desiredNumberScheduled once its controller has reported it
+func expectedPodCount(workload *unstructured.Unstructured) (int64, string) {
+	if workload.GetKind() == "DaemonSet" {
+		observed, _, _ := unstructured.NestedInt64(workload.Object, "status", "observedGeneration")
+		desired, found, _ := unstructured.NestedInt64(workload.Object, "status", "desiredNumberScheduled")
+		if !found || observed == 0 {
+			return 0, "DaemonSet has not reported its desired pod count yet"
+		}
+		return desired, ""
+	}
+	replicas, found, _ := unstructured.NestedInt64(workload.Object, "spec", "replicas")
+	if !found {
+		return 1, ""
+	}
+	return replicas, ""
+}
+
+// podsRevisionPending says why workload's pods may still be a previous revision's: the
+// controller hasn't observed metadata.generation yet, or fewer than expected pods have
+// been updated to the current template. ReplicaSets have no revisions, so only the
+// generation is checked for them.
+func podsRevisionPending(workload *unstructured.Unstructured, expected int64) string {
+	generation := workload.GetGeneration()
+	observed, _, _ := unstructured.NestedInt64(workload.Object, "status", "observedGeneration")
+	if observed < generation {
+		return fmt.Sprintf("%s controller has not observed generation %d yet (observed %d)", workload.GetKind(), generation, observed)
+	}
+
+	updatedField := "updatedReplicas"
+	switch workload.GetKind() {
+	case "ReplicaSet":
+		return ""
+	case "DaemonSet":
+		updatedField = "updatedNumberScheduled"
+	}
+	updated, _, _ := unstructured.NestedInt64(workload.Object, "status", updatedField)
+	if updated < expected {
+		return fmt.Sprintf("%d/%d pods updated to the current revision", updated, expected)
+	}
+	return ""
+}
+
+// podSelector returns workload's spec.selector, matchLabels and matchExpressions alike, as
+// a label selector string
+func podSelector(workload *unstructured.Unstructured) (string, error) {
+	raw, found, _ := unstructured.NestedMap(workload.Object, "spec", "selector")
+	if !found || len(raw) == 0 {
+		return "", fmt.Errorf("%s has no spec.selector", workload.GetKind())
+	}
+	var labelSelector metav1.LabelSelector
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(raw, &labelSelector); err != nil {
+		return "", fmt.Errorf("invalid spec.selector: %w", err)
+	}
+	selector, err := metav1.LabelSelectorAsSelector(&labelSelector)
+	if err != nil {
+		return "", fmt.Errorf("invalid spec.selector: %w", err)
+	}
+	return selector.String(), nil
+}
+
+// podNotReadyReason returns why pod isn't Ready, or "" when its Ready condition is True
+func podNotReadyReason(pod *unstructured.Unstructured) string {
+	cond, found := readyCondition(pod, "Ready")
+	if found && cond.status == "True" {
+		return ""
+	}
+
+	// A container waiting (ImagePullBackOff, CrashLoopBackOff) says more than the condition
+	containerStatuses, _, _ := unstructured.NestedSlice(pod.Object, "status", "containerStatuses")
+	for _, cs := range containerStatuses {
+		csMap, ok := cs.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if reason, found, _ := unstructured.NestedString(csMap, "state", "waiting", "reason"); found && reason != "" {
+			name, _ := csMap["name"].(string)
+			return fmt.Sprintf("container %s %s", name, reason)
+		}
+	}
+
+	if scheduled, found := readyCondition(pod, "PodScheduled"); found && scheduled.status == "False" {
+		return fmt.Sprintf("not scheduled: %s", scheduled.describe())
+	}
+	if found {
+		return cond.describe()
+	}
+	phase, _, _ := unstructured.NestedString(pod.Object, "status", "phase")
+	if phase == "" {
+		phase = "unknown"
+	}
+	return fmt.Sprintf("phase %s", phase)
+}
+
+// buildPodsReadyTimeoutError creates the timeout error for pods_ready waits, listing the
+// pods that are not Ready and the container issues fetchPodIssues finds
+func (r *waitResource) buildPodsReadyTimeoutError(ctx context.Context, client k8sclient.K8sClient, current, original *unstructured.Unstructured,
+	status podsReadyStatus, timeout time.Duration) error {
+	obj := current
+	if obj == nil {
+		obj = original
+	}
+
+	errMsg := fmt.Sprintf("Wait Timeout: %s\n\n", readyResourceRef(obj))
+	errMsg += fmt.Sprintf("%s pods were not all Ready within %v\n\n", obj.GetKind(), timeout)
+
+	errMsg += "Current status:\n"
+	errMsg += fmt.Sprintf("  %s\n", status.describe())
+	if status.pending == "" && int64(len(status.ready)+len(status.notReady)) < status.expected {
+		errMsg += fmt.Sprintf("  Missing pods: %d (the controller hasn't created them, check its events and quota)\n",
+			status.expected-int64(len(status.ready)+len(status.notReady)))
+	}
+	if len(status.notReady) > 0 {
+		errMsg += "  Pods not Ready:\n"
+		for i, pod := range status.notReady {
+			if i == 5 {
+				errMsg += fmt.Sprintf("    • ... and %d more\n", len(status.notReady)-i)
+				break
+			}
+			errMsg += fmt.Sprintf("    • %s\n", pod)
+		}
+	}
+
+	if podIssues := r.fetchPodIssues(ctx, client, obj); len(podIssues) > 0 {
+		errMsg += "  Pod Issues:\n"
+		for _, issue := range podIssues {
+			errMsg += fmt.Sprintf("    • %s\n", issue)
+		}
+	}
+
+	if selector, err := podSelector(obj); err == nil {
+		errMsg += "\nTroubleshooting:\n"
+		errMsg += fmt.Sprintf("• List the pods: kubectl get pods -n %s -l '%s'\n", obj.GetNamespace(), selector)
+		errMsg += fmt.Sprintf("• Describe a pod that is not Ready: kubectl describe pod -n %s <name>\n", obj.GetNamespace())
+	}
+
+	return &waitTimeoutError{message: errMsg, lastObserved: current}
+}
@@ -0,0 +1,178 @@
+package wait
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/jmorris0x0/terraform-provider-k8sconnect/internal/k8sconnect/common/k8sclient"
+)
+
+const (
+	// pvcPhaseField and pvcBoundPhase are the field_value a pvc_bound wait waits for
+	pvcPhaseField = "status.phase"
+	pvcBoundPhase = "Bound"
+
+	// maxPVCEvents caps the events listed in a pvc_bound timeout error
+	maxPVCEvents = 5
+
+	defaultStorageClassAnnotation   = "storageclass.kubernetes.io/is-default-class"
+	bindingModeWaitForFirstConsumer = "WaitForFirstConsumer"
+)
+
+var storageClassGVR = schema.GroupVersionResource{Group: "storage.k8s.io", Version: "v1", Resource: "storageclasses"}
+
+// pvcBoundFieldValues returns the field_value equivalent of pvc_bound = true
+func pvcBoundFieldValues() map[string]string {
+	return map[string]string{pvcPhaseField: pvcBoundPhase}
+}
+
+// buildPVCTimeoutError creates the timeout error for pvc_bound waits. Binding is done by
+// the volume provisioner of the claim's StorageClass, which records its progress (e.g.
+// "waiting for a volume to be created") as events on the claim, so both are included.
+func (r *waitResource) buildPVCTimeoutError(ctx context.Context, client k8sclient.K8sClient, current, original *unstructured.Unstructured, timeout time.Duration) error {
+	obj := current
+	if obj == nil {
+		obj = original
+	}
+
+	name := obj.GetName()
+	namespace := obj.GetNamespace()
+
+	phase, _, _ := unstructured.NestedString(obj.Object, "status", "phase")
+	if phase == "" {
+		phase = "<not set>"
+	}
+
+	errMsg := "Wait Timeout\n\n"
+	errMsg += fmt.Sprintf("PersistentVolumeClaim %q in namespace %q was not bound within %v (status.phase: %s)\n\n", name, namespace, timeout, phase)
+
+	errMsg += r.storageClassSection(ctx, client, obj)
+
+	if events := r.pvcEvents(ctx, client, obj); len(events) > 0 {
+		errMsg += "Recent events:\n"
+		for _, event := range events {
+			errMsg += fmt.Sprintf("• %s\n", event)
+		}
+		errMsg += "\n"
+	}
+
+	errMsg += "Common causes:\n"
+	errMsg += "• The StorageClass uses volumeBindingMode WaitForFirstConsumer, so the claim binds only once a pod using it is scheduled\n"
+	errMsg += "• The StorageClass's provisioner (CSI driver) is not installed or not running\n"
+	errMsg += "• No existing PersistentVolume matches the claim's size, access modes, and storageClassName\n"
+	errMsg += "• The cloud provider is slow to create the volume or has hit a quota\n\n"
+
+	errMsg += "Troubleshooting:\n"
+	errMsg += "• Increase timeout if the volume is legitimately slow to provision:\n"
+	errMsg += "    wait_for = { pvc_bound = true, timeout = \"10m\" }\n"
+	errMsg += "• Inspect the claim and its events:\n"
+	errMsg += fmt.Sprintf("    kubectl describe pvc %s -n %s\n", name, namespace)
+	errMsg += "• Check the StorageClasses and their provisioners:\n"
+	errMsg += "    kubectl get storageclass\n"
+
+	return &waitTimeoutError{message: errMsg, lastObserved: current}
+}
+
+// storageClassSection describes the StorageClass the claim uses: spec.storageClassName,
+// or the default class when it is not set. Lookup failures are logged and skipped: the
+// section only adds context.
+func (r *waitResource) storageClassSection(ctx context.Context, client k8sclient.K8sClient, obj *unstructured.Unstructured) string {
+	className, classNameSet, _ := unstructured.NestedString(obj.Object, "spec", "storageClassName")
+	if classNameSet && className == "" {
+		return "StorageClass: none (storageClassName is \"\"), so only a pre-created PersistentVolume without a class can bind the claim\n\n"
+	}
+
+	classes, err := client.List(ctx, storageClassGVR, "", metav1.ListOptions{})
+	if err != nil {
+		tflog.Warn(ctx, "Failed to list StorageClasses for timeout diagnostics", map[string]interface{}{
+			"error": err.Error(),
+		})
+		if className != "" {
+			return fmt.Sprintf("StorageClass: %s\n\n", className)
+		}
+		return ""
+	}
+
+	class := findStorageClass(className, classes.Items)
+	switch {
+	case class == nil && className != "":
+		return fmt.Sprintf("Warning: StorageClass %q from spec.storageClassName does not exist, so no provisioner will create a volume\n\n", className)
+	case class == nil:
+		return fmt.Sprintf("Warning: spec.storageClassName is not set and no StorageClass is marked as default (%s=true), "+
+			"so only a pre-created PersistentVolume can bind the claim\n\n", defaultStorageClassAnnotation)
+	}
+
+	section := fmt.Sprintf("StorageClass: %s", class.GetName())
+	if className == "" {
+		section += " (cluster default)"
+	}
+	section += "\n"
+	if provisioner, _, _ := unstructured.NestedString(class.Object, "provisioner"); provisioner != "" {
+		section += fmt.Sprintf("  Provisioner: %s\n", provisioner)
+	}
+	if mode, _, _ := unstructured.NestedString(class.Object, "volumeBindingMode"); mode != "" {
+		section += fmt.Sprintf("  Volume binding mode: %s\n", mode)
+		if mode == bindingModeWaitForFirstConsumer {
+			section += "  The claim stays Pending until a pod that uses it is scheduled; wait for the pod instead of the claim\n"
+		}
+	}
+	return section + "\n"
+}
+
+// findStorageClass returns the StorageClass named className, or the default class when
+// className is empty. Nil if there is none.
+func findStorageClass(className string, classes []unstructured.Unstructured) *unstructured.Unstructured {
+	for i := range classes {
+		class := &classes[i]
+		if className != "" && class.GetName() == className {
+			return class
+		}
+		if className == "" && class.GetAnnotations()[defaultStorageClassAnnotation] == "true" {
+			return class
+		}
+	}
+	return nil
+}
+
+// pvcEvents returns the most recent events recorded on the claim, newest last, formatted
+// as "Type Reason: message". Provisioners record progress as Normal events, so both
+// types are included.
+func (r *waitResource) pvcEvents(ctx context.Context, client k8sclient.K8sClient, obj *unstructured.Unstructured) []string {
+	events, err := client.List(ctx, eventGVR, obj.GetNamespace(), metav1.ListOptions{
+		FieldSelector: fmt.Sprintf("involvedObject.kind=PersistentVolumeClaim,involvedObject.name=%s", obj.GetName()),
+	})
+	if err != nil {
+		tflog.Warn(ctx, "Failed to list PersistentVolumeClaim events for timeout diagnostics", map[string]interface{}{
+			"error": err.Error(),
+		})
+		return nil
+	}
+
+	items := events.Items
+	sort.SliceStable(items, func(i, j int) bool {
+		return eventLastSeen(items[i].Object).Before(eventLastSeen(items[j].Object))
+	})
+	if len(items) > maxPVCEvents {
+		items = items[len(items)-maxPVCEvents:]
+	}
+
+	formatted := make([]string, 0, len(items))
+	for _, item := range items {
+		eventType, _, _ := unstructured.NestedString(item.Object, "type")
+		reason, _, _ := unstructured.NestedString(item.Object, "reason")
+		message, _, _ := unstructured.NestedString(item.Object, "message")
+		entry := fmt.Sprintf("%s %s: %s", eventType, reason, message)
+		if count := eventCount(item.Object); count > 1 {
+			entry += fmt.Sprintf(" (x%d)", count)
+		}
+		formatted = append(formatted, entry)
+	}
+	return formatted
+}
@@ -0,0 +1,245 @@
+package wait
+
+import (
+	"fmt"
+	"time"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// ready waits compute readiness the way kstatus (sigs.k8s.io/cli-utils/pkg/kstatus) does:
+// generic conventions (observedGeneration, Reconciling/Stalled/Ready conditions) for any
+// resource, plus the built-in rules for workloads, Pods, PVCs, Services, Jobs, and CRDs.
+//
+// The rules are a copy of kstatus's status.Compute, not a call into it: cli-utils is not a
+// dependency of this module. The copy doesn't follow kstatus releases, so a change to
+// those rules has to be made here by hand, and the docs for ready describe this copy.
+const (
+	readyWaitType = "ready"
+
+	readyStatusCurrent     = "Current"
+	readyStatusInProgress  = "InProgress"
+	readyStatusFailed      = "Failed"
+	readyStatusTerminating = "Terminating"
+)
+
+// readyStatus is the computed status of an object and a message explaining it
+type readyStatus struct {
+	status  string
+	message string
+}
+
+// checkReady reports whether obj is Current, for waitWithCheck
+func checkReady(obj *unstructured.Unstructured) (bool, string) {
+	rs := computeReadyStatus(obj)
+	if rs.status == readyStatusCurrent {
+		return true, ""
+	}
+	return false, fmt.Sprintf("%s: %s", rs.status, rs.message)
+}
+
+// readyFailedError returns an error when obj's status is Failed. A failed object won't
+// become ready without intervention, so the wait ends instead of running to its timeout.
+func readyFailedError(obj *unstructured.Unstructured) error {
+	rs := computeReadyStatus(obj)
+	if rs.status != readyStatusFailed {
+		return nil
+	}
+	return fmt.Errorf("Resource Failed: %s\n\n"+
+		"%s reports status Failed and will not become ready without intervention.\n\n"+
+		"Status: %s\n"+
+		"Message: %s\n\n"+
+		"%s", readyResourceRef(obj), obj.GetKind(), rs.status, rs.message, statusSection(obj))
+}
+
+// computeReadyStatus computes obj's readiness as Current, InProgress, Failed, or Terminating
+func computeReadyStatus(obj *unstructured.Unstructured) readyStatus {
+	if obj.GetDeletionTimestamp() != nil {
+		return readyStatus{readyStatusTerminating, "resource scheduled for deletion"}
+	}
+
+	// Generic conventions apply to every resource, built-in or custom
+	generation := obj.GetGeneration()
+	if observedGen, found, _ := unstructured.NestedInt64(obj.Object, "status", "observedGeneration"); found && observedGen < generation {
+		return readyStatus{readyStatusInProgress,
+			fmt.Sprintf("%s generation is %d, but latest observed generation is %d", obj.GetKind(), generation, observedGen)}
+	}
+	if cond, found := readyCondition(obj, "Reconciling"); found && cond.status == "True" {
+		return readyStatus{readyStatusInProgress, cond.describe()}
+	}
+	if cond, found := readyCondition(obj, "Stalled"); found && cond.status == "True" {
+		return readyStatus{readyStatusFailed, cond.describe()}
+	}
+
+	gvk := obj.GroupVersionKind()
+	switch {
+	case gvk.Group == "apps" && gvk.Kind == "Deployment":
+		if isDeploymentProgressDeadlineExceeded(obj) {
+			return readyStatus{readyStatusFailed, "Progress deadline exceeded"}
+		}
+		return readyFromCheck(checkDeploymentRollout(obj))
+	case gvk.Group == "apps" && gvk.Kind == "StatefulSet":
+		return readyFromCheck(checkStatefulSetRollout(obj))
+	case gvk.Group == "apps" && gvk.Kind == "DaemonSet":
+		return readyFromCheck(checkDaemonSetRollout(obj))
+	case gvk.Group == "apps" && gvk.Kind == "ReplicaSet":
+		return replicaSetReadyStatus(obj)
+	case gvk.Group == "" && gvk.Kind == "Pod":
+		return podReadyStatus(obj)
+	case gvk.Group == "" && gvk.Kind == "PersistentVolumeClaim":
+		phase, _, _ := unstructured.NestedString(obj.Object, "status", "phase")
+		if phase != pvcBoundPhase {
+			return readyStatus{readyStatusInProgress, fmt.Sprintf("PVC is not Bound. phase: %s", phase)}
+		}
+		return readyStatus{readyStatusCurrent, "PVC is Bound"}
+	case gvk.Group == "" && gvk.Kind == "Service":
+		serviceType, _, _ := unstructured.NestedString(obj.Object, "spec", "type")
+		if serviceType == "LoadBalancer" && len(ingressAddresses(obj)) == 0 {
+			return readyStatus{readyStatusInProgress, "Pending external IP"}
+		}
+		return readyStatus{readyStatusCurrent, "Service is ready"}
+	case gvk.Group == "batch" && gvk.Kind == "Job":
+		return jobReadyStatus(obj)
+	case gvk.Group == "apiextensions.k8s.io" && gvk.Kind == "CustomResourceDefinition":
+		if cond, found := readyCondition(obj, "NamesAccepted"); found && cond.status == "False" {
+			return readyStatus{readyStatusFailed, cond.describe()}
+		}
+		if cond, found := readyCondition(obj, "Established"); found && cond.status == "True" {
+			return readyStatus{readyStatusCurrent, "CRD is established"}
+		}
+		return readyStatus{readyStatusInProgress, "CRD is not established"}
+	}
+
+	// Anything else is ready unless it reports Ready=False
+	if cond, found := readyCondition(obj, "Ready"); found && cond.status != "True" {
+		return readyStatus{readyStatusInProgress, cond.describe()}
+	}
+	return readyStatus{readyStatusCurrent, "Resource is current"}
+}
+
+// readyFromCheck converts the result of a rollout check into a readyStatus
+func readyFromCheck(ready bool, reason string) readyStatus {
+	if ready {
+		return readyStatus{readyStatusCurrent, "Rollout complete"}
+	}
+	return readyStatus{readyStatusInProgress, reason}
+}
+
+func replicaSetReadyStatus(obj *unstructured.Unstructured) readyStatus {
+	replicas, found, _ := unstructured.NestedInt64(obj.Object, "spec", "replicas")
+	if !found {
+		replicas = 1
+	}
+	readyReplicas, _, _ := unstructured.NestedInt64(obj.Object, "status", "readyReplicas")
+	availableReplicas, _, _ := unstructured.NestedInt64(obj.Object, "status", "availableReplicas")
+	if readyReplicas < replicas || availableReplicas < replicas {
+		return readyStatus{readyStatusInProgress,
+			fmt.Sprintf("Ready: %d/%d, available: %d/%d", readyReplicas, replicas, availableReplicas, replicas)}
+	}
+	return readyStatus{readyStatusCurrent, fmt.Sprintf("ReplicaSet is available. Replicas: %d", replicas)}
+}
+
+func podReadyStatus(obj *unstructured.Unstructured) readyStatus {
+	phase, _, _ := unstructured.NestedString(obj.Object, "status", "phase")
+	switch phase {
+	case "Succeeded":
+		return readyStatus{readyStatusCurrent, "Pod has completed successfully"}
+	case "Failed":
+		return readyStatus{readyStatusFailed, "Pod has completed, but not successfully"}
+	case "Running":
+		if cond, found := readyCondition(obj, "Ready"); found && cond.status == "True" {
+			return readyStatus{readyStatusCurrent, "Pod is Ready"}
+		}
+		return readyStatus{readyStatusInProgress, "Pod is running but is not Ready"}
+	}
+	if cond, found := readyCondition(obj, "PodScheduled"); found && cond.status == "False" && cond.reason == "Unschedulable" {
+		return readyStatus{readyStatusInProgress, "Pod could not be scheduled"}
+	}
+	return readyStatus{readyStatusInProgress, fmt.Sprintf("Pod phase is %s", phase)}
+}
+
+func jobReadyStatus(obj *unstructured.Unstructured) readyStatus {
+	if cond, found := readyCondition(obj, "Complete"); found && cond.status == "True" {
+		return readyStatus{readyStatusCurrent, "Job Completed"}
+	}
+	if cond, found := readyCondition(obj, "Failed"); found && cond.status == "True" {
+		return readyStatus{readyStatusFailed, cond.describe()}
+	}
+	active, _, _ := unstructured.NestedInt64(obj.Object, "status", "active")
+	succeeded, _, _ := unstructured.NestedInt64(obj.Object, "status", "succeeded")
+	failed, _, _ := unstructured.NestedInt64(obj.Object, "status", "failed")
+	return readyStatus{readyStatusInProgress,
+		fmt.Sprintf("Job in progress. success: %d, active: %d, failed: %d", succeeded, active, failed)}
+}
+
+// readyConditionState is one status.conditions entry
+type readyConditionState struct {
+	conditionType string
+	status        string
+	reason        string
+	message       string
+}
+
+// describe returns the condition's message, falling back to its reason
+func (c readyConditionState) describe() string {
+	switch {
+	case c.message != "":
+		return c.message
+	case c.reason != "":
+		return fmt.Sprintf("%s: %s", c.conditionType, c.reason)
+	default:
+		return fmt.Sprintf("%s is %s", c.conditionType, c.status)
+	}
+}
+
+// readyCondition returns the status.conditions entry of conditionType
+func readyCondition(obj *unstructured.Unstructured, conditionType string) (readyConditionState, bool) {
+	conditions, _, _ := unstructured.NestedSlice(obj.Object, "status", "conditions")
+	for _, cond := range conditions {
+		condMap, ok := cond.(map[string]interface{})
+		if !ok || condMap["type"] != conditionType {
+			continue
+		}
+		state := readyConditionState{conditionType: conditionType}
+		state.status, _ = condMap["status"].(string)
+		state.reason, _ = condMap["reason"].(string)
+		state.message, _ = condMap["message"].(string)
+		return state, true
+	}
+	return readyConditionState{}, false
+}
+
+func readyResourceRef(obj *unstructured.Unstructured) string {
+	if namespace := obj.GetNamespace(); namespace != "" {
+		return fmt.Sprintf("%s/%s/%s", obj.GetKind(), namespace, obj.GetName())
+	}
+	return fmt.Sprintf("%s/%s", obj.GetKind(), obj.GetName())
+}
+
+// buildReadyTimeoutError creates the timeout error for ready waits, with the computed
+// status and message of the last observed state
+func buildReadyTimeoutError(current, original *unstructured.Unstructured, timeout time.Duration) error {
+	obj := current
+	if obj == nil {
+		obj = original
+	}
+	rs := computeReadyStatus(obj)
+
+	errMsg := fmt.Sprintf("Wait Timeout: %s\n\n", readyResourceRef(obj))
+	errMsg += fmt.Sprintf("%s did not become ready within %v\n\n", obj.GetKind(), timeout)
+	errMsg += fmt.Sprintf("Status: %s\n", rs.status)
+	errMsg += fmt.Sprintf("Message: %s\n\n", rs.message)
+	errMsg += statusSection(obj)
+
+	errMsg += "Troubleshooting:\n"
+	errMsg += "• Increase timeout if the resource is legitimately slow:\n"
+	errMsg += "    wait_for = { ready = true, timeout = \"15m\" }\n"
+	if namespace := obj.GetNamespace(); namespace != "" {
+		errMsg += fmt.Sprintf("• Inspect the resource: kubectl describe %s %s -n %s\n", obj.GetKind(), obj.GetName(), namespace)
+	} else {
+		errMsg += fmt.Sprintf("• Inspect the resource: kubectl describe %s %s\n", obj.GetKind(), obj.GetName())
+	}
+	errMsg += "• For custom resources that don't follow the status conventions, wait on a condition or field instead"
+
+	return &waitTimeoutError{message: errMsg, lastObserved: obj}
+}
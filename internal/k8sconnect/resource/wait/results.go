@@ -0,0 +1,132 @@
+package wait
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/util/jsonpath"
+)
+
+// recordResults reads the object after waitConfig's wait completed and adds the values
+// it observed to wc.Results. With steps, each step records its values as it completes,
+// so a later step doesn't overwrite what an earlier one waited for with newer state.
+func (r *waitResource) recordResults(ctx context.Context, wc *waitContext, waitConfig waitForModel) {
+	current, err := wc.Client.Get(ctx, wc.GVR, wc.ObjectRef.Namespace.ValueString(), wc.ObjectRef.Name.ValueString())
+	if err != nil {
+		tflog.Warn(ctx, "Failed to read observed values after wait", map[string]interface{}{"error": err.Error()})
+		return
+	}
+
+	if wc.Results == nil {
+		wc.Results = make(map[string]string)
+	}
+	for key, value := range observedValues(current, waitConfig) {
+		wc.Results[key] = value
+	}
+}
+
+// observedValues returns the values a completed wait observed on obj, keyed by field path
+// for field, field_value, pvc_bound, cronjob_scheduled, phase, and ingress_ready waits and by condition type for
+// condition and apiservice_available waits. Rollout waits track replica counts rather than a single value and
+// contribute nothing.
+func observedValues(obj *unstructured.Unstructured, waitConfig waitForModel) map[string]string {
+	values := make(map[string]string)
+	switch {
+	case waitConfig.Rollout.ValueBool():
+	case waitConfig.IngressReady.ValueBool():
+		if addresses := ingressAddresses(obj); len(addresses) > 0 {
+			values[ingressAddressField] = strings.Join(addresses, ",")
+		}
+	case waitConfig.PVCBound.ValueBool():
+		for field := range pvcBoundFieldValues() {
+			if value, ok := observedFieldValue(obj, field); ok {
+				values[field] = value
+			}
+		}
+	case waitConfig.CronJobScheduled.ValueBool():
+		for _, field := range []string{cronJobLastScheduleField, cronJobActiveField} {
+			if value, ok := observedFieldValue(obj, field); ok {
+				values[field] = value
+			}
+		}
+	case waitConfig.APIServiceAvailable.ValueBool():
+		if status, ok := observedConditionStatus(obj, apiServiceAvailableCondition); ok {
+			values[apiServiceAvailableCondition] = status
+		}
+	case !waitConfig.Phase.IsNull() && waitConfig.Phase.ValueString() != "":
+		if value, ok := observedFieldValue(obj, phaseField); ok {
+			values[phaseField] = value
+		}
+	case !waitConfig.Field.IsNull() && waitConfig.Field.ValueString() != "":
+		if value, ok := observedFieldValue(obj, waitConfig.Field.ValueString()); ok {
+			values[waitConfig.Field.ValueString()] = value
+		}
+	case !waitConfig.FieldValue.IsNull():
+		for field := range waitConfig.FieldValue.Elements() {
+			if value, ok := observedFieldValue(obj, field); ok {
+				values[field] = value
+			}
+		}
+	case !waitConfig.Condition.IsNull() && waitConfig.Condition.ValueString() != "":
+		if status, ok := observedConditionStatus(obj, waitConfig.Condition.ValueString()); ok {
+			values[waitConfig.Condition.ValueString()] = status
+		}
+	}
+	return values
+}
+
+// observedFieldValue returns the value at fieldPath formatted the way field_value
+// compares it; maps and lists are JSON-encoded
+func observedFieldValue(obj *unstructured.Unstructured, fieldPath string) (string, bool) {
+	jp := jsonpath.New("result")
+	if err := jp.Parse(fmt.Sprintf("{.%s}", fieldPath)); err != nil {
+		return "", false
+	}
+	results, err := jp.FindResults(obj.Object)
+	if err != nil || len(results) == 0 || len(results[0]) == 0 {
+		return "", false
+	}
+
+	value := results[0][0].Interface()
+	switch value.(type) {
+	case map[string]interface{}, []interface{}:
+		encoded, err := json.Marshal(value)
+		if err != nil {
+			return "", false
+		}
+		return string(encoded), true
+	default:
+		return fmt.Sprintf("%v", value), true
+	}
+}
+
+// observedConditionStatus returns the status of the conditionType entry in status.conditions
+func observedConditionStatus(obj *unstructured.Unstructured, conditionType string) (string, bool) {
+	conditions, _, _ := unstructured.NestedSlice(obj.Object, "status", "conditions")
+	for _, cond := range conditions {
+		condMap, ok := cond.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if condType, _ := condMap["type"].(string); condType == conditionType {
+			status, ok := condMap["status"].(string)
+			return status, ok
+		}
+	}
+	return "", false
+}
+
+// resultsValue converts the recorded observed values into the results attribute;
+// null when the wait observed no values
+func resultsValue(ctx context.Context, results map[string]string) (types.Map, diag.Diagnostics) {
+	if len(results) == 0 {
+		return types.MapNull(types.StringType), nil
+	}
+	return types.MapValueFrom(ctx, types.StringType, results)
+}
@@ -0,0 +1,118 @@
+package wait
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/jmorris0x0/terraform-provider-k8sconnect/internal/k8sconnect/common/k8sclient"
+)
+
+// waitWithRolloutCheck is waitWithCheck for rollouts. It also ends the wait early when the
+// workload is changed from outside so the rollout awaited can't complete, or when a
+// Deployment exceeded its progress deadline.
+func (r *waitResource) waitWithRolloutCheck(ctx context.Context, client k8sclient.K8sClient,
+	gvr schema.GroupVersionResource, obj *unstructured.Unstructured,
+	checkFunc func(*unstructured.Unstructured) (bool, string), waitType string, timeout time.Duration, ps pollSettings) error {
+
+	baseline := &rolloutBaseline{obj: obj}
+
+	// A workload scaled to zero passes every rollout check, so report it not ready and
+	// let failFunc end the wait with the reason
+	guardedCheck := func(current *unstructured.Unstructured) (bool, string) {
+		if err := baseline.changeError(current); err != nil {
+			return false, "changed outside of Terraform during the wait"
+		}
+		return checkFunc(current)
+	}
+	failFunc := func(current *unstructured.Unstructured) error {
+		if err := baseline.changeError(current); err != nil {
+			return err
+		}
+		if err := deploymentProgressDeadlineError(current); err != nil {
+			return err
+		}
+		return failFastError(current, waitType)
+	}
+	return r.waitWithFailCheck(ctx, client, gvr, obj, guardedCheck, failFunc, baseline.deleted, waitType, timeout, ps)
+}
+
+// rolloutBaseline is the workload state a rollout wait compares against. A wait that sees
+// the workload deleted follows it across the recreation, like every other wait: the
+// recreated object becomes the baseline and its rollout is the one waited for. Without an
+// observed deletion, a generation that went backwards still fails the wait.
+type rolloutBaseline struct {
+	obj      *unstructured.Unstructured
+	recreate bool
+}
+
+// deleted records that the wait saw the workload gone
+func (b *rolloutBaseline) deleted() {
+	b.recreate = true
+}
+
+// changeError is externalRolloutChangeError against the baseline, first adopting current
+// as the baseline when it is the first object seen after a deletion
+func (b *rolloutBaseline) changeError(current *unstructured.Unstructured) error {
+	if b.recreate && current != nil {
+		b.obj = current
+		b.recreate = false
+	}
+	return externalRolloutChangeError(b.obj, current)
+}
+
+// externalRolloutChangeError compares the workload with the baseline read when the wait
+// started. It returns an error when spec.replicas dropped to zero, e.g. by a failing HPA
+// or a manual kubectl scale, or when metadata.generation went backwards because the
+// object was deleted and recreated without the wait seeing the deletion. Either way the
+// rollout awaited won't happen.
+func externalRolloutChangeError(baseline, current *unstructured.Unstructured) error {
+	if baseline == nil || current == nil {
+		return nil
+	}
+
+	resourceRef := fmt.Sprintf("%s/%s", current.GetKind(), current.GetName())
+	if namespace := current.GetNamespace(); namespace != "" {
+		resourceRef = fmt.Sprintf("%s/%s/%s", current.GetKind(), namespace, current.GetName())
+	}
+
+	baseGeneration := baseline.GetGeneration()
+	if generation := current.GetGeneration(); generation > 0 && generation < baseGeneration {
+		return fmt.Errorf("Rollout Target Recreated: %s\n\n"+
+			"metadata.generation went from %d to %d during the wait. The object was deleted and recreated "+
+			"outside of Terraform, so the rollout being waited for no longer exists.\n\n"+
+			"Check what recreated it, then run terraform apply again.",
+			resourceRef, baseGeneration, generation)
+	}
+
+	if kind := current.GetKind(); kind != "Deployment" && kind != "StatefulSet" {
+		return nil
+	}
+	baseReplicas := specReplicas(baseline)
+	if replicas := specReplicas(current); baseReplicas > 0 && replicas == 0 {
+		scaleCmd := fmt.Sprintf("kubectl scale %s/%s --replicas=%d", strings.ToLower(current.GetKind()), current.GetName(), baseReplicas)
+		if namespace := current.GetNamespace(); namespace != "" {
+			scaleCmd += " -n " + namespace
+		}
+		return fmt.Errorf("Rollout Target Scaled to Zero: %s\n\n"+
+			"spec.replicas dropped from %d to 0 during the wait. Something outside of Terraform scaled the workload "+
+			"down, e.g. a HorizontalPodAutoscaler, a manual 'kubectl scale', or another controller, so there are no "+
+			"pods left to roll out.\n\n"+
+			"Find and stop what scaled it down, then restore the replicas with terraform apply or:\n"+
+			"  %s", resourceRef, baseReplicas, scaleCmd)
+	}
+	return nil
+}
+
+// specReplicas returns spec.replicas, defaulting to 1 like the API server
+func specReplicas(obj *unstructured.Unstructured) int64 {
+	replicas, found, _ := unstructured.NestedInt64(obj.Object, "spec", "replicas")
+	if !found {
+		return 1
+	}
+	return replicas
+}
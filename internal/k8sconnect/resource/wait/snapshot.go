@@ -0,0 +1,111 @@
+package wait
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/yaml"
+)
+
+// maxStatusDumpBytes caps the status shown in timeout errors, so a controller reporting
+// a large status doesn't flood the diagnostic
+const maxStatusDumpBytes = 2048
+
+// redactedValue replaces Secret values in timeout snapshots so they don't end up in CI logs
+const redactedValue = "<redacted>"
+
+// waitFailureDetail returns the diagnostic detail for a failed wait. With
+// wait_for.snapshot_on_timeout, a timeout also shows the last object the wait observed,
+// so the failing state is captured without a follow-up kubectl get.
+func waitFailureDetail(err error, waitConfig waitForModel) string {
+	detail := err.Error()
+	if !waitConfig.SnapshotOnTimeout.ValueBool() {
+		return detail
+	}
+
+	var timeoutErr *waitTimeoutError
+	if !errors.As(err, &timeoutErr) || timeoutErr.lastObserved == nil {
+		return detail
+	}
+
+	snapshot, snapErr := formatObjectSnapshot(timeoutErr.lastObserved)
+	if snapErr != nil {
+		return detail + fmt.Sprintf("\n\nLast observed object could not be rendered: %s", snapErr)
+	}
+
+	return detail + "\n\nLast observed object (wait_for.snapshot_on_timeout):\n" +
+		"---\n" + snapshot + "---"
+}
+
+// formatObjectSnapshot renders obj as YAML without managedFields. Secret data is redacted;
+// keys are kept so missing entries are still visible.
+func formatObjectSnapshot(obj *unstructured.Unstructured) (string, error) {
+	snapshot := obj.DeepCopy()
+	unstructured.RemoveNestedField(snapshot.Object, "metadata", "managedFields")
+
+	if snapshot.GetKind() == "Secret" && snapshot.GroupVersionKind().Group == "" {
+		for _, field := range []string{"data", "stringData"} {
+			values, found, _ := unstructured.NestedMap(snapshot.Object, field)
+			if !found {
+				continue
+			}
+			for key := range values {
+				values[key] = redactedValue
+			}
+			_ = unstructured.SetNestedMap(snapshot.Object, values, field)
+		}
+		// kubectl.kubernetes.io/last-applied-configuration repeats the data in plain text
+		annotations := snapshot.GetAnnotations()
+		if _, ok := annotations["kubectl.kubernetes.io/last-applied-configuration"]; ok {
+			annotations["kubectl.kubernetes.io/last-applied-configuration"] = redactedValue
+			snapshot.SetAnnotations(annotations)
+		}
+	}
+
+	out, err := yaml.Marshal(snapshot.Object)
+	if err != nil {
+		return "", err
+	}
+	return string(out), nil
+}
+
+// formatStatusDump renders the status of obj as indented YAML for timeout errors, without
+// the top-level fields in omit (e.g. conditions already listed). Output beyond
+// maxStatusDumpBytes is cut at a line boundary. Returns "" when there is nothing to show.
+func formatStatusDump(obj *unstructured.Unstructured, omit ...string) string {
+	status, found, _ := unstructured.NestedMap(obj.Object, "status")
+	if !found {
+		return ""
+	}
+	for _, field := range omit {
+		delete(status, field)
+	}
+	if len(status) == 0 {
+		return ""
+	}
+
+	out, err := yaml.Marshal(status)
+	if err != nil {
+		return ""
+	}
+	dump := string(out)
+
+	var truncated int
+	if len(dump) > maxStatusDumpBytes {
+		cut := strings.LastIndex(dump[:maxStatusDumpBytes], "\n") + 1
+		if cut == 0 {
+			cut = maxStatusDumpBytes
+		}
+		truncated = len(dump) - cut
+		dump = strings.ToValidUTF8(dump[:cut], "")
+	}
+
+	lines := strings.Split(strings.TrimSuffix(dump, "\n"), "\n")
+	result := "    " + strings.Join(lines, "\n    ") + "\n"
+	if truncated > 0 {
+		result += fmt.Sprintf("    ... (%d more bytes truncated)\n", truncated)
+	}
+	return result
+}
@@ -0,0 +1,390 @@
+package wait
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/jmorris0x0/terraform-provider-k8sconnect/internal/k8sconnect/common/validators"
+)
+
+// waitStepModel is one entry of wait_for.steps. Mode, poll_interval, and
+// snapshot_on_timeout are shared by all steps and come from wait_for itself.
+type waitStepModel struct {
+	Field               types.String `tfsdk:"field"`
+	FieldValue          types.Map    `tfsdk:"field_value"`
+	Condition           types.String `tfsdk:"condition"`
+	SuccessReasons      types.List   `tfsdk:"success_reasons"`
+	Rollout             types.Bool   `tfsdk:"rollout"`
+	IngressReady        types.Bool   `tfsdk:"ingress_ready"`
+	PVCBound            types.Bool   `tfsdk:"pvc_bound"`
+	CronJobScheduled    types.Bool   `tfsdk:"cronjob_scheduled"`
+	APIServiceAvailable types.Bool   `tfsdk:"apiservice_available"`
+	PodsReady           types.Bool   `tfsdk:"pods_ready"`
+	Ready               types.Bool   `tfsdk:"ready"`
+	Phase               types.String `tfsdk:"phase"`
+	FailPhases          types.List   `tfsdk:"fail_phases"`
+	Strict              types.Bool   `tfsdk:"strict"`
+	MinReadyPercent     types.Int64  `tfsdk:"min_ready_percent"`
+	Timeout             types.String `tfsdk:"timeout"`
+}
+
+// waitStepAttributes returns the schema of a wait_for.steps entry
+func waitStepAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"field": schema.StringAttribute{
+			Optional:    true,
+			Description: "JSONPath to field that must exist/be non-empty. Example: 'status.endpoint'",
+			Validators: []validator.String{
+				validators.JSONPath{},
+			},
+		},
+		"field_value": schema.MapAttribute{
+			Optional:    true,
+			ElementType: types.StringType,
+			Description: "Map of JSONPath to expected value. Example: {'status.phase': 'Running'}",
+			Validators: []validator.Map{
+				validators.JSONPathMapKeys{},
+			},
+		},
+		"condition": schema.StringAttribute{
+			Optional:    true,
+			Description: "Condition type that must be True. Example: 'Reconciled'",
+		},
+		"success_reasons": schema.ListAttribute{
+			Optional:    true,
+			ElementType: types.StringType,
+			Description: "Reasons that also satisfy this condition step, whatever the condition's status. Example: ['Completed']. Requires condition.",
+		},
+		"rollout": schema.BoolAttribute{
+			Optional:    true,
+			Description: "Wait for Deployment/StatefulSet/DaemonSet to complete rollout.",
+		},
+		"ingress_ready": schema.BoolAttribute{
+			Optional:    true,
+			Description: "Wait for an Ingress to be assigned an address in status.loadBalancer.ingress.",
+		},
+		"pvc_bound": schema.BoolAttribute{
+			Optional:    true,
+			Description: "Wait for a PersistentVolumeClaim to be bound to a volume (status.phase = Bound).",
+		},
+		"cronjob_scheduled": schema.BoolAttribute{
+			Optional:    true,
+			Description: "Wait for a CronJob to schedule a Job (status.lastScheduleTime set or an active Job).",
+		},
+		"apiservice_available": schema.BoolAttribute{
+			Optional:    true,
+			Description: "Wait for an APIService's Available condition to be True.",
+		},
+		"pods_ready": schema.BoolAttribute{
+			Optional:    true,
+			Description: "Wait for the expected number of a workload's pods to exist and all be Ready.",
+		},
+		"ready": schema.BoolAttribute{
+			Optional:    true,
+			Description: "Wait for the resource to be ready by the kstatus conventions (status Current), for built-in kinds and CRDs alike.",
+		},
+		"phase": schema.StringAttribute{
+			Optional:    true,
+			Description: "Wait for status.phase to equal this value, failing as soon as it enters one of fail_phases. Example: 'Succeeded'",
+		},
+		"fail_phases": schema.ListAttribute{
+			Optional:    true,
+			ElementType: types.StringType,
+			Description: "Phases that end this phase step with an error immediately. Example: ['Failed']. Requires phase.",
+		},
+		"min_ready_percent": schema.Int64Attribute{
+			Optional:    true,
+			Description: "Complete this rollout step once at least this percentage (1-100) of desired replicas are updated and ready. Requires rollout = true.",
+			Validators: []validator.Int64{
+				int64validator.Between(1, 100),
+			},
+		},
+		"strict": schema.BoolAttribute{
+			Optional:    true,
+			Description: "Also require the Available condition, zero unavailable replicas, and a current observedGeneration for this Deployment rollout step. Requires rollout = true.",
+		},
+		"timeout": schema.StringAttribute{
+			Optional:    true,
+			Description: "Maximum time to wait for this step, counted from when the previous step completed. Defaults to wait_for.timeout, or 10m.",
+			Validators: []validator.String{
+				durationValidator{},
+			},
+		},
+	}
+}
+
+// expandWaitSteps converts wait_for.steps into one waitForModel per step, so each step
+// runs through the same wait helpers as a single-mode wait_for. Returns nil without steps.
+func expandWaitSteps(ctx context.Context, waitConfig waitForModel) ([]waitForModel, diag.Diagnostics) {
+	if waitConfig.Steps.IsNull() || waitConfig.Steps.IsUnknown() {
+		return nil, nil
+	}
+
+	var steps []waitStepModel
+	diags := waitConfig.Steps.ElementsAs(ctx, &steps, false)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	configs := make([]waitForModel, 0, len(steps))
+	for _, step := range steps {
+		timeout := step.Timeout
+		if timeout.IsNull() {
+			timeout = waitConfig.Timeout
+		}
+		configs = append(configs, waitForModel{
+			Field:               step.Field,
+			FieldValue:          step.FieldValue,
+			Condition:           step.Condition,
+			SuccessReasons:      step.SuccessReasons,
+			Rollout:             step.Rollout,
+			IngressReady:        step.IngressReady,
+			PVCBound:            step.PVCBound,
+			CronJobScheduled:    step.CronJobScheduled,
+			APIServiceAvailable: step.APIServiceAvailable,
+			PodsReady:           step.PodsReady,
+			Ready:               step.Ready,
+			Phase:               step.Phase,
+			FailPhases:          step.FailPhases,
+			Strict:              step.Strict,
+			MinReadyPercent:     step.MinReadyPercent,
+			Timeout:             timeout,
+			Mode:                waitConfig.Mode,
+			PollInterval:        waitConfig.PollInterval,
+			SnapshotOnTimeout:   waitConfig.SnapshotOnTimeout,
+			Steps:               types.ListNull(waitConfig.Steps.ElementType(ctx)),
+		})
+	}
+	return configs, diags
+}
+
+// validateWaitSteps checks a wait_for that uses steps: no single-mode settings beside
+// steps, and exactly one wait mode per step. Unknown values are validated once known.
+func validateWaitSteps(ctx context.Context, waitFor waitForModel, resp *resource.ValidateConfigResponse) {
+	stepsPath := path.Root("wait_for").AtName("steps")
+
+	conflicting := configuredWaitModes(waitFor)
+	if !waitFor.MinReadyPercent.IsNull() && !waitFor.MinReadyPercent.IsUnknown() {
+		conflicting = append(conflicting, "min_ready_percent")
+	}
+	if waitFor.Strict.ValueBool() {
+		conflicting = append(conflicting, "strict")
+	}
+	if !waitFor.FailPhases.IsNull() && !waitFor.FailPhases.IsUnknown() {
+		conflicting = append(conflicting, "fail_phases")
+	}
+	if !waitFor.SuccessReasons.IsNull() && !waitFor.SuccessReasons.IsUnknown() {
+		conflicting = append(conflicting, "success_reasons")
+	}
+	if len(conflicting) > 0 {
+		resp.Diagnostics.AddAttributeError(
+			stepsPath,
+			"Steps Combined With Wait Mode",
+			fmt.Sprintf("wait_for sets steps together with %s. With steps, every wait mode belongs to a step.\n\n"+
+				"Solutions:\n"+
+				"• Move %s into an entry of steps\n"+
+				"• Remove steps to use a single wait mode",
+				strings.Join(conflicting, ", "), strings.Join(conflicting, ", ")),
+		)
+	}
+
+	for _, elem := range waitFor.Steps.Elements() {
+		if elem.IsUnknown() {
+			return
+		}
+	}
+
+	steps, diags := expandWaitSteps(ctx, waitFor)
+	resp.Diagnostics.Append(diags...)
+	if diags.HasError() {
+		return
+	}
+
+	for i, step := range steps {
+		stepPath := stepsPath.AtListIndex(i)
+		modes := configuredWaitModes(step)
+		hasUnknownMode := step.Field.IsUnknown() || step.FieldValue.IsUnknown() ||
+			step.Condition.IsUnknown() || step.Rollout.IsUnknown() || step.IngressReady.IsUnknown() || step.PVCBound.IsUnknown() || step.CronJobScheduled.IsUnknown() || step.APIServiceAvailable.IsUnknown() || step.PodsReady.IsUnknown() || step.Ready.IsUnknown() || step.Phase.IsUnknown()
+
+		switch {
+		case len(modes) > 1:
+			resp.Diagnostics.AddAttributeError(
+				stepPath,
+				"Multiple Wait Modes Configured",
+				fmt.Sprintf("wait_for.steps[%d] sets %s, but each step waits for exactly one thing.\n\n"+
+					"Solutions:\n"+
+					"• Split them into consecutive steps, in the order they should complete",
+					i, strings.Join(modes, ", ")),
+			)
+		case len(modes) == 0 && !hasUnknownMode:
+			resp.Diagnostics.AddAttributeError(
+				stepPath,
+				"Wait Step Has No Wait Mode",
+				fmt.Sprintf("wait_for.steps[%d] does not set field, field_value, condition, rollout, ingress_ready, pvc_bound, cronjob_scheduled, apiservice_available, pods_ready, ready, or phase, so it would not wait for anything.\n\n"+
+					"Solutions:\n"+
+					"• Set one wait mode on the step\n"+
+					"• Remove the step", i),
+			)
+		}
+
+		validateStrictRollout(step, stepPath, fmt.Sprintf("wait_for.steps[%d]", i), resp)
+		validateFailPhases(step, stepPath, fmt.Sprintf("wait_for.steps[%d]", i), resp)
+		validateSuccessReasons(step, stepPath, fmt.Sprintf("wait_for.steps[%d]", i), resp)
+
+		if !step.MinReadyPercent.IsNull() && !step.MinReadyPercent.IsUnknown() &&
+			!step.Rollout.IsUnknown() && (step.Rollout.IsNull() || !step.Rollout.ValueBool()) {
+			resp.Diagnostics.AddAttributeError(
+				stepPath.AtName("min_ready_percent"),
+				"Min Ready Percent Requires Rollout",
+				fmt.Sprintf("wait_for.steps[%d].min_ready_percent only applies to rollout steps and would be ignored.\n\n"+
+					"Solutions:\n"+
+					"• Set rollout = true on the step to wait for a partial rollout\n"+
+					"• Remove min_ready_percent", i),
+			)
+		}
+	}
+}
+
+// performWaitSteps runs the steps in order. Each step starts once the previous one
+// completes and has its own timeout. A failure reports the failing step and the
+// steps that completed before it. The steps check the object through counted.
+func (r *waitResource) performWaitSteps(ctx context.Context, wc *waitContext, counted *attemptCountingClient) error {
+	var completed []string
+	for i, step := range wc.Steps {
+		stepDesc := describeWaitStep(step)
+		tflog.Info(ctx, "Starting wait step", map[string]interface{}{
+			"step":  i + 1,
+			"total": len(wc.Steps),
+			"wait":  stepDesc,
+		})
+		started := time.Now()
+
+		// The object may only appear during an earlier step's wait; each step re-reads it
+		stepWC := *wc
+		stepWC.WaitConfig = step
+		stepWC.Client = counted
+		obj, err := r.waitForExistence(ctx, &stepWC)
+		if err == nil {
+			err = r.waitForResource(ctx, counted, wc.GVR, obj, step)
+		}
+		if err != nil {
+			return stepFailure(i, len(wc.Steps), stepDesc, completed, err)
+		}
+		r.recordResults(ctx, wc, step)
+
+		tflog.Info(ctx, "Wait step completed", map[string]interface{}{
+			"step":     i + 1,
+			"total":    len(wc.Steps),
+			"wait":     stepDesc,
+			"duration": time.Since(started).String(),
+		})
+		completed = append(completed, fmt.Sprintf("%d. %s", i+1, stepDesc))
+	}
+	return nil
+}
+
+// stepFailure wraps a step's error with its position in the sequence. The error stays
+// wrapped so timeouts are still classified (and snapshotted) like single-step waits.
+func stepFailure(index, total int, stepDesc string, completed []string, err error) error {
+	header := fmt.Sprintf("wait_for.steps[%d] (step %d of %d: %s) did not complete.", index, index+1, total, stepDesc)
+	if len(completed) > 0 {
+		header += "\n\nCompleted steps:\n  " + strings.Join(completed, "\n  ")
+	} else {
+		header += "\n\nNo earlier steps completed."
+	}
+	return fmt.Errorf("%s\n\n%w", header, err)
+}
+
+// describeWaitStep summarizes the wait mode of a step for logs and errors
+func describeWaitStep(step waitForModel) string {
+	switch {
+	case step.Rollout.ValueBool():
+		if step.Strict.ValueBool() {
+			return "rollout (strict)"
+		}
+		if !step.MinReadyPercent.IsNull() {
+			return fmt.Sprintf("rollout (min_ready_percent = %d)", step.MinReadyPercent.ValueInt64())
+		}
+		return "rollout"
+	case step.IngressReady.ValueBool():
+		return "ingress address"
+	case step.PVCBound.ValueBool():
+		return "pvc bound"
+	case step.CronJobScheduled.ValueBool():
+		return "cronjob scheduled"
+	case step.APIServiceAvailable.ValueBool():
+		return "apiservice available"
+	case step.PodsReady.ValueBool():
+		return "pods ready"
+	case step.Ready.ValueBool():
+		return "ready"
+	case !step.Phase.IsNull():
+		return fmt.Sprintf("phase %q", step.Phase.ValueString())
+	case !step.Field.IsNull():
+		return fmt.Sprintf("field %q", step.Field.ValueString())
+	case !step.FieldValue.IsNull():
+		var pairs []string
+		for key, value := range step.FieldValue.Elements() {
+			if s, ok := value.(types.String); ok {
+				pairs = append(pairs, fmt.Sprintf("%s = %q", key, s.ValueString()))
+			}
+		}
+		sort.Strings(pairs)
+		return fmt.Sprintf("field_value {%s}", strings.Join(pairs, ", "))
+	case !step.Condition.IsNull():
+		return fmt.Sprintf("condition %q", step.Condition.ValueString())
+	default:
+		return "no wait mode"
+	}
+}
+
+// resultFields returns the field paths whose values populate result: wait_for.field or
+// the ingress address of ingress_ready, or those of every step
+func resultFields(wc *waitContext) []string {
+	if len(wc.Steps) == 0 {
+		return waitResultFields(wc.WaitConfig)
+	}
+
+	var fields []string
+	for _, step := range wc.Steps {
+		fields = append(fields, waitResultFields(step)...)
+	}
+	return fields
+}
+
+// waitResultFields returns the field paths a single wait mode puts in result
+func waitResultFields(waitConfig waitForModel) []string {
+	switch {
+	case waitConfig.IngressReady.ValueBool():
+		return []string{ingressAddressField}
+	case !waitConfig.Field.IsNull() && waitConfig.Field.ValueString() != "":
+		return []string{waitConfig.Field.ValueString()}
+	default:
+		return nil
+	}
+}
+
+// mergeResult deep-merges src into dst, so several pruned fields share one result object
+func mergeResult(dst, src map[string]interface{}) {
+	for key, value := range src {
+		srcMap, srcIsMap := value.(map[string]interface{})
+		dstMap, dstIsMap := dst[key].(map[string]interface{})
+		if srcIsMap && dstIsMap {
+			mergeResult(dstMap, srcMap)
+			continue
+		}
+		dst[key] = value
+	}
+}
@@ -0,0 +1,202 @@
+package wait
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/jmorris0x0/terraform-provider-k8sconnect/internal/k8sconnect/common/k8sclient"
+)
+
+var stepAttrTypes = map[string]attr.Type{
+	"field":                types.StringType,
+	"field_value":          types.MapType{ElemType: types.StringType},
+	"condition":            types.StringType,
+	"success_reasons":      types.ListType{ElemType: types.StringType},
+	"rollout":              types.BoolType,
+	"ingress_ready":        types.BoolType,
+	"pvc_bound":            types.BoolType,
+	"cronjob_scheduled":    types.BoolType,
+	"apiservice_available": types.BoolType,
+	"pods_ready":           types.BoolType,
+	"ready":                types.BoolType,
+	"phase":                types.StringType,
+	"fail_phases":          types.ListType{ElemType: types.StringType},
+	"strict":               types.BoolType,
+	"min_ready_percent":    types.Int64Type,
+	"timeout":              types.StringType,
+}
+
+// stepValue builds a wait_for.steps entry; overrides replace the null defaults
+func stepValue(overrides map[string]attr.Value) attr.Value {
+	values := map[string]attr.Value{
+		"field":                types.StringNull(),
+		"field_value":          types.MapNull(types.StringType),
+		"condition":            types.StringNull(),
+		"success_reasons":      types.ListNull(types.StringType),
+		"rollout":              types.BoolNull(),
+		"ingress_ready":        types.BoolNull(),
+		"pvc_bound":            types.BoolNull(),
+		"cronjob_scheduled":    types.BoolNull(),
+		"apiservice_available": types.BoolNull(),
+		"pods_ready":           types.BoolNull(),
+		"ready":                types.BoolNull(),
+		"phase":                types.StringNull(),
+		"fail_phases":          types.ListNull(types.StringType),
+		"strict":               types.BoolNull(),
+		"min_ready_percent":    types.Int64Null(),
+		"timeout":              types.StringNull(),
+	}
+	for k, v := range overrides {
+		values[k] = v
+	}
+	return types.ObjectValueMust(stepAttrTypes, values)
+}
+
+func stepsWaitFor(steps ...attr.Value) waitForModel {
+	return waitForModel{
+		Field:             types.StringNull(),
+		FieldValue:        types.MapNull(types.StringType),
+		Condition:         types.StringNull(),
+		Rollout:           types.BoolNull(),
+		MinReadyPercent:   types.Int64Null(),
+		Timeout:           types.StringValue("5m"),
+		Mode:              types.StringValue(waitModePoll),
+		PollInterval:      types.StringValue("10ms"),
+		SnapshotOnTimeout: types.BoolValue(true),
+		Steps:             types.ListValueMust(types.ObjectType{AttrTypes: stepAttrTypes}, steps),
+	}
+}
+
+func TestExpandWaitSteps(t *testing.T) {
+	waitFor := stepsWaitFor(
+		stepValue(map[string]attr.Value{"condition": types.StringValue("Reconciled")}),
+		stepValue(map[string]attr.Value{
+			"field":   types.StringValue("status.endpoint"),
+			"timeout": types.StringValue("30s"),
+		}),
+	)
+
+	steps, diags := expandWaitSteps(context.Background(), waitFor)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if len(steps) != 2 {
+		t.Fatalf("expected 2 steps, got %d", len(steps))
+	}
+
+	if steps[0].Condition.ValueString() != "Reconciled" || steps[1].Field.ValueString() != "status.endpoint" {
+		t.Errorf("steps out of order: %+v", steps)
+	}
+	if got := steps[0].Timeout.ValueString(); got != "5m" {
+		t.Errorf("step without timeout should inherit wait_for.timeout, got %q", got)
+	}
+	if got := steps[1].Timeout.ValueString(); got != "30s" {
+		t.Errorf("step timeout = %q, want 30s", got)
+	}
+	for i, step := range steps {
+		if step.Mode.ValueString() != waitModePoll || step.PollInterval.ValueString() != "10ms" || !step.SnapshotOnTimeout.ValueBool() {
+			t.Errorf("step %d did not inherit mode, poll_interval, and snapshot_on_timeout: %+v", i, step)
+		}
+		if !step.Steps.IsNull() {
+			t.Errorf("step %d should not carry nested steps", i)
+		}
+	}
+
+	none, _ := expandWaitSteps(context.Background(), waitForModel{Steps: types.ListNull(types.ObjectType{AttrTypes: stepAttrTypes})})
+	if none != nil {
+		t.Errorf("expected nil without steps, got %+v", none)
+	}
+}
+
+func TestStepFailureKeepsTimeoutClassification(t *testing.T) {
+	timeout := &waitTimeoutError{message: "Wait Timeout: field 'status.endpoint'"}
+
+	err := stepFailure(1, 3, `field "status.endpoint"`, []string{`1. condition "Reconciled"`}, timeout)
+
+	var timeoutErr *waitTimeoutError
+	if !errors.As(err, &timeoutErr) {
+		t.Fatalf("expected the step error to wrap the timeout, got %T", err)
+	}
+	for _, want := range []string{"wait_for.steps[1] (step 2 of 3", "Completed steps:", `1. condition "Reconciled"`, timeout.message} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("error missing %q:\n%s", want, err)
+		}
+	}
+
+	first := stepFailure(0, 2, "rollout", nil, timeout)
+	if !strings.Contains(first.Error(), "No earlier steps completed.") {
+		t.Errorf("first step failure should say no steps completed:\n%s", first)
+	}
+}
+
+func TestMergeResult(t *testing.T) {
+	dst := map[string]interface{}{
+		"status": map[string]interface{}{"endpoint": "db.example.com"},
+	}
+	mergeResult(dst, map[string]interface{}{
+		"status":   map[string]interface{}{"port": int64(5432)},
+		"metadata": map[string]interface{}{"uid": "abc"},
+	})
+
+	want := map[string]interface{}{
+		"status":   map[string]interface{}{"endpoint": "db.example.com", "port": int64(5432)},
+		"metadata": map[string]interface{}{"uid": "abc"},
+	}
+	if !reflect.DeepEqual(dst, want) {
+		t.Errorf("mergeResult() = %v, want %v", dst, want)
+	}
+}
+
+func TestPerformWaitStepsRunsInOrder(t *testing.T) {
+	gvr := schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "deployments"}
+	rolling := deploymentFixture(2, 2, 3, 3, 1, 1)
+	ready := deploymentFixture(2, 2, 3, 3, 3, 3)
+
+	waitFor := stepsWaitFor(
+		stepValue(map[string]attr.Value{"rollout": types.BoolValue(true)}),
+		stepValue(map[string]attr.Value{
+			"field":   types.StringValue("status.endpoint"),
+			"timeout": types.StringValue("50ms"),
+		}),
+	)
+	steps, diags := expandWaitSteps(context.Background(), waitFor)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	wc := &waitContext{
+		Client: &pollOnlyClient{K8sClient: k8sclient.NewStubK8sClient(), t: t, responses: []*unstructured.Unstructured{rolling, rolling, ready}},
+		GVR:    gvr,
+		ObjectRef: objectRefModel{
+			APIVersion: types.StringValue("apps/v1"),
+			Kind:       types.StringValue("Deployment"),
+			Name:       types.StringValue("web"),
+			Namespace:  types.StringValue("default"),
+		},
+		WaitConfig: waitFor,
+		Steps:      steps,
+	}
+
+	// The rollout completes; the endpoint never appears, so the second step times out
+	err := (&waitResource{}).performWaitSteps(context.Background(), wc, newAttemptCountingClient(wc))
+	if err == nil {
+		t.Fatal("expected the field step to time out")
+	}
+	var timeoutErr *waitTimeoutError
+	if !errors.As(err, &timeoutErr) {
+		t.Errorf("expected a wait timeout, got: %v", err)
+	}
+	for _, want := range []string{"step 2 of 2", "1. rollout"} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("error missing %q:\n%s", want, err)
+		}
+	}
+}
@@ -11,10 +11,11 @@ import (
 
 // updateStatus populates the result field after a successful wait
 // Following ADR-008: "You only get what you wait for"
-// Only wait_for.field populates result (extracted resource fields)
+// Only wait_for.field and field steps populate result (extracted resource fields)
 func (r *waitResource) updateStatus(ctx context.Context, wc *waitContext) error {
 	// Only field waits populate result
-	if wc.WaitConfig.Field.IsNull() || wc.WaitConfig.Field.ValueString() == "" {
+	fields := resultFields(wc)
+	if len(fields) == 0 {
 		wc.Data.Result = types.DynamicNull()
 		tflog.Debug(ctx, "Not populating result - not a field wait")
 		return nil
@@ -34,11 +35,26 @@ func (r *waitResource) updateStatus(ctx context.Context, wc *waitContext) error
 	//   field="spec.volumeName" → wait.result.spec.volumeName
 	//   field="metadata.uid" → wait.result.metadata.uid
 	//   field="status.succeeded" → wait.result.status.succeeded
-	prunedResource := pruneStatusToField(currentObj.Object, wc.WaitConfig.Field.ValueString())
+	// With several field steps, their pruned structures are merged into one result.
+	var prunedResource map[string]interface{}
+	for _, field := range fields {
+		pruned := pruneStatusToField(currentObj.Object, field)
+		if pruned == nil {
+			tflog.Debug(ctx, "Field not found in resource", map[string]interface{}{
+				"field": field,
+			})
+			continue
+		}
+		if prunedResource == nil {
+			prunedResource = pruned
+		} else {
+			mergeResult(prunedResource, pruned)
+		}
+	}
 
 	if prunedResource != nil {
-		tflog.Debug(ctx, "Extracted waited field from resource", map[string]interface{}{
-			"field": wc.WaitConfig.Field.ValueString(),
+		tflog.Debug(ctx, "Extracted waited fields from resource", map[string]interface{}{
+			"fields": fields,
 		})
 
 		objectValue, err := common.ConvertToAttrValue(ctx, prunedResource)
@@ -50,9 +66,6 @@ func (r *waitResource) updateStatus(ctx context.Context, wc *waitContext) error
 			wc.Data.Result = types.DynamicValue(objectValue)
 		}
 	} else {
-		tflog.Debug(ctx, "Field not found in resource", map[string]interface{}{
-			"field": wc.WaitConfig.Field.ValueString(),
-		})
 		wc.Data.Result = types.DynamicNull()
 	}
 
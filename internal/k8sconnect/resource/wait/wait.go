@@ -3,9 +3,11 @@ package wait
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
-	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -40,6 +42,10 @@ type waitResourceModel struct {
 	Cluster   types.Object  `tfsdk:"cluster"`
 	WaitFor   types.Object  `tfsdk:"wait_for"`
 	Result    types.Dynamic `tfsdk:"result"`
+	Results   types.Map     `tfsdk:"results"`
+
+	WaitDuration types.String `tfsdk:"wait_duration"`
+	WaitAttempts types.Int64  `tfsdk:"wait_attempts"`
 }
 
 // objectRefModel defines the structure for referencing a Kubernetes object
@@ -52,11 +58,27 @@ type objectRefModel struct {
 
 // waitForModel defines wait conditions (transplanted from manifest resource)
 type waitForModel struct {
-	Field      types.String `tfsdk:"field"`
-	FieldValue types.Map    `tfsdk:"field_value"`
-	Condition  types.String `tfsdk:"condition"`
-	Rollout    types.Bool   `tfsdk:"rollout"`
-	Timeout    types.String `tfsdk:"timeout"`
+	Field               types.String `tfsdk:"field"`
+	FieldValue          types.Map    `tfsdk:"field_value"`
+	Condition           types.String `tfsdk:"condition"`
+	SuccessReasons      types.List   `tfsdk:"success_reasons"`
+	Rollout             types.Bool   `tfsdk:"rollout"`
+	IngressReady        types.Bool   `tfsdk:"ingress_ready"`
+	PVCBound            types.Bool   `tfsdk:"pvc_bound"`
+	CronJobScheduled    types.Bool   `tfsdk:"cronjob_scheduled"`
+	APIServiceAvailable types.Bool   `tfsdk:"apiservice_available"`
+	PodsReady           types.Bool   `tfsdk:"pods_ready"`
+	Ready               types.Bool   `tfsdk:"ready"`
+	Phase               types.String `tfsdk:"phase"`
+	FailPhases          types.List   `tfsdk:"fail_phases"`
+	Strict              types.Bool   `tfsdk:"strict"`
+	MinReadyPercent     types.Int64  `tfsdk:"min_ready_percent"`
+	Timeout             types.String `tfsdk:"timeout"`
+	Mode                types.String `tfsdk:"mode"`
+	PollInterval        types.String `tfsdk:"poll_interval"`
+	SnapshotOnTimeout   types.Bool   `tfsdk:"snapshot_on_timeout"`
+	ReportWarningEvents types.Bool   `tfsdk:"report_warning_events"`
+	Steps               types.List   `tfsdk:"steps"`
 }
 
 // Creates a wait resource with custom client getter
@@ -139,17 +161,14 @@ func (r *waitResource) Schema(ctx context.Context, req resource.SchemaRequest, r
 				Attributes: auth.GetConnectionSchemaForResource(),
 			},
 			"wait_for": schema.SingleNestedAttribute{
-				Required:    true,
-				Description: "Conditions to wait for before considering the resource ready.",
+				Required: true,
+				Description: "Conditions to wait for before considering the resource ready. " +
+					"Exactly one of field, field_value, condition, rollout, ingress_ready, pvc_bound, cronjob_scheduled, apiservice_available, pods_ready, ready, or phase may be set, or steps to wait for several in sequence.",
 				Attributes: map[string]schema.Attribute{
 					"field": schema.StringAttribute{
 						Optional:    true,
 						Description: "JSONPath to field that must exist/be non-empty. Example: 'status.loadBalancer.ingress'",
 						Validators: []validator.String{
-							stringvalidator.ConflictsWith(
-								path.MatchRelative().AtParent().AtName("field_value"),
-								path.MatchRelative().AtParent().AtName("condition"),
-							),
 							validators.JSONPath{},
 						},
 					},
@@ -158,28 +177,83 @@ func (r *waitResource) Schema(ctx context.Context, req resource.SchemaRequest, r
 						ElementType: types.StringType,
 						Description: "Map of JSONPath to expected value. Example: {'status.phase': 'Running'}",
 						Validators: []validator.Map{
-							mapvalidator.ConflictsWith(
-								path.MatchRelative().AtParent().AtName("field"),
-								path.MatchRelative().AtParent().AtName("condition"),
-							),
 							validators.JSONPathMapKeys{},
 						},
 					},
 					"condition": schema.StringAttribute{
 						Optional:    true,
 						Description: "Condition type that must be True. Example: 'Ready'",
-						Validators: []validator.String{
-							stringvalidator.ConflictsWith(
-								path.MatchRelative().AtParent().AtName("field"),
-								path.MatchRelative().AtParent().AtName("field_value"),
-							),
-						},
+					},
+					"success_reasons": schema.ListAttribute{
+						Optional:    true,
+						ElementType: types.StringType,
+						Description: "Reasons that also satisfy a condition wait when the condition reports one, whatever its status, e.g. ['Completed'] for a controller " +
+							"that reports Ready=False with reason Completed once it is done and only sets Ready=True briefly. Requires condition.",
 					},
 					"rollout": schema.BoolAttribute{
 						Optional: true,
 						Description: "Wait for Deployment/StatefulSet/DaemonSet to complete rollout. " +
 							"Checks that all replicas are updated and available.",
 					},
+					"ingress_ready": schema.BoolAttribute{
+						Optional: true,
+						Description: "Wait for an Ingress to be assigned an address in status.loadBalancer.ingress by its controller. " +
+							"The assigned hostnames/IPs are exposed in result.status.loadBalancer.ingress.",
+					},
+					"pvc_bound": schema.BoolAttribute{
+						Optional: true,
+						Description: "Wait for a PersistentVolumeClaim to be bound to a volume. Shortcut for field_value = {'status.phase': 'Bound'}; " +
+							"on timeout the error includes the claim's events and its StorageClass.",
+					},
+					"cronjob_scheduled": schema.BoolAttribute{
+						Optional: true,
+						Description: "Wait for a CronJob to schedule its first Job: status.lastScheduleTime is set or a Job is listed in status.active. " +
+							"On timeout the error shows the schedule and notes when spec.suspend = true prevents scheduling.",
+					},
+					"apiservice_available": schema.BoolAttribute{
+						Optional: true,
+						Description: "Wait for an APIService to be Available, i.e. the kube-apiserver can reach the aggregated API server behind it, before resources of its API group are applied. " +
+							"Shortcut for condition = 'Available'; on timeout the error includes the condition's message (often a TLS or endpoint error) and the backing Service.",
+					},
+					"pods_ready": schema.BoolAttribute{
+						Optional: true,
+						Description: "Wait for the pods of a Deployment, StatefulSet, DaemonSet, or ReplicaSet to be Ready, rather than trusting the workload's aggregate status: " +
+							"the pods matching spec.selector are listed and the wait completes once the expected number (spec.replicas, or the DaemonSet's desired count) exist and every one is Ready. " +
+							"Pods are only counted once the controller has observed the current generation and updated the expected number of pods to the current revision. " +
+							"Terminating pods are ignored. Always polls; on timeout the error lists the pods that are not Ready and their container issues.",
+					},
+					"ready": schema.BoolAttribute{
+						Optional: true,
+						Description: "Wait for the resource to be ready using kstatus conventions, without writing conditions per kind: " +
+							"status.observedGeneration must match metadata.generation, Reconciling and Stalled conditions are honored, " +
+							"built-in kinds (workloads, Pods, PVCs, Services, Jobs, CRDs) use their own readiness rules, and other resources " +
+							"are ready unless they report Ready=False. Fails fast when the resource reports status Failed (e.g. Stalled=True).",
+					},
+					"phase": schema.StringAttribute{
+						Optional: true,
+						Description: "Wait for status.phase to equal this value, e.g. 'Running' or 'Succeeded' for a Pod or 'Bound' for a PersistentVolumeClaim. " +
+							"Unlike field_value, the wait fails as soon as the resource enters one of fail_phases.",
+					},
+					"fail_phases": schema.ListAttribute{
+						Optional:    true,
+						ElementType: types.StringType,
+						Description: "Phases that end a phase wait with an error immediately, e.g. ['Failed', 'Unknown'] for a Pod or ['Lost'] for a PersistentVolumeClaim. " +
+							"The error includes status.reason and status.message. Requires phase.",
+					},
+					"min_ready_percent": schema.Int64Attribute{
+						Optional: true,
+						Description: "Complete a rollout wait once at least this percentage (1-100) of desired replicas are updated and ready, " +
+							"instead of all of them. Requires rollout = true. Useful for large DaemonSets where a few nodes are always unschedulable.",
+						Validators: []validator.Int64{
+							int64validator.Between(1, 100),
+						},
+					},
+					"strict": schema.BoolAttribute{
+						Optional: true,
+						Description: "Make a Deployment rollout wait stricter: besides the usual rollout checks, the Available condition must be True, " +
+							"status.unavailableReplicas must be 0, and status.observedGeneration must match metadata.generation. " +
+							"Requires rollout = true; cannot be combined with min_ready_percent.",
+					},
 					"timeout": schema.StringAttribute{
 						Optional:    true,
 						Description: "Maximum time to wait. Defaults to 10m. Format: '30s', '5m', '1h'",
@@ -187,13 +261,72 @@ func (r *waitResource) Schema(ctx context.Context, req resource.SchemaRequest, r
 							durationValidator{},
 						},
 					},
+					"mode": schema.StringAttribute{
+						Optional: true,
+						Description: "How the resource is observed: 'watch' (default) uses a watch and falls back to polling if it fails; " +
+							"'poll' skips the watch and polls every poll_interval. Use 'poll' behind proxies or API gateways that break long-lived watches.",
+						Validators: []validator.String{
+							stringvalidator.OneOf(waitModeWatch, waitModePoll),
+						},
+					},
+					"poll_interval": schema.StringAttribute{
+						Optional: true,
+						Description: "Interval between polls in 'poll' mode and when a watch falls back to polling. Defaults to 2s. " +
+							"Format: '5s', '1m'",
+						Validators: []validator.String{
+							durationValidator{subject: "Poll Interval"},
+						},
+					},
+					"snapshot_on_timeout": schema.BoolAttribute{
+						Optional: true,
+						Description: "When true, a timeout error includes the last observed object as YAML (without managedFields), " +
+							"so the failing state is captured in CI logs. Secret data values are redacted. Defaults to false.",
+					},
+					"report_warning_events": schema.BoolAttribute{
+						Optional: true,
+						Description: "When true, Warning events recorded for the object while waiting (for a workload, also for its pods " +
+							"and ReplicaSets) are summarized in a warning after the wait succeeds, so intermittent problems during a rollout " +
+							"are visible. The events never fail the wait. Defaults to false.",
+					},
+					"steps": schema.ListNestedAttribute{
+						Optional: true,
+						Description: "Ordered wait steps, each setting exactly one of field, field_value, condition, rollout, ingress_ready, pvc_bound, cronjob_scheduled, apiservice_available, pods_ready, ready, or phase. " +
+							"Steps run in sequence: each starts once the previous one completes and has its own timeout (defaulting to wait_for.timeout). " +
+							"Cannot be combined with field, field_value, condition, success_reasons, rollout, ingress_ready, pvc_bound, cronjob_scheduled, apiservice_available, pods_ready, ready, phase, fail_phases, min_ready_percent, or strict on wait_for itself; mode, poll_interval, " +
+							"and snapshot_on_timeout apply to every step. result holds the fields of all field and ingress_ready steps.",
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: waitStepAttributes(),
+						},
+						Validators: []validator.List{
+							listvalidator.SizeAtLeast(1),
+						},
+					},
 				},
 			},
 			"result": schema.DynamicAttribute{
 				Computed: true,
 				Description: "Result of the wait operation containing extracted fields from the Kubernetes resource. " +
 					"The structure preserves the full path from the resource (e.g., field='spec.volumeName' → result.spec.volumeName). " +
-					"Follows ADR-008: 'You get only what you wait for' - only populated for field waits, null for condition/rollout waits.",
+					"Follows ADR-008: 'You get only what you wait for' - only populated for field waits (including field steps) and ingress_ready waits " +
+					"(status.loadBalancer.ingress), null for condition/rollout waits.",
+			},
+			"results": schema.MapAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "Every value observed by the wait, as strings keyed by field path (field, field_value, pvc_bound, cronjob_scheduled, phase, and ingress_ready waits) " +
+					"or condition type (condition and apiservice_available waits), e.g. results[\"status.phase\"] or results[\"Ready\"]. " +
+					"With steps, each step adds the values it observed when it completed. Maps and lists are JSON-encoded. " +
+					"Set when the wait succeeds; null when nothing was observed, such as for rollout waits.",
+			},
+			"wait_duration": schema.StringAttribute{
+				Computed: true,
+				Description: "How long the last successful wait took, as a duration such as '1m23.456s', including waiting for the object to exist. " +
+					"With steps, the time for all of them. Use to track rollout times from Terraform outputs. Null when the wait was skipped (dry_run).",
+			},
+			"wait_attempts": schema.Int64Attribute{
+				Computed: true,
+				Description: "How many times the last successful wait checked the object: each read while polling and each watch event received. " +
+					"With steps, the total across them. Null when the wait was skipped (dry_run).",
 			},
 		},
 	}
@@ -209,7 +342,186 @@ func (r *waitResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 func (r *waitResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
 	return []resource.ConfigValidator{
 		&rolloutKindValidator{},
+		&ingressKindValidator{},
+		&pvcKindValidator{},
+		&cronJobKindValidator{},
+		&apiServiceKindValidator{},
+		&podsReadyKindValidator{},
+		&waitModeValidator{},
+	}
+}
+
+// waitModeValidator ensures only one wait mode is configured. waitForResource
+// evaluates modes in priority order (rollout, ingress_ready, pvc_bound, cronjob_scheduled, apiservice_available, pods_ready, ready, phase, field, field_value, condition) and
+// silently ignores the rest, so configuring several is always a mistake.
+type waitModeValidator struct{}
+
+func (v waitModeValidator) Description(ctx context.Context) string {
+	return "validates that only one of field, field_value, condition, rollout, ingress_ready, pvc_bound, cronjob_scheduled, apiservice_available, pods_ready, ready, or phase is set in wait_for"
+}
+
+func (v waitModeValidator) MarkdownDescription(ctx context.Context) string {
+	return "validates that only one of `field`, `field_value`, `condition`, `rollout`, `ingress_ready`, `pvc_bound`, `cronjob_scheduled`, `apiservice_available`, `pods_ready`, `ready`, or `phase` is set in `wait_for`"
+}
+
+func (v waitModeValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data waitResourceModel
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.WaitFor.IsNull() || data.WaitFor.IsUnknown() {
+		return
+	}
+
+	var waitFor waitForModel
+	diags = data.WaitFor.As(ctx, &waitFor, basetypes.ObjectAsOptions{})
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !waitFor.Steps.IsNull() && !waitFor.Steps.IsUnknown() {
+		validateWaitSteps(ctx, waitFor, resp)
+		return
+	}
+
+	modes := configuredWaitModes(waitFor)
+
+	// min_ready_percent only changes what "complete" means for a rollout wait
+	if !waitFor.MinReadyPercent.IsNull() && !waitFor.MinReadyPercent.IsUnknown() &&
+		!waitFor.Rollout.IsUnknown() && (waitFor.Rollout.IsNull() || !waitFor.Rollout.ValueBool()) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("wait_for").AtName("min_ready_percent"),
+			"Min Ready Percent Requires Rollout",
+			"wait_for.min_ready_percent only applies to rollout waits and would be ignored.\n\n"+
+				"Solutions:\n"+
+				"• Set rollout = true to wait for a partial rollout\n"+
+				"• Remove min_ready_percent",
+		)
+	}
+
+	validateStrictRollout(waitFor, path.Root("wait_for"), "wait_for", resp)
+	validateFailPhases(waitFor, path.Root("wait_for"), "wait_for", resp)
+	validateSuccessReasons(waitFor, path.Root("wait_for"), "wait_for", resp)
+
+	if len(modes) <= 1 {
+		return
+	}
+
+	resp.Diagnostics.AddAttributeError(
+		path.Root("wait_for"),
+		"Multiple Wait Modes Configured",
+		fmt.Sprintf("wait_for sets %s, but only one wait mode can be used per k8sconnect_wait resource.\n\n"+
+			"Only the first in priority order (rollout, ingress_ready, pvc_bound, cronjob_scheduled, apiservice_available, pods_ready, ready, phase, field, field_value, condition) would take effect "+
+			"and the others would be silently ignored.\n\n"+
+			"Solutions:\n"+
+			"• Keep the single mode that expresses readiness for this resource\n"+
+			"• Use separate k8sconnect_wait resources to wait for several things in sequence",
+			strings.Join(modes, ", ")),
+	)
+}
+
+// validateStrictRollout checks that strict is only set on a rollout wait without
+// min_ready_percent. attrPath and label locate the wait_for or step being validated.
+func validateStrictRollout(waitConfig waitForModel, attrPath path.Path, label string, resp *resource.ValidateConfigResponse) {
+	if waitConfig.Strict.IsUnknown() || !waitConfig.Strict.ValueBool() {
+		return
+	}
+
+	if !waitConfig.Rollout.IsUnknown() && !waitConfig.Rollout.ValueBool() {
+		resp.Diagnostics.AddAttributeError(
+			attrPath.AtName("strict"),
+			"Strict Requires Rollout",
+			fmt.Sprintf("%s.strict only applies to rollout waits and would be ignored.\n\n"+
+				"Solutions:\n"+
+				"• Set rollout = true to wait for a strict rollout\n"+
+				"• Remove strict", label),
+		)
 	}
+	if !waitConfig.MinReadyPercent.IsNull() && !waitConfig.MinReadyPercent.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			attrPath.AtName("strict"),
+			"Strict Conflicts With Min Ready Percent",
+			fmt.Sprintf("%s sets both strict and min_ready_percent. strict waits for every replica to be available, "+
+				"min_ready_percent for only some of them.\n\n"+
+				"Solutions:\n"+
+				"• Remove min_ready_percent to require all replicas\n"+
+				"• Remove strict to complete on a partial rollout", label),
+		)
+	}
+}
+
+// validateFailPhases checks that fail_phases is only set on a phase wait
+func validateFailPhases(waitConfig waitForModel, attrPath path.Path, label string, resp *resource.ValidateConfigResponse) {
+	if waitConfig.FailPhases.IsNull() || waitConfig.FailPhases.IsUnknown() || !waitConfig.Phase.IsNull() {
+		return
+	}
+	resp.Diagnostics.AddAttributeError(
+		attrPath.AtName("fail_phases"),
+		"Fail Phases Requires Phase",
+		fmt.Sprintf("%s.fail_phases only applies to phase waits and would be ignored.\n\n"+
+			"Solutions:\n"+
+			"• Set phase to the phase to wait for\n"+
+			"• Remove fail_phases", label),
+	)
+}
+
+// validateSuccessReasons checks that success_reasons is only set on a condition wait
+func validateSuccessReasons(waitConfig waitForModel, attrPath path.Path, label string, resp *resource.ValidateConfigResponse) {
+	if waitConfig.SuccessReasons.IsNull() || waitConfig.SuccessReasons.IsUnknown() || !waitConfig.Condition.IsNull() {
+		return
+	}
+	resp.Diagnostics.AddAttributeError(
+		attrPath.AtName("success_reasons"),
+		"Success Reasons Requires Condition",
+		fmt.Sprintf("%s.success_reasons only applies to condition waits and would be ignored.\n\n"+
+			"Solutions:\n"+
+			"• Set condition to the condition type whose reasons count as success\n"+
+			"• Remove success_reasons", label),
+	)
+}
+
+// configuredWaitModes returns the wait modes set in wait_for, in priority order.
+// Unknown values are skipped - they are validated again once known.
+func configuredWaitModes(waitFor waitForModel) []string {
+	var modes []string
+	if !waitFor.Rollout.IsNull() && !waitFor.Rollout.IsUnknown() && waitFor.Rollout.ValueBool() {
+		modes = append(modes, "rollout")
+	}
+	if !waitFor.IngressReady.IsNull() && !waitFor.IngressReady.IsUnknown() && waitFor.IngressReady.ValueBool() {
+		modes = append(modes, "ingress_ready")
+	}
+	if !waitFor.PVCBound.IsNull() && !waitFor.PVCBound.IsUnknown() && waitFor.PVCBound.ValueBool() {
+		modes = append(modes, "pvc_bound")
+	}
+	if !waitFor.CronJobScheduled.IsNull() && !waitFor.CronJobScheduled.IsUnknown() && waitFor.CronJobScheduled.ValueBool() {
+		modes = append(modes, "cronjob_scheduled")
+	}
+	if !waitFor.APIServiceAvailable.IsNull() && !waitFor.APIServiceAvailable.IsUnknown() && waitFor.APIServiceAvailable.ValueBool() {
+		modes = append(modes, "apiservice_available")
+	}
+	if !waitFor.PodsReady.IsNull() && !waitFor.PodsReady.IsUnknown() && waitFor.PodsReady.ValueBool() {
+		modes = append(modes, "pods_ready")
+	}
+	if !waitFor.Ready.IsNull() && !waitFor.Ready.IsUnknown() && waitFor.Ready.ValueBool() {
+		modes = append(modes, "ready")
+	}
+	if !waitFor.Phase.IsNull() && !waitFor.Phase.IsUnknown() {
+		modes = append(modes, "phase")
+	}
+	if !waitFor.Field.IsNull() && !waitFor.Field.IsUnknown() {
+		modes = append(modes, "field")
+	}
+	if !waitFor.FieldValue.IsNull() && !waitFor.FieldValue.IsUnknown() {
+		modes = append(modes, "field_value")
+	}
+	if !waitFor.Condition.IsNull() && !waitFor.Condition.IsUnknown() {
+		modes = append(modes, "condition")
+	}
+	return modes
 }
 
 // rolloutKindValidator validates that rollout waits are only used on appropriate resource kinds
@@ -251,8 +563,13 @@ func (v rolloutKindValidator) ValidateResource(ctx context.Context, req resource
 		return
 	}
 
-	// Only validate if rollout is true
-	if waitFor.Rollout.IsNull() || !waitFor.Rollout.ValueBool() {
+	// Only validate if rollout is true, on wait_for or any step
+	rollout := !waitFor.Rollout.IsNull() && waitFor.Rollout.ValueBool()
+	steps, _ := expandWaitSteps(ctx, waitFor)
+	for _, step := range steps {
+		rollout = rollout || (!step.Rollout.IsNull() && step.Rollout.ValueBool())
+	}
+	if !rollout {
 		return
 	}
 
@@ -282,6 +599,19 @@ func (v rolloutKindValidator) ValidateResource(ctx context.Context, req resource
 		"Pod":                     true, // Pods don't rollout - they just exist or don't
 	}
 
+	strict := waitFor.Strict.ValueBool()
+	for _, step := range steps {
+		strict = strict || step.Strict.ValueBool()
+	}
+	if strict && kind != "" && kind != "Deployment" {
+		resp.Diagnostics.AddError(
+			"Strict Rollout Not Supported",
+			fmt.Sprintf("%s resources do not support strict rollout waits. "+
+				"strict checks the Available condition and status.unavailableReplicas, which only Deployments report. "+
+				"Remove strict to use the regular rollout wait for %s.", kind, kind),
+		)
+	}
+
 	if nonRolloutKinds[kind] {
 		resp.Diagnostics.AddError(
 			"Rollout Not Supported",
@@ -292,8 +622,286 @@ func (v rolloutKindValidator) ValidateResource(ctx context.Context, req resource
 	}
 }
 
+// ingressKindValidator validates that ingress_ready waits are only used on Ingresses
+type ingressKindValidator struct{}
+
+func (v ingressKindValidator) Description(ctx context.Context) string {
+	return "validates that ingress_ready waits are only used on Ingress resources"
+}
+
+func (v ingressKindValidator) MarkdownDescription(ctx context.Context) string {
+	return "validates that `ingress_ready` waits are only used on `Ingress` resources"
+}
+
+func (v ingressKindValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data waitResourceModel
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.WaitFor.IsNull() || data.WaitFor.IsUnknown() || data.ObjectRef.IsNull() || data.ObjectRef.IsUnknown() {
+		return
+	}
+
+	var waitFor waitForModel
+	diags = data.WaitFor.As(ctx, &waitFor, basetypes.ObjectAsOptions{})
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var objRef objectRefModel
+	diags = data.ObjectRef.As(ctx, &objRef, basetypes.ObjectAsOptions{})
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ingressReady := waitFor.IngressReady.ValueBool()
+	steps, _ := expandWaitSteps(ctx, waitFor)
+	for _, step := range steps {
+		ingressReady = ingressReady || step.IngressReady.ValueBool()
+	}
+	if !ingressReady || objRef.Kind.IsUnknown() || objRef.Kind.ValueString() == "Ingress" {
+		return
+	}
+
+	resp.Diagnostics.AddError(
+		"Ingress Ready Not Supported",
+		fmt.Sprintf("%s resources do not support ingress_ready waits. "+
+			"ingress_ready waits for an Ingress controller to publish an address in status.loadBalancer.ingress. "+
+			"For a LoadBalancer Service use wait_for.field = \"status.loadBalancer.ingress\"; for other kinds use wait_for.condition or wait_for.field.",
+			objRef.Kind.ValueString()),
+	)
+}
+
+// pvcKindValidator validates that pvc_bound waits are only used on PersistentVolumeClaims
+type pvcKindValidator struct{}
+
+func (v pvcKindValidator) Description(ctx context.Context) string {
+	return "validates that pvc_bound waits are only used on PersistentVolumeClaim resources"
+}
+
+func (v pvcKindValidator) MarkdownDescription(ctx context.Context) string {
+	return "validates that `pvc_bound` waits are only used on `PersistentVolumeClaim` resources"
+}
+
+func (v pvcKindValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data waitResourceModel
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.WaitFor.IsNull() || data.WaitFor.IsUnknown() || data.ObjectRef.IsNull() || data.ObjectRef.IsUnknown() {
+		return
+	}
+
+	var waitFor waitForModel
+	diags = data.WaitFor.As(ctx, &waitFor, basetypes.ObjectAsOptions{})
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var objRef objectRefModel
+	diags = data.ObjectRef.As(ctx, &objRef, basetypes.ObjectAsOptions{})
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	pvcBound := waitFor.PVCBound.ValueBool()
+	steps, _ := expandWaitSteps(ctx, waitFor)
+	for _, step := range steps {
+		pvcBound = pvcBound || step.PVCBound.ValueBool()
+	}
+	if !pvcBound || objRef.Kind.IsUnknown() || objRef.Kind.ValueString() == "PersistentVolumeClaim" {
+		return
+	}
+
+	resp.Diagnostics.AddError(
+		"PVC Bound Not Supported",
+		fmt.Sprintf("%s resources do not support pvc_bound waits. "+
+			"pvc_bound waits for a PersistentVolumeClaim's status.phase to be Bound. "+
+			"For other kinds use wait_for.field_value, e.g. field_value = { \"status.phase\" = \"Bound\" } for a PersistentVolume.",
+			objRef.Kind.ValueString()),
+	)
+}
+
+// cronJobKindValidator validates that cronjob_scheduled waits are only used on CronJobs
+type cronJobKindValidator struct{}
+
+func (v cronJobKindValidator) Description(ctx context.Context) string {
+	return "validates that cronjob_scheduled waits are only used on CronJob resources"
+}
+
+func (v cronJobKindValidator) MarkdownDescription(ctx context.Context) string {
+	return "validates that `cronjob_scheduled` waits are only used on `CronJob` resources"
+}
+
+func (v cronJobKindValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data waitResourceModel
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.WaitFor.IsNull() || data.WaitFor.IsUnknown() || data.ObjectRef.IsNull() || data.ObjectRef.IsUnknown() {
+		return
+	}
+
+	var waitFor waitForModel
+	diags = data.WaitFor.As(ctx, &waitFor, basetypes.ObjectAsOptions{})
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var objRef objectRefModel
+	diags = data.ObjectRef.As(ctx, &objRef, basetypes.ObjectAsOptions{})
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	scheduled := waitFor.CronJobScheduled.ValueBool()
+	steps, _ := expandWaitSteps(ctx, waitFor)
+	for _, step := range steps {
+		scheduled = scheduled || step.CronJobScheduled.ValueBool()
+	}
+	if !scheduled || objRef.Kind.IsUnknown() || objRef.Kind.ValueString() == "CronJob" {
+		return
+	}
+
+	resp.Diagnostics.AddError(
+		"CronJob Scheduled Not Supported",
+		fmt.Sprintf("%s resources do not support cronjob_scheduled waits. "+
+			"cronjob_scheduled waits for a CronJob's status.lastScheduleTime or status.active. "+
+			"For a Job use wait_for.condition = \"Complete\"; for other kinds use wait_for.condition or wait_for.field.",
+			objRef.Kind.ValueString()),
+	)
+}
+
+// apiServiceKindValidator validates that apiservice_available waits are only used on APIServices
+type apiServiceKindValidator struct{}
+
+func (v apiServiceKindValidator) Description(ctx context.Context) string {
+	return "validates that apiservice_available waits are only used on APIService resources"
+}
+
+func (v apiServiceKindValidator) MarkdownDescription(ctx context.Context) string {
+	return "validates that `apiservice_available` waits are only used on `APIService` resources"
+}
+
+func (v apiServiceKindValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data waitResourceModel
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.WaitFor.IsNull() || data.WaitFor.IsUnknown() || data.ObjectRef.IsNull() || data.ObjectRef.IsUnknown() {
+		return
+	}
+
+	var waitFor waitForModel
+	diags = data.WaitFor.As(ctx, &waitFor, basetypes.ObjectAsOptions{})
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var objRef objectRefModel
+	diags = data.ObjectRef.As(ctx, &objRef, basetypes.ObjectAsOptions{})
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	available := waitFor.APIServiceAvailable.ValueBool()
+	steps, _ := expandWaitSteps(ctx, waitFor)
+	for _, step := range steps {
+		available = available || step.APIServiceAvailable.ValueBool()
+	}
+	if !available || objRef.Kind.IsUnknown() || objRef.Kind.ValueString() == "APIService" {
+		return
+	}
+
+	resp.Diagnostics.AddError(
+		"APIService Available Not Supported",
+		fmt.Sprintf("%s resources do not support apiservice_available waits. "+
+			"apiservice_available waits for an APIService's Available condition to be True. "+
+			"For other kinds use wait_for.condition, e.g. condition = \"Available\" for a Deployment.",
+			objRef.Kind.ValueString()),
+	)
+}
+
+// podsReadyKindValidator validates that pods_ready waits are only used on workloads with a pod selector
+type podsReadyKindValidator struct{}
+
+func (v podsReadyKindValidator) Description(ctx context.Context) string {
+	return "validates that pods_ready waits are only used on Deployment, StatefulSet, DaemonSet, or ReplicaSet resources"
+}
+
+func (v podsReadyKindValidator) MarkdownDescription(ctx context.Context) string {
+	return "validates that `pods_ready` waits are only used on `Deployment`, `StatefulSet`, `DaemonSet`, or `ReplicaSet` resources"
+}
+
+func (v podsReadyKindValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data waitResourceModel
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.WaitFor.IsNull() || data.WaitFor.IsUnknown() || data.ObjectRef.IsNull() || data.ObjectRef.IsUnknown() {
+		return
+	}
+
+	var waitFor waitForModel
+	diags = data.WaitFor.As(ctx, &waitFor, basetypes.ObjectAsOptions{})
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var objRef objectRefModel
+	diags = data.ObjectRef.As(ctx, &objRef, basetypes.ObjectAsOptions{})
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	podsReady := waitFor.PodsReady.ValueBool()
+	steps, _ := expandWaitSteps(ctx, waitFor)
+	for _, step := range steps {
+		podsReady = podsReady || step.PodsReady.ValueBool()
+	}
+	if !podsReady || objRef.Kind.IsUnknown() || podsReadyKinds[objRef.Kind.ValueString()] {
+		return
+	}
+
+	resp.Diagnostics.AddError(
+		"Pods Ready Not Supported",
+		fmt.Sprintf("%s resources do not support pods_ready waits. "+
+			"pods_ready waits for the pods selected by a Deployment, StatefulSet, DaemonSet, or ReplicaSet. "+
+			"For a Pod use condition = \"Ready\"; for other kinds use ready = true or condition.",
+			objRef.Kind.ValueString()),
+	)
+}
+
 // durationValidator validates that a string is a valid duration
-type durationValidator struct{}
+type durationValidator struct {
+	// subject names the duration in error messages; defaults to "Timeout"
+	subject string
+}
 
 func (v durationValidator) Description(ctx context.Context) string {
 	return "validates that the value is a valid duration"
@@ -321,10 +929,14 @@ func (v durationValidator) ValidateString(ctx context.Context, req validator.Str
 
 	// Reject zero or negative durations
 	if duration <= 0 {
+		subject := v.subject
+		if subject == "" {
+			subject = "Timeout"
+		}
 		resp.Diagnostics.AddAttributeError(
 			req.Path,
-			"Invalid Timeout",
-			fmt.Sprintf("Timeout must be positive, got '%s'. Use a positive duration like '30s', '5m', or '1h'", value),
+			"Invalid "+subject,
+			fmt.Sprintf("%s must be positive, got '%s'. Use a positive duration like '30s', '5m', or '1h'", subject, value),
 		)
 	}
 }
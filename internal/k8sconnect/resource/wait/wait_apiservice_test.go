@@ -0,0 +1,116 @@
+package wait
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// metricsAPIService returns the metrics-server APIService with the Available condition
+// when given. A non-nil spec replaces the default one.
+func metricsAPIService(spec map[string]interface{}, available map[string]interface{}) *unstructured.Unstructured {
+	if spec == nil {
+		spec = map[string]interface{}{
+			"group":   "metrics.k8s.io",
+			"version": "v1beta1",
+			"service": map[string]interface{}{"namespace": "kube-system", "name": "metrics-server", "port": int64(443)},
+		}
+	}
+	var conditions []interface{}
+	if available != nil {
+		conditions = append(conditions, available)
+	}
+	return testObject("apiregistration.k8s.io/v1", "APIService", "", "v1beta1.metrics.k8s.io", map[string]interface{}{
+		"spec":   spec,
+		"status": map[string]interface{}{"conditions": conditions},
+	})
+}
+
+func availableCondition(status, reason, message string) map[string]interface{} {
+	return map[string]interface{}{"type": "Available", "status": status, "reason": reason, "message": message}
+}
+
+var apiServiceAvailable = waitForModel{APIServiceAvailable: types.BoolValue(true)}
+
+func TestCheckAPIServiceAvailable(t *testing.T) {
+	tests := []struct {
+		name       string
+		obj        *unstructured.Unstructured
+		want       bool
+		wantReason string
+	}{
+		{"no conditions", metricsAPIService(nil, nil), false, "not reported yet"},
+		{"unavailable", metricsAPIService(nil, availableCondition("False", "MissingEndpoints", "endpoints for service/metrics-server in \"kube-system\" have no addresses")), false, "have no addresses"},
+		{"available", metricsAPIService(nil, availableCondition("True", "Passed", "all checks passed")), true, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, reason := checkAPIServiceAvailable(tt.obj)
+			if got != tt.want || !strings.Contains(reason, tt.wantReason) {
+				t.Errorf("checkAPIServiceAvailable() = %v, %q, want %v, %q", got, reason, tt.want, tt.wantReason)
+			}
+		})
+	}
+}
+
+func TestAPIServiceAvailableWait(t *testing.T) {
+	t.Run("completes once Available is True", func(t *testing.T) {
+		pending := metricsAPIService(nil, availableCondition("False", "MissingEndpoints", "no addresses"))
+		client := newPollClient(t, pending, pending, metricsAPIService(nil, availableCondition("True", "Passed", "all checks passed")))
+		requireWaitCompletes(t, client, apiServiceGVR, pending, polledWait(apiServiceAvailable, "5s"))
+		if client.gets < 3 {
+			t.Errorf("expected polling until available, got %d gets", client.gets)
+		}
+	})
+
+	t.Run("timeout shows the condition message and Service", func(t *testing.T) {
+		failing := metricsAPIService(nil, availableCondition("False", "FailedDiscoveryCheck",
+			`failing or missing response from https://10.96.0.10:443/apis/metrics.k8s.io/v1beta1: x509: certificate signed by unknown authority`))
+		requireWaitTimeout(t, newPollClient(t, failing), apiServiceGVR, failing, polledWait(apiServiceAvailable, "50ms"),
+			`APIService "v1beta1.metrics.k8s.io" did not become Available`,
+			"Available: False (reason: FailedDiscoveryCheck)",
+			"x509: certificate signed by unknown authority",
+			"Service: kube-system/metrics-server (port 443)",
+			"spec.caBundle is empty",
+			"kubectl logs -n kube-system service/metrics-server",
+		)
+	})
+}
+
+func TestAPIServiceCause(t *testing.T) {
+	withCABundle := metricsAPIService(map[string]interface{}{"caBundle": "LS0tLS1CRUdJTi..."}, nil)
+	tests := []struct {
+		name    string
+		obj     *unstructured.Unstructured
+		reason  string
+		message string
+		want    string
+	}{
+		{"service not found", metricsAPIService(nil, nil), "ServiceNotFound", "", "Service that does not exist"},
+		{"missing endpoints", metricsAPIService(nil, nil), "MissingEndpoints", "", "no ready endpoints"},
+		{"tls without caBundle", metricsAPIService(nil, nil), "FailedDiscoveryCheck", "x509: certificate signed by unknown authority", "spec.caBundle is empty"},
+		{"tls with caBundle", withCABundle, "FailedDiscoveryCheck", "x509: certificate signed by unknown authority", "caBundle match"},
+		{"discovery failure", metricsAPIService(nil, nil), "FailedDiscoveryCheck", "503 Service Unavailable", "did not answer discovery"},
+		{"unknown reason", metricsAPIService(nil, nil), "Passed", "", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := apiServiceCause(tt.obj, tt.reason, tt.message)
+			if (tt.want == "") != (got == "") || !strings.Contains(got, tt.want) {
+				t.Errorf("apiServiceCause() = %q, want it to contain %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAPIServiceAvailableObservedValues(t *testing.T) {
+	obj := metricsAPIService(nil, availableCondition("True", "Passed", "all checks passed"))
+	want := map[string]string{apiServiceAvailableCondition: "True"}
+	if got := observedValues(obj, apiServiceAvailable); !reflect.DeepEqual(got, want) {
+		t.Errorf("observedValues() = %v, want %v", got, want)
+	}
+}
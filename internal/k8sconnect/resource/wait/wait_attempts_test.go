@@ -0,0 +1,92 @@
+package wait
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/watch"
+
+	"github.com/jmorris0x0/terraform-provider-k8sconnect/internal/k8sconnect/common/k8sclient"
+)
+
+func attemptsWaitContext(client k8sclient.K8sClient, waitConfig waitForModel) *waitContext {
+	return &waitContext{
+		Data:   &waitResourceModel{},
+		Client: client,
+		GVR:    schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "deployments"},
+		ObjectRef: objectRefModel{
+			APIVersion: types.StringValue("apps/v1"),
+			Kind:       types.StringValue("Deployment"),
+			Name:       types.StringValue("web"),
+			Namespace:  types.StringValue("default"),
+		},
+		WaitConfig: waitConfig,
+	}
+}
+
+func TestPerformWaitRecordsMetrics(t *testing.T) {
+	rolling := deploymentFixture(2, 2, 3, 3, 1, 1)
+	ready := deploymentFixture(2, 2, 3, 3, 3, 3)
+
+	t.Run("poll", func(t *testing.T) {
+		// Not found, then found still rolling, then polled twice until the rollout completes
+		client := &pollOnlyClient{K8sClient: k8sclient.NewStubK8sClient(), t: t, responses: []*unstructured.Unstructured{nil, rolling, rolling, ready}}
+		wc := attemptsWaitContext(client, waitForModel{
+			Rollout:      types.BoolValue(true),
+			Mode:         types.StringValue(waitModePoll),
+			PollInterval: types.StringValue("10ms"),
+			Timeout:      types.StringValue("5s"),
+		})
+		if err := (&waitResource{}).performWait(context.Background(), wc); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got := wc.Data.WaitAttempts.ValueInt64(); got != 4 {
+			t.Errorf("wait_attempts = %d, want 4", got)
+		}
+		elapsed, err := time.ParseDuration(wc.Data.WaitDuration.ValueString())
+		if err != nil || elapsed < 10*time.Millisecond {
+			t.Errorf("wait_duration = %q, want at least one poll interval", wc.Data.WaitDuration.ValueString())
+		}
+	})
+
+	t.Run("watch", func(t *testing.T) {
+		client := &recreatingClient{
+			K8sClient: k8sclient.NewStubK8sClient(),
+			current:   rolling,
+			watcher:   watch.NewFakeWithChanSize(2, false),
+		}
+		client.watcher.Modify(rolling)
+		client.watcher.Modify(ready)
+		wc := attemptsWaitContext(client, waitForModel{Rollout: types.BoolValue(true), Timeout: types.StringValue("5s")})
+		if err := (&waitResource{}).performWait(context.Background(), wc); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		// The existence check, the initial check, and the two watch events
+		if got := wc.Data.WaitAttempts.ValueInt64(); got != 4 {
+			t.Errorf("wait_attempts = %d, want 4", got)
+		}
+		if wc.Data.WaitDuration.IsNull() {
+			t.Error("expected wait_duration to be set")
+		}
+	})
+
+	t.Run("not set on failure", func(t *testing.T) {
+		client := &pollOnlyClient{K8sClient: k8sclient.NewStubK8sClient(), t: t, responses: []*unstructured.Unstructured{rolling}}
+		wc := attemptsWaitContext(client, waitForModel{
+			Rollout:      types.BoolValue(true),
+			Mode:         types.StringValue(waitModePoll),
+			PollInterval: types.StringValue("10ms"),
+			Timeout:      types.StringValue("30ms"),
+		})
+		if err := (&waitResource{}).performWait(context.Background(), wc); err == nil {
+			t.Fatal("expected the rollout wait to time out")
+		}
+		if !wc.Data.WaitAttempts.IsNull() || !wc.Data.WaitDuration.IsNull() {
+			t.Errorf("expected no metrics for a failed wait, got %v and %v", wc.Data.WaitAttempts, wc.Data.WaitDuration)
+		}
+	})
+}
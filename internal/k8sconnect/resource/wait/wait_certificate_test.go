@@ -0,0 +1,119 @@
+package wait
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/jmorris0x0/terraform-provider-k8sconnect/internal/k8sconnect/common/k8sclient"
+)
+
+func certManagerFixture(apiVersion, kind, name, uid, ownerKind, ownerName, ownerUID string, status map[string]interface{}) unstructured.Unstructured {
+	metadata := map[string]interface{}{"name": name, "namespace": "default", "uid": uid}
+	if ownerUID != "" {
+		metadata["ownerReferences"] = []interface{}{
+			map[string]interface{}{"apiVersion": certManagerGroup + "/v1", "kind": ownerKind, "name": ownerName, "uid": ownerUID},
+		}
+	}
+	return unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": apiVersion,
+		"kind":       kind,
+		"metadata":   metadata,
+		"status":     status,
+	}}
+}
+
+func conditionFixture(conditionType, status, reason, message string) map[string]interface{} {
+	return map[string]interface{}{"type": conditionType, "status": status, "reason": reason, "message": message}
+}
+
+func TestConditionTimeoutErrorExplainsCertificate(t *testing.T) {
+	cert := certManagerFixture("cert-manager.io/v1", "Certificate", "web-tls", "cert-uid", "", "", "", map[string]interface{}{
+		"conditions": []interface{}{
+			conditionFixture("Ready", "False", "DoesNotExist", "Issuing certificate as Secret does not exist"),
+			conditionFixture("Issuing", "True", "Requested", "Created new CertificateRequest resource \"web-tls-2\""),
+		},
+		"failedIssuanceAttempts": int64(1),
+		"lastFailureTime":        "2026-10-14T09:00:00Z",
+	})
+	cert.Object["spec"] = map[string]interface{}{"issuerRef": map[string]interface{}{"name": "letsencrypt", "kind": "ClusterIssuer"}}
+
+	oldRequest := certManagerFixture("cert-manager.io/v1", "CertificateRequest", "web-tls-1", "cr1-uid", "Certificate", "web-tls", "cert-uid", map[string]interface{}{
+		"conditions": []interface{}{conditionFixture("Ready", "False", "Failed", "old attempt")},
+	})
+	oldRequest.SetAnnotations(map[string]string{certificateRevisionAnnotation: "1"})
+	request := certManagerFixture("cert-manager.io/v1", "CertificateRequest", "web-tls-2", "cr2-uid", "Certificate", "web-tls", "cert-uid", map[string]interface{}{
+		"conditions": []interface{}{
+			conditionFixture("Approved", "True", "cert-manager.io", "Certificate request has been approved by cert-manager.io"),
+			conditionFixture("Ready", "False", "Pending", "Waiting on certificate issuance from order default/web-tls-2-123: \"pending\""),
+		},
+	})
+	request.SetAnnotations(map[string]string{certificateRevisionAnnotation: "2"})
+	order := certManagerFixture("acme.cert-manager.io/v1", "Order", "web-tls-2-123", "order-uid", "CertificateRequest", "web-tls-2", "cr2-uid", map[string]interface{}{
+		"state":  "invalid",
+		"reason": "Failed to finalize Order: 429 urn:ietf:params:acme:error:rateLimited",
+	})
+	otherOrder := certManagerFixture("acme.cert-manager.io/v1", "Order", "other-1", "other-uid", "CertificateRequest", "other", "other-cr-uid", map[string]interface{}{"state": "valid"})
+
+	stub := k8sclient.NewStubK8sClient()
+	issuer := certManagerFixture("cert-manager.io/v1", "ClusterIssuer", "letsencrypt", "issuer-uid", "", "", "", map[string]interface{}{
+		"conditions": []interface{}{conditionFixture("Ready", "False", "ErrRegisterACMEAccount", "Failed to register ACME account")},
+	})
+	stub.GetResponse = &issuer
+	client := &listClient{
+		K8sClient: stub,
+		lists: map[string][]unstructured.Unstructured{
+			"certificaterequests": {request, oldRequest},
+			"orders":              {otherOrder, order},
+		},
+	}
+
+	err := (&waitResource{}).buildConditionTimeoutError(context.Background(), client,
+		schema.GroupVersionResource{Group: certManagerGroup, Version: "v1", Resource: "certificates"},
+		"default", "web-tls", &cert, "Ready", nil, time.Minute)
+	if err == nil {
+		t.Fatal("expected a timeout error")
+	}
+
+	msg := err.Error()
+	for _, want := range []string{
+		`Condition "Ready" exists but is False (reason: DoesNotExist)`,
+		"Issuance:",
+		"failedIssuanceAttempts: 1",
+		"Issuer: ClusterIssuer/letsencrypt is not Ready: Failed to register ACME account",
+		"CertificateRequest web-tls-2: Ready = False (reason: Pending)",
+		"Order web-tls-2-123: state invalid. Failed to finalize Order: 429",
+		"cmctl status certificate web-tls -n default",
+	} {
+		if !strings.Contains(msg, want) {
+			t.Errorf("timeout error missing %q:\n%s", want, msg)
+		}
+	}
+	if strings.Contains(msg, "web-tls-1") || strings.Contains(msg, "other-1") {
+		t.Errorf("timeout error reports an older or unrelated issuance object:\n%s", msg)
+	}
+}
+
+func TestCertificateIssuanceDetailsDeniedRequest(t *testing.T) {
+	cert := certManagerFixture("cert-manager.io/v1", "Certificate", "web-tls", "cert-uid", "", "", "", nil)
+	request := certManagerFixture("cert-manager.io/v1", "CertificateRequest", "web-tls-1", "cr-uid", "Certificate", "web-tls", "cert-uid", map[string]interface{}{
+		"conditions": []interface{}{
+			conditionFixture("Denied", "True", "policy.cert-manager.io", "dnsName example.com is not allowed"),
+			conditionFixture("Ready", "False", "Denied", "The CertificateRequest was denied by an approval controller"),
+		},
+		"failureTime": "2026-10-14T09:00:00Z",
+	})
+	client := &listClient{K8sClient: k8sclient.NewStubK8sClient(), lists: map[string][]unstructured.Unstructured{"certificaterequests": {request}}}
+
+	details := strings.Join(certificateIssuanceDetails(context.Background(), client, &cert), "\n")
+	if !strings.Contains(details, "CertificateRequest web-tls-1: Denied = True (reason: policy.cert-manager.io). dnsName example.com is not allowed") {
+		t.Errorf("expected the denial to be reported, got:\n%s", details)
+	}
+	if !strings.Contains(details, "CertificateRequest web-tls-1 failed at 2026-10-14T09:00:00Z") {
+		t.Errorf("expected the failure time, got:\n%s", details)
+	}
+}
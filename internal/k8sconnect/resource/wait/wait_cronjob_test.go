@@ -0,0 +1,83 @@
+package wait
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func nightlyBackup(suspend bool, status map[string]interface{}) *unstructured.Unstructured {
+	return testObject("batch/v1", "CronJob", "default", "backup", map[string]interface{}{
+		"spec":   map[string]interface{}{"schedule": "0 3 * * *", "suspend": suspend},
+		"status": status,
+	})
+}
+
+var cronJobScheduled = waitForModel{CronJobScheduled: types.BoolValue(true)}
+
+func TestCheckCronJobScheduled(t *testing.T) {
+	activeJob := []interface{}{map[string]interface{}{"kind": "Job", "name": "backup-29000000"}}
+	tests := []struct {
+		name       string
+		obj        *unstructured.Unstructured
+		want       bool
+		wantReason string
+	}{
+		{"not scheduled yet", nightlyBackup(false, map[string]interface{}{}), false, "no Job scheduled yet"},
+		{"suspended", nightlyBackup(true, map[string]interface{}{}), false, "spec.suspend is true"},
+		{"last schedule time", nightlyBackup(false, map[string]interface{}{"lastScheduleTime": "2026-01-01T03:00:00Z"}), true, ""},
+		{"active job", nightlyBackup(false, map[string]interface{}{"active": activeJob}), true, ""},
+		{"suspended after scheduling", nightlyBackup(true, map[string]interface{}{"lastScheduleTime": "2026-01-01T03:00:00Z"}), true, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, reason := checkCronJobScheduled(tt.obj)
+			if got != tt.want || !strings.Contains(reason, tt.wantReason) {
+				t.Errorf("checkCronJobScheduled() = %v, %q, want %v, %q", got, reason, tt.want, tt.wantReason)
+			}
+		})
+	}
+}
+
+func TestCronJobScheduledWait(t *testing.T) {
+	t.Run("completes once a Job is scheduled", func(t *testing.T) {
+		pending := nightlyBackup(false, map[string]interface{}{})
+		client := newPollClient(t, pending, pending, nightlyBackup(false, map[string]interface{}{"lastScheduleTime": "2026-01-01T03:00:00Z"}))
+		requireWaitCompletes(t, client, cronJobGVR, pending, polledWait(cronJobScheduled, "5s"))
+		if client.gets < 3 {
+			t.Errorf("expected polling until scheduled, got %d gets", client.gets)
+		}
+	})
+
+	t.Run("timeout notes a suspended CronJob", func(t *testing.T) {
+		suspended := nightlyBackup(true, map[string]interface{}{})
+		requireWaitTimeout(t, newPollClient(t, suspended), cronJobGVR, suspended, polledWait(cronJobScheduled, "50ms"),
+			`CronJob "backup" in namespace "default" did not schedule a Job`, "spec.suspend is true", "Schedule: 0 3 * * *", "kubectl create job --from=cronjob/backup")
+	})
+
+	t.Run("timeout without suspend has no suspend warning", func(t *testing.T) {
+		pending := nightlyBackup(false, map[string]interface{}{})
+		timeoutErr := requireWaitTimeout(t, newPollClient(t, pending), cronJobGVR, pending, polledWait(cronJobScheduled, "50ms"))
+		if strings.Contains(timeoutErr.Error(), "spec.suspend is true") {
+			t.Errorf("expected a timeout without a suspend warning, got %v", timeoutErr)
+		}
+	})
+}
+
+func TestCronJobScheduledObservedValues(t *testing.T) {
+	obj := nightlyBackup(false, map[string]interface{}{
+		"lastScheduleTime": "2026-01-01T03:00:00Z",
+		"active":           []interface{}{map[string]interface{}{"name": "backup-29000000"}},
+	})
+	want := map[string]string{
+		cronJobLastScheduleField: "2026-01-01T03:00:00Z",
+		cronJobActiveField:       `[{"name":"backup-29000000"}]`,
+	}
+	if got := observedValues(obj, cronJobScheduled); !reflect.DeepEqual(got, want) {
+		t.Errorf("observedValues() = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,164 @@
+package wait
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestWaitFailureSummary(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want string
+	}{
+		{"timeout", &waitTimeoutError{message: "Wait Timeout: Deployment/web"}, "[WaitTimeout] Wait Operation Failed"},
+		{"wrapped timeout", fmt.Errorf("wait: %w", &waitTimeoutError{message: "timeout"}), "[WaitTimeout] Wait Operation Failed"},
+		{"context deadline", context.DeadlineExceeded, "[WaitTimeout] Wait Operation Failed"},
+		{"other failure", fmt.Errorf("failed to get resource: boom"), "[WaitFailed] Wait Operation Failed"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := waitFailureSummary(tt.err); got != tt.want {
+				t.Errorf("waitFailureSummary() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWaitFailureDetailSnapshot(t *testing.T) {
+	obj := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"metadata": map[string]interface{}{
+			"name":          "web",
+			"namespace":     "default",
+			"managedFields": []interface{}{map[string]interface{}{"manager": "k8sconnect"}},
+		},
+		"status": map[string]interface{}{"readyReplicas": int64(1)},
+	}}
+	err := &waitTimeoutError{message: "Wait Timeout: Deployment/default/web", lastObserved: obj}
+
+	disabled := waitFailureDetail(err, waitForModel{SnapshotOnTimeout: types.BoolNull()})
+	if disabled != err.message {
+		t.Errorf("expected plain message without snapshot_on_timeout, got:\n%s", disabled)
+	}
+
+	enabled := waitFailureDetail(err, waitForModel{SnapshotOnTimeout: types.BoolValue(true)})
+	for _, want := range []string{err.message, "Last observed object", "readyReplicas: 1", "name: web"} {
+		if !strings.Contains(enabled, want) {
+			t.Errorf("detail missing %q:\n%s", want, enabled)
+		}
+	}
+	if strings.Contains(enabled, "managedFields") {
+		t.Errorf("managedFields should be omitted from the snapshot:\n%s", enabled)
+	}
+
+	// Non-timeout failures and timeouts without an observed object are unchanged
+	other := fmt.Errorf("failed to get resource: boom")
+	if got := waitFailureDetail(other, waitForModel{SnapshotOnTimeout: types.BoolValue(true)}); got != other.Error() {
+		t.Errorf("non-timeout detail = %q, want %q", got, other.Error())
+	}
+	notFound := &waitTimeoutError{message: "did not appear"}
+	if got := waitFailureDetail(notFound, waitForModel{SnapshotOnTimeout: types.BoolValue(true)}); got != notFound.message {
+		t.Errorf("detail without observed object = %q, want %q", got, notFound.message)
+	}
+}
+
+func TestFormatObjectSnapshotRedactsSecrets(t *testing.T) {
+	obj := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "Secret",
+		"metadata": map[string]interface{}{
+			"name": "creds",
+			"annotations": map[string]interface{}{
+				"kubectl.kubernetes.io/last-applied-configuration": `{"data":{"password":"aHVudGVyMg=="}}`,
+			},
+		},
+		"data": map[string]interface{}{"password": "aHVudGVyMg=="},
+	}}
+
+	snapshot, err := formatObjectSnapshot(obj)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if strings.Contains(snapshot, "aHVudGVyMg==") {
+		t.Errorf("secret value leaked into snapshot:\n%s", snapshot)
+	}
+	if !strings.Contains(snapshot, "password: <redacted>") {
+		t.Errorf("expected redacted key to remain visible:\n%s", snapshot)
+	}
+	if _, found, _ := unstructured.NestedString(obj.Object, "data", "password"); !found {
+		t.Error("original object was modified")
+	}
+}
+
+func TestTimeoutErrorsIncludeCRDStatus(t *testing.T) {
+	obj := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "example.com/v1",
+		"kind":       "Database",
+		"metadata":   map[string]interface{}{"name": "db", "namespace": "default"},
+		"status": map[string]interface{}{
+			"phase":   "Provisioning",
+			"message": "waiting for volume",
+		},
+	}}
+	r := &waitResource{}
+
+	for name, err := range map[string]error{
+		"no conditions": r.buildNoConditionsError(obj, "Database/default/db", "Ready", time.Minute),
+		"field":         r.buildFieldTimeoutError(obj, "status.endpoint", time.Minute),
+		"field_value":   r.buildFieldValuesTimeoutError(obj, map[string]string{"status.phase": "Ready"}, time.Minute),
+	} {
+		for _, want := range []string{"Current status:\n", "    phase: Provisioning\n", "    message: waiting for volume\n"} {
+			if !strings.Contains(err.Error(), want) {
+				t.Errorf("%s: error missing %q:\n%s", name, want, err)
+			}
+		}
+	}
+
+	empty := obj.DeepCopy()
+	unstructured.RemoveNestedField(empty.Object, "status")
+	if err := r.buildFieldTimeoutError(empty, "status.endpoint", time.Minute); !strings.Contains(err.Error(), "Current status: none reported") {
+		t.Errorf("expected a note that no status was reported:\n%s", err)
+	}
+}
+
+func TestFormatStatusDump(t *testing.T) {
+	obj := &unstructured.Unstructured{Object: map[string]interface{}{
+		"status": map[string]interface{}{
+			"conditions": []interface{}{map[string]interface{}{"type": "Ready", "status": "False"}},
+			"phase":      "Pending",
+		},
+	}}
+	if got := formatStatusDump(obj, "conditions"); got != "    phase: Pending\n" {
+		t.Errorf("formatStatusDump() = %q, want only phase", got)
+	}
+	if got := formatStatusDump(obj, "conditions", "phase"); got != "" {
+		t.Errorf("expected nothing to show once every field is omitted, got %q", got)
+	}
+
+	var nodes []interface{}
+	for i := 0; i < 200; i++ {
+		nodes = append(nodes, fmt.Sprintf("node-%03d.example.com", i))
+	}
+	large := &unstructured.Unstructured{Object: map[string]interface{}{
+		"status": map[string]interface{}{"nodes": nodes},
+	}}
+	dump := formatStatusDump(large)
+	if !strings.Contains(dump, "more bytes truncated)") {
+		t.Fatalf("expected a truncation note:\n%s", dump)
+	}
+	if strings.Contains(dump, "node-199") {
+		t.Error("expected the dump to be cut before the last entries")
+	}
+	if len(dump) > maxStatusDumpBytes*2 {
+		t.Errorf("dump is %d bytes, expected it capped near %d", len(dump), maxStatusDumpBytes)
+	}
+}
@@ -0,0 +1,115 @@
+package wait
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/jmorris0x0/terraform-provider-k8sconnect/internal/k8sconnect/common/k8sclient"
+)
+
+func warningEventFixture(kind, name, reason, message string, lastSeen time.Time, count int64) unstructured.Unstructured {
+	return unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion":     "v1",
+		"kind":           "Event",
+		"metadata":       map[string]interface{}{"name": name + "." + reason, "namespace": "default"},
+		"type":           "Warning",
+		"involvedObject": map[string]interface{}{"kind": kind, "name": name},
+		"reason":         reason,
+		"message":        message,
+		"lastTimestamp":  lastSeen.UTC().Format(time.RFC3339),
+		"count":          count,
+	}}
+}
+
+func TestCollectWarningEvents(t *testing.T) {
+	started := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	items := []unstructured.Unstructured{
+		warningEventFixture("Pod", "web-7d4b9c8f5-x2kqp", "BackOff", "Back-off restarting failed container", started.Add(20*time.Second), 4),
+		warningEventFixture("ReplicaSet", "web-7d4b9c8f5", "FailedCreate", "exceeded quota", started.Add(10*time.Second), 1),
+		warningEventFixture("Deployment", "web", "Unhealthy", "probe failed", started.Add(30*time.Second), 1),
+		// Before the wait started
+		warningEventFixture("Pod", "web-7d4b9c8f5-abcde", "BackOff", "Back-off restarting failed container", started.Add(-time.Minute), 9),
+		// Pods of another Deployment whose name starts with "web-"
+		warningEventFixture("Pod", "web-api-5f6c7d8e9-zzzzz", "BackOff", "Back-off restarting failed container", started.Add(time.Second), 1),
+		warningEventFixture("Service", "web", "Unhealthy", "not the waited-on kind", started.Add(time.Second), 1),
+	}
+	normal := warningEventFixture("Deployment", "web", "ScalingReplicaSet", "Scaled up", started.Add(time.Second), 1)
+	normal.Object["type"] = "Normal"
+	items = append(items, normal)
+
+	events := collectWarningEvents(items, "Deployment", "web", started.Add(500*time.Millisecond))
+	var got []string
+	for _, event := range events {
+		got = append(got, event.object+" "+event.reason)
+	}
+	want := "ReplicaSet/web-7d4b9c8f5 FailedCreate,Pod/web-7d4b9c8f5-x2kqp BackOff,Deployment/web Unhealthy"
+	if strings.Join(got, ",") != want {
+		t.Errorf("collectWarningEvents() = %v, want %s", got, want)
+	}
+	if events[1].count != 4 {
+		t.Errorf("expected the event count to be kept, got %d", events[1].count)
+	}
+}
+
+func TestReportWarningEvents(t *testing.T) {
+	started := time.Now()
+	client := &listClient{
+		K8sClient: k8sclient.NewStubK8sClient(),
+		lists: map[string][]unstructured.Unstructured{
+			"events": {warningEventFixture("Pod", "web-7d4b9c8f5-x2kqp", "BackOff", "Back-off restarting failed container", started.Add(time.Second), 3)},
+		},
+	}
+	wc := &waitContext{
+		Client: client,
+		ObjectRef: objectRefModel{
+			APIVersion: types.StringValue("apps/v1"),
+			Kind:       types.StringValue("Deployment"),
+			Name:       types.StringValue("web"),
+			Namespace:  types.StringValue("default"),
+		},
+		WaitConfig: waitForModel{ReportWarningEvents: types.BoolNull()},
+	}
+	r := &waitResource{}
+
+	var diags diag.Diagnostics
+	r.reportWarningEvents(context.Background(), wc, started, &diags)
+	if len(diags) != 0 {
+		t.Fatalf("expected no diagnostics without report_warning_events, got %v", diags)
+	}
+
+	wc.WaitConfig.ReportWarningEvents = types.BoolValue(true)
+	r.reportWarningEvents(context.Background(), wc, started, &diags)
+	if len(diags) != 1 || diags.HasError() {
+		t.Fatalf("expected a single warning, got %v", diags)
+	}
+	detail := diags[0].Detail()
+	for _, want := range []string{
+		"Deployment web (namespace: default) succeeded",
+		"• Pod/web-7d4b9c8f5-x2kqp BackOff (x3): Back-off restarting failed container",
+		"kubectl get events -n default --field-selector type=Warning",
+	} {
+		if !strings.Contains(detail, want) {
+			t.Errorf("warning missing %q:\n%s", want, detail)
+		}
+	}
+}
+
+func TestFormatWarningEventsCapsEntries(t *testing.T) {
+	var events []warningEvent
+	for i := 0; i < maxWarningEvents+3; i++ {
+		events = append(events, warningEvent{object: "Pod/web-0", reason: "BackOff", message: "m", count: 1})
+	}
+	detail := formatWarningEvents("StatefulSet web", "", events)
+	if got := strings.Count(detail, "• Pod/web-0"); got != maxWarningEvents {
+		t.Errorf("expected %d listed events, got %d", maxWarningEvents, got)
+	}
+	if !strings.Contains(detail, "... and 3 more") || !strings.Contains(detail, "kubectl get events -A") {
+		t.Errorf("unexpected detail:\n%s", detail)
+	}
+}
@@ -0,0 +1,80 @@
+package wait
+
+import (
+	"context"
+	stderrors "errors"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/jmorris0x0/terraform-provider-k8sconnect/internal/k8sconnect/common/k8sclient"
+)
+
+// Harness shared by the unit tests of the kind-specific wait types: build the object,
+// serve it from pollOnlyClient, and run wait_for in poll mode against it
+
+var (
+	apiServiceGVR = schema.GroupVersionResource{Group: "apiregistration.k8s.io", Version: "v1", Resource: "apiservices"}
+	cronJobGVR    = schema.GroupVersionResource{Group: "batch", Version: "v1", Resource: "cronjobs"}
+	deploymentGVR = schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "deployments"}
+	pvcGVR        = schema.GroupVersionResource{Version: "v1", Resource: "persistentvolumeclaims"}
+)
+
+// testObject returns an object with the given identity and top-level fields (spec, status,
+// ...). An empty namespace makes it cluster-scoped.
+func testObject(apiVersion, kind, namespace, name string, fields map[string]interface{}) *unstructured.Unstructured {
+	metadata := map[string]interface{}{"name": name}
+	if namespace != "" {
+		metadata["namespace"] = namespace
+	}
+	obj := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": apiVersion,
+		"kind":       kind,
+		"metadata":   metadata,
+	}}
+	for field, value := range fields {
+		obj.Object[field] = value
+	}
+	return obj
+}
+
+// polledWait returns cfg polling every 10ms until timeout
+func polledWait(cfg waitForModel, timeout string) waitForModel {
+	cfg.Timeout = types.StringValue(timeout)
+	cfg.Mode = types.StringValue(waitModePoll)
+	cfg.PollInterval = types.StringValue("10ms")
+	return cfg
+}
+
+// newPollClient serves responses to Get in turn, repeating the last one
+func newPollClient(t *testing.T, responses ...*unstructured.Unstructured) *pollOnlyClient {
+	return &pollOnlyClient{K8sClient: k8sclient.NewStubK8sClient(), t: t, responses: responses}
+}
+
+// requireWaitCompletes fails the test unless waiting for obj with cfg succeeds
+func requireWaitCompletes(t *testing.T, client k8sclient.K8sClient, gvr schema.GroupVersionResource, obj *unstructured.Unstructured, cfg waitForModel) {
+	t.Helper()
+	if err := (&waitResource{}).waitForResource(context.Background(), client, gvr, obj, cfg); err != nil {
+		t.Fatalf("expected the wait to complete, got %v", err)
+	}
+}
+
+// requireWaitTimeout fails the test unless waiting for obj with cfg times out with an error
+// containing every one of wants
+func requireWaitTimeout(t *testing.T, client k8sclient.K8sClient, gvr schema.GroupVersionResource, obj *unstructured.Unstructured, cfg waitForModel, wants ...string) *waitTimeoutError {
+	t.Helper()
+	err := (&waitResource{}).waitForResource(context.Background(), client, gvr, obj, cfg)
+	var timeoutErr *waitTimeoutError
+	if !stderrors.As(err, &timeoutErr) {
+		t.Fatalf("expected a wait timeout error, got %v", err)
+	}
+	for _, want := range wants {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("error missing %q:\n%s", want, err)
+		}
+	}
+	return timeoutErr
+}
@@ -0,0 +1,161 @@
+package wait
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/jmorris0x0/terraform-provider-k8sconnect/internal/k8sconnect/common/k8sclient"
+)
+
+// listClient serves List from fixed results per resource
+type listClient struct {
+	k8sclient.K8sClient
+	lists map[string][]unstructured.Unstructured
+}
+
+func (c *listClient) List(ctx context.Context, gvr schema.GroupVersionResource, namespace string, opts metav1.ListOptions) (*unstructured.UnstructuredList, error) {
+	return &unstructured.UnstructuredList{Items: c.lists[gvr.Resource]}, nil
+}
+
+func ingressFixture(className string, status map[string]interface{}) *unstructured.Unstructured {
+	spec := map[string]interface{}{}
+	if className != "" {
+		spec["ingressClassName"] = className
+	}
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "networking.k8s.io/v1",
+		"kind":       "Ingress",
+		"metadata":   map[string]interface{}{"name": "web", "namespace": "default"},
+		"spec":       spec,
+		"status":     status,
+	}}
+}
+
+func ingressClassFixture(name string, isDefault bool) unstructured.Unstructured {
+	class := unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "networking.k8s.io/v1",
+		"kind":       "IngressClass",
+		"metadata":   map[string]interface{}{"name": name},
+		"spec":       map[string]interface{}{"controller": "k8s.io/ingress-nginx"},
+	}}
+	if isDefault {
+		class.SetAnnotations(map[string]string{defaultIngressClassAnnotation: "true"})
+	}
+	return class
+}
+
+func TestCheckIngressReady(t *testing.T) {
+	tests := []struct {
+		name   string
+		status map[string]interface{}
+		ready  bool
+	}{
+		{"no status", map[string]interface{}{}, false},
+		{"empty ingress list", map[string]interface{}{"loadBalancer": map[string]interface{}{"ingress": []interface{}{}}}, false},
+		{"entry without address", map[string]interface{}{"loadBalancer": map[string]interface{}{
+			"ingress": []interface{}{map[string]interface{}{"ports": []interface{}{}}},
+		}}, false},
+		{"ip", map[string]interface{}{"loadBalancer": map[string]interface{}{
+			"ingress": []interface{}{map[string]interface{}{"ip": "203.0.113.10"}},
+		}}, true},
+		{"hostname", map[string]interface{}{"loadBalancer": map[string]interface{}{
+			"ingress": []interface{}{map[string]interface{}{"hostname": "lb.example.com"}},
+		}}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ready, reason := checkIngressReady(ingressFixture("nginx", tt.status))
+			if ready != tt.ready {
+				t.Errorf("checkIngressReady() = %v (%s), want %v", ready, reason, tt.ready)
+			}
+		})
+	}
+}
+
+func TestIngressClassIssues(t *testing.T) {
+	nginx := ingressClassFixture("nginx", false)
+	defaultNginx := ingressClassFixture("nginx", true)
+
+	tests := []struct {
+		name        string
+		className   string
+		legacyClass string
+		classes     []unstructured.Unstructured
+		want        string
+	}{
+		{"class exists", "nginx", "", []unstructured.Unstructured{nginx}, ""},
+		{"class missing", "traefik", "", []unstructured.Unstructured{nginx}, `IngressClass "traefik" from spec.ingressClassName does not exist (available: nginx)`},
+		{"no classes at all", "nginx", "", nil, "No IngressClass exists"},
+		{"no class and no default", "", "", []unstructured.Unstructured{nginx}, "no IngressClass is marked as default"},
+		{"no class with a default", "", "", []unstructured.Unstructured{defaultNginx}, ""},
+		{"legacy annotation", "", "nginx", nil, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			issues := ingressClassIssues(tt.className, tt.legacyClass, tt.classes)
+			if tt.want == "" {
+				if len(issues) != 0 {
+					t.Errorf("expected no issues, got %v", issues)
+				}
+				return
+			}
+			if len(issues) != 1 || !strings.Contains(issues[0], tt.want) {
+				t.Errorf("expected an issue containing %q, got %v", tt.want, issues)
+			}
+		})
+	}
+}
+
+func TestIngressTimeoutErrorWarnsWithoutController(t *testing.T) {
+	obj := ingressFixture("", map[string]interface{}{})
+	r := &waitResource{}
+
+	// A default class exists, but nothing has recorded events on the Ingress
+	client := &listClient{
+		K8sClient: k8sclient.NewStubK8sClient(),
+		lists: map[string][]unstructured.Unstructured{
+			"ingressclasses": {ingressClassFixture("nginx", true)},
+		},
+	}
+	err := r.buildIngressTimeoutError(context.Background(), client, obj, obj, time.Minute)
+	for _, want := range []string{
+		`Ingress "web" in namespace "default" was not assigned an address`,
+		"no ingress controller appears to be processing this Ingress",
+		"No events have been recorded",
+		"kubectl describe ingress web -n default",
+	} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("error missing %q:\n%s", want, err)
+		}
+	}
+
+	// With controller events and a claiming class there is nothing to warn about
+	client.lists["events"] = []unstructured.Unstructured{{Object: map[string]interface{}{"reason": "Sync"}}}
+	err = r.buildIngressTimeoutError(context.Background(), client, obj, obj, time.Minute)
+	if strings.Contains(err.Error(), "Warning:") {
+		t.Errorf("unexpected controller warning:\n%s", err)
+	}
+}
+
+func TestIngressReadyPopulatesResult(t *testing.T) {
+	wc := &waitContext{WaitConfig: waitForModel{IngressReady: types.BoolValue(true)}}
+	if got := resultFields(wc); len(got) != 1 || got[0] != ingressAddressField {
+		t.Errorf("resultFields() = %v, want [%s]", got, ingressAddressField)
+	}
+
+	wc = &waitContext{Steps: []waitForModel{
+		{IngressReady: types.BoolValue(true)},
+		{Field: types.StringValue("metadata.uid")},
+		{Condition: types.StringValue("Ready")},
+	}}
+	if got := resultFields(wc); len(got) != 2 || got[0] != ingressAddressField || got[1] != "metadata.uid" {
+		t.Errorf("resultFields() with steps = %v", got)
+	}
+}
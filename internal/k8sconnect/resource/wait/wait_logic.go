@@ -2,11 +2,14 @@ package wait
 
 import (
 	"context"
+	stderrors "errors"
 	"fmt"
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -16,6 +19,55 @@ import (
 	"github.com/jmorris0x0/terraform-provider-k8sconnect/internal/k8sconnect/common/k8sclient"
 )
 
+const (
+	waitModeWatch = "watch"
+	waitModePoll  = "poll"
+
+	defaultPollInterval = 2 * time.Second
+
+	// strictRolloutWaitType names strict Deployment rollouts in logs and timeout errors
+	strictRolloutWaitType = "deployment rollout (strict)"
+)
+
+// pollSettings controls how a wait observes the resource. By default waits watch
+// and fall back to polling if the watch fails; mode = "poll" skips the watch for
+// networks (proxies, API gateways) that break long-lived connections.
+type pollSettings struct {
+	pollOnly bool
+	interval time.Duration
+}
+
+// parsePollSettings reads wait_for.mode and wait_for.poll_interval
+func parsePollSettings(ctx context.Context, waitConfig waitForModel) pollSettings {
+	ps := pollSettings{
+		pollOnly: waitConfig.Mode.ValueString() == waitModePoll,
+		interval: defaultPollInterval,
+	}
+	if !waitConfig.PollInterval.IsNull() && waitConfig.PollInterval.ValueString() != "" {
+		if d, err := time.ParseDuration(waitConfig.PollInterval.ValueString()); err == nil && d > 0 {
+			ps.interval = d
+		} else {
+			tflog.Warn(ctx, "Invalid poll_interval, using default", map[string]interface{}{
+				"provided": waitConfig.PollInterval.ValueString(),
+				"default":  defaultPollInterval.String(),
+			})
+		}
+	}
+	return ps
+}
+
+// firstTick returns the channel a poll loop waits on first. In poll mode it has
+// already fired, so the initial check runs immediately rather than after a full
+// interval; as a watch fallback the resource was just checked, so the loop waits.
+func (ps pollSettings) firstTick(tick <-chan time.Time) <-chan time.Time {
+	if !ps.pollOnly {
+		return tick
+	}
+	fired := make(chan time.Time, 1)
+	fired <- time.Now()
+	return fired
+}
+
 // waitForResource waits for resource to meet configured conditions
 func (r *waitResource) waitForResource(ctx context.Context, client k8sclient.K8sClient,
 	gvr schema.GroupVersionResource, obj *unstructured.Unstructured, waitConfig waitForModel) error {
@@ -33,48 +85,127 @@ func (r *waitResource) waitForResource(ctx context.Context, client k8sclient.K8s
 		}
 	}
 
+	ps := parsePollSettings(ctx, waitConfig)
+
 	// Handle explicit rollout=true
 	if !waitConfig.Rollout.IsNull() && waitConfig.Rollout.ValueBool() {
 		tflog.Info(ctx, "Explicit rollout waiting", map[string]interface{}{
 			"kind": obj.GetKind(),
 			"name": obj.GetName(),
 		})
-		if err := r.waitForRollout(ctx, client, gvr, obj, timeout); err != nil {
+		if waitConfig.Strict.ValueBool() && obj.GetKind() == "Deployment" {
+			return r.waitWithRolloutCheck(ctx, client, gvr, obj, checkStrictDeploymentRollout, strictRolloutWaitType, timeout, ps)
+		}
+		minReadyPercent := waitConfig.MinReadyPercent.ValueInt64()
+		if err := r.waitForRollout(ctx, client, gvr, obj, minReadyPercent, timeout, ps); err != nil {
 			return err
 		}
 		return nil
 	}
 
+	// Handle ingress address readiness
+	if !waitConfig.IngressReady.IsNull() && waitConfig.IngressReady.ValueBool() {
+		tflog.Info(ctx, "Waiting for ingress address", map[string]interface{}{
+			"resource": fmt.Sprintf("%s/%s", obj.GetKind(), obj.GetName()),
+		})
+		return r.waitWithCheck(ctx, client, gvr, obj, checkIngressReady, ingressWaitType, timeout, ps)
+	}
+
+	// Handle PVC binding, a field_value wait with its own timeout diagnostics
+	if waitConfig.PVCBound.ValueBool() {
+		tflog.Info(ctx, "Waiting for PersistentVolumeClaim to be bound", map[string]interface{}{
+			"resource": fmt.Sprintf("%s/%s", obj.GetKind(), obj.GetName()),
+		})
+		err := r.waitForFieldValues(ctx, client, gvr, obj, pvcBoundFieldValues(), timeout, ps)
+		var timeoutErr *waitTimeoutError
+		if stderrors.As(err, &timeoutErr) {
+			return r.buildPVCTimeoutError(ctx, client, timeoutErr.lastObserved, obj, timeout)
+		}
+		return err
+	}
+
+	// Handle CronJob scheduling, with its own timeout diagnostics
+	if waitConfig.CronJobScheduled.ValueBool() {
+		tflog.Info(ctx, "Waiting for CronJob to schedule a Job", map[string]interface{}{
+			"resource": fmt.Sprintf("%s/%s", obj.GetKind(), obj.GetName()),
+		})
+		return r.waitWithCheck(ctx, client, gvr, obj, checkCronJobScheduled, cronJobWaitType, timeout, ps)
+	}
+
+	// Handle APIService availability, a condition wait with its own timeout diagnostics
+	if waitConfig.APIServiceAvailable.ValueBool() {
+		tflog.Info(ctx, "Waiting for APIService to be available", map[string]interface{}{
+			"resource": fmt.Sprintf("%s/%s", obj.GetKind(), obj.GetName()),
+		})
+		return r.waitWithCheck(ctx, client, gvr, obj, checkAPIServiceAvailable, apiServiceWaitType, timeout, ps)
+	}
+
+	// Handle pod readiness, checked on the selected pods rather than the workload's status
+	if waitConfig.PodsReady.ValueBool() {
+		tflog.Info(ctx, "Waiting for pods to be ready", map[string]interface{}{
+			"resource": fmt.Sprintf("%s/%s", obj.GetKind(), obj.GetName()),
+		})
+		return r.waitForPodsReady(ctx, client, gvr, obj, timeout, ps)
+	}
+
+	// Handle kstatus-style readiness
+	if waitConfig.Ready.ValueBool() {
+		tflog.Info(ctx, "Waiting for resource to be ready", map[string]interface{}{
+			"resource": fmt.Sprintf("%s/%s", obj.GetKind(), obj.GetName()),
+		})
+		return r.waitWithCheck(ctx, client, gvr, obj, checkReady, readyWaitType, timeout, ps)
+	}
+
+	// Handle status.phase, failing fast on fail_phases
+	if !waitConfig.Phase.IsNull() && waitConfig.Phase.ValueString() != "" {
+		pw, err := newPhaseWait(ctx, waitConfig)
+		if err != nil {
+			return err
+		}
+		tflog.Info(ctx, "Waiting for phase", map[string]interface{}{
+			"phase":       pw.phase,
+			"fail_phases": pw.failPhases,
+			"resource":    fmt.Sprintf("%s/%s", obj.GetKind(), obj.GetName()),
+		})
+		return r.waitWithFailCheck(ctx, client, gvr, obj, pw.check, pw.failedError, nil, phaseWaitType, timeout, ps)
+	}
+
 	// Handle field existence check
 	if !waitConfig.Field.IsNull() && waitConfig.Field.ValueString() != "" {
 		tflog.Info(ctx, "Waiting for field to exist", map[string]interface{}{
 			"field":    waitConfig.Field.ValueString(),
 			"resource": fmt.Sprintf("%s/%s", obj.GetKind(), obj.GetName()),
 		})
-		return r.waitForField(ctx, client, gvr, obj, waitConfig.Field.ValueString(), timeout)
+		return r.waitForField(ctx, client, gvr, obj, waitConfig.Field.ValueString(), timeout, ps)
 	}
 
 	// Handle field value check
 	if !waitConfig.FieldValue.IsNull() {
-		fieldMap := make(map[string]string)
-		diags := waitConfig.FieldValue.ElementsAs(ctx, &fieldMap, false)
-		if diags.HasError() {
-			return fmt.Errorf("failed to parse field_value map")
+		fieldMap, err := expectedFieldValues(waitConfig.FieldValue)
+		if err != nil {
+			return err
 		}
 		tflog.Info(ctx, "Waiting for field values", map[string]interface{}{
 			"fields":   fieldMap,
 			"resource": fmt.Sprintf("%s/%s", obj.GetKind(), obj.GetName()),
 		})
-		return r.waitForFieldValues(ctx, client, gvr, obj, fieldMap, timeout)
+		return r.waitForFieldValues(ctx, client, gvr, obj, fieldMap, timeout, ps)
 	}
 
 	// Handle condition check
 	if !waitConfig.Condition.IsNull() && waitConfig.Condition.ValueString() != "" {
+		var successReasons []string
+		if !waitConfig.SuccessReasons.IsNull() && !waitConfig.SuccessReasons.IsUnknown() {
+			if diags := waitConfig.SuccessReasons.ElementsAs(ctx, &successReasons, false); diags.HasError() {
+				return fmt.Errorf("invalid success_reasons: %v", diags)
+			}
+		}
 		tflog.Info(ctx, "Waiting for condition", map[string]interface{}{
-			"condition": waitConfig.Condition.ValueString(),
-			"resource":  fmt.Sprintf("%s/%s", obj.GetKind(), obj.GetName()),
+			"condition":       waitConfig.Condition.ValueString(),
+			"success_reasons": successReasons,
+			"resource":        fmt.Sprintf("%s/%s", obj.GetKind(), obj.GetName()),
 		})
-		return r.waitForCondition(ctx, client, gvr, obj, waitConfig.Condition.ValueString(), timeout)
+		return r.waitForCondition(ctx, client, gvr, obj, waitConfig.Condition.ValueString(), successReasons, timeout, ps)
 	}
 
 	// No wait conditions configured
@@ -84,13 +215,17 @@ func (r *waitResource) waitForResource(ctx context.Context, client k8sclient.K8s
 // waitForField waits for a field to exist and be non-empty
 func (r *waitResource) waitForField(ctx context.Context, client k8sclient.K8sClient,
 	gvr schema.GroupVersionResource, obj *unstructured.Unstructured,
-	fieldPath string, timeout time.Duration) error {
+	fieldPath string, timeout time.Duration, ps pollSettings) error {
 
 	jp := jsonpath.New("wait")
 	if err := jp.Parse(fmt.Sprintf("{.%s}", fieldPath)); err != nil {
 		return fmt.Errorf("invalid field path %q: %w", fieldPath, err)
 	}
 
+	if ps.pollOnly {
+		return r.pollForField(ctx, client, gvr, obj, jp, fieldPath, timeout, ps)
+	}
+
 	// Check current state first and get ResourceVersion
 	current, err := client.Get(ctx, gvr, obj.GetNamespace(), obj.GetName())
 	if err == nil {
@@ -115,18 +250,19 @@ func (r *waitResource) waitForField(ctx context.Context, client k8sclient.K8sCli
 			tflog.Warn(ctx, "Watch not supported, falling back to polling", map[string]interface{}{
 				"error": err.Error(),
 			})
-			return r.pollForField(ctx, client, gvr, obj, jp, fieldPath, timeout)
+			return r.pollForField(ctx, client, gvr, obj, jp, fieldPath, timeout, ps)
 		}
 		defer watcher.Stop()
 
 		timeoutCh := time.After(timeout)
+		lastSeen := current
 
 		for {
 			select {
 			case <-ctx.Done():
 				return ctx.Err()
 			case <-timeoutCh:
-				return r.buildFieldTimeoutError(obj, fieldPath, timeout)
+				return r.buildFieldTimeoutError(lastSeen, fieldPath, timeout)
 			case event, ok := <-watcher.ResultChan():
 				if !ok {
 					return fmt.Errorf("watch ended unexpectedly")
@@ -136,11 +272,17 @@ func (r *waitResource) waitForField(ctx context.Context, client k8sclient.K8sCli
 					tflog.Warn(ctx, "Watch error, falling back to polling", map[string]interface{}{
 						"error": fmt.Sprintf("%v", event.Object),
 					})
-					return r.pollForField(ctx, client, gvr, obj, jp, fieldPath, timeout)
+					return r.pollForField(ctx, client, gvr, obj, jp, fieldPath, timeout, ps)
+				}
+
+				if event.Type == watch.Deleted {
+					logWaitResourceDeleted(ctx, obj, "field")
+					continue
 				}
 
 				if event.Type == watch.Modified || event.Type == watch.Added {
 					current := event.Object.(*unstructured.Unstructured)
+					lastSeen = current
 					results, err := jp.FindResults(current.Object)
 					if err == nil && len(results) > 0 && len(results[0]) > 0 {
 						val := results[0][0].Interface()
@@ -158,35 +300,37 @@ func (r *waitResource) waitForField(ctx context.Context, client k8sclient.K8sCli
 	}
 
 	// If we can't get current state, fall back to polling
-	return r.pollForField(ctx, client, gvr, obj, jp, fieldPath, timeout)
+	return r.pollForField(ctx, client, gvr, obj, jp, fieldPath, timeout, ps)
 }
 
 // pollForField falls back to polling when watch is not available
 func (r *waitResource) pollForField(ctx context.Context, client k8sclient.K8sClient,
 	gvr schema.GroupVersionResource, obj *unstructured.Unstructured,
-	jp *jsonpath.JSONPath, fieldPath string, timeout time.Duration) error {
+	jp *jsonpath.JSONPath, fieldPath string, timeout time.Duration, ps pollSettings) error {
 
-	ticker := time.NewTicker(2 * time.Second)
+	ticker := time.NewTicker(ps.interval)
 	defer ticker.Stop()
+	next := ps.firstTick(ticker.C)
 
 	deadline := time.Now().Add(timeout)
+	lastSeen := obj
 
 	for {
 		select {
 		case <-ctx.Done():
 			return ctx.Err()
-		case <-ticker.C:
+		case <-next:
+			next = ticker.C
 			if time.Now().After(deadline) {
-				return r.buildFieldTimeoutError(obj, fieldPath, timeout)
+				return r.buildFieldTimeoutError(lastSeen, fieldPath, timeout)
 			}
 
 			current, err := client.Get(ctx, gvr, obj.GetNamespace(), obj.GetName())
 			if err != nil {
-				tflog.Warn(ctx, "Failed to get resource during poll", map[string]interface{}{
-					"error": err.Error(),
-				})
+				logWaitGetError(ctx, err, "field")
 				continue
 			}
+			lastSeen = current
 
 			results, err := jp.FindResults(current.Object)
 			if err == nil && len(results) > 0 && len(results[0]) > 0 {
@@ -203,10 +347,35 @@ func (r *waitResource) pollForField(ctx context.Context, client k8sclient.K8sCli
 	}
 }
 
+// expectedFieldValues resolves field_value into the expected value per field path.
+// Expected values may come from attributes that are unknown during plan (validation skips
+// them); by apply Terraform has resolved them, so a value still unknown here is reported
+// by key rather than waited on.
+func expectedFieldValues(fieldValue types.Map) (map[string]string, error) {
+	if fieldValue.IsUnknown() {
+		return nil, fmt.Errorf("field_value is not known yet; its expected values are resolved at apply")
+	}
+
+	fieldMap := make(map[string]string, len(fieldValue.Elements()))
+	for field, value := range fieldValue.Elements() {
+		expected, ok := value.(types.String)
+		switch {
+		case !ok:
+			return nil, fmt.Errorf("field_value[%q] is not a string", field)
+		case expected.IsUnknown():
+			return nil, fmt.Errorf("field_value[%q] is not known yet; its expected value is resolved at apply", field)
+		case expected.IsNull():
+			return nil, fmt.Errorf("field_value[%q] is null; set the expected value or remove %q from field_value", field, field)
+		}
+		fieldMap[field] = expected.ValueString()
+	}
+	return fieldMap, nil
+}
+
 // waitForFieldValues waits for fields to have specific values
 func (r *waitResource) waitForFieldValues(ctx context.Context, client k8sclient.K8sClient,
 	gvr schema.GroupVersionResource, obj *unstructured.Unstructured,
-	fieldValues map[string]string, timeout time.Duration) error {
+	fieldValues map[string]string, timeout time.Duration, ps pollSettings) error {
 
 	// Create JSONPath parsers for each field
 	parsers := make(map[string]*jsonpath.JSONPath)
@@ -235,6 +404,10 @@ func (r *waitResource) waitForFieldValues(ctx context.Context, client k8sclient.
 		return true
 	}
 
+	if ps.pollOnly {
+		return r.pollForFieldValues(ctx, client, gvr, obj, checkFields, fieldValues, timeout, ps)
+	}
+
 	// Check current state first
 	current, err := client.Get(ctx, gvr, obj.GetNamespace(), obj.GetName())
 	if err == nil && checkFields(current) {
@@ -254,29 +427,39 @@ func (r *waitResource) waitForFieldValues(ctx context.Context, client k8sclient.
 
 	watcher, err := client.Watch(ctx, gvr, obj.GetNamespace(), opts)
 	if err != nil {
-		return r.pollForFieldValues(ctx, client, gvr, obj, checkFields, fieldValues, timeout)
+		return r.pollForFieldValues(ctx, client, gvr, obj, checkFields, fieldValues, timeout, ps)
 	}
 	defer watcher.Stop()
 
 	timeoutCh := time.After(timeout)
+	lastSeen := obj
+	if current != nil {
+		lastSeen = current
+	}
 
 	for {
 		select {
 		case <-ctx.Done():
 			return ctx.Err()
 		case <-timeoutCh:
-			return r.buildFieldValuesTimeoutError(obj, fieldValues, timeout)
+			return r.buildFieldValuesTimeoutError(lastSeen, fieldValues, timeout)
 		case event, ok := <-watcher.ResultChan():
 			if !ok {
 				return fmt.Errorf("watch ended unexpectedly")
 			}
 
 			if event.Type == watch.Error {
-				return r.pollForFieldValues(ctx, client, gvr, obj, checkFields, fieldValues, timeout)
+				return r.pollForFieldValues(ctx, client, gvr, obj, checkFields, fieldValues, timeout, ps)
+			}
+
+			if event.Type == watch.Deleted {
+				logWaitResourceDeleted(ctx, obj, "field_value")
+				continue
 			}
 
 			if event.Type == watch.Modified || event.Type == watch.Added {
 				current := event.Object.(*unstructured.Unstructured)
+				lastSeen = current
 				if checkFields(current) {
 					tflog.Info(ctx, "Field values now match", map[string]interface{}{
 						"fields": fieldValues,
@@ -291,26 +474,31 @@ func (r *waitResource) waitForFieldValues(ctx context.Context, client k8sclient.
 // pollForFieldValues polls for field values when watch is not available
 func (r *waitResource) pollForFieldValues(ctx context.Context, client k8sclient.K8sClient,
 	gvr schema.GroupVersionResource, obj *unstructured.Unstructured,
-	checkFunc func(*unstructured.Unstructured) bool, fieldValues map[string]string, timeout time.Duration) error {
+	checkFunc func(*unstructured.Unstructured) bool, fieldValues map[string]string, timeout time.Duration, ps pollSettings) error {
 
-	ticker := time.NewTicker(2 * time.Second)
+	ticker := time.NewTicker(ps.interval)
 	defer ticker.Stop()
+	next := ps.firstTick(ticker.C)
 
 	deadline := time.Now().Add(timeout)
+	lastSeen := obj
 
 	for {
 		select {
 		case <-ctx.Done():
 			return ctx.Err()
-		case <-ticker.C:
+		case <-next:
+			next = ticker.C
 			if time.Now().After(deadline) {
-				return r.buildFieldValuesTimeoutError(obj, fieldValues, timeout)
+				return r.buildFieldValuesTimeoutError(lastSeen, fieldValues, timeout)
 			}
 
 			current, err := client.Get(ctx, gvr, obj.GetNamespace(), obj.GetName())
 			if err != nil {
+				logWaitGetError(ctx, err, "field_value")
 				continue
 			}
+			lastSeen = current
 
 			if checkFunc(current) {
 				tflog.Info(ctx, "Field values now match (via polling)", map[string]interface{}{
@@ -322,13 +510,14 @@ func (r *waitResource) pollForFieldValues(ctx context.Context, client k8sclient.
 	}
 }
 
-// waitForCondition waits for a Kubernetes condition to be True
+// waitForCondition waits for a Kubernetes condition to be True, or to report one of
+// successReasons
 func (r *waitResource) waitForCondition(ctx context.Context, client k8sclient.K8sClient,
 	gvr schema.GroupVersionResource, obj *unstructured.Unstructured,
-	conditionType string, timeout time.Duration) error {
+	conditionType string, successReasons []string, timeout time.Duration, ps pollSettings) error {
 
 	// Create condition checker
-	checker := r.createConditionChecker(conditionType)
+	checker := r.createConditionChecker(conditionType, successReasons...)
 
 	// Check if already satisfied
 	if satisfied, err := r.checkConditionImmediately(ctx, client, gvr, obj, checker, conditionType); err != nil {
@@ -337,11 +526,15 @@ func (r *waitResource) waitForCondition(ctx context.Context, client k8sclient.K8
 		return nil
 	}
 
+	if ps.pollOnly {
+		return r.pollForCondition(ctx, client, gvr, obj, checker, conditionType, successReasons, timeout, ps)
+	}
+
 	// Try watching for changes
-	if err := r.watchForCondition(ctx, client, gvr, obj, checker, conditionType, timeout); err != nil {
+	if err := r.watchForCondition(ctx, client, gvr, obj, checker, conditionType, successReasons, timeout); err != nil {
 		// Fall back to polling if watch fails
 		if r.isWatchError(err) {
-			return r.pollForCondition(ctx, client, gvr, obj, checker, conditionType, timeout)
+			return r.pollForCondition(ctx, client, gvr, obj, checker, conditionType, successReasons, timeout, ps)
 		}
 		return err
 	}
@@ -350,7 +543,7 @@ func (r *waitResource) waitForCondition(ctx context.Context, client k8sclient.K8
 }
 
 // createConditionChecker returns a function that checks if a condition is met
-func (r *waitResource) createConditionChecker(conditionType string) func(*unstructured.Unstructured) bool {
+func (r *waitResource) createConditionChecker(conditionType string, successReasons ...string) func(*unstructured.Unstructured) bool {
 	return func(obj *unstructured.Unstructured) bool {
 		conditions, found, err := unstructured.NestedSlice(obj.Object, "status", "conditions")
 		if err != nil || !found {
@@ -358,25 +551,109 @@ func (r *waitResource) createConditionChecker(conditionType string) func(*unstru
 		}
 
 		for _, cond := range conditions {
-			if r.isConditionMet(cond, conditionType) {
-				return true
+			if r.isConditionMet(cond, conditionType, successReasons) {
+				// A Pod is only ready once every readiness gate is True as well
+				return len(blockingReadinessGates(obj, conditionType)) == 0
 			}
 		}
 		return false
 	}
 }
 
-// isConditionMet checks if a single condition matches and is True
-func (r *waitResource) isConditionMet(cond interface{}, conditionType string) bool {
+// isConditionMet checks if a single condition matches and is True, or reports one of
+// successReasons whatever its status
+func (r *waitResource) isConditionMet(cond interface{}, conditionType string, successReasons []string) bool {
 	condMap, ok := cond.(map[string]interface{})
 	if !ok {
 		return false
 	}
 
 	typeVal, typeOk := condMap["type"].(string)
-	statusVal, statusOk := condMap["status"].(string)
+	if !typeOk || typeVal != conditionType {
+		return false
+	}
+	if statusVal, _ := condMap["status"].(string); statusVal == "True" {
+		return true
+	}
+	reason, _ := condMap["reason"].(string)
+	if reason == "" {
+		return false
+	}
+	for _, successReason := range successReasons {
+		if reason == successReason {
+			return true
+		}
+	}
+	return false
+}
 
-	return typeOk && typeVal == conditionType && statusOk && statusVal == "True"
+// readinessGateStatus is the state of one spec.readinessGates entry on a Pod
+type readinessGateStatus struct {
+	conditionType string
+	status        string // empty when the condition has not been reported
+	reason        string
+	message       string
+}
+
+// podReadinessGates returns the Pod's declared readiness gates with the status of the
+// matching status.conditions entries. Custom gates are set by external controllers
+// (e.g., load balancer controllers), so Ready can be reported before they settle.
+func podReadinessGates(obj *unstructured.Unstructured) []readinessGateStatus {
+	if obj.GetKind() != "Pod" {
+		return nil
+	}
+
+	gates, _, _ := unstructured.NestedSlice(obj.Object, "spec", "readinessGates")
+	if len(gates) == 0 {
+		return nil
+	}
+
+	conditions, _, _ := unstructured.NestedSlice(obj.Object, "status", "conditions")
+	byType := make(map[string]map[string]interface{}, len(conditions))
+	for _, cond := range conditions {
+		if condMap, ok := cond.(map[string]interface{}); ok {
+			if typeVal, ok := condMap["type"].(string); ok {
+				byType[typeVal] = condMap
+			}
+		}
+	}
+
+	var result []readinessGateStatus
+	for _, gate := range gates {
+		gateMap, ok := gate.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		conditionType, _ := gateMap["conditionType"].(string)
+		if conditionType == "" {
+			continue
+		}
+
+		gs := readinessGateStatus{conditionType: conditionType}
+		if cond, ok := byType[conditionType]; ok {
+			gs.status, _ = cond["status"].(string)
+			gs.reason, _ = cond["reason"].(string)
+			gs.message, _ = cond["message"].(string)
+		}
+		result = append(result, gs)
+	}
+	return result
+}
+
+// blockingReadinessGates returns the readiness gates that keep a Pod Ready wait from
+// completing. Only Ready waits on Pods consider readiness gates.
+func blockingReadinessGates(obj *unstructured.Unstructured, conditionType string) []readinessGateStatus {
+	if conditionType != "Ready" {
+		return nil
+	}
+
+	var blocking []readinessGateStatus
+	for _, gate := range podReadinessGates(obj) {
+		if gate.status != "True" {
+			blocking = append(blocking, gate)
+		}
+	}
+	return blocking
 }
 
 // checkConditionImmediately checks if condition is already satisfied
@@ -402,7 +679,7 @@ func (r *waitResource) checkConditionImmediately(ctx context.Context, client k8s
 // watchForCondition sets up a watch for condition changes
 func (r *waitResource) watchForCondition(ctx context.Context, client k8sclient.K8sClient,
 	gvr schema.GroupVersionResource, obj *unstructured.Unstructured,
-	checker func(*unstructured.Unstructured) bool, conditionType string, timeout time.Duration) error {
+	checker func(*unstructured.Unstructured) bool, conditionType string, successReasons []string, timeout time.Duration) error {
 
 	// Setup watch options
 	watchOpts := r.createWatchOptions(obj)
@@ -421,7 +698,7 @@ func (r *waitResource) watchForCondition(ctx context.Context, client k8sclient.K
 	defer watcher.Stop()
 
 	// Watch for events
-	return r.processWatchEvents(ctx, client, gvr, obj.GetNamespace(), obj.GetName(), watcher, checker, conditionType, timeout)
+	return r.processWatchEvents(ctx, client, gvr, obj.GetNamespace(), obj.GetName(), watcher, checker, conditionType, successReasons, timeout)
 }
 
 // createWatchOptions creates watch options for the resource
@@ -434,7 +711,7 @@ func (r *waitResource) createWatchOptions(obj *unstructured.Unstructured) metav1
 // processWatchEvents processes events from the watcher
 func (r *waitResource) processWatchEvents(ctx context.Context, client k8sclient.K8sClient,
 	gvr schema.GroupVersionResource, namespace, name string, watcher watch.Interface,
-	checker func(*unstructured.Unstructured) bool, conditionType string, timeout time.Duration) error {
+	checker func(*unstructured.Unstructured) bool, conditionType string, successReasons []string, timeout time.Duration) error {
 
 	timeoutCh := time.After(timeout)
 	var lastSeenObj *unstructured.Unstructured
@@ -456,7 +733,7 @@ func (r *waitResource) processWatchEvents(ctx context.Context, client k8sclient.
 				})
 				return nil
 			}
-			return r.buildConditionTimeoutError(ctx, client, gvr, namespace, name, lastSeenObj, conditionType, timeout)
+			return r.buildConditionTimeoutError(ctx, client, gvr, namespace, name, lastSeenObj, conditionType, successReasons, timeout)
 
 		case event, ok := <-watcher.ResultChan():
 			if !ok {
@@ -467,6 +744,17 @@ func (r *waitResource) processWatchEvents(ctx context.Context, client k8sclient.
 				return err
 			}
 
+			// The resource may be recreated under the same name; forget the
+			// deleted object's status and keep waiting for it to come back.
+			if event.Type == watch.Deleted {
+				lastSeenObj = nil
+				tflog.Debug(ctx, "Resource deleted during wait, waiting for it to reappear", map[string]interface{}{
+					"condition": conditionType,
+					"resource":  fmt.Sprintf("%s/%s", namespace, name),
+				})
+				continue
+			}
+
 			// Track last seen object for better timeout diagnostics
 			if event.Type == watch.Modified || event.Type == watch.Added {
 				if obj, ok := event.Object.(*unstructured.Unstructured); ok {
@@ -518,7 +806,7 @@ func (r *waitResource) isWatchError(err error) bool {
 // Following ADR-015: Actionable Error Messages and Diagnostic Context
 func (r *waitResource) buildConditionTimeoutError(ctx context.Context, client k8sclient.K8sClient,
 	gvr schema.GroupVersionResource, namespace, name string, obj *unstructured.Unstructured,
-	conditionType string, timeout time.Duration) error {
+	conditionType string, successReasons []string, timeout time.Duration) error {
 
 	// If no object from watch events, fetch current state from API
 	if obj == nil {
@@ -547,7 +835,7 @@ func (r *waitResource) buildConditionTimeoutError(ctx context.Context, client k8
 	// Extract all conditions for diagnostics
 	conditions, found, err := unstructured.NestedSlice(obj.Object, "status", "conditions")
 	if err != nil || !found || len(conditions) == 0 {
-		return r.buildNoConditionsError(resourceRef, kind, objName, objNamespace, conditionType, timeout)
+		return r.buildNoConditionsError(obj, resourceRef, conditionType, timeout)
 	}
 
 	// Parse conditions
@@ -581,9 +869,9 @@ func (r *waitResource) buildConditionTimeoutError(ctx context.Context, client k8
 	// DEFENSE IN DEPTH: Final check if condition is actually met
 	// Primary fix is in processWatchEvents, but this catches edge cases
 	// (e.g., if lastSeenObj was nil and fresh fetch shows condition met)
-	if targetFound {
-		statusVal, _ := targetCondition["status"].(string)
-		if statusVal == "True" {
+	blockingGates := blockingReadinessGates(obj, conditionType)
+	if targetFound && len(blockingGates) == 0 {
+		if r.isConditionMet(targetCondition, conditionType, successReasons) {
 			// Condition is met - this is success, not a timeout
 			// This should rarely happen if the primary fix in processWatchEvents is working
 			tflog.Warn(ctx, "Condition was True when building timeout error - watch event likely delayed", map[string]interface{}{
@@ -595,8 +883,12 @@ func (r *waitResource) buildConditionTimeoutError(ctx context.Context, client k8
 	}
 
 	// Build error message following ADR-015 template
-	errMsg := fmt.Sprintf("Wait Timeout: %s\n\n%s did not reach condition %q=True within %v\n\n",
-		resourceRef, kind, conditionType, timeout)
+	target := fmt.Sprintf("%q=True", conditionType)
+	if len(successReasons) > 0 {
+		target += fmt.Sprintf(" or a reason in success_reasons (%s)", strings.Join(successReasons, ", "))
+	}
+	errMsg := fmt.Sprintf("Wait Timeout: %s\n\n%s did not reach condition %s within %v\n\n",
+		resourceRef, kind, target, timeout)
 
 	// Current state - show workload-specific details only for known types
 	errMsg += "Current status:\n"
@@ -608,6 +900,40 @@ func (r *waitResource) buildConditionTimeoutError(ctx context.Context, client k8
 	errMsg += "  Conditions:\n"
 	errMsg += strings.Join(conditionDetails, "\n")
 
+	// Workloads are summarized by replica counts; other kinds (CRDs) show the rest of status
+	if !r.isWorkloadResource(kind) {
+		if dump := formatStatusDump(obj, "conditions"); dump != "" {
+			errMsg += "\n  Other status fields:\n" + strings.TrimSuffix(dump, "\n")
+		}
+	}
+
+	// cert-manager reports why issuance is stuck on the objects it creates for a Certificate
+	if isCertManagerCertificate(obj) {
+		if details := certificateIssuanceDetails(ctx, client, obj); len(details) > 0 {
+			errMsg += "\n  Issuance:\n"
+			for _, detail := range details {
+				errMsg += fmt.Sprintf("    • %s\n", detail)
+			}
+		}
+	}
+
+	if gates := podReadinessGates(obj); len(gates) > 0 && conditionType == "Ready" {
+		errMsg += "\n  Readiness Gates:\n"
+		var gateDetails []string
+		for _, gate := range gates {
+			status := gate.status
+			if status == "" {
+				status = "<not reported>"
+			}
+			gateStr := fmt.Sprintf("  • %s = %s", gate.conditionType, status)
+			if gate.reason != "" {
+				gateStr += fmt.Sprintf(" (reason: %s)", gate.reason)
+			}
+			gateDetails = append(gateDetails, gateStr)
+		}
+		errMsg += strings.Join(gateDetails, "\n")
+	}
+
 	// Fetch and show pod issues for workload resources (same as rollout waits)
 	if r.isWorkloadResource(kind) {
 		podIssues := r.fetchPodIssues(ctx, client, obj)
@@ -640,9 +966,22 @@ func (r *waitResource) buildConditionTimeoutError(ctx context.Context, client k8
 		errMsg += "The resource controller may not be running or the condition may not exist for this resource type.\n\n"
 	}
 
+	if len(blockingGates) > 0 {
+		var names []string
+		for _, gate := range blockingGates {
+			names = append(names, fmt.Sprintf("%q", gate.conditionType))
+		}
+		errMsg += fmt.Sprintf("Blocked by readiness gate(s): %s. ", strings.Join(names, ", "))
+		errMsg += "Readiness gates are set by an external controller, not the kubelet, so the Pod is not Ready until that controller marks them True.\n\n"
+	}
+
 	// Troubleshooting options
 	errMsg += "Troubleshooting:\n"
 
+	if len(blockingGates) > 0 {
+		errMsg += "• Check that the controller responsible for the readiness gate(s) is running and has permission to update Pod status\n"
+	}
+
 	// Add workload-specific guidance only for known workload types
 	if r.isWorkloadResource(kind) {
 		replicaStatus := r.extractReplicaStatus(obj)
@@ -654,6 +993,10 @@ func (r *waitResource) buildConditionTimeoutError(ctx context.Context, client k8
 		}
 	}
 
+	if isCertManagerCertificate(obj) {
+		errMsg += fmt.Sprintf("• Check the issuance:\n    kubectl describe certificaterequest -n %s\n    cmctl status certificate %s -n %s\n", objNamespace, objName, objNamespace)
+	}
+
 	// Generic guidance for all resources
 	if namespace != "" {
 		errMsg += fmt.Sprintf("• View resource status and events:\n    kubectl describe %s %s -n %s\n", kind, name, namespace)
@@ -665,7 +1008,7 @@ func (r *waitResource) buildConditionTimeoutError(ctx context.Context, client k8
 
 	errMsg += fmt.Sprintf("• Increase timeout if needed:\n    wait_for = { condition = %q, timeout = \"10m\" }", conditionType)
 
-	return fmt.Errorf("%s", errMsg)
+	return &waitTimeoutError{message: errMsg, lastObserved: obj}
 }
 
 // isWorkloadResource checks if the resource is a workload type with pods
@@ -712,7 +1055,9 @@ func (r *waitResource) extractReplicaStatus(obj *unstructured.Unstructured) stri
 }
 
 // buildNoConditionsError builds error for resources with no conditions
-func (r *waitResource) buildNoConditionsError(resourceRef, kind, name, namespace, conditionType string, timeout time.Duration) error {
+func (r *waitResource) buildNoConditionsError(obj *unstructured.Unstructured, resourceRef, conditionType string, timeout time.Duration) *waitTimeoutError {
+	kind, name, namespace := obj.GetKind(), obj.GetName(), obj.GetNamespace()
+
 	errMsg := fmt.Sprintf("Wait Timeout: %s\n\n", resourceRef)
 	errMsg += fmt.Sprintf("%s did not become ready within %v\n\n", kind, timeout)
 
@@ -729,6 +1074,7 @@ func (r *waitResource) buildNoConditionsError(resourceRef, kind, name, namespace
 		// For other resources (CRDs, etc), provide generic guidance
 		errMsg += fmt.Sprintf("No conditions found in status. The resource may not report conditions, or the controller may not be running.\n\n")
 		errMsg += fmt.Sprintf("Not all Kubernetes resources have conditions. Condition %q may not exist for %s.\n\n", conditionType, kind)
+		errMsg += statusSection(obj)
 		errMsg += "Troubleshooting:\n"
 		if namespace != "" {
 			errMsg += fmt.Sprintf("• Check resource status:\n    kubectl get %s %s -n %s -o yaml\n", kind, name, namespace)
@@ -741,32 +1087,47 @@ func (r *waitResource) buildNoConditionsError(resourceRef, kind, name, namespace
 		errMsg += fmt.Sprintf("• Consider using wait_for.field or wait_for.field_value instead for %s\n", kind)
 	}
 
-	return fmt.Errorf("%s", errMsg)
+	return &waitTimeoutError{message: errMsg, lastObserved: obj}
+}
+
+// statusSection returns the "Current status" part of a timeout error for kinds without a
+// workload-specific summary, so the user sees what the controller reported
+func statusSection(obj *unstructured.Unstructured) string {
+	dump := formatStatusDump(obj)
+	if dump == "" {
+		return "Current status: none reported. The controller may not have processed the resource yet.\n\n"
+	}
+	return "Current status:\n" + dump + "\n"
 }
 
 // pollForCondition polls for condition when watch is not available
 func (r *waitResource) pollForCondition(ctx context.Context, client k8sclient.K8sClient,
 	gvr schema.GroupVersionResource, obj *unstructured.Unstructured,
-	checkFunc func(*unstructured.Unstructured) bool, conditionType string, timeout time.Duration) error {
+	checkFunc func(*unstructured.Unstructured) bool, conditionType string, successReasons []string, timeout time.Duration, ps pollSettings) error {
 
-	ticker := time.NewTicker(2 * time.Second)
+	ticker := time.NewTicker(ps.interval)
 	defer ticker.Stop()
+	next := ps.firstTick(ticker.C)
 
 	deadline := time.Now().Add(timeout)
+	var lastSeen *unstructured.Unstructured
 
 	for {
 		select {
 		case <-ctx.Done():
 			return ctx.Err()
-		case <-ticker.C:
+		case <-next:
+			next = ticker.C
 			if time.Now().After(deadline) {
-				return fmt.Errorf("timeout after %v waiting for condition %q", timeout, conditionType)
+				return r.buildConditionTimeoutError(ctx, client, gvr, obj.GetNamespace(), obj.GetName(), lastSeen, conditionType, successReasons, timeout)
 			}
 
 			current, err := client.Get(ctx, gvr, obj.GetNamespace(), obj.GetName())
 			if err != nil {
+				logWaitGetError(ctx, err, "condition")
 				continue
 			}
+			lastSeen = current
 
 			if checkFunc(current) {
 				tflog.Info(ctx, "Condition is now met (via polling)", map[string]interface{}{
@@ -780,16 +1141,28 @@ func (r *waitResource) pollForCondition(ctx context.Context, client k8sclient.K8
 
 // waitForRollout waits for Deployment/StatefulSet/DaemonSet rollout
 func (r *waitResource) waitForRollout(ctx context.Context, client k8sclient.K8sClient,
-	gvr schema.GroupVersionResource, obj *unstructured.Unstructured, timeout time.Duration) error {
+	gvr schema.GroupVersionResource, obj *unstructured.Unstructured, minReadyPercent int64, timeout time.Duration, ps pollSettings) error {
 
 	kind := obj.GetKind()
+	if minReadyPercent > 0 {
+		switch kind {
+		case "Deployment", "StatefulSet", "DaemonSet":
+			checkRollout := func(obj *unstructured.Unstructured) (bool, string) {
+				return checkRolloutReadyPercent(obj, minReadyPercent)
+			}
+			return r.waitWithRolloutCheck(ctx, client, gvr, obj, checkRollout, strings.ToLower(kind)+" rollout", timeout, ps)
+		default:
+			return nil
+		}
+	}
+
 	switch kind {
 	case "Deployment":
-		return r.waitForDeploymentRollout(ctx, client, gvr, obj, timeout)
+		return r.waitForDeploymentRollout(ctx, client, gvr, obj, timeout, ps)
 	case "StatefulSet":
-		return r.waitForStatefulSetRollout(ctx, client, gvr, obj, timeout)
+		return r.waitForStatefulSetRollout(ctx, client, gvr, obj, timeout, ps)
 	case "DaemonSet":
-		return r.waitForDaemonSetRollout(ctx, client, gvr, obj, timeout)
+		return r.waitForDaemonSetRollout(ctx, client, gvr, obj, timeout, ps)
 	default:
 		return nil
 	}
@@ -797,100 +1170,325 @@ func (r *waitResource) waitForRollout(ctx context.Context, client k8sclient.K8sC
 
 // waitForDeploymentRollout waits for a Deployment to complete its rollout
 func (r *waitResource) waitForDeploymentRollout(ctx context.Context, client k8sclient.K8sClient,
-	gvr schema.GroupVersionResource, obj *unstructured.Unstructured, timeout time.Duration) error {
+	gvr schema.GroupVersionResource, obj *unstructured.Unstructured, timeout time.Duration, ps pollSettings) error {
+
+	return r.waitWithRolloutCheck(ctx, client, gvr, obj, checkDeploymentRollout, "deployment rollout", timeout, ps)
+}
 
-	checkRollout := func(obj *unstructured.Unstructured) (bool, string) {
-		// Check if replicas match
-		replicas, _, _ := unstructured.NestedInt64(obj.Object, "spec", "replicas")
-		readyReplicas, _, _ := unstructured.NestedInt64(obj.Object, "status", "readyReplicas")
-		updatedReplicas, _, _ := unstructured.NestedInt64(obj.Object, "status", "updatedReplicas")
+// checkDeploymentRollout reports whether a Deployment rollout is complete, matching
+// `kubectl rollout status` semantics. The controller must have observed the latest spec
+// first - until then status still describes the previous ReplicaSet. After that, every
+// replica must be updated and available and no old replicas may be left terminating.
+func checkDeploymentRollout(obj *unstructured.Unstructured) (bool, string) {
+	generation, _, _ := unstructured.NestedInt64(obj.Object, "metadata", "generation")
+	observedGen, _, _ := unstructured.NestedInt64(obj.Object, "status", "observedGeneration")
 
-		// Check generation matches observedGeneration
-		generation, _, _ := unstructured.NestedInt64(obj.Object, "metadata", "generation")
-		observedGen, _, _ := unstructured.NestedInt64(obj.Object, "status", "observedGeneration")
+	if observedGen < generation {
+		return false, fmt.Sprintf("waiting for deployment spec update to be observed: generation %d, observed %d",
+			generation, observedGen)
+	}
 
-		if generation != observedGen {
-			return false, fmt.Sprintf("generation mismatch: %d != %d", generation, observedGen)
-		}
+	if isDeploymentProgressDeadlineExceeded(obj) {
+		return false, "deployment exceeded its progress deadline"
+	}
+
+	// spec.replicas defaults to 1 when omitted; an explicit 0 is a valid scale-down
+	replicas, found, _ := unstructured.NestedInt64(obj.Object, "spec", "replicas")
+	if !found {
+		replicas = 1
+	}
+	statusReplicas, _, _ := unstructured.NestedInt64(obj.Object, "status", "replicas")
+	updatedReplicas, _, _ := unstructured.NestedInt64(obj.Object, "status", "updatedReplicas")
+	availableReplicas, _, _ := unstructured.NestedInt64(obj.Object, "status", "availableReplicas")
+
+	if updatedReplicas < replicas {
+		return false, fmt.Sprintf("%d of %d updated replicas are available, %d/%d updated",
+			availableReplicas, replicas, updatedReplicas, replicas)
+	}
 
-		if replicas == 0 {
-			replicas = 1 // Default if not specified
+	if statusReplicas > updatedReplicas {
+		return false, fmt.Sprintf("%d old replicas are pending termination", statusReplicas-updatedReplicas)
+	}
+
+	if availableReplicas < updatedReplicas {
+		return false, fmt.Sprintf("%d of %d updated replicas are available", availableReplicas, updatedReplicas)
+	}
+
+	return true, ""
+}
+
+// checkStrictDeploymentRollout is checkDeploymentRollout for strict = true: the rollout
+// must also be reported Available with no unavailable replicas, so no pod of the new
+// generation is still starting
+func checkStrictDeploymentRollout(obj *unstructured.Unstructured) (bool, string) {
+	if ready, reason := checkDeploymentRollout(obj); !ready {
+		return false, reason
+	}
+
+	available, generation, observedGen, unavailable := strictRolloutValues(obj)
+	if available != "True" || unavailable > 0 || observedGen < generation {
+		return false, fmt.Sprintf("Available condition is %s, %d unavailable replicas, observed generation %d of %d",
+			available, unavailable, observedGen, generation)
+	}
+	return true, ""
+}
+
+// strictRolloutValues returns the values a strict rollout checks: the status of the
+// Available condition ("Unknown" if not reported), metadata.generation,
+// status.observedGeneration, and status.unavailableReplicas
+func strictRolloutValues(obj *unstructured.Unstructured) (available string, generation, observedGen, unavailable int64) {
+	available = "Unknown"
+	conditions, _, _ := unstructured.NestedSlice(obj.Object, "status", "conditions")
+	for _, cond := range conditions {
+		if condMap, ok := cond.(map[string]interface{}); ok && condMap["type"] == "Available" {
+			if status, ok := condMap["status"].(string); ok {
+				available = status
+			}
 		}
+	}
+	generation, _, _ = unstructured.NestedInt64(obj.Object, "metadata", "generation")
+	observedGen, _, _ = unstructured.NestedInt64(obj.Object, "status", "observedGeneration")
+	unavailable, _, _ = unstructured.NestedInt64(obj.Object, "status", "unavailableReplicas")
+	return available, generation, observedGen, unavailable
+}
 
-		if readyReplicas == replicas && updatedReplicas == replicas {
-			return true, ""
+// isDeploymentProgressDeadlineExceeded checks the Progressing condition for ProgressDeadlineExceeded
+func isDeploymentProgressDeadlineExceeded(obj *unstructured.Unstructured) bool {
+	conditions, _, _ := unstructured.NestedSlice(obj.Object, "status", "conditions")
+	for _, cond := range conditions {
+		condMap, ok := cond.(map[string]interface{})
+		if !ok {
+			continue
 		}
+		if condMap["type"] == "Progressing" && condMap["reason"] == "ProgressDeadlineExceeded" {
+			return true
+		}
+	}
+	return false
+}
 
-		return false, fmt.Sprintf("replicas not ready: %d/%d ready, %d/%d updated",
-			readyReplicas, replicas, updatedReplicas, replicas)
+// deploymentPausedError returns an error for a paused Deployment whose rollout is not
+// complete. The controller doesn't progress a paused Deployment, so waiting for the
+// timeout would never succeed. Other kinds and unpaused Deployments return nil.
+func deploymentPausedError(obj *unstructured.Unstructured) error {
+	if obj.GetKind() != "Deployment" || obj.GroupVersionKind().Group != "apps" {
+		return nil
+	}
+	if paused, _, _ := unstructured.NestedBool(obj.Object, "spec", "paused"); !paused {
+		return nil
+	}
+
+	resourceRef := fmt.Sprintf("Deployment/%s", obj.GetName())
+	resumeCmd := fmt.Sprintf("kubectl rollout resume deployment/%s", obj.GetName())
+	if namespace := obj.GetNamespace(); namespace != "" {
+		resourceRef = fmt.Sprintf("Deployment/%s/%s", namespace, obj.GetName())
+		resumeCmd += " -n " + namespace
+	}
+	return fmt.Errorf("Deployment Paused: %s\n\n"+
+		"Deployment is paused (spec.paused: true) and will not complete a rollout until it is resumed.\n\n"+
+		"To resume it:\n"+
+		"  • Remove spec.paused or set it to false in yaml_body\n"+
+		"  • Or run: %s", resourceRef, resumeCmd)
+}
+
+// deploymentProgressDeadlineError returns an error for a Deployment the controller marked
+// ProgressDeadlineExceeded after observing its latest spec. The controller stops trying to
+// progress the rollout, so like `kubectl rollout status` the wait fails instead of running
+// into its timeout. Other kinds and Deployments still progressing return nil.
+func deploymentProgressDeadlineError(obj *unstructured.Unstructured) error {
+	if obj.GetKind() != "Deployment" || obj.GroupVersionKind().Group != "apps" {
+		return nil
+	}
+	generation, _, _ := unstructured.NestedInt64(obj.Object, "metadata", "generation")
+	observedGen, _, _ := unstructured.NestedInt64(obj.Object, "status", "observedGeneration")
+	if observedGen < generation || !isDeploymentProgressDeadlineExceeded(obj) {
+		return nil
 	}
 
-	return r.waitWithCheck(ctx, client, gvr, obj, checkRollout, "deployment rollout", timeout)
+	resourceRef := fmt.Sprintf("Deployment/%s", obj.GetName())
+	describeCmd := fmt.Sprintf("kubectl describe deployment/%s", obj.GetName())
+	if namespace := obj.GetNamespace(); namespace != "" {
+		resourceRef = fmt.Sprintf("Deployment/%s/%s", namespace, obj.GetName())
+		describeCmd += " -n " + namespace
+	}
+	return fmt.Errorf("Deployment Progress Deadline Exceeded: %s\n\n"+
+		"Deployment exceeded its progress deadline (spec.progressDeadlineSeconds) and the controller "+
+		"reported Progressing=False with reason ProgressDeadlineExceeded. The rollout will not complete "+
+		"without a change.\n\n"+
+		"To investigate:\n"+
+		"  • Run: %s\n"+
+		"  • Check the new ReplicaSet's pods for image pull errors, crash loops, or failing readiness probes", resourceRef, describeCmd)
 }
 
 // waitForStatefulSetRollout waits for a StatefulSet to complete its rollout
 func (r *waitResource) waitForStatefulSetRollout(ctx context.Context, client k8sclient.K8sClient,
-	gvr schema.GroupVersionResource, obj *unstructured.Unstructured, timeout time.Duration) error {
+	gvr schema.GroupVersionResource, obj *unstructured.Unstructured, timeout time.Duration, ps pollSettings) error {
 
-	checkRollout := func(obj *unstructured.Unstructured) (bool, string) {
-		replicas, _, _ := unstructured.NestedInt64(obj.Object, "spec", "replicas")
-		readyReplicas, _, _ := unstructured.NestedInt64(obj.Object, "status", "readyReplicas")
-		currentReplicas, _, _ := unstructured.NestedInt64(obj.Object, "status", "currentReplicas")
-		updatedReplicas, _, _ := unstructured.NestedInt64(obj.Object, "status", "updatedReplicas")
+	return r.waitWithRolloutCheck(ctx, client, gvr, obj, checkStatefulSetRollout, "statefulset rollout", timeout, ps)
+}
 
-		generation, _, _ := unstructured.NestedInt64(obj.Object, "metadata", "generation")
-		observedGen, _, _ := unstructured.NestedInt64(obj.Object, "status", "observedGeneration")
+// checkStatefulSetRollout reports whether a StatefulSet rollout is complete.
+// With a rollingUpdate partition only ordinals >= partition are updated, so the rollout
+// is complete once those pods are updated and all replicas are ready; pods below the
+// partition intentionally stay on the current revision.
+func checkStatefulSetRollout(obj *unstructured.Unstructured) (bool, string) {
+	replicas, _, _ := unstructured.NestedInt64(obj.Object, "spec", "replicas")
+	readyReplicas, _, _ := unstructured.NestedInt64(obj.Object, "status", "readyReplicas")
+	currentReplicas, _, _ := unstructured.NestedInt64(obj.Object, "status", "currentReplicas")
+	updatedReplicas, _, _ := unstructured.NestedInt64(obj.Object, "status", "updatedReplicas")
 
-		if generation != observedGen {
-			return false, fmt.Sprintf("generation mismatch: %d != %d", generation, observedGen)
-		}
+	generation, _, _ := unstructured.NestedInt64(obj.Object, "metadata", "generation")
+	observedGen, _, _ := unstructured.NestedInt64(obj.Object, "status", "observedGeneration")
+
+	if generation != observedGen {
+		return false, fmt.Sprintf("generation mismatch: %d != %d", generation, observedGen)
+	}
+
+	if replicas == 0 {
+		replicas = 1
+	}
 
-		if replicas == 0 {
-			replicas = 1
+	if partition := statefulSetPartition(obj); partition > 0 {
+		expectedUpdated := replicas - partition
+		if expectedUpdated < 0 {
+			expectedUpdated = 0
 		}
 
-		if readyReplicas == replicas && currentReplicas == replicas && updatedReplicas == replicas {
+		if readyReplicas == replicas && updatedReplicas >= expectedUpdated {
 			return true, ""
 		}
 
-		return false, fmt.Sprintf("replicas not ready: %d/%d ready, %d/%d current, %d/%d updated",
-			readyReplicas, replicas, currentReplicas, replicas, updatedReplicas, replicas)
+		return false, fmt.Sprintf("partitioned rollout not ready: %d/%d ready, %d/%d updated (partition: %d)",
+			readyReplicas, replicas, updatedReplicas, expectedUpdated, partition)
 	}
 
-	return r.waitWithCheck(ctx, client, gvr, obj, checkRollout, "statefulset rollout", timeout)
+	if readyReplicas == replicas && currentReplicas == replicas && updatedReplicas == replicas {
+		return true, ""
+	}
+
+	return false, fmt.Sprintf("replicas not ready: %d/%d ready, %d/%d current, %d/%d updated",
+		readyReplicas, replicas, currentReplicas, replicas, updatedReplicas, replicas)
+}
+
+// statefulSetPartition returns spec.updateStrategy.rollingUpdate.partition, or 0 if unset
+// or the update strategy is not RollingUpdate
+func statefulSetPartition(obj *unstructured.Unstructured) int64 {
+	strategyType, _, _ := unstructured.NestedString(obj.Object, "spec", "updateStrategy", "type")
+	if strategyType != "" && strategyType != "RollingUpdate" {
+		return 0
+	}
+
+	partition, _, _ := unstructured.NestedInt64(obj.Object, "spec", "updateStrategy", "rollingUpdate", "partition")
+	return partition
 }
 
 // waitForDaemonSetRollout waits for a DaemonSet to complete its rollout
 func (r *waitResource) waitForDaemonSetRollout(ctx context.Context, client k8sclient.K8sClient,
-	gvr schema.GroupVersionResource, obj *unstructured.Unstructured, timeout time.Duration) error {
+	gvr schema.GroupVersionResource, obj *unstructured.Unstructured, timeout time.Duration, ps pollSettings) error {
 
-	checkRollout := func(obj *unstructured.Unstructured) (bool, string) {
-		desiredNumberScheduled, _, _ := unstructured.NestedInt64(obj.Object, "status", "desiredNumberScheduled")
-		numberReady, _, _ := unstructured.NestedInt64(obj.Object, "status", "numberReady")
-		updatedNumberScheduled, _, _ := unstructured.NestedInt64(obj.Object, "status", "updatedNumberScheduled")
+	return r.waitWithRolloutCheck(ctx, client, gvr, obj, checkDaemonSetRollout, "daemonset rollout", timeout, ps)
+}
 
-		generation, _, _ := unstructured.NestedInt64(obj.Object, "metadata", "generation")
-		observedGen, _, _ := unstructured.NestedInt64(obj.Object, "status", "observedGeneration")
+// checkDaemonSetRollout reports whether every scheduled DaemonSet pod is updated and ready
+func checkDaemonSetRollout(obj *unstructured.Unstructured) (bool, string) {
+	desiredNumberScheduled, _, _ := unstructured.NestedInt64(obj.Object, "status", "desiredNumberScheduled")
+	numberReady, _, _ := unstructured.NestedInt64(obj.Object, "status", "numberReady")
+	updatedNumberScheduled, _, _ := unstructured.NestedInt64(obj.Object, "status", "updatedNumberScheduled")
 
-		if generation != observedGen {
-			return false, fmt.Sprintf("generation mismatch: %d != %d", generation, observedGen)
-		}
+	generation, _, _ := unstructured.NestedInt64(obj.Object, "metadata", "generation")
+	observedGen, _, _ := unstructured.NestedInt64(obj.Object, "status", "observedGeneration")
 
-		if numberReady == desiredNumberScheduled && updatedNumberScheduled == desiredNumberScheduled {
-			return true, ""
-		}
+	if generation != observedGen {
+		return false, fmt.Sprintf("generation mismatch: %d != %d", generation, observedGen)
+	}
+
+	if numberReady == desiredNumberScheduled && updatedNumberScheduled == desiredNumberScheduled {
+		return true, ""
+	}
+
+	return false, fmt.Sprintf("pods not ready: %d/%d ready, %d/%d updated",
+		numberReady, desiredNumberScheduled, updatedNumberScheduled, desiredNumberScheduled)
+}
+
+// rolloutReplicaCounts returns the desired, ready, and updated replica counts of a
+// Deployment, StatefulSet, or DaemonSet, plus how many replicas the rollout is expected
+// to update (fewer than desired below a StatefulSet partition)
+func rolloutReplicaCounts(obj *unstructured.Unstructured) (desired, ready, updated, expectedUpdated int64) {
+	if obj.GetKind() == "DaemonSet" {
+		desired, _, _ = unstructured.NestedInt64(obj.Object, "status", "desiredNumberScheduled")
+		ready, _, _ = unstructured.NestedInt64(obj.Object, "status", "numberReady")
+		updated, _, _ = unstructured.NestedInt64(obj.Object, "status", "updatedNumberScheduled")
+		return desired, ready, updated, desired
+	}
 
-		return false, fmt.Sprintf("pods not ready: %d/%d ready, %d/%d updated",
-			numberReady, desiredNumberScheduled, updatedNumberScheduled, desiredNumberScheduled)
+	// spec.replicas defaults to 1 when omitted
+	desired, found, _ := unstructured.NestedInt64(obj.Object, "spec", "replicas")
+	if !found {
+		desired = 1
 	}
+	ready, _, _ = unstructured.NestedInt64(obj.Object, "status", "readyReplicas")
+	updated, _, _ = unstructured.NestedInt64(obj.Object, "status", "updatedReplicas")
 
-	return r.waitWithCheck(ctx, client, gvr, obj, checkRollout, "daemonset rollout", timeout)
+	expectedUpdated = desired
+	if obj.GetKind() == "StatefulSet" {
+		expectedUpdated = max(desired-statefulSetPartition(obj), 0)
+	}
+	return desired, ready, updated, expectedUpdated
+}
+
+// checkRolloutReadyPercent reports whether at least minReadyPercent of the desired
+// replicas are ready and at least minReadyPercent of the replicas the rollout should
+// update have been updated. The reason always includes the achieved percentage so
+// it can be shown in the timeout error.
+func checkRolloutReadyPercent(obj *unstructured.Unstructured, minReadyPercent int64) (bool, string) {
+	generation, _, _ := unstructured.NestedInt64(obj.Object, "metadata", "generation")
+	observedGen, _, _ := unstructured.NestedInt64(obj.Object, "status", "observedGeneration")
+	if observedGen < generation {
+		return false, fmt.Sprintf("waiting for spec update to be observed: generation %d, observed %d",
+			generation, observedGen)
+	}
+
+	if obj.GetKind() == "Deployment" && isDeploymentProgressDeadlineExceeded(obj) {
+		return false, "deployment exceeded its progress deadline"
+	}
+
+	desired, ready, updated, expectedUpdated := rolloutReplicaCounts(obj)
+	if desired == 0 {
+		return true, ""
+	}
+
+	// Round up: 80% of 3 replicas requires all 3
+	requiredReady := (desired*minReadyPercent + 99) / 100
+	requiredUpdated := (expectedUpdated*minReadyPercent + 99) / 100
+	if ready >= requiredReady && updated >= requiredUpdated {
+		return true, ""
+	}
+
+	return false, fmt.Sprintf("%d%% ready (%d/%d), %d/%d updated; min_ready_percent = %d requires %d ready and %d updated",
+		ready*100/desired, ready, desired, updated, expectedUpdated, minReadyPercent, requiredReady, requiredUpdated)
 }
 
 // waitWithCheck is a generic wait function using a check function
 func (r *waitResource) waitWithCheck(ctx context.Context, client k8sclient.K8sClient,
 	gvr schema.GroupVersionResource, obj *unstructured.Unstructured,
-	checkFunc func(*unstructured.Unstructured) (bool, string), waitType string, timeout time.Duration) error {
+	checkFunc func(*unstructured.Unstructured) (bool, string), waitType string, timeout time.Duration, ps pollSettings) error {
+
+	failFunc := func(current *unstructured.Unstructured) error { return failFastError(current, waitType) }
+	return r.waitWithFailCheck(ctx, client, gvr, obj, checkFunc, failFunc, nil, waitType, timeout, ps)
+}
+
+// waitWithFailCheck is waitWithCheck with the check that ends the wait early. failFunc returns
+// an error for states the object won't leave on its own, for waits whose failure states
+// depend on their configuration rather than only on the wait type. onDeleted, when set, is
+// called each time the wait sees the object gone, before a recreated object arrives.
+func (r *waitResource) waitWithFailCheck(ctx context.Context, client k8sclient.K8sClient,
+	gvr schema.GroupVersionResource, obj *unstructured.Unstructured,
+	checkFunc func(*unstructured.Unstructured) (bool, string), failFunc func(*unstructured.Unstructured) error,
+	onDeleted func(), waitType string, timeout time.Duration, ps pollSettings) error {
+
+	if ps.pollOnly {
+		return r.pollWithCheck(ctx, client, gvr, obj, checkFunc, failFunc, onDeleted, waitType, timeout, ps)
+	}
 
 	// Check current state first
 	current, err := client.Get(ctx, gvr, obj.GetNamespace(), obj.GetName())
@@ -902,6 +1500,9 @@ func (r *waitResource) waitWithCheck(ctx context.Context, client k8sclient.K8sCl
 			})
 			return nil
 		}
+		if err := failFunc(current); err != nil {
+			return err
+		}
 
 		// Set up watch with ResourceVersion
 		opts := metav1.ListOptions{
@@ -911,7 +1512,7 @@ func (r *waitResource) waitWithCheck(ctx context.Context, client k8sclient.K8sCl
 
 		watcher, err := client.Watch(ctx, gvr, obj.GetNamespace(), opts)
 		if err != nil {
-			return r.pollWithCheck(ctx, client, gvr, obj, checkFunc, waitType, timeout)
+			return r.pollWithCheck(ctx, client, gvr, obj, checkFunc, failFunc, onDeleted, waitType, timeout, ps)
 		}
 		defer watcher.Stop()
 
@@ -924,14 +1525,22 @@ func (r *waitResource) waitWithCheck(ctx context.Context, client k8sclient.K8sCl
 			case <-timeoutCh:
 				// Get final status for error message
 				current, _ := client.Get(ctx, gvr, obj.GetNamespace(), obj.GetName())
-				return r.buildRolloutTimeoutError(ctx, client, current, obj, waitType, timeout)
+				return r.buildCheckTimeoutError(ctx, client, current, obj, checkFunc, waitType, timeout)
 			case event, ok := <-watcher.ResultChan():
 				if !ok {
 					return fmt.Errorf("watch ended unexpectedly")
 				}
 
 				if event.Type == watch.Error {
-					return r.pollWithCheck(ctx, client, gvr, obj, checkFunc, waitType, timeout)
+					return r.pollWithCheck(ctx, client, gvr, obj, checkFunc, failFunc, onDeleted, waitType, timeout, ps)
+				}
+
+				if event.Type == watch.Deleted {
+					logWaitResourceDeleted(ctx, obj, waitType)
+					if onDeleted != nil {
+						onDeleted()
+					}
+					continue
 				}
 
 				if event.Type == watch.Modified || event.Type == watch.Added {
@@ -942,6 +1551,8 @@ func (r *waitResource) waitWithCheck(ctx context.Context, client k8sclient.K8sCl
 							"resource": fmt.Sprintf("%s/%s", obj.GetKind(), obj.GetName()),
 						})
 						return nil
+					} else if err := failFunc(current); err != nil {
+						return err
 					} else {
 						tflog.Debug(ctx, "Not ready yet", map[string]interface{}{
 							"type":   waitType,
@@ -954,16 +1565,18 @@ func (r *waitResource) waitWithCheck(ctx context.Context, client k8sclient.K8sCl
 	}
 
 	// If we can't get current state, fall back to polling
-	return r.pollWithCheck(ctx, client, gvr, obj, checkFunc, waitType, timeout)
+	return r.pollWithCheck(ctx, client, gvr, obj, checkFunc, failFunc, onDeleted, waitType, timeout, ps)
 }
 
 // pollWithCheck polls using a check function when watch is not available
 func (r *waitResource) pollWithCheck(ctx context.Context, client k8sclient.K8sClient,
 	gvr schema.GroupVersionResource, obj *unstructured.Unstructured,
-	checkFunc func(*unstructured.Unstructured) (bool, string), waitType string, timeout time.Duration) error {
+	checkFunc func(*unstructured.Unstructured) (bool, string), failFunc func(*unstructured.Unstructured) error,
+	onDeleted func(), waitType string, timeout time.Duration, ps pollSettings) error {
 
-	ticker := time.NewTicker(2 * time.Second)
+	ticker := time.NewTicker(ps.interval)
 	defer ticker.Stop()
+	next := ps.firstTick(ticker.C)
 
 	deadline := time.Now().Add(timeout)
 
@@ -971,18 +1584,19 @@ func (r *waitResource) pollWithCheck(ctx context.Context, client k8sclient.K8sCl
 		select {
 		case <-ctx.Done():
 			return ctx.Err()
-		case <-ticker.C:
+		case <-next:
+			next = ticker.C
 			if time.Now().After(deadline) {
 				current, _ := client.Get(ctx, gvr, obj.GetNamespace(), obj.GetName())
-				return r.buildRolloutTimeoutError(ctx, client, current, obj, waitType, timeout)
+				return r.buildCheckTimeoutError(ctx, client, current, obj, checkFunc, waitType, timeout)
 			}
 
 			current, err := client.Get(ctx, gvr, obj.GetNamespace(), obj.GetName())
 			if err != nil {
-				tflog.Warn(ctx, "Failed to get resource during poll", map[string]interface{}{
-					"error": err.Error(),
-					"type":  waitType,
-				})
+				logWaitGetError(ctx, err, waitType)
+				if errors.IsNotFound(err) && onDeleted != nil {
+					onDeleted()
+				}
 				continue
 			}
 
@@ -992,6 +1606,8 @@ func (r *waitResource) pollWithCheck(ctx context.Context, client k8sclient.K8sCl
 					"resource": fmt.Sprintf("%s/%s", obj.GetKind(), obj.GetName()),
 				})
 				return nil
+			} else if err := failFunc(current); err != nil {
+				return err
 			} else {
 				tflog.Debug(ctx, "Not ready yet (polling)", map[string]interface{}{
 					"type":   waitType,
@@ -1002,6 +1618,43 @@ func (r *waitResource) pollWithCheck(ctx context.Context, client k8sclient.K8sCl
 	}
 }
 
+// failFastError returns the error that ends a waitWithCheck wait before its timeout,
+// for states the object won't leave without intervention
+func failFastError(obj *unstructured.Unstructured, waitType string) error {
+	if waitType == readyWaitType {
+		if err := readyFailedError(obj); err != nil {
+			return err
+		}
+	}
+	return deploymentPausedError(obj)
+}
+
+// logWaitGetError records a failed Get inside a polling loop. NotFound is
+// expected while a resource is being replaced or recreated, so it is treated
+// as "not ready yet" rather than a failure; the caller keeps polling until the
+// wait timeout either way.
+func logWaitGetError(ctx context.Context, err error, waitType string) {
+	if errors.IsNotFound(err) {
+		tflog.Debug(ctx, "Resource not found during wait, continuing until timeout", map[string]interface{}{
+			"type": waitType,
+		})
+		return
+	}
+	tflog.Warn(ctx, "Failed to get resource during poll", map[string]interface{}{
+		"error": err.Error(),
+		"type":  waitType,
+	})
+}
+
+// logWaitResourceDeleted records a Deleted watch event. The watch is scoped to
+// the resource name, so a recreated resource arrives as a later Added event.
+func logWaitResourceDeleted(ctx context.Context, obj *unstructured.Unstructured, waitType string) {
+	tflog.Debug(ctx, "Resource deleted during wait, waiting for it to reappear", map[string]interface{}{
+		"type":     waitType,
+		"resource": fmt.Sprintf("%s/%s", obj.GetKind(), obj.GetName()),
+	})
+}
+
 // Helper to check if a value is empty
 func isEmptyValue(v interface{}) bool {
 	switch val := v.(type) {
@@ -1023,8 +1676,30 @@ func isEmptyValue(v interface{}) bool {
 	}
 }
 
+// buildCheckTimeoutError creates the timeout error of a waitWithCheck wait
+func (r *waitResource) buildCheckTimeoutError(ctx context.Context, client k8sclient.K8sClient, current, original *unstructured.Unstructured,
+	checkFunc func(*unstructured.Unstructured) (bool, string), waitType string, timeout time.Duration) error {
+	if waitType == ingressWaitType {
+		return r.buildIngressTimeoutError(ctx, client, current, original, timeout)
+	}
+	if waitType == cronJobWaitType {
+		return buildCronJobTimeoutError(current, original, timeout)
+	}
+	if waitType == apiServiceWaitType {
+		return buildAPIServiceTimeoutError(current, original, timeout)
+	}
+	if waitType == readyWaitType {
+		return buildReadyTimeoutError(current, original, timeout)
+	}
+	if waitType == phaseWaitType {
+		return buildPhaseTimeoutError(current, original, checkFunc, timeout)
+	}
+	return r.buildRolloutTimeoutError(ctx, client, current, original, checkFunc, waitType, timeout)
+}
+
 // buildRolloutTimeoutError creates a clean timeout error for rollout waits
-func (r *waitResource) buildRolloutTimeoutError(ctx context.Context, client k8sclient.K8sClient, current, original *unstructured.Unstructured, waitType string, timeout time.Duration) error {
+func (r *waitResource) buildRolloutTimeoutError(ctx context.Context, client k8sclient.K8sClient, current, original *unstructured.Unstructured,
+	checkFunc func(*unstructured.Unstructured) (bool, string), waitType string, timeout time.Duration) error {
 	// Use current object if available, otherwise fall back to original
 	obj := current
 	if obj == nil {
@@ -1051,6 +1726,30 @@ func (r *waitResource) buildRolloutTimeoutError(ctx context.Context, client k8sc
 		errMsg += fmt.Sprintf("  %s\n", replicaStatus)
 	}
 
+	// Show what the rollout is still waiting for (includes the achieved percentage for min_ready_percent)
+	if current != nil {
+		if _, reason := checkFunc(current); reason != "" {
+			errMsg += fmt.Sprintf("  Waiting for: %s\n", reason)
+		}
+	}
+
+	// Strict rollouts list the three values they require
+	if waitType == strictRolloutWaitType {
+		available, generation, observedGen, unavailable := strictRolloutValues(obj)
+		errMsg += "  Strict rollout checks:\n"
+		errMsg += fmt.Sprintf("    • Available condition: %s (required: True)\n", available)
+		errMsg += fmt.Sprintf("    • Unavailable replicas: %d (required: 0)\n", unavailable)
+		errMsg += fmt.Sprintf("    • Observed generation: %d (required: %d)\n", observedGen, generation)
+	}
+
+	// Explain partitioned StatefulSet rollouts - pods below the partition are not updated
+	if kind == "StatefulSet" {
+		if partition := statefulSetPartition(obj); partition > 0 {
+			errMsg += fmt.Sprintf("  Partition: %d (only pods with ordinal >= %d are updated; "+
+				"lower ordinals stay on the current revision until the partition is lowered)\n", partition, partition)
+		}
+	}
+
 	// Show conditions if available
 	conditions, found, err := unstructured.NestedSlice(obj.Object, "status", "conditions")
 	if err == nil && found && len(conditions) > 0 {
@@ -1082,7 +1781,7 @@ func (r *waitResource) buildRolloutTimeoutError(ctx context.Context, client k8sc
 		}
 	}
 
-	return fmt.Errorf("%s", errMsg)
+	return &waitTimeoutError{message: errMsg, lastObserved: current}
 }
 
 // fetchPodIssues fetches pods for a workload and extracts failure information
@@ -1240,6 +1939,9 @@ func (r *waitResource) buildFieldTimeoutError(obj *unstructured.Unstructured, fi
 
 	errMsg := fmt.Sprintf("Wait Timeout\n\n")
 	errMsg += fmt.Sprintf("%s field %q was not populated within %v\n\n", resourceRef, fieldPath, timeout)
+	if !r.isWorkloadResource(kind) {
+		errMsg += statusSection(obj)
+	}
 
 	errMsg += "Common causes:\n"
 	errMsg += "• Resource controller may be slow or not running\n"
@@ -1262,7 +1964,7 @@ func (r *waitResource) buildFieldTimeoutError(obj *unstructured.Unstructured, fi
 		errMsg += fmt.Sprintf("    kubectl get %s %s -o yaml\n", kind, name)
 	}
 
-	return fmt.Errorf("%s", errMsg)
+	return &waitTimeoutError{message: errMsg, lastObserved: obj}
 }
 
 // buildFieldValuesTimeoutError creates a helpful timeout error for field value waits
@@ -1294,6 +1996,9 @@ func (r *waitResource) buildFieldValuesTimeoutError(obj *unstructured.Unstructur
 		errMsg += fmt.Sprintf("  Current value: %s\n", currentValStr)
 	}
 	errMsg += "\n"
+	if !r.isWorkloadResource(kind) {
+		errMsg += statusSection(obj)
+	}
 
 	errMsg += "Common causes:\n"
 	errMsg += "• Resource may not be progressing (check status and events)\n"
@@ -1316,5 +2021,5 @@ func (r *waitResource) buildFieldValuesTimeoutError(obj *unstructured.Unstructur
 		errMsg += fmt.Sprintf("    kubectl get %s %s -o yaml\n", kind, name)
 	}
 
-	return fmt.Errorf("%s", errMsg)
+	return &waitTimeoutError{message: errMsg, lastObserved: obj}
 }
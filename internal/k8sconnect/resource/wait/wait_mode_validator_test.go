@@ -0,0 +1,180 @@
+package wait
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestConfiguredWaitModes(t *testing.T) {
+	fieldValue := types.MapValueMust(types.StringType, map[string]attr.Value{
+		"status.phase": types.StringValue("Running"),
+	})
+
+	tests := []struct {
+		name     string
+		waitFor  waitForModel
+		expected []string
+	}{
+		{
+			name: "single field",
+			waitFor: waitForModel{
+				Field:      types.StringValue("status.loadBalancer.ingress"),
+				FieldValue: types.MapNull(types.StringType),
+				Condition:  types.StringNull(),
+				Rollout:    types.BoolNull(),
+			},
+			expected: []string{"field"},
+		},
+		{
+			name: "rollout false is not a mode",
+			waitFor: waitForModel{
+				Field:      types.StringNull(),
+				FieldValue: types.MapNull(types.StringType),
+				Condition:  types.StringValue("Ready"),
+				Rollout:    types.BoolValue(false),
+			},
+			expected: []string{"condition"},
+		},
+		{
+			name: "multiple modes reported in priority order",
+			waitFor: waitForModel{
+				Field:      types.StringValue("status.loadBalancer.ingress"),
+				FieldValue: fieldValue,
+				Condition:  types.StringValue("Ready"),
+				Rollout:    types.BoolValue(true),
+			},
+			expected: []string{"rollout", "field", "field_value", "condition"},
+		},
+		{
+			name: "ingress_ready is reported after rollout",
+			waitFor: waitForModel{
+				Field:        types.StringValue("status.loadBalancer.ingress"),
+				FieldValue:   types.MapNull(types.StringType),
+				Condition:    types.StringNull(),
+				Rollout:      types.BoolValue(true),
+				IngressReady: types.BoolValue(true),
+			},
+			expected: []string{"rollout", "ingress_ready", "field"},
+		},
+		{
+			name: "pvc_bound is reported before field_value",
+			waitFor: waitForModel{
+				Field:      types.StringNull(),
+				FieldValue: fieldValue,
+				Condition:  types.StringNull(),
+				Rollout:    types.BoolNull(),
+				PVCBound:   types.BoolValue(true),
+			},
+			expected: []string{"pvc_bound", "field_value"},
+		},
+		{
+			name: "phase is reported after ready and before field_value",
+			waitFor: waitForModel{
+				FieldValue: fieldValue,
+				Ready:      types.BoolValue(true),
+				Phase:      types.StringValue("Running"),
+			},
+			expected: []string{"ready", "phase", "field_value"},
+		},
+		{
+			name: "field_value with an expected value unknown at plan is still a mode",
+			waitFor: waitForModel{
+				Field: types.StringNull(),
+				FieldValue: types.MapValueMust(types.StringType, map[string]attr.Value{
+					"status.dbName": types.StringUnknown(),
+				}),
+				Condition: types.StringNull(),
+				Rollout:   types.BoolNull(),
+			},
+			expected: []string{"field_value"},
+		},
+		{
+			name: "unknown values are skipped",
+			waitFor: waitForModel{
+				Field:      types.StringUnknown(),
+				FieldValue: types.MapNull(types.StringType),
+				Condition:  types.StringValue("Ready"),
+				Rollout:    types.BoolUnknown(),
+			},
+			expected: []string{"condition"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := configuredWaitModes(tt.waitFor)
+			if !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("configuredWaitModes() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestValidateWaitStepsAllowsUnknownExpectedValues(t *testing.T) {
+	waitFor := stepsWaitFor(stepValue(map[string]attr.Value{
+		"field_value": types.MapValueMust(types.StringType, map[string]attr.Value{
+			"status.endpoint": types.StringUnknown(),
+		}),
+	}))
+
+	resp := &resource.ValidateConfigResponse{}
+	validateWaitSteps(context.Background(), waitFor, resp)
+	if resp.Diagnostics.HasError() {
+		t.Errorf("expected values unknown at plan should not fail validation: %v", resp.Diagnostics)
+	}
+}
+
+func TestExpectedFieldValues(t *testing.T) {
+	got, err := expectedFieldValues(types.MapValueMust(types.StringType, map[string]attr.Value{
+		"status.phase":  types.StringValue("Bound"),
+		"spec.dbName":   types.StringValue("orders"),
+		"status.source": types.StringValue(""),
+	}))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := map[string]string{"status.phase": "Bound", "spec.dbName": "orders", "status.source": ""}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("expectedFieldValues() = %v, want %v", got, want)
+	}
+
+	tests := []struct {
+		name       string
+		fieldValue types.Map
+		wantErr    string
+	}{
+		{
+			name: "unknown expected value",
+			fieldValue: types.MapValueMust(types.StringType, map[string]attr.Value{
+				"status.phase": types.StringUnknown(),
+			}),
+			wantErr: `field_value["status.phase"] is not known yet`,
+		},
+		{
+			name: "null expected value",
+			fieldValue: types.MapValueMust(types.StringType, map[string]attr.Value{
+				"status.phase": types.StringNull(),
+			}),
+			wantErr: `field_value["status.phase"] is null`,
+		},
+		{
+			name:       "unknown map",
+			fieldValue: types.MapUnknown(types.StringType),
+			wantErr:    "field_value is not known yet",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := expectedFieldValues(tt.fieldValue)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("expected error containing %q, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
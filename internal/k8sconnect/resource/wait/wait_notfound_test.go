@@ -0,0 +1,117 @@
+package wait
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/watch"
+
+	"github.com/jmorris0x0/terraform-provider-k8sconnect/internal/k8sconnect/common/k8sclient"
+)
+
+// recreatingClient serves a fixed Get response and a fake watcher so tests can
+// replay the event sequence of a resource being deleted and recreated.
+type recreatingClient struct {
+	k8sclient.K8sClient
+	current *unstructured.Unstructured
+	watcher *watch.FakeWatcher
+}
+
+func (c *recreatingClient) Get(ctx context.Context, gvr schema.GroupVersionResource, namespace, name string) (*unstructured.Unstructured, error) {
+	if c.current == nil {
+		return nil, apierrors.NewNotFound(gvr.GroupResource(), name)
+	}
+	return c.current, nil
+}
+
+func (c *recreatingClient) Watch(ctx context.Context, gvr schema.GroupVersionResource, namespace string, opts metav1.ListOptions) (watch.Interface, error) {
+	return c.watcher, nil
+}
+
+// watchSettings are the defaults used when wait_for sets neither mode nor poll_interval
+var watchSettings = pollSettings{interval: defaultPollInterval}
+
+func TestWaitSurvivesResourceRecreation(t *testing.T) {
+	gvr := schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "deployments"}
+	rolling := deploymentFixture(2, 2, 3, 3, 1, 1)
+	recreated := deploymentFixture(1, 1, 3, 3, 3, 3)
+
+	tests := []struct {
+		name string
+		wait func(r *waitResource, client k8sclient.K8sClient) error
+	}{
+		{
+			name: "rollout",
+			wait: func(r *waitResource, client k8sclient.K8sClient) error {
+				// The recreated Deployment's generation went backwards; the observed
+				// deletion makes it the new baseline instead of failing the wait
+				return r.waitForRollout(context.Background(), client, gvr, rolling, 0, 5*time.Second, watchSettings)
+			},
+		},
+		{
+			name: "field value",
+			wait: func(r *waitResource, client k8sclient.K8sClient) error {
+				return r.waitForFieldValues(context.Background(), client, gvr, rolling,
+					map[string]string{"status.updatedReplicas": "3"}, 5*time.Second, watchSettings)
+			},
+		},
+		{
+			name: "field",
+			wait: func(r *waitResource, client k8sclient.K8sClient) error {
+				obj := rolling.DeepCopy()
+				unstructured.RemoveNestedField(obj.Object, "status")
+				client.(*recreatingClient).current = obj
+				return r.waitForField(context.Background(), client, gvr, obj, "status.availableReplicas", 5*time.Second, watchSettings)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &recreatingClient{
+				K8sClient: k8sclient.NewStubK8sClient(),
+				current:   rolling,
+				watcher:   watch.NewFakeWithChanSize(2, false),
+			}
+			client.watcher.Delete(rolling)
+			client.watcher.Add(recreated)
+
+			if err := tt.wait(&waitResource{}, client); err != nil {
+				t.Fatalf("expected wait to succeed after recreation, got: %v", err)
+			}
+		})
+	}
+}
+
+func TestConditionWaitSurvivesResourceRecreation(t *testing.T) {
+	gvr := schema.GroupVersionResource{Version: "v1", Resource: "pods"}
+	pod := func(status string) *unstructured.Unstructured {
+		return &unstructured.Unstructured{Object: map[string]interface{}{
+			"apiVersion": "v1",
+			"kind":       "Pod",
+			"metadata":   map[string]interface{}{"name": "app", "namespace": "default"},
+			"status": map[string]interface{}{
+				"conditions": []interface{}{
+					map[string]interface{}{"type": "Ready", "status": status},
+				},
+			},
+		}}
+	}
+
+	client := &recreatingClient{
+		K8sClient: k8sclient.NewStubK8sClient(),
+		watcher:   watch.NewFakeWithChanSize(2, false),
+	}
+	client.watcher.Delete(pod("False"))
+	client.watcher.Add(pod("True"))
+
+	r := &waitResource{}
+	if err := r.waitForCondition(context.Background(), client, gvr, pod("False"), "Ready", nil, 5*time.Second, watchSettings); err != nil {
+		t.Fatalf("expected condition wait to succeed after recreation, got: %v", err)
+	}
+}
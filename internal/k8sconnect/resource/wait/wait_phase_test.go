@@ -0,0 +1,95 @@
+package wait
+
+import (
+	"context"
+	stderrors "errors"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/jmorris0x0/terraform-provider-k8sconnect/internal/k8sconnect/common/k8sclient"
+)
+
+func podInPhase(phase string) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "Pod",
+		"metadata":   map[string]interface{}{"name": "migrate", "namespace": "default"},
+		"status":     map[string]interface{}{"phase": phase},
+	}}
+	if phase == "Failed" {
+		_ = unstructured.SetNestedField(obj.Object, "Evicted", "status", "reason")
+		_ = unstructured.SetNestedField(obj.Object, "The node was low on resource: memory.", "status", "message")
+	}
+	return obj
+}
+
+func phaseWaitConfig(phase, timeout string, failPhases ...string) waitForModel {
+	elems := make([]attr.Value, 0, len(failPhases))
+	for _, p := range failPhases {
+		elems = append(elems, types.StringValue(p))
+	}
+	return waitForModel{
+		Phase:        types.StringValue(phase),
+		FailPhases:   types.ListValueMust(types.StringType, elems),
+		Timeout:      types.StringValue(timeout),
+		Mode:         types.StringValue(waitModePoll),
+		PollInterval: types.StringValue("10ms"),
+	}
+}
+
+func TestPhaseWait(t *testing.T) {
+	r := &waitResource{}
+	gvr := schema.GroupVersionResource{Version: "v1", Resource: "pods"}
+
+	t.Run("succeeds on the target phase", func(t *testing.T) {
+		client := &pollOnlyClient{K8sClient: k8sclient.NewStubK8sClient(), t: t, responses: []*unstructured.Unstructured{
+			podInPhase("Pending"), podInPhase("Running"), podInPhase("Succeeded"),
+		}}
+		if err := r.waitForResource(context.Background(), client, gvr, podInPhase("Pending"), phaseWaitConfig("Succeeded", "5s", "Failed")); err != nil {
+			t.Fatalf("expected the phase wait to succeed, got %v", err)
+		}
+		if client.gets != 3 {
+			t.Errorf("expected 3 polls, got %d", client.gets)
+		}
+	})
+
+	t.Run("fails immediately on a fail phase", func(t *testing.T) {
+		client := &pollOnlyClient{K8sClient: k8sclient.NewStubK8sClient(), t: t, responses: []*unstructured.Unstructured{
+			podInPhase("Running"), podInPhase("Failed"),
+		}}
+		err := r.waitForResource(context.Background(), client, gvr, podInPhase("Running"), phaseWaitConfig("Succeeded", "5s", "Failed", "Unknown"))
+		var timeoutErr *waitTimeoutError
+		if err == nil || stderrors.As(err, &timeoutErr) {
+			t.Fatalf("expected a phase failure before the timeout, got %v", err)
+		}
+		for _, want := range []string{"Phase Failed: Pod/default/migrate", "entered phase Failed", "Reason: Evicted", "Message: The node was low on resource: memory."} {
+			if !strings.Contains(err.Error(), want) {
+				t.Errorf("error missing %q:\n%s", want, err)
+			}
+		}
+	})
+
+	t.Run("without fail_phases a failed phase waits until the timeout", func(t *testing.T) {
+		client := &pollOnlyClient{K8sClient: k8sclient.NewStubK8sClient(), t: t, responses: []*unstructured.Unstructured{podInPhase("Failed")}}
+		err := r.waitForResource(context.Background(), client, gvr, podInPhase("Failed"), phaseWaitConfig("Succeeded", "50ms"))
+		var timeoutErr *waitTimeoutError
+		if !stderrors.As(err, &timeoutErr) {
+			t.Fatalf("expected a wait timeout, got %v", err)
+		}
+		if !strings.Contains(err.Error(), "phase is Failed, waiting for Succeeded") {
+			t.Errorf("timeout error missing the observed phase:\n%s", err)
+		}
+	})
+}
+
+func TestPhaseWaitObservedValues(t *testing.T) {
+	values := observedValues(podInPhase("Running"), phaseWaitConfig("Running", "1m"))
+	if values[phaseField] != "Running" {
+		t.Errorf("results[%q] = %q, want Running", phaseField, values[phaseField])
+	}
+}
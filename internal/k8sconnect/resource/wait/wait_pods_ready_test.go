@@ -0,0 +1,197 @@
+package wait
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// podListClient serves the workload from pollOnlyClient and pod lists from podLists in
+// turn, repeating the last one, recording the label selectors it was asked for
+type podListClient struct {
+	pollOnlyClient
+	podLists  [][]unstructured.Unstructured
+	lists     int
+	selectors []string
+}
+
+func (c *podListClient) List(ctx context.Context, gvr schema.GroupVersionResource, namespace string, opts metav1.ListOptions) (*unstructured.UnstructuredList, error) {
+	c.selectors = append(c.selectors, opts.LabelSelector)
+	i := c.lists
+	if i >= len(c.podLists) {
+		i = len(c.podLists) - 1
+	}
+	c.lists++
+	return &unstructured.UnstructuredList{Items: c.podLists[i]}, nil
+}
+
+func podsReadyDeployment(replicas int64) *unstructured.Unstructured {
+	deployment := testObject("apps/v1", "Deployment", "default", "web", map[string]interface{}{
+		"status": map[string]interface{}{"observedGeneration": int64(1), "updatedReplicas": replicas},
+		"spec": map[string]interface{}{
+			"replicas": replicas,
+			"selector": map[string]interface{}{
+				"matchLabels": map[string]interface{}{"app": "web"},
+				"matchExpressions": []interface{}{
+					map[string]interface{}{"key": "tier", "operator": "In", "values": []interface{}{"frontend"}},
+				},
+			},
+		},
+	})
+	deployment.SetGeneration(1)
+	return deployment
+}
+
+func selectedPod(name, ready string, waitingReason string) unstructured.Unstructured {
+	pod := testObject("v1", "Pod", "default", name, map[string]interface{}{
+		"status": map[string]interface{}{
+			"phase": "Running",
+			"conditions": []interface{}{
+				map[string]interface{}{"type": "Ready", "status": ready, "reason": "ContainersNotReady"},
+			},
+		},
+	})
+	if waitingReason != "" {
+		_ = unstructured.SetNestedSlice(pod.Object, []interface{}{
+			map[string]interface{}{"name": "app", "state": map[string]interface{}{"waiting": map[string]interface{}{"reason": waitingReason}}},
+		}, "status", "containerStatuses")
+	}
+	return *pod
+}
+
+func terminatingPod(name string) unstructured.Unstructured {
+	pod := selectedPod(name, "True", "")
+	now := metav1.Now()
+	pod.SetDeletionTimestamp(&now)
+	return pod
+}
+
+var podsReady = waitForModel{PodsReady: types.BoolValue(true)}
+
+func TestPodsReadyWait(t *testing.T) {
+	t.Run("completes once the expected pods are all Ready", func(t *testing.T) {
+		deployment := podsReadyDeployment(2)
+		client := &podListClient{
+			pollOnlyClient: *newPollClient(t, deployment),
+			podLists: [][]unstructured.Unstructured{
+				{selectedPod("web-a", "False", "ContainerCreating")},
+				{selectedPod("web-a", "True", ""), selectedPod("web-b", "False", "")},
+				{selectedPod("web-a", "True", ""), selectedPod("web-b", "True", ""), terminatingPod("web-old")},
+			},
+		}
+		requireWaitCompletes(t, client, deploymentGVR, deployment, polledWait(podsReady, "5s"))
+		if client.lists != 3 {
+			t.Errorf("expected 3 pod lists, got %d", client.lists)
+		}
+		if want := "app=web,tier in (frontend)"; client.selectors[0] != want {
+			t.Errorf("selector = %q, want %q", client.selectors[0], want)
+		}
+	})
+
+	t.Run("timeout lists the pods that are not Ready", func(t *testing.T) {
+		deployment := podsReadyDeployment(3)
+		client := &podListClient{
+			pollOnlyClient: *newPollClient(t, deployment),
+			podLists: [][]unstructured.Unstructured{
+				{selectedPod("web-a", "True", ""), selectedPod("web-b", "False", "CrashLoopBackOff")},
+			},
+		}
+		requireWaitTimeout(t, client, deploymentGVR, deployment, polledWait(podsReady, "50ms"),
+			"Wait Timeout: Deployment/default/web",
+			"1/3 pods Ready (1 not Ready)",
+			"Missing pods: 1",
+			"web-b: container app CrashLoopBackOff",
+			"kubectl get pods -n default -l 'app=web,tier in (frontend)'",
+		)
+	})
+
+	t.Run("previous revision's Ready pods don't count before the rollout", func(t *testing.T) {
+		changed := podsReadyDeployment(2)
+		changed.SetGeneration(2)
+		rolling := podsReadyDeployment(2)
+		rolling.SetGeneration(2)
+		_ = unstructured.SetNestedField(rolling.Object, int64(2), "status", "observedGeneration")
+		_ = unstructured.SetNestedField(rolling.Object, int64(1), "status", "updatedReplicas")
+		rolled := podsReadyDeployment(2)
+		rolled.SetGeneration(2)
+		_ = unstructured.SetNestedField(rolled.Object, int64(2), "status", "observedGeneration")
+
+		client := &podListClient{
+			pollOnlyClient: *newPollClient(t, changed, rolling, rolled),
+			podLists: [][]unstructured.Unstructured{
+				{selectedPod("web-new-a", "True", ""), selectedPod("web-new-b", "True", "")},
+			},
+		}
+		requireWaitCompletes(t, client, deploymentGVR, changed, polledWait(podsReady, "5s"))
+		if client.lists != 1 {
+			t.Errorf("expected pods to be listed only once the rollout was done, got %d lists", client.lists)
+		}
+	})
+}
+
+func TestPodsRevisionPending(t *testing.T) {
+	statefulSet := func(generation, observed, updated int64) *unstructured.Unstructured {
+		obj := testObject("apps/v1", "StatefulSet", "default", "db", map[string]interface{}{
+			"status": map[string]interface{}{"observedGeneration": observed, "updatedReplicas": updated},
+		})
+		obj.SetGeneration(generation)
+		return obj
+	}
+	daemonSet := testObject("apps/v1", "DaemonSet", "default", "agent", map[string]interface{}{
+		"status": map[string]interface{}{"observedGeneration": int64(1), "updatedNumberScheduled": int64(2)},
+	})
+	replicaSet := testObject("apps/v1", "ReplicaSet", "default", "web", map[string]interface{}{
+		"status": map[string]interface{}{"observedGeneration": int64(1)},
+	})
+
+	tests := []struct {
+		name        string
+		obj         *unstructured.Unstructured
+		expected    int64
+		wantPending bool
+	}{
+		{"current", statefulSet(3, 3, 2), 2, false},
+		{"generation not observed", statefulSet(3, 2, 2), 2, true},
+		{"pods not updated", statefulSet(3, 3, 1), 2, true},
+		{"daemonset updated", daemonSet, 2, false},
+		{"daemonset not updated", daemonSet, 3, true},
+		{"replicaset has no revisions", replicaSet, 2, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := podsRevisionPending(tt.obj, tt.expected); (got != "") != tt.wantPending {
+				t.Errorf("podsRevisionPending() = %q, want pending %v", got, tt.wantPending)
+			}
+		})
+	}
+}
+
+func TestExpectedPodCount(t *testing.T) {
+	daemonSet := func(status map[string]interface{}) *unstructured.Unstructured {
+		return &unstructured.Unstructured{Object: map[string]interface{}{"kind": "DaemonSet", "status": status}}
+	}
+	tests := []struct {
+		name        string
+		obj         *unstructured.Unstructured
+		want        int64
+		wantPending bool
+	}{
+		{"replicas", podsReadyDeployment(4), 4, false},
+		{"default replicas", &unstructured.Unstructured{Object: map[string]interface{}{"kind": "StatefulSet"}}, 1, false},
+		{"scaled to zero", podsReadyDeployment(0), 0, false},
+		{"daemonset", daemonSet(map[string]interface{}{"observedGeneration": int64(1), "desiredNumberScheduled": int64(3)}), 3, false},
+		{"daemonset not observed", daemonSet(map[string]interface{}{}), 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, pending := expectedPodCount(tt.obj)
+			if got != tt.want || (pending != "") != tt.wantPending {
+				t.Errorf("expectedPodCount() = %d, %q, want %d (pending %v)", got, pending, tt.want, tt.wantPending)
+			}
+		})
+	}
+}
@@ -0,0 +1,103 @@
+package wait
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/watch"
+
+	"github.com/jmorris0x0/terraform-provider-k8sconnect/internal/k8sconnect/common/k8sclient"
+)
+
+// pollOnlyClient replays a sequence of Get responses (nil means NotFound) and
+// fails the test if a watch is opened.
+type pollOnlyClient struct {
+	k8sclient.K8sClient
+	t         *testing.T
+	responses []*unstructured.Unstructured
+	gets      int
+}
+
+func (c *pollOnlyClient) Get(ctx context.Context, gvr schema.GroupVersionResource, namespace, name string) (*unstructured.Unstructured, error) {
+	i := c.gets
+	if i >= len(c.responses) {
+		i = len(c.responses) - 1
+	}
+	c.gets++
+	if c.responses[i] == nil {
+		return nil, apierrors.NewNotFound(gvr.GroupResource(), name)
+	}
+	return c.responses[i], nil
+}
+
+func (c *pollOnlyClient) Watch(ctx context.Context, gvr schema.GroupVersionResource, namespace string, opts metav1.ListOptions) (watch.Interface, error) {
+	c.t.Error("watch opened in poll mode")
+	return nil, fmt.Errorf("unexpected watch")
+}
+
+func TestParsePollSettings(t *testing.T) {
+	ctx := context.Background()
+	tests := []struct {
+		name string
+		cfg  waitForModel
+		want pollSettings
+	}{
+		{"defaults", waitForModel{}, pollSettings{interval: defaultPollInterval}},
+		{"explicit watch", waitForModel{Mode: types.StringValue("watch")}, pollSettings{interval: defaultPollInterval}},
+		{"poll with interval", waitForModel{Mode: types.StringValue("poll"), PollInterval: types.StringValue("5s")}, pollSettings{pollOnly: true, interval: 5 * time.Second}},
+		{"interval applies to watch fallback", waitForModel{PollInterval: types.StringValue("500ms")}, pollSettings{interval: 500 * time.Millisecond}},
+		{"invalid interval uses default", waitForModel{Mode: types.StringValue("poll"), PollInterval: types.StringValue("soon")}, pollSettings{pollOnly: true, interval: defaultPollInterval}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := parsePollSettings(ctx, tt.cfg); got != tt.want {
+				t.Errorf("parsePollSettings() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPollModeSkipsWatch(t *testing.T) {
+	gvr := schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "deployments"}
+	rolling := deploymentFixture(2, 2, 3, 3, 1, 1)
+	ready := deploymentFixture(2, 2, 3, 3, 3, 3)
+	ps := pollSettings{pollOnly: true, interval: 10 * time.Millisecond}
+
+	client := &pollOnlyClient{
+		K8sClient: k8sclient.NewStubK8sClient(),
+		t:         t,
+		responses: []*unstructured.Unstructured{rolling, nil, ready},
+	}
+
+	r := &waitResource{}
+	if err := r.waitWithCheck(context.Background(), client, gvr, rolling, checkDeploymentRollout, "deployment", 5*time.Second, ps); err != nil {
+		t.Fatalf("expected poll-mode rollout wait to succeed, got: %v", err)
+	}
+	if client.gets != 3 {
+		t.Errorf("expected 3 polls (not ready, not found, ready), got %d", client.gets)
+	}
+}
+
+func TestPollModeChecksImmediately(t *testing.T) {
+	gvr := schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "deployments"}
+	ready := deploymentFixture(2, 2, 3, 3, 3, 3)
+	client := &pollOnlyClient{K8sClient: k8sclient.NewStubK8sClient(), t: t, responses: []*unstructured.Unstructured{ready}}
+
+	// An interval longer than the timeout proves the first check doesn't wait for a tick
+	ps := pollSettings{pollOnly: true, interval: time.Hour}
+
+	r := &waitResource{}
+	err := r.waitForFieldValues(context.Background(), client, gvr, ready,
+		map[string]string{"status.updatedReplicas": "3"}, time.Second, ps)
+	if err != nil {
+		t.Fatalf("expected immediate match in poll mode, got: %v", err)
+	}
+}
@@ -0,0 +1,108 @@
+package wait
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/jmorris0x0/terraform-provider-k8sconnect/internal/k8sconnect/common/k8sclient"
+)
+
+func pendingClaim(spec map[string]interface{}) *unstructured.Unstructured {
+	return testObject("v1", "PersistentVolumeClaim", "default", "data", map[string]interface{}{
+		"spec":   spec,
+		"status": map[string]interface{}{"phase": "Pending"},
+	})
+}
+
+func storageClass(name, provisioner, bindingMode string, isDefault bool) unstructured.Unstructured {
+	class := testObject("storage.k8s.io/v1", "StorageClass", "", name, map[string]interface{}{
+		"provisioner":       provisioner,
+		"volumeBindingMode": bindingMode,
+	})
+	if isDefault {
+		class.SetAnnotations(map[string]string{defaultStorageClassAnnotation: "true"})
+	}
+	return *class
+}
+
+var pvcBound = waitForModel{PVCBound: types.BoolValue(true)}
+
+func TestPVCBoundWaitsForBoundPhase(t *testing.T) {
+	pending := pendingClaim(map[string]interface{}{})
+	bound := pendingClaim(map[string]interface{}{})
+	bound.Object["status"] = map[string]interface{}{"phase": "Bound"}
+	client := newPollClient(t, pending, pending, bound)
+
+	requireWaitCompletes(t, client, pvcGVR, pending, polledWait(pvcBound, "5s"))
+	if client.gets < 3 {
+		t.Errorf("expected polling until Bound, got %d gets", client.gets)
+	}
+}
+
+func TestPVCBoundTimeoutIncludesEventsAndStorageClass(t *testing.T) {
+	pending := pendingClaim(map[string]interface{}{})
+	now := time.Now()
+	provisioning := warningEventFixture("PersistentVolumeClaim", "data", "ExternalProvisioning",
+		"Waiting for a volume to be created either by the external provisioner 'ebs.csi.aws.com' or manually by the system administrator", now, 12)
+	provisioning.Object["type"] = "Normal"
+	client := newPollClient(t, pending)
+	client.K8sClient = &listClient{
+		K8sClient: k8sclient.NewStubK8sClient(),
+		lists: map[string][]unstructured.Unstructured{
+			"storageclasses": {
+				storageClass("standard", "kubernetes.io/no-provisioner", "Immediate", false),
+				storageClass("gp3", "ebs.csi.aws.com", "Immediate", true),
+			},
+			"events": {
+				warningEventFixture("PersistentVolumeClaim", "data", "ProvisioningFailed", "quota exceeded", now.Add(-time.Minute), 1),
+				provisioning,
+			},
+		},
+	}
+
+	timeoutErr := requireWaitTimeout(t, client, pvcGVR, pending, polledWait(pvcBound, "50ms"),
+		`PersistentVolumeClaim "data" in namespace "default" was not bound within 50ms (status.phase: Pending)`,
+		"StorageClass: gp3 (cluster default)",
+		"Provisioner: ebs.csi.aws.com",
+		"• Warning ProvisioningFailed: quota exceeded\n• Normal ExternalProvisioning: Waiting for a volume to be created",
+		"(x12)",
+		"kubectl describe pvc data -n default",
+	)
+	if timeoutErr.lastObserved == nil {
+		t.Error("expected the last observed claim to be kept for snapshots")
+	}
+}
+
+func TestPVCStorageClassSection(t *testing.T) {
+	classes := []unstructured.Unstructured{
+		storageClass("local", "rancher.io/local-path", bindingModeWaitForFirstConsumer, false),
+	}
+	client := &listClient{K8sClient: k8sclient.NewStubK8sClient(), lists: map[string][]unstructured.Unstructured{"storageclasses": classes}}
+	r := &waitResource{}
+	ctx := context.Background()
+
+	tests := []struct {
+		name string
+		spec map[string]interface{}
+		want string
+	}{
+		{"named class", map[string]interface{}{"storageClassName": "local"}, "wait for the pod instead of the claim"},
+		{"missing class", map[string]interface{}{"storageClassName": "fast"}, `StorageClass "fast" from spec.storageClassName does not exist`},
+		{"no default class", map[string]interface{}{}, "no StorageClass is marked as default"},
+		{"class disabled", map[string]interface{}{"storageClassName": ""}, "StorageClass: none"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := r.storageClassSection(ctx, client, pendingClaim(tt.spec))
+			if !strings.Contains(got, tt.want) {
+				t.Errorf("storageClassSection() = %q, want it to contain %q", got, tt.want)
+			}
+		})
+	}
+}
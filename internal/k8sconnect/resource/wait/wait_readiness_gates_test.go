@@ -0,0 +1,124 @@
+package wait
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// podFixture returns a Pod declaring the given readiness gates with the given conditions
+// (type -> status)
+func podFixture(gates []string, conditions map[string]string) *unstructured.Unstructured {
+	var readinessGates []interface{}
+	for _, gate := range gates {
+		readinessGates = append(readinessGates, map[string]interface{}{"conditionType": gate})
+	}
+
+	var conds []interface{}
+	for condType, status := range conditions {
+		conds = append(conds, map[string]interface{}{"type": condType, "status": status})
+	}
+
+	obj := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "Pod",
+		"metadata":   map[string]interface{}{"name": "web", "namespace": "default"},
+		"spec":       map[string]interface{}{},
+		"status":     map[string]interface{}{"conditions": conds},
+	}}
+	if readinessGates != nil {
+		_ = unstructured.SetNestedSlice(obj.Object, readinessGates, "spec", "readinessGates")
+	}
+	return obj
+}
+
+func TestConditionCheckerPodReadinessGates(t *testing.T) {
+	r := &waitResource{}
+	tests := []struct {
+		name      string
+		obj       *unstructured.Unstructured
+		condition string
+		want      bool
+	}{
+		{
+			name:      "Ready without gates",
+			obj:       podFixture(nil, map[string]string{"Ready": "True"}),
+			condition: "Ready",
+			want:      true,
+		},
+		{
+			name:      "Ready with gate True",
+			obj:       podFixture([]string{"example.com/lb-ready"}, map[string]string{"Ready": "True", "example.com/lb-ready": "True"}),
+			condition: "Ready",
+			want:      true,
+		},
+		{
+			name:      "Ready with gate False",
+			obj:       podFixture([]string{"example.com/lb-ready"}, map[string]string{"Ready": "True", "example.com/lb-ready": "False"}),
+			condition: "Ready",
+			want:      false,
+		},
+		{
+			name:      "Ready with gate not reported",
+			obj:       podFixture([]string{"example.com/lb-ready"}, map[string]string{"Ready": "True"}),
+			condition: "Ready",
+			want:      false,
+		},
+		{
+			name:      "other conditions ignore gates",
+			obj:       podFixture([]string{"example.com/lb-ready"}, map[string]string{"ContainersReady": "True"}),
+			condition: "ContainersReady",
+			want:      true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := r.createConditionChecker(tt.condition)(tt.obj); got != tt.want {
+				t.Errorf("checker(%q) = %v, want %v", tt.condition, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestReadinessGatesIgnoredForNonPods(t *testing.T) {
+	obj := podFixture([]string{"example.com/lb-ready"}, map[string]string{"Ready": "True"})
+	obj.SetKind("Node")
+
+	if gates := blockingReadinessGates(obj, "Ready"); len(gates) != 0 {
+		t.Errorf("expected no readiness gates for non-Pod, got %v", gates)
+	}
+}
+
+func TestConditionTimeoutErrorReportsBlockingGate(t *testing.T) {
+	r := &waitResource{}
+	obj := podFixture(
+		[]string{"example.com/lb-ready", "example.com/dns-ready"},
+		map[string]string{"Ready": "True", "example.com/lb-ready": "True"},
+	)
+
+	err := r.buildConditionTimeoutError(context.Background(), nil, schema.GroupVersionResource{Version: "v1", Resource: "pods"},
+		"default", "web", obj, "Ready", nil, time.Minute)
+	if err == nil {
+		t.Fatal("expected timeout error while a readiness gate is pending")
+	}
+
+	msg := err.Error()
+	for _, want := range []string{
+		"Readiness Gates:",
+		"example.com/dns-ready = <not reported>",
+		"example.com/lb-ready = True",
+		`Blocked by readiness gate(s): "example.com/dns-ready"`,
+	} {
+		if !strings.Contains(msg, want) {
+			t.Errorf("timeout error missing %q:\n%s", want, msg)
+		}
+	}
+	if strings.Contains(msg, `"example.com/lb-ready", `) || strings.Contains(msg, `gate(s): "example.com/lb-ready"`) {
+		t.Errorf("satisfied gate reported as blocking:\n%s", msg)
+	}
+}
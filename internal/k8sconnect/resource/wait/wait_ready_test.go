@@ -0,0 +1,121 @@
+package wait
+
+import (
+	"context"
+	stderrors "errors"
+	"strings"
+	"testing"
+	"time"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/jmorris0x0/terraform-provider-k8sconnect/internal/k8sconnect/common/k8sclient"
+)
+
+// customResource builds a custom resource with the given generation, observed
+// generation, and status.conditions (type -> status)
+func customResource(generation, observedGeneration int64, conditions map[string]string) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "example.com/v1",
+		"kind":       "Database",
+		"metadata":   map[string]interface{}{"name": "orders", "namespace": "default", "generation": generation},
+		"status":     map[string]interface{}{"observedGeneration": observedGeneration},
+	}}
+	var conds []interface{}
+	for condType, status := range conditions {
+		conds = append(conds, map[string]interface{}{"type": condType, "status": status, "message": condType + " message"})
+	}
+	if conds != nil {
+		_ = unstructured.SetNestedSlice(obj.Object, conds, "status", "conditions")
+	}
+	return obj
+}
+
+func TestComputeReadyStatus(t *testing.T) {
+	pvc := func(phase string) *unstructured.Unstructured {
+		return &unstructured.Unstructured{Object: map[string]interface{}{
+			"apiVersion": "v1",
+			"kind":       "PersistentVolumeClaim",
+			"metadata":   map[string]interface{}{"name": "data"},
+			"status":     map[string]interface{}{"phase": phase},
+		}}
+	}
+	job := func(condType string) *unstructured.Unstructured {
+		return &unstructured.Unstructured{Object: map[string]interface{}{
+			"apiVersion": "batch/v1",
+			"kind":       "Job",
+			"metadata":   map[string]interface{}{"name": "migrate"},
+			"status": map[string]interface{}{
+				"conditions": []interface{}{map[string]interface{}{"type": condType, "status": "True"}},
+			},
+		}}
+	}
+
+	tests := []struct {
+		name   string
+		obj    *unstructured.Unstructured
+		status string
+	}{
+		{"custom resource without conditions", customResource(1, 1, nil), readyStatusCurrent},
+		{"custom resource Ready", customResource(2, 2, map[string]string{"Ready": "True"}), readyStatusCurrent},
+		{"custom resource not Ready", customResource(2, 2, map[string]string{"Ready": "False"}), readyStatusInProgress},
+		{"generation not observed", customResource(3, 2, map[string]string{"Ready": "True"}), readyStatusInProgress},
+		{"reconciling", customResource(1, 1, map[string]string{"Reconciling": "True"}), readyStatusInProgress},
+		{"stalled", customResource(1, 1, map[string]string{"Stalled": "True"}), readyStatusFailed},
+		{"deployment rolling out", deploymentFixture(2, 2, 3, 3, 1, 1), readyStatusInProgress},
+		{"deployment complete", deploymentFixture(2, 2, 3, 3, 3, 3), readyStatusCurrent},
+		{"pvc pending", pvc("Pending"), readyStatusInProgress},
+		{"pvc bound", pvc("Bound"), readyStatusCurrent},
+		{"job complete", job("Complete"), readyStatusCurrent},
+		{"job failed", job("Failed"), readyStatusFailed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := computeReadyStatus(tt.obj); got.status != tt.status {
+				t.Errorf("computeReadyStatus() = %+v, want status %s", got, tt.status)
+			}
+		})
+	}
+}
+
+func TestReadyWait(t *testing.T) {
+	r := &waitResource{}
+	ps := pollSettings{interval: 10 * time.Millisecond, pollOnly: true}
+	gvr := schema.GroupVersionResource{Group: "example.com", Version: "v1", Resource: "databases"}
+
+	// Becomes ready once the controller observes the new generation
+	pending := customResource(2, 1, map[string]string{"Ready": "True"})
+	ready := customResource(2, 2, map[string]string{"Ready": "True"})
+	client := &pollOnlyClient{K8sClient: k8sclient.NewStubK8sClient(), t: t, responses: []*unstructured.Unstructured{pending, ready}}
+	if err := r.waitWithCheck(context.Background(), client, gvr, pending, checkReady, readyWaitType, 5*time.Second, ps); err != nil {
+		t.Errorf("expected the ready wait to succeed, got %v", err)
+	}
+
+	// A stalled resource fails before the timeout
+	stalled := customResource(1, 1, map[string]string{"Stalled": "True"})
+	client = &pollOnlyClient{K8sClient: k8sclient.NewStubK8sClient(), t: t, responses: []*unstructured.Unstructured{stalled}}
+	started := time.Now()
+	err := r.waitWithCheck(context.Background(), client, gvr, stalled, checkReady, readyWaitType, 5*time.Second, ps)
+	var timeoutErr *waitTimeoutError
+	if err == nil || stderrors.As(err, &timeoutErr) || time.Since(started) > time.Second {
+		t.Fatalf("expected the wait to fail before its timeout, got %v after %s", err, time.Since(started))
+	}
+	if !strings.Contains(err.Error(), "Stalled message") {
+		t.Errorf("expected the Stalled message in the error, got:\n%s", err)
+	}
+
+	// The timeout error shows the computed status and message
+	reconciling := customResource(1, 1, map[string]string{"Reconciling": "True"})
+	client = &pollOnlyClient{K8sClient: k8sclient.NewStubK8sClient(), t: t, responses: []*unstructured.Unstructured{reconciling}}
+	err = r.waitWithCheck(context.Background(), client, gvr, reconciling, checkReady, readyWaitType, 50*time.Millisecond, ps)
+	if !stderrors.As(err, &timeoutErr) {
+		t.Fatalf("expected a wait timeout, got %v", err)
+	}
+	for _, want := range []string{"Database/default/orders", "Status: InProgress", "Message: Reconciling message"} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("timeout error missing %q:\n%s", want, err)
+		}
+	}
+}
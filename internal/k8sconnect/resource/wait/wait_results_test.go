@@ -0,0 +1,94 @@
+package wait
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/jmorris0x0/terraform-provider-k8sconnect/internal/k8sconnect/common/k8sclient"
+)
+
+func TestObservedValues(t *testing.T) {
+	obj := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "PersistentVolumeClaim",
+		"metadata":   map[string]interface{}{"name": "data", "uid": "abc-123"},
+		"spec":       map[string]interface{}{"volumeName": "pv-1", "accessModes": []interface{}{"ReadWriteOnce"}},
+		"status": map[string]interface{}{
+			"phase":      "Bound",
+			"capacity":   map[string]interface{}{"storage": "1Gi"},
+			"conditions": []interface{}{map[string]interface{}{"type": "Resizing", "status": "False"}},
+		},
+	}}
+
+	tests := []struct {
+		name string
+		cfg  waitForModel
+		want map[string]string
+	}{
+		{"field", waitForModel{Field: types.StringValue("spec.volumeName")}, map[string]string{"spec.volumeName": "pv-1"}},
+		{"field with a map value", waitForModel{Field: types.StringValue("status.capacity")}, map[string]string{"status.capacity": `{"storage":"1Gi"}`}},
+		{"field_value", waitForModel{FieldValue: types.MapValueMust(types.StringType, map[string]attr.Value{
+			"status.phase": types.StringValue("Bound"),
+			"metadata.uid": types.StringValue("abc-123"),
+		})}, map[string]string{"status.phase": "Bound", "metadata.uid": "abc-123"}},
+		{"pvc_bound", waitForModel{PVCBound: types.BoolValue(true)}, map[string]string{pvcPhaseField: "Bound"}},
+		{"condition", waitForModel{Condition: types.StringValue("Resizing")}, map[string]string{"Resizing": "False"}},
+		{"missing field", waitForModel{Field: types.StringValue("status.missing")}, map[string]string{}},
+		{"rollout", waitForModel{Rollout: types.BoolValue(true)}, map[string]string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := observedValues(obj, tt.cfg); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("observedValues() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRecordResultsAcrossSteps(t *testing.T) {
+	ctx := context.Background()
+	client := k8sclient.NewStubK8sClient()
+	client.GetResponse = &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "Pod",
+		"metadata":   map[string]interface{}{"name": "web", "namespace": "default"},
+		"status": map[string]interface{}{
+			"podIP":      "10.0.0.5",
+			"conditions": []interface{}{map[string]interface{}{"type": "Ready", "status": "True"}},
+		},
+	}}
+
+	wc := &waitContext{
+		Client: client,
+		ObjectRef: objectRefModel{
+			Name:      types.StringValue("web"),
+			Namespace: types.StringValue("default"),
+		},
+	}
+	r := &waitResource{}
+	r.recordResults(ctx, wc, waitForModel{Condition: types.StringValue("Ready")})
+	r.recordResults(ctx, wc, waitForModel{Field: types.StringValue("status.podIP")})
+
+	results, diags := resultsValue(ctx, wc.Results)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	want := types.MapValueMust(types.StringType, map[string]attr.Value{
+		"Ready":        types.StringValue("True"),
+		"status.podIP": types.StringValue("10.0.0.5"),
+	})
+	if !results.Equal(want) {
+		t.Errorf("results = %v, want %v", results, want)
+	}
+
+	// Nothing observed leaves results null
+	if empty, _ := resultsValue(ctx, nil); !empty.IsNull() {
+		t.Errorf("expected null results, got %v", empty)
+	}
+}
@@ -0,0 +1,353 @@
+package wait
+
+import (
+	"context"
+	stderrors "errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/jmorris0x0/terraform-provider-k8sconnect/internal/k8sconnect/common/k8sclient"
+)
+
+func statefulSetFixture(replicas, partition, ready, current, updated int64) *unstructured.Unstructured {
+	spec := map[string]interface{}{"replicas": replicas}
+	if partition > 0 {
+		spec["updateStrategy"] = map[string]interface{}{
+			"type":          "RollingUpdate",
+			"rollingUpdate": map[string]interface{}{"partition": partition},
+		}
+	}
+
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "apps/v1",
+		"kind":       "StatefulSet",
+		"metadata":   map[string]interface{}{"name": "web", "namespace": "default", "generation": int64(2)},
+		"spec":       spec,
+		"status": map[string]interface{}{
+			"observedGeneration": int64(2),
+			"readyReplicas":      ready,
+			"currentReplicas":    current,
+			"updatedReplicas":    updated,
+		},
+	}}
+}
+
+func TestCheckStatefulSetRollout(t *testing.T) {
+	tests := []struct {
+		name      string
+		obj       *unstructured.Unstructured
+		wantReady bool
+	}{
+		{"no partition, fully updated", statefulSetFixture(3, 0, 3, 3, 3), true},
+		{"no partition, update in progress", statefulSetFixture(3, 0, 3, 1, 2), false},
+		{"partition, pods above partition updated", statefulSetFixture(5, 3, 5, 3, 2), true},
+		{"partition, pods above partition still updating", statefulSetFixture(5, 3, 5, 4, 1), false},
+		{"partition, updated pod not ready", statefulSetFixture(5, 3, 4, 3, 2), false},
+		{"partition above replicas updates nothing", statefulSetFixture(3, 5, 3, 3, 0), true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ready, reason := checkStatefulSetRollout(tt.obj)
+			if ready != tt.wantReady {
+				t.Errorf("checkStatefulSetRollout() = %v (%s), want %v", ready, reason, tt.wantReady)
+			}
+		})
+	}
+}
+
+func TestCheckStatefulSetRolloutGenerationMismatch(t *testing.T) {
+	obj := statefulSetFixture(3, 0, 3, 3, 3)
+	_ = unstructured.SetNestedField(obj.Object, int64(1), "status", "observedGeneration")
+
+	ready, reason := checkStatefulSetRollout(obj)
+	if ready || !strings.Contains(reason, "generation mismatch") {
+		t.Errorf("expected generation mismatch, got ready=%v reason=%q", ready, reason)
+	}
+}
+
+func TestStatefulSetPartitionIgnoredForOnDelete(t *testing.T) {
+	obj := statefulSetFixture(3, 2, 3, 3, 3)
+	_ = unstructured.SetNestedField(obj.Object, "OnDelete", "spec", "updateStrategy", "type")
+
+	if got := statefulSetPartition(obj); got != 0 {
+		t.Errorf("statefulSetPartition() = %d, want 0 for OnDelete strategy", got)
+	}
+}
+
+func deploymentFixture(generation, observedGen, replicas, statusReplicas, updated, available int64) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"metadata":   map[string]interface{}{"name": "web", "namespace": "default", "generation": generation},
+		"spec":       map[string]interface{}{"replicas": replicas},
+		"status": map[string]interface{}{
+			"observedGeneration": observedGen,
+			"replicas":           statusReplicas,
+			"updatedReplicas":    updated,
+			"availableReplicas":  available,
+			"readyReplicas":      available,
+		},
+	}}
+}
+
+func TestCheckDeploymentRollout(t *testing.T) {
+	tests := []struct {
+		name       string
+		obj        *unstructured.Unstructured
+		wantReady  bool
+		wantReason string
+	}{
+		{"complete", deploymentFixture(3, 3, 3, 3, 3, 3), true, ""},
+		{"new spec not yet observed", deploymentFixture(4, 3, 3, 3, 3, 3), false, "observed"},
+		{"updated replicas still rolling", deploymentFixture(3, 3, 3, 4, 2, 3), false, "updated"},
+		{"old replicas pending termination", deploymentFixture(3, 3, 3, 4, 3, 3), false, "pending termination"},
+		{"updated replicas not available", deploymentFixture(3, 3, 3, 3, 3, 2), false, "available"},
+		{"observed generation ahead is fine", deploymentFixture(3, 4, 3, 3, 3, 3), true, ""},
+		{"scaled to zero", deploymentFixture(2, 2, 0, 0, 0, 0), true, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ready, reason := checkDeploymentRollout(tt.obj)
+			if ready != tt.wantReady || !strings.Contains(reason, tt.wantReason) {
+				t.Errorf("checkDeploymentRollout() = %v (%q), want %v containing %q", ready, reason, tt.wantReady, tt.wantReason)
+			}
+		})
+	}
+}
+
+func TestCheckDeploymentRolloutProgressDeadlineExceeded(t *testing.T) {
+	obj := deploymentFixture(3, 3, 3, 3, 1, 1)
+	_ = unstructured.SetNestedSlice(obj.Object, []interface{}{
+		map[string]interface{}{"type": "Progressing", "status": "False", "reason": "ProgressDeadlineExceeded"},
+	}, "status", "conditions")
+
+	ready, reason := checkDeploymentRollout(obj)
+	if ready || !strings.Contains(reason, "progress deadline") {
+		t.Errorf("expected progress deadline reason, got ready=%v reason=%q", ready, reason)
+	}
+}
+
+func daemonSetFixture(desired, ready, updated int64) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "apps/v1",
+		"kind":       "DaemonSet",
+		"metadata":   map[string]interface{}{"name": "agent", "namespace": "kube-system", "generation": int64(1)},
+		"status": map[string]interface{}{
+			"observedGeneration":     int64(1),
+			"desiredNumberScheduled": desired,
+			"numberReady":            ready,
+			"updatedNumberScheduled": updated,
+		},
+	}}
+}
+
+func TestCheckRolloutReadyPercent(t *testing.T) {
+	tests := []struct {
+		name       string
+		obj        *unstructured.Unstructured
+		percent    int64
+		wantReady  bool
+		wantReason string
+	}{
+		{"daemonset above threshold", daemonSetFixture(100, 85, 90), 80, true, ""},
+		{"daemonset exactly at threshold", daemonSetFixture(10, 8, 8), 80, true, ""},
+		{"daemonset below threshold", daemonSetFixture(100, 75, 100), 80, false, "75% ready (75/100)"},
+		{"daemonset not enough updated", daemonSetFixture(100, 100, 50), 80, false, "50/100 updated"},
+		{"threshold rounds up", daemonSetFixture(3, 2, 3), 80, false, "requires 3 ready"},
+		{"no desired pods", daemonSetFixture(0, 0, 0), 80, true, ""},
+		{"deployment partial", deploymentFixture(2, 2, 10, 10, 10, 9), 90, true, ""},
+		{"deployment spec not observed", deploymentFixture(3, 2, 10, 10, 10, 10), 50, false, "observed"},
+		{"statefulset partition lowers expected updates", statefulSetFixture(10, 5, 10, 10, 5), 100, true, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ready, reason := checkRolloutReadyPercent(tt.obj, tt.percent)
+			if ready != tt.wantReady || !strings.Contains(reason, tt.wantReason) {
+				t.Errorf("checkRolloutReadyPercent() = %v (%q), want %v containing %q", ready, reason, tt.wantReady, tt.wantReason)
+			}
+		})
+	}
+}
+
+func TestRolloutTimeoutErrorShowsAchievedPercent(t *testing.T) {
+	r := &waitResource{}
+	obj := daemonSetFixture(40, 30, 40)
+	check := func(obj *unstructured.Unstructured) (bool, string) {
+		return checkRolloutReadyPercent(obj, 90)
+	}
+
+	err := r.buildRolloutTimeoutError(context.Background(), nil, obj, obj, check, "daemonset rollout", time.Minute)
+	if err == nil || !strings.Contains(err.Error(), "Waiting for: 75% ready (30/40)") {
+		t.Errorf("expected achieved percentage in timeout error, got: %v", err)
+	}
+}
+
+func TestPausedDeploymentRolloutFailsFast(t *testing.T) {
+	paused := deploymentFixture(3, 3, 3, 3, 1, 1)
+	_ = unstructured.SetNestedField(paused.Object, true, "spec", "paused")
+	client := &pollOnlyClient{K8sClient: k8sclient.NewStubK8sClient(), t: t, responses: []*unstructured.Unstructured{paused}}
+	r := &waitResource{}
+	ps := pollSettings{interval: 10 * time.Millisecond, pollOnly: true}
+	gvr := schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "deployments"}
+
+	started := time.Now()
+	err := r.waitForRollout(context.Background(), client, gvr, paused, 0, 5*time.Second, ps)
+	if err == nil {
+		t.Fatal("expected a paused deployment to fail the rollout wait")
+	}
+	var timeoutErr *waitTimeoutError
+	if stderrors.As(err, &timeoutErr) || time.Since(started) > time.Second {
+		t.Errorf("expected the wait to fail before its timeout, got %v after %s", err, time.Since(started))
+	}
+	for _, want := range []string{"Deployment/default/web", "spec.paused: true", "kubectl rollout resume deployment/web -n default"} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("error missing %q:\n%s", want, err)
+		}
+	}
+
+	// A rollout that completed before the pause has nothing left to wait for
+	complete := deploymentFixture(3, 3, 3, 3, 3, 3)
+	_ = unstructured.SetNestedField(complete.Object, true, "spec", "paused")
+	client.responses = []*unstructured.Unstructured{complete}
+	if err := r.waitForRollout(context.Background(), client, gvr, complete, 0, 5*time.Second, ps); err != nil {
+		t.Errorf("expected a complete paused deployment to succeed, got %v", err)
+	}
+}
+
+func TestProgressDeadlineExceededRolloutFailsFast(t *testing.T) {
+	withDeadlineExceeded := func(obj *unstructured.Unstructured) *unstructured.Unstructured {
+		_ = unstructured.SetNestedSlice(obj.Object, []interface{}{
+			map[string]interface{}{"type": "Progressing", "status": "False", "reason": "ProgressDeadlineExceeded"},
+		}, "status", "conditions")
+		return obj
+	}
+	stuck := withDeadlineExceeded(deploymentFixture(3, 3, 3, 3, 1, 1))
+	client := &pollOnlyClient{K8sClient: k8sclient.NewStubK8sClient(), t: t, responses: []*unstructured.Unstructured{stuck}}
+	r := &waitResource{}
+	ps := pollSettings{interval: 10 * time.Millisecond, pollOnly: true}
+	gvr := schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "deployments"}
+
+	started := time.Now()
+	err := r.waitForRollout(context.Background(), client, gvr, stuck, 0, 5*time.Second, ps)
+	if err == nil {
+		t.Fatal("expected a deployment past its progress deadline to fail the rollout wait")
+	}
+	var timeoutErr *waitTimeoutError
+	if stderrors.As(err, &timeoutErr) || time.Since(started) > time.Second {
+		t.Errorf("expected the wait to fail before its timeout, got %v after %s", err, time.Since(started))
+	}
+	for _, want := range []string{"Deployment/default/web", "ProgressDeadlineExceeded", "kubectl describe deployment/web -n default"} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("error missing %q:\n%s", want, err)
+		}
+	}
+
+	// A new spec the controller hasn't observed yet restarts the progress deadline
+	if err := deploymentProgressDeadlineError(withDeadlineExceeded(deploymentFixture(4, 3, 3, 3, 1, 1))); err != nil {
+		t.Errorf("expected no error before the new spec is observed, got %v", err)
+	}
+}
+
+func TestStrictDeploymentRollout(t *testing.T) {
+	withStrictStatus := func(obj *unstructured.Unstructured, available string, unavailable int64) *unstructured.Unstructured {
+		_ = unstructured.SetNestedSlice(obj.Object, []interface{}{
+			map[string]interface{}{"type": "Available", "status": available},
+		}, "status", "conditions")
+		_ = unstructured.SetNestedField(obj.Object, unavailable, "status", "unavailableReplicas")
+		return obj
+	}
+
+	// A rollout checkDeploymentRollout accepts still has an unavailable replica
+	lagging := withStrictStatus(deploymentFixture(3, 3, 3, 3, 3, 3), "False", 1)
+	if ready, _ := checkDeploymentRollout(lagging); !ready {
+		t.Fatal("fixture should pass the regular rollout check")
+	}
+	if ready, reason := checkStrictDeploymentRollout(lagging); ready || !strings.Contains(reason, "1 unavailable replicas") {
+		t.Errorf("expected the strict check to wait, got ready=%v reason=%q", ready, reason)
+	}
+
+	if ready, reason := checkStrictDeploymentRollout(withStrictStatus(deploymentFixture(3, 3, 3, 3, 3, 3), "True", 0)); !ready {
+		t.Errorf("expected a fully available rollout to pass, got %q", reason)
+	}
+
+	// The timeout error lists the three strict values
+	r := &waitResource{}
+	client := &pollOnlyClient{K8sClient: k8sclient.NewStubK8sClient(), t: t, responses: []*unstructured.Unstructured{lagging}}
+	gvr := schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "deployments"}
+	err := r.waitForResource(context.Background(), client, gvr, lagging, waitForModel{
+		Rollout:      types.BoolValue(true),
+		Strict:       types.BoolValue(true),
+		Timeout:      types.StringValue("50ms"),
+		Mode:         types.StringValue("poll"),
+		PollInterval: types.StringValue("10ms"),
+	})
+	var timeoutErr *waitTimeoutError
+	if !stderrors.As(err, &timeoutErr) {
+		t.Fatalf("expected a wait timeout, got %v", err)
+	}
+	for _, want := range []string{"Available condition: False", "Unavailable replicas: 1", "Observed generation: 3 (required: 3)"} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("timeout error missing %q:\n%s", want, err)
+		}
+	}
+}
+
+func TestWaitForRolloutFailsOnExternalChange(t *testing.T) {
+	r := &waitResource{}
+	gvr := schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "deployments"}
+	rolling := deploymentFixture(2, 2, 3, 3, 1, 1)
+	config := waitForModel{
+		Rollout:      types.BoolValue(true),
+		Timeout:      types.StringValue("5s"),
+		Mode:         types.StringValue("poll"),
+		PollInterval: types.StringValue("10ms"),
+	}
+
+	tests := []struct {
+		name    string
+		current *unstructured.Unstructured
+		want    string
+	}{
+		// Scaled to zero would otherwise pass as a completed rollout
+		{"scaled to zero", deploymentFixture(3, 3, 0, 0, 0, 0), "spec.replicas dropped from 3 to 0"},
+		{"recreated", deploymentFixture(1, 1, 3, 3, 0, 0), "metadata.generation went from 2 to 1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &pollOnlyClient{K8sClient: k8sclient.NewStubK8sClient(), t: t, responses: []*unstructured.Unstructured{rolling, tt.current}}
+			started := time.Now()
+			err := r.waitForResource(context.Background(), client, gvr, rolling, config)
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Fatalf("expected an error containing %q, got %v", tt.want, err)
+			}
+			if time.Since(started) > time.Second {
+				t.Errorf("expected the wait to fail fast, took %s", time.Since(started))
+			}
+		})
+	}
+
+	// A deletion the wait sees is followed across the recreation, as for every wait
+	t.Run("recreated after an observed deletion", func(t *testing.T) {
+		recreated := deploymentFixture(1, 1, 3, 3, 3, 3)
+		client := &pollOnlyClient{K8sClient: k8sclient.NewStubK8sClient(), t: t, responses: []*unstructured.Unstructured{rolling, nil, recreated}}
+		if err := r.waitForResource(context.Background(), client, gvr, rolling, config); err != nil {
+			t.Errorf("expected the wait to follow the recreated Deployment, got %v", err)
+		}
+	})
+
+	t.Run("declared with zero replicas", func(t *testing.T) {
+		zero := deploymentFixture(2, 2, 0, 0, 0, 0)
+		client := &pollOnlyClient{K8sClient: k8sclient.NewStubK8sClient(), t: t, responses: []*unstructured.Unstructured{zero}}
+		if err := r.waitForResource(context.Background(), client, gvr, zero, config); err != nil {
+			t.Errorf("a workload at zero replicas when the wait starts has rolled out, got %v", err)
+		}
+	})
+}
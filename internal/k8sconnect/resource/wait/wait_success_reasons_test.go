@@ -0,0 +1,109 @@
+package wait
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/jmorris0x0/terraform-provider-k8sconnect/internal/k8sconnect/common/k8sclient"
+)
+
+// pipelineRun returns a custom resource reporting a single Ready condition
+func pipelineRun(status, reason string) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "example.com/v1",
+		"kind":       "PipelineRun",
+		"metadata":   map[string]interface{}{"name": "build", "namespace": "default"},
+		"status": map[string]interface{}{
+			"conditions": []interface{}{conditionFixture("Ready", status, reason, "")},
+		},
+	}}
+}
+
+func TestConditionCheckerSuccessReasons(t *testing.T) {
+	r := &waitResource{}
+	tests := []struct {
+		name    string
+		obj     *unstructured.Unstructured
+		reasons []string
+		want    bool
+	}{
+		{"True without reasons", pipelineRun("True", "Succeeded"), nil, true},
+		{"False without reasons", pipelineRun("False", "Completed"), nil, false},
+		{"False with a listed reason", pipelineRun("False", "Completed"), []string{"Succeeded", "Completed"}, true},
+		{"Unknown with a listed reason", pipelineRun("Unknown", "Completed"), []string{"Completed"}, true},
+		{"False with another reason", pipelineRun("False", "Running"), []string{"Completed"}, false},
+		{"False without a reason", pipelineRun("False", ""), []string{""}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := r.createConditionChecker("Ready", tt.reasons...)(tt.obj); got != tt.want {
+				t.Errorf("checker = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWaitForConditionSuccessReason(t *testing.T) {
+	gvr := schema.GroupVersionResource{Group: "example.com", Version: "v1", Resource: "pipelineruns"}
+	client := &pollOnlyClient{
+		K8sClient: k8sclient.NewStubK8sClient(),
+		t:         t,
+		responses: []*unstructured.Unstructured{pipelineRun("False", "Running"), pipelineRun("False", "Completed")},
+	}
+
+	err := (&waitResource{}).waitForCondition(context.Background(), client, gvr, pipelineRun("False", "Running"),
+		"Ready", []string{"Completed"}, 5*time.Second, pollSettings{pollOnly: true, interval: 10 * time.Millisecond})
+	if err != nil {
+		t.Fatalf("expected the Completed reason to satisfy the wait, got: %v", err)
+	}
+}
+
+func TestConditionTimeoutErrorNamesSuccessReasons(t *testing.T) {
+	gvr := schema.GroupVersionResource{Group: "example.com", Version: "v1", Resource: "pipelineruns"}
+	err := (&waitResource{}).buildConditionTimeoutError(context.Background(), nil, gvr,
+		"default", "build", pipelineRun("False", "Running"), "Ready", []string{"Completed", "Skipped"}, time.Minute)
+	if err == nil {
+		t.Fatal("expected a timeout error")
+	}
+	if !strings.Contains(err.Error(), `did not reach condition "Ready"=True or a reason in success_reasons (Completed, Skipped)`) {
+		t.Errorf("timeout error should name the success reasons:\n%s", err)
+	}
+
+	if err := (&waitResource{}).buildConditionTimeoutError(context.Background(), nil, gvr,
+		"default", "build", pipelineRun("False", "Completed"), "Ready", []string{"Completed"}, time.Minute); err != nil {
+		t.Errorf("a listed reason at the timeout boundary is success, got: %v", err)
+	}
+}
+
+func TestValidateSuccessReasons(t *testing.T) {
+	reasons := types.ListValueMust(types.StringType, []attr.Value{types.StringValue("Completed")})
+	tests := []struct {
+		name    string
+		waitFor waitForModel
+		wantErr bool
+	}{
+		{"with condition", waitForModel{Condition: types.StringValue("Ready"), SuccessReasons: reasons}, false},
+		{"without condition", waitForModel{Condition: types.StringNull(), Phase: types.StringValue("Succeeded"), SuccessReasons: reasons}, true},
+		{"unset", waitForModel{Condition: types.StringNull(), SuccessReasons: types.ListNull(types.StringType)}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := &resource.ValidateConfigResponse{}
+			validateSuccessReasons(tt.waitFor, path.Root("wait_for"), "wait_for", resp)
+			if got := resp.Diagnostics.HasError(); got != tt.wantErr {
+				t.Errorf("HasError() = %v, want %v: %v", got, tt.wantErr, resp.Diagnostics)
+			}
+		})
+	}
+}
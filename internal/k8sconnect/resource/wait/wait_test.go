@@ -588,6 +588,366 @@ resource "k8sconnect_wait" "pvc" {
 `, namespace, name, name, name, namespace)
 }
 
+// TestAccWaitResource_PVCBound tests the pvc_bound wait on a claim bound to a static volume
+func TestAccWaitResource_PVCBound(t *testing.T) {
+	t.Parallel()
+
+	raw := os.Getenv("TF_ACC_KUBECONFIG")
+	if raw == "" {
+		t.Fatal("TF_ACC_KUBECONFIG must be set")
+	}
+
+	ns := fmt.Sprintf("wait-pvc-bound-ns-%d", time.Now().UnixNano()%1000000)
+	pvcName := fmt.Sprintf("wait-pvc-bound-%d", time.Now().UnixNano()%1000000)
+	k8sClient := testhelpers.CreateK8sClient(t, raw)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"k8sconnect": providerserver.NewProtocol6WithError(k8sconnect.New()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWaitConfigPVCBound(ns, pvcName),
+				ConfigVariables: config.Variables{
+					"raw": config.StringVariable(raw),
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testhelpers.CheckPVCExists(k8sClient, ns, pvcName),
+					resource.TestCheckResourceAttr("k8sconnect_wait.pvc", "results.status.phase", "Bound"),
+				),
+			},
+		},
+		CheckDestroy: testhelpers.CheckPVCDestroy(k8sClient, ns, pvcName),
+	})
+}
+
+func testAccWaitConfigPVCBound(namespace, name string) string {
+	return fmt.Sprintf(`
+variable "raw" {
+  type = string
+}
+
+provider "k8sconnect" {}
+
+resource "k8sconnect_object" "test_namespace" {
+  yaml_body = <<YAML
+apiVersion: v1
+kind: Namespace
+metadata:
+  name: %[1]s
+YAML
+
+  cluster = {
+    kubeconfig = var.raw
+  }
+}
+
+resource "k8sconnect_object" "pv" {
+  yaml_body = <<YAML
+apiVersion: v1
+kind: PersistentVolume
+metadata:
+  name: %[2]s-pv
+spec:
+  capacity:
+    storage: 1Gi
+  accessModes:
+    - ReadWriteOnce
+  persistentVolumeReclaimPolicy: Delete
+  storageClassName: manual
+  hostPath:
+    path: /tmp/%[2]s
+YAML
+
+  cluster = {
+    kubeconfig = var.raw
+  }
+}
+
+resource "k8sconnect_object" "pvc" {
+  yaml_body = <<YAML
+apiVersion: v1
+kind: PersistentVolumeClaim
+metadata:
+  name: %[2]s
+  namespace: %[1]s
+spec:
+  accessModes:
+    - ReadWriteOnce
+  storageClassName: manual
+  resources:
+    requests:
+      storage: 1Gi
+YAML
+
+  cluster = {
+    kubeconfig = var.raw
+  }
+
+  depends_on = [k8sconnect_object.pv, k8sconnect_object.test_namespace]
+}
+
+resource "k8sconnect_wait" "pvc" {
+  object_ref = k8sconnect_object.pvc.object_ref
+
+  cluster = {
+    kubeconfig = var.raw
+  }
+
+  wait_for = {
+    pvc_bound = true
+    timeout   = "60s"
+  }
+}
+`, namespace, name)
+}
+
+// TestAccWaitResource_CronJobScheduled tests the cronjob_scheduled wait on a CronJob that
+// runs every minute
+func TestAccWaitResource_CronJobScheduled(t *testing.T) {
+	t.Parallel()
+
+	raw := os.Getenv("TF_ACC_KUBECONFIG")
+	if raw == "" {
+		t.Fatal("TF_ACC_KUBECONFIG must be set")
+	}
+
+	ns := fmt.Sprintf("wait-cronjob-ns-%d", time.Now().UnixNano()%1000000)
+	k8sClient := testhelpers.CreateK8sClient(t, raw)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"k8sconnect": providerserver.NewProtocol6WithError(k8sconnect.New()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWaitConfigCronJobScheduled(ns),
+				ConfigVariables: config.Variables{
+					"raw": config.StringVariable(raw),
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("k8sconnect_wait.cronjob", "results.status.lastScheduleTime"),
+				),
+			},
+		},
+		CheckDestroy: testhelpers.CheckNamespaceDestroy(k8sClient, ns),
+	})
+}
+
+func testAccWaitConfigCronJobScheduled(namespace string) string {
+	return fmt.Sprintf(`
+variable "raw" {
+  type = string
+}
+
+provider "k8sconnect" {}
+
+resource "k8sconnect_object" "test_namespace" {
+  yaml_body = <<YAML
+apiVersion: v1
+kind: Namespace
+metadata:
+  name: %[1]s
+YAML
+
+  cluster = {
+    kubeconfig = var.raw
+  }
+}
+
+resource "k8sconnect_object" "cronjob" {
+  yaml_body = <<YAML
+apiVersion: batch/v1
+kind: CronJob
+metadata:
+  name: every-minute
+  namespace: %[1]s
+spec:
+  schedule: "* * * * *"
+  jobTemplate:
+    spec:
+      template:
+        spec:
+          restartPolicy: Never
+          containers:
+          - name: tick
+            image: public.ecr.aws/docker/library/busybox:latest
+            command: ["true"]
+YAML
+
+  cluster = {
+    kubeconfig = var.raw
+  }
+
+  depends_on = [k8sconnect_object.test_namespace]
+}
+
+resource "k8sconnect_wait" "cronjob" {
+  object_ref = k8sconnect_object.cronjob.object_ref
+
+  cluster = {
+    kubeconfig = var.raw
+  }
+
+  wait_for = {
+    cronjob_scheduled = true
+    timeout           = "150s"
+  }
+}
+`, namespace)
+}
+
+// TestAccWaitResource_APIServiceAvailable tests the apiservice_available wait on the
+// built-in APIService of the apps group, which the API server serves locally
+func TestAccWaitResource_APIServiceAvailable(t *testing.T) {
+	t.Parallel()
+
+	raw := os.Getenv("TF_ACC_KUBECONFIG")
+	if raw == "" {
+		t.Fatal("TF_ACC_KUBECONFIG must be set")
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"k8sconnect": providerserver.NewProtocol6WithError(k8sconnect.New()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `
+variable "raw" {
+  type = string
+}
+
+provider "k8sconnect" {}
+
+resource "k8sconnect_wait" "apiservice" {
+  object_ref = {
+    api_version = "apiregistration.k8s.io/v1"
+    kind        = "APIService"
+    name        = "v1.apps"
+  }
+
+  cluster = {
+    kubeconfig = var.raw
+  }
+
+  wait_for = {
+    apiservice_available = true
+    timeout              = "30s"
+  }
+}
+`,
+				ConfigVariables: config.Variables{
+					"raw": config.StringVariable(raw),
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("k8sconnect_wait.apiservice", "results.Available", "True"),
+				),
+			},
+		},
+	})
+}
+
+// TestAccWaitResource_PodsReady tests the pods_ready wait on the pods a Deployment selects
+func TestAccWaitResource_PodsReady(t *testing.T) {
+	t.Parallel()
+
+	raw := os.Getenv("TF_ACC_KUBECONFIG")
+	if raw == "" {
+		t.Fatal("TF_ACC_KUBECONFIG must be set")
+	}
+
+	ns := fmt.Sprintf("wait-pods-ready-ns-%d", time.Now().UnixNano()%1000000)
+	deployName := fmt.Sprintf("wait-pods-ready-%d", time.Now().UnixNano()%1000000)
+	k8sClient := testhelpers.CreateK8sClient(t, raw)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"k8sconnect": providerserver.NewProtocol6WithError(k8sconnect.New()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWaitConfigPodsReady(ns, deployName),
+				ConfigVariables: config.Variables{
+					"raw": config.StringVariable(raw),
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testhelpers.CheckDeploymentExists(k8sClient, ns, deployName),
+					resource.TestCheckResourceAttrSet("k8sconnect_wait.pods", "id"),
+				),
+			},
+		},
+		CheckDestroy: testhelpers.CheckDeploymentDestroy(k8sClient, ns, deployName),
+	})
+}
+
+func testAccWaitConfigPodsReady(namespace, name string) string {
+	return fmt.Sprintf(`
+variable "raw" {
+  type = string
+}
+
+provider "k8sconnect" {}
+
+resource "k8sconnect_object" "test_namespace" {
+  yaml_body = <<YAML
+apiVersion: v1
+kind: Namespace
+metadata:
+  name: %[1]s
+YAML
+
+  cluster = {
+    kubeconfig = var.raw
+  }
+}
+
+resource "k8sconnect_object" "deployment" {
+  yaml_body = <<YAML
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: %[2]s
+  namespace: %[1]s
+spec:
+  replicas: 2
+  selector:
+    matchLabels:
+      app: %[2]s
+  template:
+    metadata:
+      labels:
+        app: %[2]s
+    spec:
+      containers:
+      - name: nginx
+        image: public.ecr.aws/nginx/nginx:1.21
+        ports:
+        - containerPort: 80
+YAML
+
+  cluster = {
+    kubeconfig = var.raw
+  }
+
+  depends_on = [k8sconnect_object.test_namespace]
+}
+
+resource "k8sconnect_wait" "pods" {
+  object_ref = k8sconnect_object.deployment.object_ref
+
+  cluster = {
+    kubeconfig = var.raw
+  }
+
+  wait_for = {
+    pods_ready = true
+    timeout    = "120s"
+  }
+}
+`, namespace, name)
+}
+
 // TestAccWaitResource_WaitForMultipleValues tests waiting for multiple field values
 func TestAccWaitResource_WaitForMultipleValues(t *testing.T) {
 	t.Parallel()
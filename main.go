@@ -5,9 +5,11 @@ import (
 	"context"
 	"flag"
 	"log"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/jmorris0x0/terraform-provider-k8sconnect/internal/k8sconnect"
+	"github.com/jmorris0x0/terraform-provider-k8sconnect/internal/k8sconnect/common/k8sclient"
 )
 
 func main() {
@@ -23,6 +25,17 @@ func main() {
 
 	err := providerserver.Serve(context.Background(), k8sconnect.New, opts)
 
+	// Serve returns when Terraform shuts the provider down at the end of the run. The
+	// summary is empty unless something was timed. Plain stderr lines are leveled by
+	// their prefix, so every line starts with [DEBUG] and the std log timestamp that
+	// would hide it is turned off; Terraform then shows them only with TF_LOG=DEBUG.
+	if summary := k8sclient.RunSummary(); summary != "" {
+		log.SetFlags(0)
+		for _, line := range strings.Split(summary, "\n") {
+			log.Printf("[DEBUG] %s", line)
+		}
+	}
+
 	if err != nil {
 		log.Fatal(err.Error())
 	}
@@ -29,6 +29,7 @@ func TestMarkdownDocumentation(t *testing.T) {
 		"../../docs/resources/wait.md",
 		"../../docs/resources/patch.md",
 		"../../docs/data-sources/object.md",
+		"../../docs/data-sources/diff.md",
 		"../../docs/data-sources/yaml_split.md",
 		"../../docs/data-sources/yaml_scoped.md",
 		"../../docs/guides/field-ownership.md",